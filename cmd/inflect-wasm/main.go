@@ -0,0 +1,77 @@
+//go:build js && wasm
+
+// Command inflect-wasm exposes go-inflect to JavaScript, so that frontend
+// code can use the same rules as the backend for UI strings.
+//
+// Build it with:
+//
+//	make wasm
+//
+// which writes dist/inflect.wasm and a copy of Go's wasm_exec.js. After
+// the module is started, the functions are available on globalThis.inflect:
+//
+//	const go = new Go();
+//	const { instance } = await WebAssembly.instantiateStreaming(fetch("inflect.wasm"), go.importObject);
+//	go.run(instance);
+//
+//	inflect.plural("child");      // "children"
+//	inflect.plural("child", 1);   // "child"
+//	inflect.singular("mice");     // "mouse"
+//	inflect.an("hour");           // "an hour"
+//	inflect.numberToWords(42);    // "forty-two"
+//
+// All functions use the package-level engine with its default settings.
+package main
+
+import (
+	"syscall/js"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func main() {
+	js.Global().Set("inflect", js.ValueOf(map[string]any{
+		"plural":        js.FuncOf(plural),
+		"singular":      js.FuncOf(singular),
+		"an":            js.FuncOf(an),
+		"numberToWords": js.FuncOf(numberToWords),
+	}))
+
+	// Keep the functions callable for the lifetime of the page
+	select {}
+}
+
+// plural implements inflect.plural(word, count?).
+func plural(_ js.Value, args []js.Value) any {
+	if len(args) == 0 {
+		return js.Undefined()
+	}
+	if len(args) > 1 && args[1].Type() == js.TypeNumber {
+		return inflect.PluralNoun(args[0].String(), args[1].Int())
+	}
+	return inflect.Plural(args[0].String())
+}
+
+// singular implements inflect.singular(word).
+func singular(_ js.Value, args []js.Value) any {
+	if len(args) == 0 {
+		return js.Undefined()
+	}
+	return inflect.Singular(args[0].String())
+}
+
+// an implements inflect.an(word).
+func an(_ js.Value, args []js.Value) any {
+	if len(args) == 0 {
+		return js.Undefined()
+	}
+	return inflect.An(args[0].String())
+}
+
+// numberToWords implements inflect.numberToWords(n).
+func numberToWords(_ js.Value, args []js.Value) any {
+	if len(args) == 0 || args[0].Type() != js.TypeNumber {
+		return js.Undefined()
+	}
+	return inflect.NumberToWords(args[0].Int())
+}
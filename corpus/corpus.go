@@ -0,0 +1,111 @@
+// Package corpus provides the test corpus of go-inflect: inputs to its core
+// functions and the outputs they are expected to give, such as "child" ->
+// "children" for Plural.
+//
+// go-inflect's own tests run against this corpus, and it is published so
+// that wrappers of go-inflect, such as bindings for other languages, and
+// libraries aiming for the same results can check themselves against it:
+//
+//	for _, fn := range corpus.Functions() {
+//		cases, _ := corpus.Cases(fn)
+//		for _, c := range cases {
+//			if got := myPlural(c.Input); fn == "plural" && got != c.Want {
+//				// report a difference
+//			}
+//		}
+//	}
+//
+// The corpus is also available as JSON files in the data directory of this
+// package, one per function, each an array of Case objects.
+package corpus
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownFunction is returned by Cases for a name that is not one of
+// Functions.
+var ErrUnknownFunction = errors.New("unknown corpus function")
+
+//go:embed data/*.json
+var files embed.FS
+
+// Case is an input to a function and the output expected from it, with
+// go-inflect's default settings.
+type Case struct {
+	// Group describes the kind of case, such as "Consonant + y -> ies".
+	Group string `json:"group"`
+
+	// Name identifies the case within its function.
+	Name string `json:"name"`
+
+	// Input is the argument passed to the function.
+	Input string `json:"input"`
+
+	// Want is the expected result.
+	Want string `json:"want"`
+
+	// Note is an optional remark about the case.
+	Note string `json:"note,omitempty"`
+}
+
+// Functions returns the names of the functions the corpus covers, in
+// alphabetical order. Each name is the go-inflect function in lower camel
+// case, as in its template FuncMap: "plural" for Plural, "an" for An, and
+// "pastTense" for PastTense.
+//
+// Examples:
+//   - Functions() returns ["an" "comparative" "pastParticiple" "pastTense" "plural" ...]
+func Functions() []string {
+	entries, err := files.ReadDir("data")
+	if err != nil {
+		// The directory is embedded, so it always exists
+		panic(err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Cases returns the cases for the function with the given name, one of
+// Functions, in the order they appear in the corpus. An unknown name
+// returns an error wrapping ErrUnknownFunction.
+//
+// Examples:
+//
+//	cases, _ := Cases("plural")
+//	// cases[1] is {Group: "Regular plurals - add s", Name: "cat", Input: "cat", Want: "cats"}
+//	_, err := Cases("pluralize") // err wraps ErrUnknownFunction
+func Cases(function string) ([]Case, error) {
+	data, err := files.ReadFile(path.Join("data", function+".json"))
+	if err != nil || strings.ContainsAny(function, "/.") {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFunction, function)
+	}
+	var cases []Case
+	if err := json.Unmarshal(data, &cases); err != nil {
+		return nil, fmt.Errorf("corpus: %s: %w", function, err)
+	}
+	return cases, nil
+}
+
+// Encode returns cases in the format of the corpus files, for tools that
+// regenerate them.
+func Encode(cases []Case) ([]byte, error) {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(cases); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
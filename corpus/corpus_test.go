@@ -0,0 +1,64 @@
+package corpus_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cv/go-inflect/v2/corpus"
+)
+
+func TestFunctions(t *testing.T) {
+	fns := corpus.Functions()
+	assert.Contains(t, fns, "plural")
+	assert.Contains(t, fns, "an")
+	assert.IsIncreasing(t, fns)
+}
+
+func TestCases(t *testing.T) {
+	for _, fn := range corpus.Functions() {
+		t.Run(fn, func(t *testing.T) {
+			cases, err := corpus.Cases(fn)
+			require.NoError(t, err)
+			require.NotEmpty(t, cases)
+
+			names := make(map[string]bool, len(cases))
+			for _, c := range cases {
+				assert.NotEmpty(t, c.Name)
+				assert.False(t, names[c.Name], "duplicate name %q", c.Name)
+				names[c.Name] = true
+			}
+		})
+	}
+}
+
+func TestCasesUnknown(t *testing.T) {
+	for _, fn := range []string{"pluralize", "", "../corpus", "data/plural"} {
+		_, err := corpus.Cases(fn)
+		assert.ErrorIs(t, err, corpus.ErrUnknownFunction, fn)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	cases, err := corpus.Cases("plural")
+	require.NoError(t, err)
+
+	data, err := corpus.Encode(cases[:2])
+	require.NoError(t, err)
+	assert.Equal(t, `[
+  {
+    "group": "Empty string",
+    "name": "empty",
+    "input": "",
+    "want": ""
+  },
+  {
+    "group": "Regular plurals - add s",
+    "name": "cat",
+    "input": "cat",
+    "want": "cats"
+  }
+]
+`, string(data))
+}
@@ -18,5 +18,7 @@
 //	inflect.An("apple")          // "an apple"
 //	inflect.NumberToWords(42)    // "forty-two"
 //
+//go:generate go run ./tools/gen-an-exceptions.go
+//go:generate go run ./tools/gen-context.go
 //go:generate go run ./tools/gen-exports.go
 package inflect
@@ -0,0 +1,135 @@
+// Package flect provides the naming functions of github.com/gobuffalo/flect
+// on top of go-inflect, to ease migrating code generators and ORMs.
+//
+// Replacing the import path is usually enough:
+//
+//	import "github.com/cv/go-inflect/v2/flect"
+//
+//	flect.Pluralize("person")    // "people"
+//	flect.Singularize("people")  // "person"
+//	flect.Camelize("widget_id")  // "widgetID"
+//	flect.Pascalize("widget_id") // "WidgetID"
+//
+// Inflection uses the package-level go-inflect engine, so rules added with
+// inflect.DefNoun and acronyms added with inflect.AddAcronym apply here too.
+// For counts, articles, numbers, and other grammar features, use the
+// inflect package directly.
+package flect
+
+import (
+	"strings"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+// Pluralize returns the plural form of a word. As in flect, a word that is
+// already plural is returned unchanged, and the default count set with
+// inflect.Num does not apply.
+//
+// Examples:
+//   - Pluralize("person") returns "people"
+//   - Pluralize("widget") returns "widgets"
+//   - Pluralize("people") returns "people"
+func Pluralize(s string) string {
+	return PluralizeWithSize(s, 2)
+}
+
+// PluralizeWithSize returns the plural form of a word, or the singular form
+// if size is 1 or -1.
+//
+// Examples:
+//   - PluralizeWithSize("person", 2) returns "people"
+//   - PluralizeWithSize("people", 1) returns "person"
+func PluralizeWithSize(s string, size int) string {
+	if size == 1 || size == -1 {
+		return inflect.SingularSafe(s)
+	}
+	return inflect.PluralSafe(s, size)
+}
+
+// Singularize returns the singular form of a word. As in flect, a word that
+// is already singular is returned unchanged.
+//
+// Examples:
+//   - Singularize("people") returns "person"
+//   - Singularize("widgets") returns "widget"
+//   - Singularize("status") returns "status"
+func Singularize(s string) string {
+	return inflect.SingularSafe(s)
+}
+
+// SingularizeWithSize returns the singular form of a word, or the plural
+// form if size is not 1 or -1.
+//
+// Examples:
+//   - SingularizeWithSize("people", 1) returns "person"
+//   - SingularizeWithSize("person", 2) returns "people"
+func SingularizeWithSize(s string, size int) string {
+	return PluralizeWithSize(s, size)
+}
+
+// Camelize returns a word in lower camel case, keeping registered
+// acronyms in uppercase.
+//
+// Examples:
+//   - Camelize("widget_id") returns "widgetID"
+//   - Camelize("user name") returns "userName"
+//   - Camelize("HTTPServer") returns "httpServer"
+func Camelize(s string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return ""
+	}
+	return strings.ToLower(words[0]) + titleWords(words[1:])
+}
+
+// Pascalize returns a word in upper camel case, keeping registered
+// acronyms in uppercase.
+//
+// Examples:
+//   - Pascalize("widget_id") returns "WidgetID"
+//   - Pascalize("user name") returns "UserName"
+//   - Pascalize("http_server") returns "HTTPServer"
+func Pascalize(s string) string {
+	return titleWords(splitWords(s))
+}
+
+// Underscore returns a word in lower snake case.
+//
+// Examples:
+//   - Underscore("WidgetID") returns "widget_id"
+//   - Underscore("user name") returns "user_name"
+func Underscore(s string) string {
+	return strings.Join(splitWords(s), "_")
+}
+
+// Dasherize returns a word in lower kebab case.
+//
+// Examples:
+//   - Dasherize("WidgetID") returns "widget-id"
+//   - Dasherize("user_name") returns "user-name"
+func Dasherize(s string) string {
+	return strings.Join(splitWords(s), "-")
+}
+
+// splitWords splits s into lowercase words at case changes, spaces,
+// underscores, and hyphens.
+func splitWords(s string) []string {
+	return strings.FieldsFunc(inflect.Underscore(s), func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+}
+
+// titleWords joins words with each one capitalized, or uppercased if it is
+// a registered acronym.
+func titleWords(words []string) string {
+	var b strings.Builder
+	for _, w := range words {
+		if inflect.IsAcronym(w) {
+			b.WriteString(strings.ToUpper(w))
+		} else {
+			b.WriteString(inflect.Capitalize(w))
+		}
+	}
+	return b.String()
+}
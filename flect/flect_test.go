@@ -0,0 +1,97 @@
+package flect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+	"github.com/cv/go-inflect/v2/flect"
+)
+
+func TestPluralize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "widget", want: "widgets"},
+		{input: "person", want: "people"},
+		{input: "box", want: "boxes"},
+		{input: "sheep", want: "sheep"},
+		{input: "cats", want: "cats"},
+		{input: "people", want: "people"},
+		{input: "users", want: "users"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, flect.Pluralize(tt.input))
+		})
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "widgets", want: "widget"},
+		{input: "people", want: "person"},
+		{input: "boxes", want: "box"},
+		{input: "sheep", want: "sheep"},
+		{input: "person", want: "person"},
+		{input: "status", want: "status"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, flect.Singularize(tt.input))
+		})
+	}
+}
+
+func TestWithSize(t *testing.T) {
+	assert.Equal(t, "people", flect.PluralizeWithSize("person", 2))
+	assert.Equal(t, "people", flect.PluralizeWithSize("person", 0))
+	assert.Equal(t, "person", flect.PluralizeWithSize("people", 1))
+	assert.Equal(t, "person", flect.PluralizeWithSize("people", -1))
+	assert.Equal(t, "person", flect.SingularizeWithSize("people", 1))
+	assert.Equal(t, "people", flect.SingularizeWithSize("person", 3))
+	assert.Equal(t, "people", flect.PluralizeWithSize("people", 2))
+}
+
+func TestPluralizeIgnoresNum(t *testing.T) {
+	defer inflect.Num()
+
+	inflect.Num(1)
+	assert.Equal(t, "people", flect.Pluralize("person"))
+	assert.Equal(t, "people", flect.PluralizeWithSize("person", 2))
+}
+
+func TestCase(t *testing.T) {
+	tests := []struct {
+		input      string
+		camel      string
+		pascal     string
+		underscore string
+		dasherize  string
+	}{
+		{input: "widget_id", camel: "widgetID", pascal: "WidgetID", underscore: "widget_id", dasherize: "widget-id"},
+		{input: "WidgetID", camel: "widgetID", pascal: "WidgetID", underscore: "widget_id", dasherize: "widget-id"},
+		{input: "user name", camel: "userName", pascal: "UserName", underscore: "user_name", dasherize: "user-name"},
+		{input: "foo-bar", camel: "fooBar", pascal: "FooBar", underscore: "foo_bar", dasherize: "foo-bar"},
+		{input: "HTTPServer", camel: "httpServer", pascal: "HTTPServer", underscore: "http_server", dasherize: "http-server"},
+		{input: "", camel: "", pascal: "", underscore: "", dasherize: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.camel, flect.Camelize(tt.input), "Camelize")
+			assert.Equal(t, tt.pascal, flect.Pascalize(tt.input), "Pascalize")
+			assert.Equal(t, tt.underscore, flect.Underscore(tt.input), "Underscore")
+			assert.Equal(t, tt.dasherize, flect.Dasherize(tt.input), "Dasherize")
+		})
+	}
+}
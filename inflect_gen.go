@@ -3,10 +3,128 @@
 package inflect
 
 import (
+	"context"
 	impl "github.com/cv/go-inflect/v2/internal/inflect"
 	"text/template"
+	"time"
 )
 
+// CacheStats reports how well an engine's result cache is working.
+type CacheStats = impl.CacheStats
+
+// GetCacheStats returns the statistics of the cache enabled by EnableCache.
+// It returns zero statistics if caching is disabled.
+func GetCacheStats() CacheStats {
+	return impl.GetCacheStats()
+}
+
+// ClassicalMode is a set of classical pluralization options for
+// WithClassical. Modes are combined with |.
+type ClassicalMode = impl.ClassicalMode
+
+const ClassicalModeZero = impl.ClassicalModeZero
+
+const ClassicalModeHerd = impl.ClassicalModeHerd
+
+const ClassicalModeNames = impl.ClassicalModeNames
+
+const ClassicalModeAncient = impl.ClassicalModeAncient
+
+const ClassicalModePersons = impl.ClassicalModePersons
+
+const ClassicalModeAll = impl.ClassicalModeAll
+
+// ClockStyle represents the style used by ClockToWordsWithStyle.
+type ClockStyle = impl.ClockStyle
+
+const ClockCasual = impl.ClockCasual
+
+const ClockFormal = impl.ClockFormal
+
+const ClockTwentyFourHour = impl.ClockTwentyFourHour
+
+// CompareResult is the result of CompareDetailed: how two words are
+// related, and the singular they share.
+type CompareResult = impl.CompareResult
+
+// CompareDetailed compares two nouns as Compare does, and also returns the
+// singular they are forms of.
+//
+// Examples:
+//   - CompareDetailed("cat", "cats") returns {RelSingularPlural, "cat"}
+//   - CompareDetailed("Mice", "mouse") returns {RelPluralSingular, "mouse"}
+//   - CompareDetailed("indexes", "indices") returns {RelPluralPlural, "index"}
+//   - CompareDetailed("cat", "Cat") returns {RelEqual, "cat"}
+//   - CompareDetailed("cat", "dog") returns {RelNone, ""}
+func CompareDetailed(word1 string, word2 string) CompareResult {
+	return impl.CompareDetailed(word1, word2)
+}
+
+// CompoundConj is the conjunction joining the subjects of AgreeCompound.
+type CompoundConj = impl.CompoundConj
+
+const CompoundAnd = impl.CompoundAnd
+
+const CompoundOr = impl.CompoundOr
+
+const CompoundEitherOr = impl.CompoundEitherOr
+
+const CompoundNeitherNor = impl.CompoundNeitherNor
+
+// CurrencyOptions configures CurrencyToWordsWithOptions. The zero value
+// gives the same result as CurrencyToWords.
+type CurrencyOptions = impl.CurrencyOptions
+
+// DateStyle represents the style used by DateToWordsWithStyle.
+type DateStyle = impl.DateStyle
+
+const DateSpelledOut = impl.DateSpelledOut
+
+const DateNumeric = impl.DateNumeric
+
+// Dialect is a variety of English whose pronunciation An and whose verb
+// spelling PresentParticiple, PastTense, and PastParticiple follow where
+// varieties differ.
+type Dialect = impl.Dialect
+
+const DialectDefault = impl.DialectDefault
+
+const DialectUS = impl.DialectUS
+
+const DialectUK = impl.DialectUK
+
+// GetDialect returns the dialect set with SetDialect.
+//
+// Examples:
+//
+//	GetDialect() // returns DialectDefault
+//	SetDialect(DialectUK)
+//	GetDialect() // returns DialectUK
+func GetDialect() Dialect {
+	return impl.GetDialect()
+}
+
+// DigitsToWordsOptions controls how DigitsToWordsWithOptions reads a digit string.
+//
+// The zero value reads zero as "zero" and separates digit groups with ", ".
+type DigitsToWordsOptions = impl.DigitsToWordsOptions
+
+// Domain is a subject area whose vocabulary SetDomainBias can make
+// Singular prefer, for plurals with more than one singular.
+type Domain = impl.Domain
+
+const DomainGeneral = impl.DomainGeneral
+
+const DomainSports = impl.DomainSports
+
+const DomainScience = impl.DomainScience
+
+const DomainMath = impl.DomainMath
+
+const DomainComputing = impl.DomainComputing
+
+const DomainBusiness = impl.DomainBusiness
+
 // Engine holds all mutable state for inflection operations.
 // Use NewEngine() to create an instance with default settings.
 // The Engine is safe for concurrent use; all methods are protected by a mutex.
@@ -22,13 +140,30 @@ import (
 // The following state is mutable and protected by Engine.mu:
 //   - Classical mode flags: classicalMode, classicalAll, classicalZero,
 //     classicalHerd, classicalNames, classicalAncient, classicalPersons
-//   - Custom noun mappings: irregularPlurals, singularIrregulars
+//   - Custom noun mappings: irregularPlurals, singularIrregulars, nounRules,
+//     classicalNouns
+//   - Custom invariant nouns: customUnchanged, customHerd
+//   - Plural suffix rules: suffixRules (replaced, never modified in place)
 //   - Custom verb mappings: customVerbs, customVerbsReverse
 //   - Custom adjective mappings: customAdjs, customAdjsReverse
-//   - Custom article patterns: customAWords, customAnWords, customAPatterns, customAnPatterns
+//   - Custom article patterns: customAWords, customAnWords, customAPatterns, customAnPatterns,
+//     customSounds
+//   - Custom collective nouns: customCollectives
+//   - Custom diminutives: customDiminutives
+//   - Stylized words: customStylized, customStylizedPlurals
+//   - Acronym registry: acronyms, acronymPronunciations
 //   - Gender setting: gender (for third-person pronoun singularization)
+//   - Dialect: dialect (for the articles of words like "herb")
 //   - Possessive style: possessiveStyle (modern vs traditional)
-//   - Default number: defaultNum (for Num/GetNum)
+//   - Proper names: properNameDetection, customProperNames
+//   - Default number: defaultNum (for Num/GetNum), numPropagation
+//   - Unicode normalization: normalizeInput
+//   - Technical plurals: technicalPlurals
+//   - Domain bias: domainBias
+//   - Lenient mode: lenient
+//   - Undo stack: undoStack (kept by Reset, not copied by Clone)
+//   - Result cache: cache (its contents have their own lock)
+//   - Lookup hook: hook (an atomic pointer, so lookups read it without locking)
 //
 // # Immutable State (package-level variables)
 //
@@ -39,23 +174,51 @@ import (
 //   - adjective.go: irregularComparatives, irregularSuperlatives, twoSyllableWithSuffix
 //   - adverb.go: irregularAdverbs, unchangedAdverbs
 //   - article.go: silentHWords, lowercaseAbbrevs
+//   - article_exceptions_gen.go: pronunciationExceptions
+//   - an_sentence.go: sentenceArticlePattern
+//   - collective.go: collectiveNouns
+//   - compact.go: compactScales
 //   - currency.go: currencies
-//   - number.go: onesCardinal, onesOrdinal, tensCardinal, tensOrdinal
+//   - dialect.go: dialectArticles, dialects, ukDoubleConsonantWords
+//   - diminutive.go: diminutives, diminutiveSuffixes, lingEndings
+//   - document.go: documentDeterminers, otherWords, personalSubjects, pluralPronouns,
+//     singularQuantifiers
+//   - domain.go: ambiguousSingulars
+//   - inflect_html.go: htmlSkipElements, htmlTextEscaper
+//   - guess.go: posSuffixes, plainPronouns
+//   - lemma.go: irregularVerbBases, irregularAdjectiveBases, lemmaUnchanged
+//   - lenient.go: regularizableNouns, regularizedPlurals, correctLookalikes
+//   - names.go: properNames
+//   - stylized.go: stylizedWords, stylizedPlurals
+//   - technical.go: technicalPlurals
+//   - nounphrase.go: phrasePrepositions
+//   - number.go: onesCardinal, onesOrdinal, tensCardinal, tensOrdinal, digitWords,
+//     scaleValues, unitValues, numberScales
 //   - ordinal.go: cardinalToOrdinal, ordinalToCardinalMap, ordinalWords
 //   - participle.go: doubleConsonantWords, irregularPastParticiples, knownParticiples
 //   - past_tense.go: irregularPastTense
+//   - people.go: personForms, personWords
+//   - pipeline.go: eitherNumberNouns
+//   - rulepack.go: rulePacks
+//   - rules.go: defaultSuffixRules
 //   - plural.go: changeToVesWords, oExceptionWords, unchangedPlurals, herdAnimals,
-//     classicalLatinPlurals, defaultIrregularPlurals
+//     eseNouns, classicalLatinPlurals, defaultIrregularPlurals
 //   - possessive.go: irregularPluralNoS, singularEndsInS, commonNouns, truncatedNames, validShortA
+//   - quantify.go: quantityBuckets
 //   - pronouns.go: pronounNominativePlural, pronounAccusativePlural, pronounPossessivePlural,
 //     pronounReflexivePlural, allPronounsToPlural, pronoun*SingularByGender maps
-//   - singular.go: feWordBases
+//   - singular.go: knownSingulars
+//   - time.go: durationUnits
 //   - verbs.go: verbSingularToPlural, verbPluralToSingular, verbUnchanged,
-//     adjSingularToPlural, adjPluralToSingular, adjPluralToSingularByGender
+//     verbNegatives, verbPositives, adjSingularToPlural, adjPluralToSingular,
+//     adjPluralToSingularByGender
 //
 // Compiled regular expressions (immutable after compilation):
 //   - inflect_funcs.go: inflectFuncPattern
+//   - markdown.go: markdownLinkPattern
+//   - ordinal.go: ordinalInStringPattern
 //   - rails.go: notURLSafe, multiSep
+//   - tense.go: sentenceWordPattern
 //
 // Function lookup tables (immutable after init):
 //   - inflect_funcs.go: inflectFuncs
@@ -91,6 +254,17 @@ func DefaultEngine() *impl.Engine {
 	return impl.DefaultEngine()
 }
 
+// EngineFromContext returns the Engine carried by ctx, or the default engine
+// used by the package-level functions if ctx does not carry one.
+//
+// Example:
+//
+//	EngineFromContext(context.Background()) // returns DefaultEngine()
+//	EngineFromContext(WithEngine(ctx, e))   // returns e
+func EngineFromContext(ctx context.Context) *impl.Engine {
+	return impl.EngineFromContext(ctx)
+}
+
 // NewEngine creates a new Engine instance with default settings.
 //
 // Default settings:
@@ -100,16 +274,321 @@ func DefaultEngine() *impl.Engine {
 //   - All custom maps are empty
 //   - Gender is "t" (singular they)
 //   - Possessive style is PossessiveModern
-//   - Default number is 0
+//   - Proper name detection is ProperNameHeuristic
+//   - Default number is 0, and count propagation is enabled
+//   - Dialect is DialectUS
+//
+// Options such as WithClassical and WithGender change these settings, and
+// are applied in order.
 //
 // Example:
 //
 //	e := NewEngine()
 //	e.Plural("cat") // returns "cats"
-func NewEngine() *impl.Engine {
-	return impl.NewEngine()
+//	e = NewEngine(WithClassical(ClassicalModeAncient), WithGender("f"))
+//	e.Plural("formula") // returns "formulae"
+func NewEngine(opts ...Option) *impl.Engine {
+	return impl.NewEngine(opts...)
+}
+
+// EngineSnapshot is a saved copy of an Engine's settings and custom
+// definitions, taken with Snapshot and put back with Restore.
+//
+// A snapshot is not affected by later changes to the engine it was taken
+// from, and it can be restored any number of times, to any engine.
+type EngineSnapshot = impl.EngineSnapshot
+
+// Snapshot returns a copy of the current settings and custom definitions,
+// such as those made with DefNoun, DefA, and Classical, for a later call to
+// Restore.
+//
+// Together with Restore, it lets a tool try out definitions and roll back
+// only those, where DefNounReset or Reset would also remove definitions made
+// earlier. Checkpoint and Undo keep a stack of snapshots for the same
+// purpose.
+//
+// Examples:
+//
+//	DefNoun("gadget", "gadgetz")
+//	s := Snapshot()
+//	DefNoun("widget", "widgetz")
+//	DefA("apple")
+//	Restore(s)
+//	Plural("widget") // returns "widgets"
+//	Plural("gadget") // returns "gadgetz"
+func Snapshot() *EngineSnapshot {
+	return impl.Snapshot()
+}
+
+// FormatNumberOptions controls digit grouping for FormatNumberWithOptions and
+// FormatFloatWithOptions.
+//
+// The zero value produces the same output as FormatNumber: commas between
+// groups of three digits, a period as the decimal separator, and no decimal
+// places.
+type FormatNumberOptions = impl.FormatNumberOptions
+
+// Hook observes the lookups made by Plural, Singular, and An.
+//
+// Register one with SetHook to count usage, find words that no dictionary
+// covers in production, and feed them back into DefNoun() or DefAn().
+// OnLookup is called after every lookup, including ones answered from the
+// cache, so it must be safe for concurrent use and should return quickly.
+type Hook = impl.Hook
+
+// HookFunc adapts an ordinary function to the Hook interface.
+type HookFunc = impl.HookFunc
+
+// InflectOptions configures InflectWithOptions.
+type InflectOptions = impl.InflectOptions
+
+// InflectToken is a mini-language call found by InflectTokens.
+type InflectToken = impl.InflectToken
+
+// InflectTokens returns the mini-language calls in text that Inflect would
+// consider, in order, without expanding them.
+//
+// It is meant for editors and linters that highlight or validate
+// inflection directives. Calls in Markdown code and escaped calls are not
+// returned, since Inflect leaves them alone.
+//
+// Examples:
+//   - InflectTokens("I saw plural('cat', 3)") returns one token with Start 6, End 22, Func "plural", and Args ["cat" "3"]
+//   - InflectTokens("plurl('cat')") returns one token whose Err is ErrUnknownFunc
+//   - InflectTokens("`plural('cat')`") returns no tokens
+func InflectTokens(text string) []InflectToken {
+	return impl.InflectTokens(text)
 }
 
+// InflectedName is an identifier and its inflected form.
+type InflectedName = impl.InflectedName
+
+// JoinAuthorsOptions configures JoinAuthorsWithOptions.
+//
+// The zero value lists every name, joined with "and" and an Oxford comma.
+type JoinAuthorsOptions = impl.JoinAuthorsOptions
+
+// NameMode is the form InflectStructNames gives each name.
+type NameMode = impl.NameMode
+
+const NamePlural = impl.NamePlural
+
+const NameSingular = impl.NameSingular
+
+// NounCorrection describes a misspelled or wrongly inflected irregular noun
+// found by CorrectNoun, and the noun it was taken for.
+type NounCorrection = impl.NounCorrection
+
+// CorrectNoun returns the irregular noun that word is a near-miss of, and
+// whether one was found.
+//
+// Three kinds of near-miss are recognized: regular plurals of nouns whose
+// only plural is irregular ("childs", "mouses"), plurals with an extra -s
+// ("childrens", "feets"), and misspellings of irregular nouns, found by
+// Levenshtein distance, counting a swap of two adjacent letters as one
+// edit. Words of 7 letters or more may be one edit away from the noun and
+// words of 10 letters or more two edits away, as long as they start with
+// the same letter and only one noun is that close. Shorter words are too
+// close to other words ("mice" and "rice") to be corrected. Nouns defined
+// with DefNoun are included, and words that are already correct, such as
+// "persons", are not corrected.
+//
+// CorrectNoun works whatever the lenient setting; SetLenient makes Plural
+// and Singular apply the corrections it finds.
+//
+// Examples:
+//
+//	c, ok := CorrectNoun("childs")
+//	// ok is true, c.Singular is "child", c.Plural is "children"
+//	c, _ = CorrectNoun("Phenomenas")
+//	// c.Singular is "Phenomenon", c.Plural is "Phenomena",
+//	// c.Note is `"Phenomenas" adds -s to the plural "Phenomena"`
+//	c, _ = CorrectNoun("chidren")
+//	// c.Note is `"chidren" is a misspelling of "children"`
+//	_, ok = CorrectNoun("cats") // ok is false
+func CorrectNoun(word string) (NounCorrection, bool) {
+	return impl.CorrectNoun(word)
+}
+
+// NumberToWordsBareOptions controls how NumberToWordsBareWithOptions reads
+// the groups of a number.
+//
+// The zero value reads zero as "zero" and speaks the zeros at the start of
+// each group.
+type NumberToWordsBareOptions = impl.NumberToWordsBareOptions
+
+// NumberWordOptions controls the style of the words written by
+// NumberToWordsWithOptions and OrdinalWordWithOptions, so that cardinal and
+// ordinal numbers in a text can share one style.
+//
+// The zero value writes the American style of NumberToWords and
+// OrdinalWord: "one hundred twenty-one", "one hundred twenty-first".
+type NumberWordOptions = impl.NumberWordOptions
+
+// Option configures an Engine created with NewEngine.
+//
+// Options make an engine's configuration a value that can be declared once,
+// stored, and shared, instead of a series of setter calls:
+//
+//	opts := []Option{WithClassical(ClassicalModeAncient), WithDialect(DialectUK)}
+//	e := NewEngine(opts...)
+//
+// Each option does the same as the corresponding setter, so an engine can
+// still be changed after it is created.
+type Option = impl.Option
+
+// WithClassical returns an Option that enables the given classical
+// pluralization options. Options not in modes keep their defaults.
+//
+// Examples:
+//
+//	e := NewEngine(WithClassical(ClassicalModeAncient | ClassicalModeNames))
+//	e.Plural("formula")   // returns "formulae"
+//	e.IsClassicalHerd()   // returns false
+//	e = NewEngine(WithClassical(ClassicalModeAll))
+//	e.IsClassicalAll()    // returns true
+func WithClassical(modes impl.ClassicalMode) Option {
+	return impl.WithClassical(modes)
+}
+
+// WithDialect returns an Option that sets the variety of English whose
+// pronunciation An follows, as SetDialect does.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.An("herb") // returns "a herb"
+func WithDialect(d Dialect) Option {
+	return impl.WithDialect(d)
+}
+
+// WithGender returns an Option that sets the gender of singular
+// third-person pronouns, as SetGender does. Invalid genders are ignored.
+//
+// Examples:
+//
+//	e := NewEngine(WithGender("f"))
+//	e.SingularNoun("they") // returns "she"
+func WithGender(g string) Option {
+	return impl.WithGender(g)
+}
+
+// WithRules returns an Option that replaces the suffix rules Plural falls
+// back to, as SetRules does.
+//
+// Examples:
+//
+//	rule := SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+//	e := NewEngine(WithRules(slices.Insert(Rules(), 1, rule)))
+//	e.Plural("hippopotamus") // returns "hippopotami"
+func WithRules(rules []SuffixRule) Option {
+	return impl.WithRules(rules)
+}
+
+// OrdinalDayStyle represents the style used by OrdinalDay.
+type OrdinalDayStyle = impl.OrdinalDayStyle
+
+const OrdinalDayMonthFirst = impl.OrdinalDayMonthFirst
+
+const OrdinalDayDayFirst = impl.OrdinalDayDayFirst
+
+const OrdinalDaySpelledOut = impl.OrdinalDaySpelledOut
+
+// OrdinalWordOptions controls how OrdinalWordWithOptions handles zero and
+// negative numbers, and the style of its words.
+//
+// The zero value behaves like OrdinalWord.
+type OrdinalWordOptions = impl.OrdinalWordOptions
+
+// PartOfSpeech identifies the word class used by Lemma.
+type PartOfSpeech = impl.PartOfSpeech
+
+const POSNoun = impl.POSNoun
+
+const POSVerb = impl.POSVerb
+
+const POSAdjective = impl.POSAdjective
+
+const POSPronoun = impl.POSPronoun
+
+const POSOther = impl.POSOther
+
+// GuessPOS guesses the part of speech of an English word.
+//
+// It returns the guess and a confidence between 0 and 1. Words found in the
+// internal pronoun, verb, noun, and adjective tables are classified with
+// high confidence; other words are classified by their endings ("-tion",
+// "-ous", "-ize", "-ing"). Words with no clues are guessed to be nouns with
+// low confidence.
+//
+// Examples:
+//   - GuessPOS("they") returns POSPronoun, 1.0
+//   - GuessPOS("went") returns POSVerb, 0.9
+//   - GuessPOS("children") returns POSNoun, 0.9
+//   - GuessPOS("better") returns POSAdjective, 0.9
+//   - GuessPOS("happiness") returns POSNoun, 0.8
+//   - GuessPOS("famous") returns POSAdjective, 0.8
+//   - GuessPOS("organize") returns POSVerb, 0.7
+//   - GuessPOS("table") returns POSNoun, 0.3
+func GuessPOS(word string) (PartOfSpeech, float64) {
+	return impl.GuessPOS(word)
+}
+
+// PersonSense is a meaning of "person" and "people", which decides the
+// plural and singular PluralPeople and SingularPeople return.
+type PersonSense = impl.PersonSense
+
+const PersonIndividuals = impl.PersonIndividuals
+
+const PersonFormal = impl.PersonFormal
+
+const PersonNations = impl.PersonNations
+
+// Pipeline is a sequence of Stages applied to text in order, for cleaning
+// up generated prose in a single call. It is created with NewPipeline and
+// is safe for concurrent use if its stages are.
+type Pipeline = impl.Pipeline
+
+// NewPipeline returns a Pipeline that applies the given stages in order.
+// Nil stages are skipped.
+//
+// Examples:
+//
+//	p := NewPipeline(FixArticles(), FixAgreement(), ExpandContractions())
+//	p.Apply("They doesn't want a apple.") // returns "They do not want an apple."
+func NewPipeline(stages ...Stage) *Pipeline {
+	return impl.NewPipeline(stages...)
+}
+
+// PluralForm is a plural of a noun and its kind.
+type PluralForm = impl.PluralForm
+
+// AllPlurals returns every accepted plural of a noun with its kind: the
+// modern plural first, then any assimilated and classical plurals.
+//
+// Unlike Plural, the result does not depend on classical or technical mode,
+// so it can be used to offer a choice of plurals. Nouns with a single
+// plural return one form, labeled PluralModern. Plurals defined with DefNoun
+// and DefNounMulti are included, and the forms keep the case of word.
+//
+// Examples:
+//   - AllPlurals("octopus") returns [{octopuses modern} {octopi assimilated} {octopodes classical}]
+//   - AllPlurals("person") returns [{people modern} {persons classical}]
+//   - AllPlurals("index") returns [{indexes modern} {indices classical}]
+//   - AllPlurals("cat") returns [{cats modern}]
+func AllPlurals(word string) []PluralForm {
+	return impl.AllPlurals(word)
+}
+
+// PluralLabel describes the kind of a plural form returned by AllPlurals.
+type PluralLabel = impl.PluralLabel
+
+const PluralModern = impl.PluralModern
+
+const PluralAssimilated = impl.PluralAssimilated
+
+const PluralClassical = impl.PluralClassical
+
 // PossessiveStyleType represents the style for forming possessives of words ending in s.
 type PossessiveStyleType = impl.PossessiveStyleType
 
@@ -122,11 +601,240 @@ func GetPossessiveStyle() impl.PossessiveStyleType {
 	return impl.GetPossessiveStyle()
 }
 
+// ProperNameDetection controls how Plural, Singular, and Possessive decide
+// whether a word is a proper name.
+type ProperNameDetection = impl.ProperNameDetection
+
+const ProperNameHeuristic = impl.ProperNameHeuristic
+
+const ProperNameOff = impl.ProperNameOff
+
+const ProperNameDictionary = impl.ProperNameDictionary
+
+// GetProperNameDetection returns how proper names are recognized.
+func GetProperNameDetection() ProperNameDetection {
+	return impl.GetProperNameDetection()
+}
+
+// QuantityBucket pairs a minimum count with a quantifier phrase, for use
+// with QuantifyCountWithBuckets.
+//
+// A bucket applies to counts from Min up to the Min of the next bucket.
+type QuantityBucket = impl.QuantityBucket
+
+// DefaultQuantityBuckets returns a copy of the buckets used by QuantifyCount.
+//
+// The result can be modified and passed to QuantifyCountWithBuckets to
+// adjust the thresholds or wording.
+func DefaultQuantityBuckets() []impl.QuantityBucket {
+	return impl.DefaultQuantityBuckets()
+}
+
+// Relation is how two words compared with Compare are related. Its values
+// are the strings Compare returns, so a Relation can be compared with them
+// directly: Relation(Compare("cat", "cats")) == RelSingularPlural.
+type Relation = impl.Relation
+
+const RelNone = impl.RelNone
+
+const RelEqual = impl.RelEqual
+
+const RelSingularPlural = impl.RelSingularPlural
+
+const RelPluralSingular = impl.RelPluralSingular
+
+const RelPluralPlural = impl.RelPluralPlural
+
+// RoundingMode is how CurrencyToWordsWithOptions rounds an amount to a whole
+// number of minor units.
+type RoundingMode = impl.RoundingMode
+
+const RoundHalfUp = impl.RoundHalfUp
+
+const RoundTruncate = impl.RoundTruncate
+
+// Stage is one step of a Pipeline: a function that takes a piece of text
+// and returns it corrected or rewritten.
+//
+// FixArticles, FixAgreement, and ExpandContractions return the built-in
+// stages. Any function with the same signature, such as Contract or
+// strings.TrimSpace, can be used as a stage too.
+type Stage = impl.Stage
+
+// ExpandContractions returns a Stage that replaces contractions with the
+// words they stand for, as Expand does.
+//
+// Examples:
+//
+//	ExpandContractions()("We can't stay.") // returns "We cannot stay."
+func ExpandContractions() Stage {
+	return impl.ExpandContractions()
+}
+
+// FixAgreement returns a Stage that makes present tense verbs agree with
+// their subjects.
+//
+// A verb is corrected after a subject pronoun ("he run" -> "he runs",
+// "I is" -> "I am"), and a form of "be", "have", "do", or a verb that
+// InflectDocument recognizes is corrected after a noun that follows a
+// determiner ("the cats is" -> "the cats are"). "It" and "you" count as
+// subjects only at the start of a sentence or clause, so that "give it
+// time" is unchanged. Like InflectDocument, this is a heuristic: verbs
+// separated from their subjects, as in "the cats on the mat is", are not
+// corrected.
+//
+// Examples:
+//
+//	FixAgreement()("He run and they runs.") // returns "He runs and they run."
+//	FixAgreement()("The servers was down.") // returns "The servers were down."
+//	FixAgreement()("I is ready.")           // returns "I am ready."
+func FixAgreement() Stage {
+	return impl.FixAgreement()
+}
+
+// FixArticles returns a Stage that corrects every indefinite article in the
+// text, as AnInSentence does.
+//
+// Examples:
+//
+//	FixArticles()("We saw a owl.") // returns "We saw an owl."
+func FixArticles() Stage {
+	return impl.FixArticles()
+}
+
+// StandardMethod is the verb of a gRPC service method, as named by
+// ServiceMethodName. Custom methods can use their own verb:
+// StandardMethod("Archive").
+type StandardMethod = impl.StandardMethod
+
+const MethodGet = impl.MethodGet
+
+const MethodList = impl.MethodList
+
+const MethodCreate = impl.MethodCreate
+
+const MethodUpdate = impl.MethodUpdate
+
+const MethodDelete = impl.MethodDelete
+
+const MethodBatchGet = impl.MethodBatchGet
+
+// StructNames holds the inflected names of a struct type and its fields,
+// as returned by InflectStructNames.
+type StructNames = impl.StructNames
+
+// InflectStructNames returns the plural or singular names of a struct type
+// and its exported fields, for code generators such as ORMs and OpenAPI
+// generators that name tables, collections, or properties after Go types.
+//
+// v may be a struct, a pointer to one, or its reflect.Type. Only the last
+// word of each identifier is inflected, keeping its case:
+// "UserProfile" -> "UserProfiles". A field tagged `inflect:"name"` is given
+// that name instead, and a field tagged `inflect:"-"` is left out. The
+// fields of embedded structs without a tag are included as if they were
+// declared in the outer struct, as encoding/json does. Names are made
+// plural even when a default count of 1 has been set with Num.
+//
+// It returns an error wrapping ErrNotStruct if v is not a struct.
+//
+// Examples:
+//
+//	type User struct {
+//		Address string
+//		Person  string `inflect:"staff"`
+//		Secret  string `inflect:"-"`
+//	}
+//	InflectStructNames(User{}, NamePlural)
+//	// returns {Type: {User Users}, Fields: [{Address Addresses} {Person staff}]}
+func InflectStructNames(v any, mode NameMode) (StructNames, error) {
+	return impl.InflectStructNames(v, mode)
+}
+
+// SuffixRule is one of the ordered rules that Plural falls back to for a
+// noun that is not in any table and matches no rule defined with
+// DefNounRule. The first rule that applies to a noun gives its plural.
+type SuffixRule = impl.SuffixRule
+
+// Rules returns a copy of the suffix rules that Plural falls back to, in the
+// order they are checked.
+//
+// The rules can be changed and passed to SetRules to reorder, insert, or
+// delete rules.
+//
+// Examples:
+//
+//	rules := Rules()
+//	rules[0].Name            // returns "unchanged for other scripts and symbols"
+//	rules[len(rules)-1].Name // returns "default + -s"
+func Rules() []SuffixRule {
+	return impl.Rules()
+}
+
+// Tense selects the tense of a verb phrase.
+type Tense = impl.Tense
+
+const TensePresent = impl.TensePresent
+
+const TensePast = impl.TensePast
+
+const TensePerfect = impl.TensePerfect
+
+const TenseFuture = impl.TenseFuture
+
+// Token is a word of a document, classified by InflectDocument.
+type Token = impl.Token
+
+// Transform is the change InflectDocument makes to a token, as chosen by
+// the caller's rule.
+type Transform = impl.Transform
+
+const TransformNone = impl.TransformNone
+
+const TransformPlural = impl.TransformPlural
+
+const TransformSingular = impl.TransformSingular
+
+// UnknownFuncPolicy controls what InflectWithOptions does with calls to
+// functions that are not part of the mini-language.
+type UnknownFuncPolicy = impl.UnknownFuncPolicy
+
+const UnknownFuncKeep = impl.UnknownFuncKeep
+
+const UnknownFuncError = impl.UnknownFuncError
+
 // A is an alias for An - returns word prefixed with appropriate indefinite article.
 func A(word string) string {
 	return impl.A(word)
 }
 
+// ACtx is like A but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ACtx(ctx context.Context, word string) string {
+	return impl.ACtx(ctx, word)
+}
+
+// ANumberOf returns "a number of" followed by the plural of noun and the
+// form of verb that agrees with it.
+//
+// "A number of" means "several" and takes a plural verb, unlike "the number
+// of", which takes a singular one (see NumberOfPhrase). The noun may be
+// given in the singular or plural, and only its last word is changed. The
+// verb is matched with AgreeVerb; an empty verb returns the subject alone.
+//
+// Examples:
+//   - ANumberOf("error", "was found") returns "a number of errors were found"
+//   - ANumberOf("users", "has") returns "a number of users have"
+//   - ANumberOf("child", "") returns "a number of children"
+func ANumberOf(noun string, verb string) string {
+	return impl.ANumberOf(noun, verb)
+}
+
+// ANumberOfCtx is like ANumberOf but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ANumberOfCtx(ctx context.Context, noun string, verb string) string {
+	return impl.ANumberOfCtx(ctx, noun, verb)
+}
+
 // AddAcronym registers an acronym that should preserve its case in humanization.
 //
 // Acronyms are matched case-insensitively. For example, AddAcronym("GPU") will
@@ -179,6 +887,99 @@ func Adverb(adj string) string {
 	return impl.Adverb(adj)
 }
 
+// AgreeCompound joins subjects with "and" and appends the form of verb that
+// agrees with them.
+//
+// Two or more subjects joined with "and" take a plural verb, unless the
+// first starts with "each" or "every". A single subject takes the verb
+// that agrees with it. See AgreeCompoundWithConj for "or", "either ...
+// or", and "neither ... nor".
+//
+// Examples:
+//   - AgreeCompound([]string{"Alice", "Bob"}, "is") returns "Alice and Bob are"
+//   - AgreeCompound([]string{"Alice", "Bob", "Carol"}, "has") returns "Alice, Bob, and Carol have"
+//   - AgreeCompound([]string{"the cat"}, "are") returns "the cat is"
+//   - AgreeCompound([]string{"every man", "woman"}, "are") returns "every man and woman is"
+func AgreeCompound(subjects []string, verb string) string {
+	return impl.AgreeCompound(subjects, verb)
+}
+
+// AgreeCompoundCtx is like AgreeCompound but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeCompoundCtx(ctx context.Context, subjects []string, verb string) string {
+	return impl.AgreeCompoundCtx(ctx, subjects, verb)
+}
+
+// AgreeCompoundWithConj joins subjects with the given conjunction and
+// appends the form of verb that agrees with them.
+//
+// Subjects joined with CompoundAnd are plural, as in AgreeCompound. With
+// CompoundOr, CompoundEitherOr, and CompoundNeitherNor the verb agrees
+// with the nearest subject, which is the last one. A subject is plural if
+// its last word is a plural noun or a plural pronoun such as "they";
+// capitalized names are singular, and "I" takes "am" and "was".
+//
+// Examples:
+//   - AgreeCompoundWithConj([]string{"the cat", "the dogs"}, CompoundEitherOr, "is") returns "either the cat or the dogs are"
+//   - AgreeCompoundWithConj([]string{"the dogs", "the cat"}, CompoundNeitherNor, "are") returns "neither the dogs nor the cat is"
+//   - AgreeCompoundWithConj([]string{"Alice", "Bob"}, CompoundOr, "have") returns "Alice or Bob has"
+//   - AgreeCompoundWithConj([]string{"you", "I"}, CompoundEitherOr, "is") returns "either you or I am"
+func AgreeCompoundWithConj(subjects []string, conj CompoundConj, verb string) string {
+	return impl.AgreeCompoundWithConj(subjects, conj, verb)
+}
+
+// AgreeCompoundWithConjCtx is like AgreeCompoundWithConj but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeCompoundWithConjCtx(ctx context.Context, subjects []string, conj CompoundConj, verb string) string {
+	return impl.AgreeCompoundWithConjCtx(ctx, subjects, conj, verb)
+}
+
+// AgreeVerb returns the form of a present-tense verb that agrees with a
+// subject of the given count.
+//
+// A count of 1 or -1 takes the third-person singular form; any other count
+// takes the plural form. The verb may be given in either form. Contractions
+// are supported, modal verbs ("can", "must", "won't") are returned
+// unchanged, and in a multi-word verb phrase only the first word is changed.
+//
+// Examples:
+//   - AgreeVerb(1, "have") returns "has"
+//   - AgreeVerb(3, "is") returns "are"
+//   - AgreeVerb(0, "was") returns "were"
+//   - AgreeVerb(1, "run") returns "runs"
+//   - AgreeVerb(2, "doesn't") returns "don't"
+//   - AgreeVerb(1, "can") returns "can"
+//   - AgreeVerb(3, "has been") returns "have been"
+func AgreeVerb(count int, verb string) string {
+	return impl.AgreeVerb(count, verb)
+}
+
+// AgreeVerbCtx is like AgreeVerb but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeVerbCtx(ctx context.Context, count int, verb string) string {
+	return impl.AgreeVerbCtx(ctx, count, verb)
+}
+
+// AmountPhrase returns a phrase for an amount below n of noun, using
+// FewerOrLess and the count spelled out in words, for messages such as
+// validation errors. A countable noun is made plural unless n is 1 or -1;
+// a mass noun is left as it is.
+//
+// Examples:
+//   - AmountPhrase(5, "item") returns "fewer than five items"
+//   - AmountPhrase(5, "water") returns "less than five water"
+//   - AmountPhrase(10, "minute") returns "less than ten minutes"
+//   - AmountPhrase(1, "child") returns "fewer than one child"
+func AmountPhrase(n int, noun string) string {
+	return impl.AmountPhrase(n, noun)
+}
+
+// AmountPhraseCtx is like AmountPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AmountPhraseCtx(ctx context.Context, n int, noun string) string {
+	return impl.AmountPhraseCtx(ctx, n, noun)
+}
+
 // An returns the word prefixed with the appropriate indefinite article ("a" or "an").
 //
 // The selection follows standard English rules:
@@ -189,6 +990,9 @@ func Adverb(adj string) string {
 //   - Silent 'h': "an honest person"
 //   - Vowels with consonant sounds: "a Ukrainian", "a unanimous decision"
 //   - Abbreviations: "a YAML file", "a JSON object"
+//   - Numbers and numeric ordinals, as read aloud: "an 8", "an 11th hour"
+//   - Quoted, bracketed, or markup-wrapped words: "an \"honest\" answer",
+//     "an <em>apple</em>"; the original text is returned unchanged
 //
 // Custom patterns defined via DefA(), DefAn(), DefAPattern(), and DefAnPattern()
 // take precedence over default rules.
@@ -196,6 +1000,152 @@ func An(word string) string {
 	return impl.An(word)
 }
 
+// AnCapitalized returns the word prefixed with a capitalized indefinite
+// article ("A" or "An"), for use at the start of a sentence.
+//
+// The word itself is left unchanged.
+//
+// Examples:
+//   - AnCapitalized("apple") returns "An apple"
+//   - AnCapitalized("cat") returns "A cat"
+//   - AnCapitalized("hour") returns "An hour"
+func AnCapitalized(word string) string {
+	return impl.AnCapitalized(word)
+}
+
+// AnCapitalizedCtx is like AnCapitalized but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCapitalizedCtx(ctx context.Context, word string) string {
+	return impl.AnCapitalizedCtx(ctx, word)
+}
+
+// AnCount returns the word prefixed with its indefinite article if count is
+// 1, or with the count otherwise.
+//
+// This matches Python inflect's a(text, count): the word is not
+// pluralized, so pass a plural word for counts other than 1.
+//
+// Examples:
+//   - AnCount("apple", 1) returns "an apple"
+//   - AnCount("cat", 1) returns "a cat"
+//   - AnCount("cats", 2) returns "2 cats"
+//   - AnCount("cats", 0) returns "0 cats"
+func AnCount(word string, count int) string {
+	return impl.AnCount(word, count)
+}
+
+// AnCountCtx is like AnCount but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCountCtx(ctx context.Context, word string, count int) string {
+	return impl.AnCountCtx(ctx, word, count)
+}
+
+// AnCtx is like An but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCtx(ctx context.Context, word string) string {
+	return impl.AnCtx(ctx, word)
+}
+
+// AnInSentence corrects every indefinite article in a piece of text, so
+// that each "a" or "an" agrees with the word after it, using the same rules
+// as An.
+//
+// Lowercase articles are corrected anywhere, capitalized ones at the start
+// of a sentence, and uppercase ones between uppercase words. A capital "A"
+// elsewhere, as in "plan A or plan B", is usually a letter rather than an
+// article and is left alone, although in text written in uppercase the two
+// cannot be told apart. Each corrected
+// article keeps its case ("A" -> "An", "AN" -> "A"), and the rest of the
+// text is unchanged. It is meant as a post-processing pass over generated
+// text, where articles are often chosen before the words that follow them.
+//
+// Examples:
+//   - AnInSentence("We saw a owl and an unicorn.") returns "We saw an owl and a unicorn."
+//   - AnInSentence("An user left. A hour later, a FBI agent came.") returns "A user left. An hour later, an FBI agent came."
+//   - AnInSentence("Plan A is an plan.") returns "Plan A is a plan."
+func AnInSentence(text string) string {
+	return impl.AnInSentence(text)
+}
+
+// AnInSentenceCtx is like AnInSentence but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnInSentenceCtx(ctx context.Context, text string) string {
+	return impl.AnInSentenceCtx(ctx, text)
+}
+
+// AppendInflect appends text with its inflection function calls expanded,
+// as Inflect returns it, to dst and returns the extended buffer.
+//
+// Code that expands many texts, such as a rendering loop, can reuse one
+// buffer to avoid allocating a string for each.
+//
+// Examples:
+//   - AppendInflect([]byte("> "), "I saw plural('cat', 3)") returns []byte("> I saw cats")
+func AppendInflect(dst []byte, text string) []byte {
+	return impl.AppendInflect(dst, text)
+}
+
+// AppendJoin appends the list Join returns for words to dst and returns
+// the extended buffer.
+//
+// It does not allocate when dst has room for the list, so code that writes
+// many lists, such as a template rendering loop, can reuse one buffer.
+//
+// Examples:
+//   - AppendJoin([]byte("Pick "), []string{"a", "b", "c"}) returns []byte("Pick a, b, and c")
+//   - AppendJoin(nil, []string{"a"}) returns []byte("a")
+func AppendJoin(dst []byte, words []string) []byte {
+	return impl.AppendJoin(dst, words)
+}
+
+// AppendJoinWithConj appends the list JoinWithConj returns for words and
+// conj to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithConj(nil, []string{"a", "b", "c"}, "or") returns []byte("a, b, or c")
+func AppendJoinWithConj(dst []byte, words []string, conj string) []byte {
+	return impl.AppendJoinWithConj(dst, words, conj)
+}
+
+// AppendJoinWithFinalSep appends the list JoinWithFinalSep returns for
+// words, conj, sep, and finalSep to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithFinalSep(nil, []string{"a", "b", "c"}, "and", ", ", " ") returns []byte("a, b and c")
+func AppendJoinWithFinalSep(dst []byte, words []string, conj string, sep string, finalSep string) []byte {
+	return impl.AppendJoinWithFinalSep(dst, words, conj, sep, finalSep)
+}
+
+// AppendJoinWithSep appends the list JoinWithSep returns for words, conj,
+// and sep to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithSep(nil, []string{"a", "b", "c"}, "and", "; ") returns []byte("a; b; and c")
+func AppendJoinWithSep(dst []byte, words []string, conj string, sep string) []byte {
+	return impl.AppendJoinWithSep(dst, words, conj, sep)
+}
+
+// ArticleFor returns the indefinite article ("a" or "an") appropriate for the
+// word, without prefixing it.
+//
+// This uses the same rules as An(), including custom patterns. It returns
+// an empty string if word is empty or contains only whitespace.
+//
+// Examples:
+//   - ArticleFor("hour") returns "an"
+//   - ArticleFor("cat") returns "a"
+//   - ArticleFor("university") returns "a"
+//   - ArticleFor("FBI agent") returns "an"
+func ArticleFor(word string) string {
+	return impl.ArticleFor(word)
+}
+
+// ArticleForCtx is like ArticleFor but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ArticleForCtx(ctx context.Context, word string) string {
+	return impl.ArticleForCtx(ctx, word)
+}
+
 // Asciify removes or transliterates non-ASCII characters from a string.
 // Accented characters are converted to their ASCII equivalents where possible.
 //
@@ -211,6 +1161,25 @@ func Asciify(word string) string {
 	return impl.Asciify(word)
 }
 
+// BaseVerb returns the base form of a present participle.
+//
+// The -ing ending is removed only when a valid base remains: either a verb
+// in the irregular verb tables, or a base whose regular participle is the
+// given word. Doubled consonants, dropped silent e's, and -ie verbs are
+// restored. Words that are not present participles, including base verbs
+// that end in -ing, are returned unchanged.
+//
+// Examples:
+//   - BaseVerb("running") returns "run" (undouble consonant)
+//   - BaseVerb("making") returns "make" (restore silent e)
+//   - BaseVerb("dying") returns "die" (ying -> ie)
+//   - BaseVerb("singing") returns "sing"
+//   - BaseVerb("panicking") returns "panic"
+//   - BaseVerb("bring") returns "bring" (already a base verb)
+func BaseVerb(word string) string {
+	return impl.BaseVerb(word)
+}
+
 // CamelCase converts a string to camelCase.
 //
 // It handles snake_case, kebab-case, and mixed inputs.
@@ -256,6 +1225,100 @@ func Capitalize(s string) string {
 	return impl.Capitalize(s)
 }
 
+// CapitalizeSentence capitalizes the first letter of each sentence in s.
+//
+// A sentence starts at the beginning of s and after a period, question
+// mark, or exclamation mark followed by a space, skipping any quotes or
+// brackets. Common abbreviations such as "e.g." and "Dr.", single
+// initials, and a quoted question or exclamation do not end a sentence. Nothing is lowercased, and words like
+// "iPhone" and "eBay", which start with a lowercase letter but contain an
+// uppercase one, are left unchanged.
+//
+// Examples:
+//   - CapitalizeSentence("no errors were found. an apple a day.") returns "No errors were found. An apple a day."
+//   - CapitalizeSentence("3 files changed! see the log") returns "3 files changed! See the log"
+//   - CapitalizeSentence("see e.g. the FAQ") returns "See e.g. the FAQ"
+//   - CapitalizeSentence("iPhone sales grew") returns "iPhone sales grew"
+//   - CapitalizeSentence(`"why?" she asked`) returns `"Why?" she asked`
+func CapitalizeSentence(s string) string {
+	return impl.CapitalizeSentence(s)
+}
+
+// CardinalWord converts the magnitude of an integer to its English word
+// representation: NumberToWords without the "negative" prefix.
+//
+// It is meant for composing phrases from number words, where the sign is
+// worded separately or does not apply.
+//
+// Examples:
+//   - CardinalWord(0) returns "zero"
+//   - CardinalWord(42) returns "forty-two"
+//   - CardinalWord(1001) returns "one thousand one"
+//   - CardinalWord(-5) returns "five"
+func CardinalWord(n int) string {
+	return impl.CardinalWord(n)
+}
+
+// ChangeTense rewrites the main verb of a simple sentence in the given
+// tense.
+//
+// The main verb is the first word that the verb tables, or its position
+// after the subject, mark as a verb. Auxiliaries are rewritten together
+// with the verb they go with, and negation is kept. The new verb agrees
+// with the subject. Clauses built on a modal other than "will", such as
+// "can", have no tenses and are returned unchanged. This is a best-effort
+// heuristic for short generated sentences, not a parser: it does not
+// handle questions, subordinate clauses, or more than one verb. If no verb
+// is found, the sentence is returned unchanged with an error wrapping
+// ErrNoVerb.
+//
+// Examples:
+//   - ChangeTense("The job runs nightly", TensePast) returns "The job ran nightly"
+//   - ChangeTense("The build is green", TensePast) returns "The build was green"
+//   - ChangeTense("The users log in", TensePerfect) returns "The users have logged in"
+//   - ChangeTense("She doesn't know", TensePast) returns "She didn't know"
+//   - ChangeTense("The job ran", TenseFuture) returns "The job will run"
+//   - ChangeTense("Nightly", TensePast) returns ("Nightly", ErrNoVerb)
+func ChangeTense(sentence string, tense Tense) (string, error) {
+	return impl.ChangeTense(sentence, tense)
+}
+
+// CheckAmount writes an amount in US dollars the way it is written on a
+// printed check. It is CurrencyToWordsWithOptions with Check set, for
+// "USD".
+//
+// Examples:
+//   - CheckAmount(1234.5) returns "One thousand two hundred thirty-four and 50/100 dollars"
+//   - CheckAmount(100) returns "One hundred and no/100 dollars"
+//   - CheckAmount(0.99) returns "Zero and 99/100 dollars"
+//   - CheckAmount(-5) returns ""
+func CheckAmount(amount float64) string {
+	return impl.CheckAmount(amount)
+}
+
+// Checkpoint saves the current settings and custom definitions on an undo
+// stack, to be restored by Undo.
+//
+// Each Undo restores the most recent checkpoint that has not been undone,
+// so nested experiments can be rolled back one at a time. Reset does not
+// clear the stack, so a Reset after a Checkpoint can be undone.
+//
+// Examples:
+//
+//	Checkpoint()
+//	DefNoun("widget", "widgetz")
+//	Checkpoint()
+//	DefNoun("gadget", "gadgetz")
+//	Undo()           // returns true
+//	Plural("gadget") // returns "gadgets"
+//	Plural("widget") // returns "widgetz"
+//	Undo()           // returns true
+//	Plural("widget") // returns "widgets"
+//	Undo()           // returns false
+func Checkpoint() {
+	impl.Checkpoint()
+}
+
 // Classical enables or disables classical pluralization mode.
 //
 // This is an alias for ClassicalAll() for backward compatibility.
@@ -441,6 +1504,148 @@ func ClearAcronyms() {
 	impl.ClearAcronyms()
 }
 
+// ClockToWords converts a time of day to words in the casual spoken style.
+//
+// The hour is given on a 24-hour clock (0-23) and the minute must be 0-59;
+// out-of-range values return an empty string.
+//
+// Examples:
+//   - ClockToWords(14, 35) returns "twenty-five to three"
+//   - ClockToWords(14, 30) returns "half past two"
+//   - ClockToWords(9, 15) returns "quarter past nine"
+//   - ClockToWords(9, 7) returns "seven minutes past nine"
+//   - ClockToWords(15, 0) returns "three o'clock"
+//   - ClockToWords(12, 0) returns "noon"
+//   - ClockToWords(0, 0) returns "midnight"
+func ClockToWords(hour int, minute int) string {
+	return impl.ClockToWords(hour, minute)
+}
+
+// ClockToWordsWithStyle converts a time of day to words in the given style.
+//
+// The hour is given on a 24-hour clock (0-23) and the minute must be 0-59;
+// out-of-range values return an empty string.
+//
+// Examples:
+//   - ClockToWordsWithStyle(14, 35, ClockCasual) returns "twenty-five to three"
+//   - ClockToWordsWithStyle(14, 35, ClockFormal) returns "two thirty-five"
+//   - ClockToWordsWithStyle(14, 5, ClockFormal) returns "two oh five"
+//   - ClockToWordsWithStyle(14, 0, ClockFormal) returns "two o'clock"
+//   - ClockToWordsWithStyle(14, 35, ClockTwentyFourHour) returns "fourteen thirty-five"
+//   - ClockToWordsWithStyle(9, 0, ClockTwentyFourHour) returns "oh nine hundred"
+func ClockToWordsWithStyle(hour int, minute int, style ClockStyle) string {
+	return impl.ClockToWordsWithStyle(hour, minute, style)
+}
+
+// CollectiveNoun returns the collective noun for a group of the given noun.
+//
+// The noun may be singular or plural. Nouns without a known collective
+// return "group". Use DefCollective to add or override entries.
+//
+// Examples:
+//   - CollectiveNoun("lion") returns "pride"
+//   - CollectiveNoun("crows") returns "murder"
+//   - CollectiveNoun("owl") returns "parliament"
+//   - CollectiveNoun("Goose") returns "gaggle"
+//   - CollectiveNoun("engineer") returns "group"
+func CollectiveNoun(noun string) string {
+	return impl.CollectiveNoun(noun)
+}
+
+// CollectiveNounCtx is like CollectiveNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CollectiveNounCtx(ctx context.Context, noun string) string {
+	return impl.CollectiveNounCtx(ctx, noun)
+}
+
+// CollectivePhrase returns a phrase naming a group of the given noun, such
+// as "pride of lions".
+//
+// The noun may be singular or plural and keeps its case. Nouns without a
+// known collective use "group".
+//
+// Examples:
+//   - CollectivePhrase("lion") returns "pride of lions"
+//   - CollectivePhrase("crows") returns "murder of crows"
+//   - CollectivePhrase("goose") returns "gaggle of geese"
+//   - CollectivePhrase("engineer") returns "group of engineers"
+func CollectivePhrase(noun string) string {
+	return impl.CollectivePhrase(noun)
+}
+
+// CollectivePhraseCtx is like CollectivePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CollectivePhraseCtx(ctx context.Context, noun string) string {
+	return impl.CollectivePhraseCtx(ctx, noun)
+}
+
+// ColumnName returns the SQL column name for a Go field name: its
+// snake_case form.
+//
+// Examples:
+//   - ColumnName("CreatedAt") returns "created_at"
+//   - ColumnName("UserID") returns "user_id"
+//   - ColumnName("HTMLBody") returns "html_body"
+func ColumnName(fieldName string) string {
+	return impl.ColumnName(fieldName)
+}
+
+// CompactNumber formats an integer in a short human-readable form using
+// K, M, B, and T suffixes, rounded to one decimal place.
+//
+// Trailing zeros after the decimal point are dropped, and numbers below
+// 1,000 are returned unchanged.
+//
+// Examples:
+//   - CompactNumber(999) returns "999"
+//   - CompactNumber(1000) returns "1K"
+//   - CompactNumber(1234) returns "1.2K"
+//   - CompactNumber(3400000) returns "3.4M"
+//   - CompactNumber(999999) returns "1M"
+//   - CompactNumber(-1500) returns "-1.5K"
+func CompactNumber(n int) string {
+	return impl.CompactNumber(n)
+}
+
+// CompactNumberWithPrecision formats an integer in a short human-readable
+// form using K, M, B, and T suffixes, rounded to the given number of decimal
+// places. A negative precision is treated as zero.
+//
+// Examples:
+//   - CompactNumberWithPrecision(1234, 0) returns "1K"
+//   - CompactNumberWithPrecision(1234, 2) returns "1.23K"
+//   - CompactNumberWithPrecision(1234567, 3) returns "1.235M"
+//   - CompactNumberWithPrecision(1500000, 2) returns "1.5M"
+func CompactNumberWithPrecision(n int, precision int) string {
+	return impl.CompactNumberWithPrecision(n, precision)
+}
+
+// CompactNumberWords formats an integer in a short human-readable form using
+// scale words (thousand, million, billion, trillion), rounded to one decimal
+// place.
+//
+// Examples:
+//   - CompactNumberWords(999) returns "999"
+//   - CompactNumberWords(1234) returns "1.2 thousand"
+//   - CompactNumberWords(1234567) returns "1.2 million"
+//   - CompactNumberWords(2000000000) returns "2 billion"
+//   - CompactNumberWords(-3400000) returns "-3.4 million"
+func CompactNumberWords(n int) string {
+	return impl.CompactNumberWords(n)
+}
+
+// CompactNumberWordsWithPrecision formats an integer in a short human-readable
+// form using scale words, rounded to the given number of decimal places.
+// A negative precision is treated as zero.
+//
+// Examples:
+//   - CompactNumberWordsWithPrecision(1234567, 0) returns "1 million"
+//   - CompactNumberWordsWithPrecision(1234567, 2) returns "1.23 million"
+//   - CompactNumberWordsWithPrecision(1250000000, 2) returns "1.25 billion"
+func CompactNumberWordsWithPrecision(n int, precision int) string {
+	return impl.CompactNumberWordsWithPrecision(n, precision)
+}
+
 // Comparative returns the comparative form of an English adjective.
 //
 // Examples:
@@ -455,17 +1660,25 @@ func Comparative(adj string) string {
 // Compare compares two words for singular/plural equality.
 //
 // It returns:
-//   - "eq" if the words are equal (case-insensitive)
-//   - "s:p" if word1 is singular and word2 is its plural form
-//   - "p:s" if word1 is plural and word2 is its singular form
-//   - "p:p" if both words are different plural forms of the same word
-//   - "" if the words are not related
+//   - "eq" (RelEqual) if the words are equal (case-insensitive)
+//   - "s:p" (RelSingularPlural) if word1 is singular and word2 is its plural form
+//   - "p:s" (RelPluralSingular) if word1 is plural and word2 is its singular form
+//   - "p:p" (RelPluralPlural) if both words are different plural forms of the same word
+//   - "" (RelNone) if the words are not related
+//
+// Both the modern and the classical plurals count, whatever the classical
+// mode, as do plurals defined with DefNoun and DefNounMulti, so that
+// "formula" and "formulae" are related even when Plural("formula") returns
+// "formulas". CompareDetailed returns the Relation together with the
+// shared singular.
 //
 // Examples:
 //   - Compare("cat", "cat") returns "eq"
 //   - Compare("cat", "cats") returns "s:p"
 //   - Compare("cats", "cat") returns "p:s"
 //   - Compare("indexes", "indices") returns "p:p"
+//   - Compare("formula", "formulae") returns "s:p"
+//   - Compare("formulas", "formulae") returns "p:p"
 //   - Compare("cat", "dog") returns ""
 func Compare(word1 string, word2 string) string {
 	return impl.Compare(word1, word2)
@@ -490,6 +1703,18 @@ func CompareAdjs(adj1 string, adj2 string) string {
 	return impl.CompareAdjs(adj1, adj2)
 }
 
+// CompareAdjsCtx is like CompareAdjs but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareAdjsCtx(ctx context.Context, adj1 string, adj2 string) string {
+	return impl.CompareAdjsCtx(ctx, adj1, adj2)
+}
+
+// CompareCtx is like Compare but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareCtx(ctx context.Context, word1 string, word2 string) string {
+	return impl.CompareCtx(ctx, word1, word2)
+}
+
 // CompareNouns compares two nouns for singular/plural equality.
 //
 // This is an alias for Compare that makes the intent explicit when working
@@ -509,6 +1734,12 @@ func CompareNouns(noun1 string, noun2 string) string {
 	return impl.CompareNouns(noun1, noun2)
 }
 
+// CompareNounsCtx is like CompareNouns but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareNounsCtx(ctx context.Context, noun1 string, noun2 string) string {
+	return impl.CompareNounsCtx(ctx, noun1, noun2)
+}
+
 // CompareVerbs compares two verbs for singular/plural equality.
 //
 // This compares verbs using verb pluralization rules (3rd person singular vs base form).
@@ -528,6 +1759,68 @@ func CompareVerbs(verb1 string, verb2 string) string {
 	return impl.CompareVerbs(verb1, verb2)
 }
 
+// CompareVerbsCtx is like CompareVerbs but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareVerbsCtx(ctx context.Context, verb1 string, verb2 string) string {
+	return impl.CompareVerbsCtx(ctx, verb1, verb2)
+}
+
+// ConnectionFieldName returns the name of the field returning the Relay
+// connection of a type, next to the plain list of QueryFieldName: its
+// plural in camelCase followed by "Connection".
+//
+// Examples:
+//   - ConnectionFieldName("Order") returns "ordersConnection"
+//   - ConnectionFieldName("Person") returns "peopleConnection"
+func ConnectionFieldName(typeName string) string {
+	return impl.ConnectionFieldName(typeName)
+}
+
+// ConnectionFieldNameCtx is like ConnectionFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ConnectionFieldNameCtx(ctx context.Context, typeName string) string {
+	return impl.ConnectionFieldNameCtx(ctx, typeName)
+}
+
+// ConnectionName returns the name of the Relay connection type for a
+// type: its singular in PascalCase followed by "Connection".
+//
+// Examples:
+//   - ConnectionName("Order") returns "OrderConnection"
+//   - ConnectionName("orders") returns "OrderConnection"
+//   - ConnectionName("user_profile") returns "UserProfileConnection"
+func ConnectionName(typeName string) string {
+	return impl.ConnectionName(typeName)
+}
+
+// ConnectionNameCtx is like ConnectionName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ConnectionNameCtx(ctx context.Context, typeName string) string {
+	return impl.ConnectionNameCtx(ctx, typeName)
+}
+
+// Contract replaces auxiliaries followed by "not", and pronouns followed by
+// an auxiliary, with their contractions.
+//
+// "X not" becomes "Xn't" for the auxiliaries and modals that have a
+// negative contraction ("do not" -> "don't", "will not" -> "won't"), and
+// "cannot" becomes "can't". A pronoun followed by "am", "are", "is",
+// "will", or "would" is contracted ("I am" -> "I'm") when another word
+// follows, since an auxiliary at the end of a clause cannot be contracted
+// ("I know who you are"). Forms of "have" are contracted only before a
+// past participle ("we have gone" -> "we've gone"). When both are
+// possible, the negative is preferred: "it is not" -> "it isn't".
+//
+// Examples:
+//   - Contract("do not") returns "don't"
+//   - Contract("I cannot go") returns "I can't go"
+//   - Contract("I am sure it is not here") returns "I'm sure it isn't here"
+//   - Contract("We have gone") returns "We've gone"
+//   - Contract("I have a car") returns "I have a car"
+func Contract(text string) string {
+	return impl.Contract(text)
+}
+
 // CountSyllables estimates the number of syllables in a word using a
 // heuristic based on vowel groups. It provides reasonable estimates for
 // most English words but may not be 100% accurate for all words, especially
@@ -623,6 +1916,20 @@ func CurrencyToWords(amount float64, currency string) string {
 	return impl.CurrencyToWords(amount, currency)
 }
 
+// CurrencyToWordsWithOptions converts a currency amount to its English word
+// representation, like CurrencyToWords, with the given options.
+//
+// Returns empty string for unknown currency codes.
+//
+// Examples:
+//   - CurrencyToWordsWithOptions(2.349, "USD", CurrencyOptions{}) returns "two dollars and thirty-five cents"
+//   - CurrencyToWordsWithOptions(2.349, "USD", CurrencyOptions{Rounding: RoundTruncate}) returns "two dollars and thirty-four cents"
+//   - CurrencyToWordsWithOptions(1234.5, "USD", CurrencyOptions{Check: true}) returns "One thousand two hundred thirty-four and 50/100 dollars"
+//   - CurrencyToWordsWithOptions(100, "EUR", CurrencyOptions{Check: true}) returns "One hundred and no/100 euros"
+func CurrencyToWordsWithOptions(amount float64, currency string, opts CurrencyOptions) string {
+	return impl.CurrencyToWordsWithOptions(amount, currency, opts)
+}
+
 // Dasherize converts a string to kebab-case.
 //
 // It handles PascalCase, camelCase, snake_case, and mixed inputs.
@@ -638,6 +1945,28 @@ func Dasherize(s string) string {
 	return impl.Dasherize(s)
 }
 
+// DateToWords converts a date to words, spelling out the day, month, and year.
+//
+// Only the date portion of t is used; the time of day and location are ignored.
+//
+// Examples:
+//   - DateToWords(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC)) returns "the fourth of July, nineteen seventy-six"
+//   - DateToWords(time.Date(2001, time.September, 11, 0, 0, 0, 0, time.UTC)) returns "the eleventh of September, two thousand one"
+func DateToWords(t time.Time) string {
+	return impl.DateToWords(t)
+}
+
+// DateToWordsWithStyle converts a date to words in the given style.
+//
+// Only the date portion of t is used; the time of day and location are ignored.
+//
+// Examples:
+//   - DateToWordsWithStyle(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC), DateSpelledOut) returns "the fourth of July, nineteen seventy-six"
+//   - DateToWordsWithStyle(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC), DateNumeric) returns "July 4th, 1976"
+func DateToWordsWithStyle(t time.Time, style DateStyle) string {
+	return impl.DateToWordsWithStyle(t, style)
+}
+
 // DefA defines a custom pattern that forces "a" instead of "an" for a word.
 //
 // The pattern is matched against the first word of the input (case-insensitive).
@@ -680,7 +2009,8 @@ func DefAPattern(pattern string) error {
 // DefAReset resets all custom a/an patterns to defaults (empty).
 //
 // This removes all custom patterns added via DefA(), DefAn(), DefAPattern(),
-// and DefAnPattern().
+// and DefAnPattern(), and the prefixes added via DefSilentH() and
+// DefSoundedVowel().
 //
 // Example:
 //
@@ -697,6 +2027,24 @@ func DefAReset() {
 	impl.DefAReset()
 }
 
+// DefAcronym registers an acronym along with how it is pronounced.
+//
+// The acronym keeps its case in humanization, takes a plain "s" in the
+// plural, and An() chooses its article from the pronunciation rather than
+// from the names of its letters. An empty pronunciation reads the acronym
+// letter by letter, which is also how unregistered acronyms are read.
+//
+// Examples:
+//
+//	DefAcronym("SQL", "sequel")
+//	An("SQL query")   // returns "a SQL query"
+//	DefAcronym("SQL", "ess-cue-ell")
+//	An("SQL query")   // returns "an SQL query"
+//	Plural("SQL")     // returns "SQLs"
+func DefAcronym(acronym string, pronunciation string) {
+	impl.DefAcronym(acronym, pronunciation)
+}
+
 // DefAdj defines a custom adjective pluralization rule.
 //
 // NOTE: This is a placeholder stub for future implementation.
@@ -736,6 +2084,27 @@ func DefAn(word string) {
 	impl.DefAn(word)
 }
 
+// DefAnException records the article to use for a word whose pronunciation
+// differs from what its spelling suggests, such as "herb" in British English
+// or a brand name.
+//
+// The article must be "a" or "an" (case-insensitive); otherwise
+// ErrInvalidArticle is returned. Exceptions are matched against the first
+// word of the input (case-insensitive) and take precedence over the built-in
+// pronunciation dictionary and default rules. They are stored alongside
+// DefA() and DefAn() definitions, so UndefA(), UndefAn(), and DefAReset()
+// remove them.
+//
+// Examples:
+//
+//	DefAnException("herb", "a")
+//	An("herb")   // returns "a herb" (British pronunciation)
+//	DefAnException("ytong", "an")
+//	An("Ytong")  // returns "an Ytong"
+func DefAnException(word string, article string) error {
+	return impl.DefAnException(word, article)
+}
+
 // DefAnPattern defines a regex pattern that forces "an" instead of "a".
 //
 // The pattern is matched against the lowercase first word of the input.
@@ -761,6 +2130,52 @@ func DefAnPattern(pattern string) error {
 	return impl.DefAnPattern(pattern)
 }
 
+// DefCollective defines the collective noun for a group of the given noun.
+//
+// The noun should be singular; both words are stored in lowercase.
+// Custom definitions take precedence over the built-in table.
+//
+// Examples:
+//
+//	DefCollective("developer", "standup")
+//	CollectiveNoun("developers")   // returns "standup"
+//	CollectivePhrase("developer")  // returns "standup of developers"
+func DefCollective(noun string, collective string) {
+	impl.DefCollective(noun, collective)
+}
+
+// DefDiminutive defines the diminutive form of a noun.
+//
+// Both words are stored in lowercase, and the result of Diminutive matches
+// the case of its input. Custom definitions take precedence over the
+// built-in table and suffix rules.
+//
+// Examples:
+//
+//	DefDiminutive("cat", "kitten")
+//	Diminutive("cat")  // returns "kitten"
+//	Diminutive("Cat")  // returns "Kitten"
+func DefDiminutive(word string, diminutive string) {
+	impl.DefDiminutive(word, diminutive)
+}
+
+// DefHerd registers a noun that is unchanged in the plural only in
+// classical herd mode, like the built-in "bison" and "elk".
+//
+// With ClassicalHerd(true), Plural returns the noun unchanged; otherwise the
+// regular rules apply. Words are matched in lowercase, and DefNoun
+// definitions take precedence.
+//
+// Examples:
+//
+//	DefHerd("yak")
+//	Plural("yak") // returns "yaks"
+//	ClassicalHerd(true)
+//	Plural("yak") // returns "yak"
+func DefHerd(word string) {
+	impl.DefHerd(word)
+}
+
 // DefNoun defines a custom noun pluralization rule.
 //
 // The singular and plural forms are stored in lowercase, and subsequent calls
@@ -776,6 +2191,27 @@ func DefNoun(singular string, plural string) {
 	impl.DefNoun(singular, plural)
 }
 
+// DefNounMulti defines a custom noun with both a modern and a classical
+// plural, so that it follows classical mode like the built-in Latin and
+// Greek nouns.
+//
+// Plural() returns the classical plural when Classical(true) or
+// ClassicalAncient(true) is in effect, and the modern plural otherwise.
+// Singular() accepts either form. Like DefNoun, the forms are stored in
+// lowercase and the results keep the case of the input, and a later DefNoun
+// for the same singular replaces both plurals.
+//
+// Examples:
+//
+//	DefNounMulti("virus", "viruses", "viri")
+//	Plural("virus")   // returns "viruses"
+//	ClassicalAncient(true)
+//	Plural("virus")   // returns "viri"
+//	Singular("viri")  // returns "virus"
+func DefNounMulti(singular string, modernPlural string, classicalPlural string) {
+	impl.DefNounMulti(singular, modernPlural, classicalPlural)
+}
+
 // DefNounReset resets all noun pluralization rules to their defaults.
 //
 // This removes all custom rules added via DefNoun() and restores any
@@ -792,34 +2228,415 @@ func DefNounReset() {
 	impl.DefNounReset()
 }
 
-// DefVerb defines a custom verb conjugation rule.
-//
-// NOTE: This is a placeholder stub for future implementation.
-// Full verb conjugation is not yet implemented; this function only stores
-// the singular/plural pair in internal maps for future use.
+// DefNounRule defines a custom pluralization rule that matches nouns by
+// pattern rather than by exact word.
+//
+// The pattern is a Go regex matched against the lowercase noun, and the
+// plural is formed by replacing the match with replacement, which may
+// refer to capture groups ("$1"). The result keeps the case of the noun.
+//
+// Rules are checked after exact definitions (DefNoun) and the built-in
+// irregular and uncountable tables, but before the default suffix rules.
+// Rules with a higher priority are checked first; among rules with the same
+// priority, the most recently defined is checked first. Defining a rule
+// with an existing pattern replaces it.
+//
+// Returns an error if the pattern is invalid.
+//
+// Examples:
+//
+//	DefNounRule("us$", "i", 0)
+//	Plural("hippopotamus") // returns "hippopotami"
+//	Plural("Octopus")      // returns "Octopi"
+//	DefNounRule("(ph|x)ylum$", "${1}yla", 1)
+//	Plural("phylum") // returns "phyla"
+func DefNounRule(pattern string, replacement string, priority int) error {
+	return impl.DefNounRule(pattern, replacement, priority)
+}
+
+// DefProperName adds a name to the dictionary used by ProperNameDictionary.
+//
+// Names are matched case-insensitively. They have no effect in the other
+// detection modes.
+//
+// Examples:
+//
+//	SetProperNameDetection(ProperNameDictionary)
+//	Plural("Jolly") // returns "Jollies"
+//	DefProperName("Jolly")
+//	Plural("Jolly") // returns "Jollys"
+func DefProperName(name string) {
+	impl.DefProperName(name)
+}
+
+// DefSilentH registers the start of words whose "h" is silent, so that An
+// uses "an" for every word beginning with it, like the built-in "hour" and
+// "honest".
+//
+// Unlike DefAn, which matches a whole first word, the prefix also matches
+// longer words: DefSilentH("homage") covers "homages". When prefixes
+// registered with DefSilentH and DefSoundedVowel both match, the longer one
+// wins. DefA and DefAn still take precedence.
+//
+// Examples:
+//
+//	An("homage")  // returns "a homage"
+//	DefSilentH("homage")
+//	An("homage")  // returns "an homage"
+//	An("Homages") // returns "an Homages"
+func DefSilentH(prefix string) {
+	impl.DefSilentH(prefix)
+}
+
+// DefSoundedVowel registers the start of words whose first vowel is
+// pronounced as a consonant, like the "y" sound of "euro" and "unicorn" or
+// the "w" sound of "one" and "Oaxaca", so that An uses "a" for every word
+// beginning with it.
+//
+// Unlike DefA, which matches a whole first word, the prefix also matches
+// longer words: DefSoundedVowel("oaxac") covers "Oaxaca" and "Oaxacan".
+// When prefixes registered with DefSilentH and DefSoundedVowel both match,
+// the longer one wins. DefA and DefAn still take precedence.
+//
+// Examples:
+//
+//	An("Oaxaca")  // returns "an Oaxaca"
+//	DefSoundedVowel("oaxac")
+//	An("Oaxaca")  // returns "a Oaxaca"
+//	An("Oaxacan") // returns "a Oaxacan"
+func DefSoundedVowel(prefix string) {
+	impl.DefSoundedVowel(prefix)
+}
+
+// DefStylized registers a brand name or other word with unusual
+// capitalization, such as "iPhone" or "macOS", along with its plural.
+//
+// Registered words are matched by their exact spelling, or in all
+// uppercase, so a stylized name never changes the inflection of an
+// ordinary word ("LaTeX" is registered, "latex" is not). Plural and
+// Singular return the registered forms without changing their case. An
+// empty plural leaves the word unchanged in the plural.
+//
+// Words that start with a lowercase letter and contain an uppercase one,
+// such as "iPhone" or "eBay", keep their capitalization without being
+// registered. DefStylized is for words that the regular rules get wrong.
+//
+// Examples:
+//
+//	DefStylized("fooOS", "")
+//	Plural("fooOS")        // returns "fooOS"
+//	DefStylized("GmbH", "GmbHs")
+//	Plural("GmbH")         // returns "GmbHs"
+//	Singular("GmbHs")      // returns "GmbH"
+func DefStylized(word string, plural string) {
+	impl.DefStylized(word, plural)
+}
+
+// DefUnchanged registers a noun whose plural is the same as its singular,
+// like the built-in "sheep" and "series".
+//
+// Plural and Singular return the noun unchanged, whatever the classical
+// settings. Words are matched in lowercase, and DefNoun definitions take
+// precedence.
+//
+// Examples:
+//
+//	Plural("kudos")  // returns "kudoses"
+//	DefUnchanged("kudos")
+//	Plural("kudos")  // returns "kudos"
+//	Singular("Kudos") // returns "Kudos"
+func DefUnchanged(word string) {
+	impl.DefUnchanged(word)
+}
+
+// DefVerb defines a custom verb conjugation rule.
+//
+// NOTE: This is a placeholder stub for future implementation.
+// Full verb conjugation is not yet implemented; this function only stores
+// the singular/plural pair in internal maps for future use.
+//
+// The singular and plural forms are stored in lowercase.
+//
+// Examples:
+//
+//	DefVerb("run", "runs")
+//	DefVerb("be", "are")
+func DefVerb(singular string, plural string) {
+	impl.DefVerb(singular, plural)
+}
+
+// DefVerbReset resets all custom verb conjugation rules.
+//
+// NOTE: This is a placeholder stub for future implementation.
+//
+// This removes all custom rules added via DefVerb().
+func DefVerbReset() {
+	impl.DefVerbReset()
+}
+
+// DefaultAcronyms returns a copy of the default acronyms list.
+func DefaultAcronyms() []string {
+	return impl.DefaultAcronyms()
+}
+
+// DefiniteArticleNeeded reports whether a noun phrase should take "the"
+// rather than "a" or "an", judging by its first word.
+//
+// Phrases that pick out a single thing need "the": those starting with
+// "first" or "1st", "last", "only", "same", "next", or a superlative such
+// as "best", "biggest", or "most" followed by an adjective. Other
+// ordinals usually mean "another", as in "a second chance", so they do
+// not. This is a heuristic; context can always call for either article.
+//
+// Examples:
+//   - DefiniteArticleNeeded("1st item") returns true
+//   - DefiniteArticleNeeded("2nd chance") returns false
+//   - DefiniteArticleNeeded("biggest dog") returns true
+//   - DefiniteArticleNeeded("most useful tool") returns true
+//   - DefiniteArticleNeeded("honest answer") returns false
+//   - DefiniteArticleNeeded("cat") returns false
+func DefiniteArticleNeeded(phrase string) bool {
+	return impl.DefiniteArticleNeeded(phrase)
+}
+
+// DigitsToWords reads a string of digits aloud, one digit at a time.
+//
+// Unlike NumberToWordsGrouped, which works on an int, this operates on the
+// string directly and so preserves leading zeros. Runs of non-digit
+// characters (dashes, spaces, parentheses, dots, and so on) split the digits
+// into groups, which are separated by commas. Strings without any digits
+// return an empty string.
+//
+// Examples:
+//   - DigitsToWords("415-555-0123") returns "four one five, five five five, zero one two three"
+//   - DigitsToWords("(020) 7946 0000") returns "zero two zero, seven nine four six, zero zero zero zero"
+//   - DigitsToWords("007") returns "zero zero seven"
+//   - DigitsToWords("no digits") returns ""
+func DigitsToWords(s string) string {
+	return impl.DigitsToWords(s)
+}
+
+// DigitsToWordsWithOptions reads a string of digits aloud, one digit at a
+// time, using the word for zero and group separator in opts.
+//
+// Examples:
+//   - DigitsToWordsWithOptions("415-555-0123", DigitsToWordsOptions{Zero: "oh"})
+//     returns "four one five, five five five, oh one two three"
+//   - DigitsToWordsWithOptions("415-555-0123", DigitsToWordsOptions{Separator: " "})
+//     returns "four one five five five five zero one two three"
+//   - DigitsToWordsWithOptions("1.2.3", DigitsToWordsOptions{Separator: " dot "})
+//     returns "one dot two dot three"
+func DigitsToWordsWithOptions(s string, opts DigitsToWordsOptions) string {
+	return impl.DigitsToWordsWithOptions(s, opts)
+}
+
+// Diminutive returns a diminutive form of a noun, naming a small or young
+// version of it.
+//
+// Common nouns are looked up in a built-in table ("dog" -> "doggy",
+// "goose" -> "gosling"). Other nouns take a suffix: "-ling" after "ck",
+// "eed", and "st", and "-let" otherwise. Words that already end in a
+// diminutive suffix are returned unchanged. Use DefDiminutive to add or
+// override entries.
+//
+// Examples:
+//   - Diminutive("dog") returns "doggy"
+//   - Diminutive("pig") returns "piglet"
+//   - Diminutive("goose") returns "gosling"
+//   - Diminutive("plate") returns "platelet"
+//   - Diminutive("chick") returns "chickling"
+//   - Diminutive("Book") returns "Booklet"
+//   - Diminutive("piglet") returns "piglet"
+func Diminutive(word string) string {
+	return impl.Diminutive(word)
+}
+
+// DiminutiveCtx is like Diminutive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func DiminutiveCtx(ctx context.Context, word string) string {
+	return impl.DiminutiveCtx(ctx, word)
+}
+
+// DomainBias returns the weight set for a domain with SetDomainBias, or 1
+// if none is set.
+//
+// Examples:
+//
+//	DomainBias(DomainSports) // returns 1
+//	SetDomainBias(DomainSports, 10)
+//	DomainBias(DomainSports) // returns 10
+func DomainBias(domain Domain) float64 {
+	return impl.DomainBias(domain)
+}
+
+// DurationToWords converts a time.Duration to its English word representation.
+//
+// The duration is broken into days, hours, minutes, and seconds; zero
+// components are omitted and the rest are joined into an English list.
+// Fractions of a second are dropped, except for durations shorter than one
+// second, which are read in milliseconds.
+//
+// Examples:
+//   - DurationToWords(90*time.Minute) returns "one hour and thirty minutes"
+//   - DurationToWords(time.Hour) returns "one hour"
+//   - DurationToWords(26*time.Hour + 3*time.Second) returns "one day, two hours, and three seconds"
+//   - DurationToWords(1500*time.Millisecond) returns "one second"
+//   - DurationToWords(250*time.Millisecond) returns "two hundred fifty milliseconds"
+//   - DurationToWords(0) returns "zero seconds"
+//   - DurationToWords(-5*time.Minute) returns "negative five minutes"
+func DurationToWords(d time.Duration) string {
+	return impl.DurationToWords(d)
+}
+
+// EdgeName returns the name of the Relay edge type for a type: its
+// singular in PascalCase followed by "Edge".
+//
+// Examples:
+//   - EdgeName("Order") returns "OrderEdge"
+//   - EdgeName("people") returns "PersonEdge"
+func EdgeName(typeName string) string {
+	return impl.EdgeName(typeName)
+}
+
+// EdgeNameCtx is like EdgeName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func EdgeNameCtx(ctx context.Context, typeName string) string {
+	return impl.EdgeNameCtx(ctx, typeName)
+}
+
+// EnableCache caches up to size results of Plural, Singular, and An, or
+// disables caching if size is 0 or less.
+//
+// Real workloads often inflect the same few hundred words over and over,
+// and the cache turns those repeats into a map lookup. When it is full, the
+// least recently used result is dropped. Results are cached separately for
+// each combination of classical, proper name, and Num() settings, and
+// defining nouns, verbs, adjectives, articles, acronyms, or proper
+// names clears the cache. Calling EnableCache again replaces the cache and
+// resets its statistics.
+//
+// Examples:
+//
+//	EnableCache(1000)
+//	Plural("child") // computed: "children"
+//	Plural("child") // cached: "children"
+//	GetCacheStats() // returns Hits: 1, Misses: 1, Size: 1, Capacity: 1000
+func EnableCache(size int) {
+	impl.EnableCache(size)
+}
+
+// EnumValueName returns the name of a Protobuf enum value in the style the
+// Protobuf style guide asks for: UPPER_SNAKE_CASE, prefixed with the enum
+// name.
+//
+// Enum names are singular, so a plural enum name is made singular for the
+// prefix: "Colors", "red" -> "COLOR_RED". A value that already has the
+// prefix is not prefixed again.
+//
+// Examples:
+//   - EnumValueName("OrderStatus", "shipped") returns "ORDER_STATUS_SHIPPED"
+//   - EnumValueName("OrderStatus", "InTransit") returns "ORDER_STATUS_IN_TRANSIT"
+//   - EnumValueName("Colors", "red") returns "COLOR_RED"
+//   - EnumValueName("OrderStatus", "ORDER_STATUS_SHIPPED") returns "ORDER_STATUS_SHIPPED"
+//   - EnumValueName("OrderStatus", "unspecified") returns "ORDER_STATUS_UNSPECIFIED"
+func EnumValueName(enumName string, value string) string {
+	return impl.EnumValueName(enumName, value)
+}
+
+// EnumValueNameCtx is like EnumValueName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func EnumValueNameCtx(ctx context.Context, enumName string, value string) string {
+	return impl.EnumValueNameCtx(ctx, enumName, value)
+}
+
+// Expand replaces the contractions in text with the words they stand for.
+//
+// Negative contractions are expanded with "not" ("don't" -> "do not"),
+// except that "can't" becomes "cannot". Contractions that have more than
+// one expansion are left unchanged: "'s" may be "is", "has", or a
+// possessive, "'d" may be "had" or "would", and "ain't" may be almost
+// anything. Both straight and curly apostrophes are recognized, and the
+// case of each contraction is preserved.
+//
+// Examples:
+//   - Expand("can't stop won't stop") returns "cannot stop will not stop"
+//   - Expand("I'm sure they're here") returns "I am sure they are here"
+//   - Expand("We'll see") returns "We will see"
+//   - Expand("It's done") returns "It's done"
+//   - Expand("let's go") returns "let us go"
+func Expand(text string) string {
+	return impl.Expand(text)
+}
+
+// ExplainAn returns the chain of rules An applies to choose the article for
+// a word, in the order they were applied. The last step names the rule that
+// chose the article.
+//
+// This is meant for debugging, for example to find out why a DefA() or
+// DefAn() rule is not taking effect. The wording of the steps may change
+// between releases.
+//
+// Examples:
+//   - ExplainAn("hourglass") returns ["silent-h list: hourglass -> an"]
+//   - ExplainAn("cat") returns ["first letter: cat -> a"]
+//   - ExplainAn("FBI agent") returns ["abbreviation: FBI -> an"]
+//   - ExplainAn("\"apple\"") returns ["wrappers: analyzing apple", "first letter: apple -> an"]
+func ExplainAn(word string) []string {
+	return impl.ExplainAn(word)
+}
+
+// ExplainPlural returns the chain of rules Plural applies to a word, in the
+// order they were applied. The last step names the rule that produced the
+// plural.
 //
-// The singular and plural forms are stored in lowercase.
+// This is meant for debugging, for example to find out why a DefNoun() rule
+// or a classical mode flag is not taking effect. The wording of the steps
+// may change between releases.
 //
 // Examples:
-//
-//	DefVerb("run", "runs")
-//	DefVerb("be", "are")
-func DefVerb(singular string, plural string) {
-	impl.DefVerb(singular, plural)
+//   - ExplainPlural("ox") returns ["irregular table: ox -> oxen"]
+//   - ExplainPlural("cat") returns ["suffix rule (default + -s): cat -> cats"]
+//   - ExplainPlural("formula") returns ["classical table: skipped formula -> formulae, classical mode is off", "suffix rule (default + -s): formula -> formulas"]
+//   - ExplainPlural("ox's") returns ["possessive: inflecting ox", "irregular table: ox -> oxen"]
+func ExplainPlural(word string) []string {
+	return impl.ExplainPlural(word)
 }
 
-// DefVerbReset resets all custom verb conjugation rules.
+// ExplainSingular returns the chain of rules Singular applies to a word, in
+// the order they were applied. The last step names the rule that produced
+// the singular.
 //
-// NOTE: This is a placeholder stub for future implementation.
+// This is meant for debugging, for example to find out why a noun ending
+// in s loses it. The wording of the steps may change between releases.
 //
-// This removes all custom rules added via DefVerb().
-func DefVerbReset() {
-	impl.DefVerbReset()
+// Examples:
+//   - ExplainSingular("oxen") returns ["irregular table: oxen -> ox"]
+//   - ExplainSingular("cats") returns ["suffix rule (-s removed): cats -> cat"]
+//   - ExplainSingular("bus") returns ["known singular: bus is already singular"]
+func ExplainSingular(word string) []string {
+	return impl.ExplainSingular(word)
 }
 
-// DefaultAcronyms returns a copy of the default acronyms list.
-func DefaultAcronyms() []string {
-	return impl.DefaultAcronyms()
+// ExplainSingularSafe returns the chain of rules SingularSafe applies to a
+// word, in the order they were applied. The last step names the rule that
+// produced the singular, or why the word was kept unchanged.
+//
+// Examples:
+//   - ExplainSingularSafe("cats") returns ["suffix rule (-s removed): cats -> cat"]
+//   - ExplainSingularSafe("octothorpus") returns ["suffix rule (-s removed after u or i): octothorpus -> octothorpu", "safe mode: octothorpus may be singular and is kept"]
+func ExplainSingularSafe(word string) []string {
+	return impl.ExplainSingularSafe(word)
+}
+
+// FewerOrLess returns "less" for a mass noun or unit of measure, and
+// "fewer" for any other noun.
+//
+// Examples:
+//   - FewerOrLess("items") returns "fewer"
+//   - FewerOrLess("water") returns "less"
+//   - FewerOrLess("minutes") returns "less"
+func FewerOrLess(noun string) string {
+	return impl.FewerOrLess(noun)
 }
 
 // ForeignKey creates an underscored foreign key name from a type name.
@@ -850,6 +2667,33 @@ func ForeignKeyCondensed(word string) string {
 	return impl.ForeignKeyCondensed(word)
 }
 
+// FormatFloat formats a floating-point number with commas as thousand
+// separators, rounded to the given number of decimal places.
+//
+// A negative decimals value uses the fewest digits needed to represent
+// the value exactly. NaN and infinities are returned as "NaN", "+Inf",
+// and "-Inf".
+//
+// Examples:
+//   - FormatFloat(1234.567, 2) returns "1,234.57"
+//   - FormatFloat(1234567.5, 0) returns "1,234,568"
+//   - FormatFloat(-9876.5, 1) returns "-9,876.5"
+//   - FormatFloat(0.125, -1) returns "0.125"
+func FormatFloat(f float64, decimals int) string {
+	return impl.FormatFloat(f, decimals)
+}
+
+// FormatFloatWithOptions formats a floating-point number using the grouping,
+// separator, and precision settings in opts.
+//
+// Examples:
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Decimals: 2}) returns "1,234.50"
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Separator: '.', DecimalSeparator: ',', Decimals: 2}) returns "1.234,50"
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Separator: ' ', DecimalSeparator: ',', Decimals: -1}) returns "1 234,5"
+func FormatFloatWithOptions(f float64, opts FormatNumberOptions) string {
+	return impl.FormatFloatWithOptions(f, opts)
+}
+
 // FormatNumber formats an integer with commas as thousand separators.
 //
 // Examples:
@@ -862,6 +2706,19 @@ func FormatNumber(n int) string {
 	return impl.FormatNumber(n)
 }
 
+// FormatNumberWithOptions formats an integer with a configurable thousands
+// separator and group size.
+//
+// Examples:
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{}) returns "1,234,567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{Separator: '.'}) returns "1.234.567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{Separator: ' '}) returns "1 234 567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{GroupSize: 4}) returns "123,4567"
+//   - FormatNumberWithOptions(-1234, FormatNumberOptions{Separator: '_'}) returns "-1_234"
+func FormatNumberWithOptions(n int, opts FormatNumberOptions) string {
+	return impl.FormatNumberWithOptions(n, opts)
+}
+
 // FractionToWords converts a fraction to its English word representation.
 //
 // Special cases are handled as follows:
@@ -909,36 +2766,86 @@ func FractionToWordsWithFourths(numerator int, denominator int) string {
 // Pluralization and Singularization:
 //   - plural(word string, count ...int) string - Plural form of a noun
 //   - pluralize(word string) string - Alias for plural
+//   - pluralSafe(word string, count ...int) string - Plural form, unchanged if already plural: "cats" -> "cats"
 //   - singular(word string) string - Singular form of a noun
 //   - singularize(word string) string - Alias for singular
+//   - singularSafe(word string) string - Singular form that never over-strips: "bus" -> "bus"
 //   - pluralNoun(word string, count ...int) string - Plural form with pronoun support
 //   - pluralVerb(word string, count ...int) string - Plural form of a verb
 //   - pluralAdj(word string, count ...int) string - Plural form of an adjective
 //   - singularNoun(word string, count ...int) string - Singular form with pronoun support
+//   - agreeVerb(count int, verb string) string - Verb agreeing with a count: 1, "have" -> "has"
+//   - agreeCompound(subjects []string, verb string) string - ["Alice" "Bob"], "is" -> "Alice and Bob are"
+//   - numberOfPhrase(n int, noun, verb string) string - 3, "error", "were" -> "the number of errors was three"
+//   - aNumberOf(noun, verb string) string - "error", "was found" -> "a number of errors were found"
+//   - pluralLastWord(phrase string) string - Plural of the last word: "blue bird" -> "blue birds"
+//   - singularLastWord(phrase string) string - Singular of the last word: "blue birds" -> "blue bird"
+//   - pluralNounPhrase(phrase string) string - Plural of the head noun: "cup of coffee" -> "cups of coffee"
+//   - singularNounPhrase(phrase string) string - Singular of the head noun: "cups of coffee" -> "cup of coffee"
+//   - singularizePhrase(text string) string - Singular with agreement: "these files are ready" -> "this file is ready"
+//   - pluralName(name string) string - Plural of a proper name: "Jones" -> "Joneses"
+//   - collectiveNoun(noun string) string - Collective noun: "lion" -> "pride"
+//   - collectivePhrase(noun string) string - Group phrase: "lion" -> "pride of lions"
+//   - diminutive(noun string) string - Diminutive form: "pig" -> "piglet"
+//   - fewerOrLess(noun string) string - "items" -> "fewer", "water" -> "less"
+//   - manyOrMuch(noun string) string - "items" -> "many", "water" -> "much"
 //
 // Articles:
 //   - an(word string) string - Prefixes word with "a" or "an"
 //   - a(word string) string - Alias for an()
+//   - articleFor(word string) string - Just the article: "hour" -> "an"
+//   - anCapitalized(word string) string - Capitalized article: "apple" -> "An apple"
+//   - the(phrase string) string - Definite article: "cat" -> "the cat"
+//   - theOrAn(phrase string) string - "1st item" -> "the 1st item", "2nd chance" -> "a 2nd chance"
 //
 // Numbers and Ordinals:
 //   - ordinal(n int) string - Ordinal with suffix: 1 -> "1st"
 //   - ordinalSuffix(n int) string - Just the suffix: 1 -> "st"
 //   - ordinalWord(n int) string - Ordinal in words: 1 -> "first"
+//   - ordinalFromEnd(n, total int) string - Position from end: 9, 10 -> "second to last"
 //   - ordinalToCardinal(s string) string - "first" -> "one"
 //   - wordToOrdinal(s string) string - "one" -> "first"
 //   - numberToWords(n int) string - Number in words: 42 -> "forty-two"
 //   - numberToWordsWithAnd(n int) string - With "and": 123 -> "one hundred and twenty-three"
 //   - formatNumber(n int) string - With commas: 1000 -> "1,000"
+//   - formatFloat(f float64, decimals int) string - With commas: 1234.567, 2 -> "1,234.57"
+//   - compactNumber(n int) string - Short form: 1234 -> "1.2K"
+//   - compactNumberWords(n int) string - Short form with words: 1234567 -> "1.2 million"
+//   - digitsToWords(s string) string - Digit by digit: "415-555" -> "four one five, five five five"
 //   - countingWord(n int) string - 1 -> "once", 2 -> "twice", 3 -> "3 times"
 //   - fractionToWords(num, denom int) string - 1,4 -> "one quarter"
+//   - percentToWords(p float64) string - 12.5 -> "twelve point five percent"
+//   - ratioToWords(num, denom int) string - 3,4 -> "three out of four"
 //   - currencyToWords(amount float64, currency string) string - 1.50, "USD" -> "one dollar and fifty cents"
+//   - checkAmount(amount float64) string - 1234.5 -> "One thousand two hundred thirty-four and 50/100 dollars"
 //   - no(word string, count int) string - 0 -> "no cats", 1 -> "1 cat"
+//   - phrase(count int, adjectives []string, noun string) string - 1, ["old"], "oak" -> "an old oak"
+//   - noWords(word string, count int) string - 0 -> "no cats", 3 -> "three cats"
+//   - noCapitalized(word string, count int) string - 0 -> "No cats", 3 -> "Three cats"
+//   - noThreshold(word string, count, threshold int) string - 3, 10 -> "three cats", 12, 10 -> "12 cats"
+//   - quantifyCount(n int, noun string) string - 7, "cat" -> "several cats", 40, "cat" -> "dozens of cats"
+//   - amountPhrase(n int, noun string) string - 5, "item" -> "fewer than five items"
+//
+// Time:
+//   - durationToWords(d time.Duration) string - 90*time.Minute -> "one hour and thirty minutes"
+//   - clockToWords(hour, minute int) string - 14, 35 -> "twenty-five to three"
+//   - yearToWords(year int) string - 1976 -> "nineteen seventy-six"
+//   - dateToWords(t time.Time) string - "the fourth of July, nineteen seventy-six"
 //
 // Verb Tenses:
 //   - pastTense(verb string) string - Past tense: "walk" -> "walked"
 //   - pastParticiple(verb string) string - Past participle: "take" -> "taken"
 //   - presentParticiple(verb string) string - Present participle: "run" -> "running"
+//   - pastTensePhrase(phrase string) string - Past tense of the head verb: "check out" -> "checked out"
+//   - pastParticiplePhrase(phrase string) string - Past participle of the head verb: "break down" -> "broken down"
+//   - presentParticiplePhrase(phrase string) string - Present participle of the head verb: "set up" -> "setting up"
+//   - pluralVerbPhrase(phrase string, count ...int) string - Plural of the head verb: "logs in" -> "log in"
 //   - futureTense(verb string) string - Future tense: "walk" -> "will walk"
+//   - baseVerb(verb string) string - Base form of a participle: "running" -> "run"
+//   - negate(verb string) string - Negative form: "is" -> "isn't", "runs" -> "doesn't run"
+//   - unnegate(verb string) string - Positive form: "isn't" -> "is"
+//   - interrogate(subject, verb, rest string) string - Question: "the user", "has", "access" -> "Does the user have access?"
+//   - passivize(subject, verb, object string) string - Passive voice: "the analyst", "wrote", "the report" -> "The report was written by the analyst"
 //
 // Adjectives and Adverbs:
 //   - comparative(adj string) string - Comparative form: "big" -> "bigger"
@@ -947,6 +2854,8 @@ func FractionToWordsWithFourths(numerator int, denominator int) string {
 //
 // Possessives:
 //   - possessive(word string) string - Possessive form: "cat" -> "cat's"
+//   - joinPossessive(names []string, noun string) string - Each owns one: "Alice's and Bob's reports"
+//   - joinPossessiveShared(names []string, noun string) string - Owned together: "Alice and Bob's report"
 //
 // List Formatting:
 //   - join(words []string) string - Join with Oxford comma: ["a","b","c"] -> "a, b, and c"
@@ -967,8 +2876,11 @@ func FractionToWordsWithFourths(numerator int, denominator int) string {
 //
 // Text Transformation:
 //   - capitalize(s string) string - Capitalize first letter: "hello" -> "Hello"
+//   - capitalizeSentence(s string) string - Capitalize each sentence: "no. yes." -> "No. Yes."
 //   - titleize(s string) string - Capitalize each word: "hello world" -> "Hello World"
 //   - humanize(s string) string - Human readable: "employee_salary" -> "Employee salary"
+//   - expand(s string) string - Expand contractions: "can't stop" -> "cannot stop"
+//   - contract(s string) string - Contract negatives and pronouns: "do not" -> "don't"
 //
 // Rails-style Helpers:
 //   - tableize(word string) string - Type to table: "Person" -> "people"
@@ -976,6 +2888,19 @@ func FractionToWordsWithFourths(numerator int, denominator int) string {
 //   - typeify(word string) string - Table to type: "user_posts" -> "UserPost"
 //   - parameterize(word string) string - URL slug: "Hello World" -> "hello-world"
 //   - asciify(word string) string - Remove diacritics: "café" -> "cafe"
+//   - tableName(typeName string) string - SQL table: "UserProfile" -> "user_profiles"
+//   - columnName(fieldName string) string - SQL column: "CreatedAt" -> "created_at"
+//   - joinTableName(a, b string) string - Join table: "User", "Role" -> "roles_users"
+//   - messageNamePlural(name string) string - Proto message plural: "UserProfile" -> "UserProfiles"
+//   - messageNameSingular(name string) string - Proto message singular: "UserProfiles" -> "UserProfile"
+//   - enumValueName(enumName, value string) string - Proto enum value: "Color", "red" -> "COLOR_RED"
+//   - serviceMethodName(method, message string) string - gRPC method: "List", "User" -> "ListUsers"
+//   - queryFieldName(typeName string) string - GraphQL list field: "Order" -> "orders"
+//   - objectFieldName(typeName string) string - GraphQL object field: "Orders" -> "order"
+//   - connectionName(typeName string) string - Relay connection: "Order" -> "OrderConnection"
+//   - edgeName(typeName string) string - Relay edge: "Order" -> "OrderEdge"
+//   - connectionFieldName(typeName string) string - Connection field: "Order" -> "ordersConnection"
+//   - mutationFieldName(action, typeName string) string - Mutation: "create", "Order" -> "createOrder"
 //
 // Utility:
 //   - wordCount(text string) int - Count words in text
@@ -989,11 +2914,30 @@ func FractionToWordsWithFourths(numerator int, denominator int) string {
 //	// With count parameter:
 //	tmpl.Parse(`There {{if eq .Count 1}}is{{else}}are{{end}} {{plural "item" .Count}}`)
 //
-// For custom engine configurations, use Engine.FuncMap() instead.
+// For custom engine configurations, use FuncMapFor or Engine.FuncMap()
+// instead.
 func FuncMap() template.FuncMap {
 	return impl.FuncMap()
 }
 
+// FuncMapFor returns a template.FuncMap whose functions use e's
+// configuration rather than the package-level settings, or the default
+// Engine's if e is nil. It is the same as e.FuncMap().
+//
+// Every function that depends on engine settings closes over e, so
+// templates rendered concurrently with engines configured differently, for
+// example one classical and one not, do not affect each other, and tests
+// need not change or reset global state.
+//
+// Example:
+//
+//	classical := inflect.NewEngine(inflect.WithClassical(inflect.ClassicalModeAll))
+//	tmpl := template.New("example").Funcs(inflect.FuncMapFor(classical))
+//	tmpl.Parse(`{{plural "formula"}}`) // renders "formulae"
+func FuncMapFor(e *impl.Engine) template.FuncMap {
+	return impl.FuncMapFor(e)
+}
+
 // FutureTense returns the future tense form of an English verb using "will".
 //
 // Examples:
@@ -1088,6 +3032,12 @@ func GoCamelCase(s string) string {
 	return impl.GoCamelCase(s)
 }
 
+// GoCamelCaseCtx is like GoCamelCase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func GoCamelCaseCtx(ctx context.Context, s string) string {
+	return impl.GoCamelCaseCtx(ctx, s)
+}
+
 // GoPascalCase converts a string to PascalCase with Go-conventional acronym
 // casing. Registered acronyms (SQL, API, URL, ID, etc.) are fully uppercased
 // per Go naming conventions enforced by linters like revive and golint.
@@ -1110,6 +3060,12 @@ func GoPascalCase(s string) string {
 	return impl.GoPascalCase(s)
 }
 
+// GoPascalCaseCtx is like GoPascalCase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func GoPascalCaseCtx(ctx context.Context, s string) string {
+	return impl.GoPascalCaseCtx(ctx, s)
+}
+
 // Humanize converts an underscored or dasherized string into a human-readable
 // form. It capitalizes the first letter, replaces underscores and dashes with
 // spaces, and strips trailing "_id" suffixes.
@@ -1133,6 +3089,212 @@ func Humanize(word string) string {
 	return impl.Humanize(word)
 }
 
+// HumanizeCtx is like Humanize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func HumanizeCtx(ctx context.Context, word string) string {
+	return impl.HumanizeCtx(ctx, word)
+}
+
+// IncrementOrdinalInString adds delta to the last numeric ordinal in s, and
+// updates its suffix to match.
+//
+// Zero padding and the case of the suffix are kept. If s has no ordinal,
+// or the result would be less than 1 ("0th" is not a position), s is
+// returned unchanged.
+//
+// Examples:
+//   - IncrementOrdinalInString("report_2nd_draft", 1) returns "report_3rd_draft"
+//   - IncrementOrdinalInString("report_3rd_draft", -1) returns "report_2nd_draft"
+//   - IncrementOrdinalInString("take_10th.wav", 2) returns "take_12th.wav"
+//   - IncrementOrdinalInString("day_01ST", 1) returns "day_02ND"
+//   - IncrementOrdinalInString("1st", -1) returns "1st"
+//   - IncrementOrdinalInString("report.txt", 1) returns "report.txt"
+func IncrementOrdinalInString(s string, delta int) string {
+	return impl.IncrementOrdinalInString(s, delta)
+}
+
+// Inflect expands inflection function calls embedded in text.
+//
+// Each call has the form name('word') or name('word', count), with the
+// word in single quotes, double quotes, or backticks, or unquoted if it is
+// a single word of three letters or more. Prose such as "a(n) value" or
+// "no(thing)" is not taken for a call. The supported functions are:
+//   - plural, plural_noun, plural_verb, plural_adj, singular_noun
+//   - a, an, no
+//   - ordinal, number_to_words (which also accept a bare integer)
+//   - present_participle
+//   - auto, which inflects with the part of speech from GuessPOS
+//   - num(n), which sets the count for later calls without one and
+//     expands to nothing; num(n, True) also expands to n, and num()
+//     clears the count again
+//
+// Calls without a count use the count from num(), or the default count
+// set by Num(). A count set by num() lasts until the end of the text.
+// As in Python inflect, a() and an() only use an explicit count, so
+// a('cat') is always "a cat" but a('cats', 2) is "2 cats". Unknown
+// functions and invalid calls are left unchanged.
+//
+// Calls inside Markdown code, either an inline `code span` or a fenced
+// code block, are left unchanged, so that documentation showing the
+// mini-language is not expanded. Elsewhere, a backslash before a call, as
+// in \plural('cat'), escapes it: the backslash is removed and the call is
+// left unchanged.
+//
+// Examples:
+//   - Inflect("The plural of cat is plural('cat')") returns "The plural of cat is cats"
+//   - Inflect("I saw plural('cat', 3)") returns "I saw cats"
+//   - Inflect("num(1)There plural_verb('are') no('error')") returns "There is 1 error"
+//   - Inflect("This is the ordinal(2) a('hour')") returns "This is the 2nd an hour"
+//   - Inflect("num(2)auto('this') auto('child') auto('was') here") returns "these children were here"
+//   - Inflect("num(3, True) plural('cat') and a('dogs', 2)") returns "3 cats and 2 dogs"
+//   - Inflect("plural(cat) and plural(`dog`)") returns "cats and dogs"
+//   - Inflect("Write `plural('cat')` to get plural('cat')") returns "Write `plural('cat')` to get cats"
+//   - Inflect("Write \\plural('cat') to get plural('cat')") returns "Write plural('cat') to get cats"
+func Inflect(text string) string {
+	return impl.Inflect(text)
+}
+
+// InflectCtx is like Inflect but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectCtx(ctx context.Context, text string) string {
+	return impl.InflectCtx(ctx, text)
+}
+
+// InflectDocument applies a rule to every word of a piece of prose and
+// returns the text with the transformed words.
+//
+// The text is split into words, and each word is classified as a noun,
+// verb, adjective, or pronoun using GuessPOS, adjusted by its neighbors: a
+// word after a determiner such as "the" is taken for a noun, and a word
+// after a subject such as "it" or "the user" is taken for a verb when it
+// can be one. The rule is called with each classified Token and returns the
+// Transform to apply. Prepositions, conjunctions, and adverbs are
+// classified as POSOther and are never changed. Everything between the
+// words is kept as it is, and the case of each word is preserved.
+//
+// This is a lightweight heuristic meant for experiments such as
+// pluralizing every noun of a paragraph, not a parser: words that can be
+// more than one part of speech are sometimes misclassified, and rules
+// should check Confidence where that matters. A nil rule returns the text
+// unchanged.
+//
+// Examples:
+//
+//	nouns := func(t Token) Transform {
+//		if t.POS == POSNoun {
+//			return TransformPlural
+//		}
+//		return TransformNone
+//	}
+//	InflectDocument("The cat sat on the mat.", nouns) // returns "The cats sat on the mats."
+//
+//	all := func(t Token) Transform { return TransformPlural }
+//	InflectDocument("A user logs in.", all) // returns "Some users log in."
+func InflectDocument(text string, rule func(token Token) Transform) string {
+	return impl.InflectDocument(text, rule)
+}
+
+// InflectDocumentCtx is like InflectDocument but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectDocumentCtx(ctx context.Context, text string, rule func(token Token) Transform) string {
+	return impl.InflectDocumentCtx(ctx, text, rule)
+}
+
+// InflectHTML expands inflection function calls in the text nodes of an
+// HTML fragment, like Inflect, leaving the markup unchanged.
+//
+// Tags, including their attributes, and comments are copied as they are,
+// as is the content of script, style, textarea, pre, and code elements.
+// Character references in the arguments of a call are decoded, and each
+// expansion is escaped, so a('R&amp;D team') becomes "an R&amp;D team".
+// Quotes around arguments must be written as characters rather than
+// references, which is how browsers and most editors store text. A count
+// set by num() lasts until the end of the fragment, across elements. The
+// fragment is not validated, and a "<" that does not start a tag is
+// treated as text.
+//
+// Examples:
+//   - InflectHTML("<p>I saw plural('cat', 3)</p>") returns "<p>I saw cats</p>"
+//   - InflectHTML(`<a title="plural('cat')">a('hour')</a>`) returns `<a title="plural('cat')">an hour</a>`
+//   - InflectHTML("<code>plural('cat')</code> is plural('cat')") returns "<code>plural('cat')</code> is cats"
+//   - InflectHTML("<b>num(2)</b> plural_verb('is')") returns "<b></b> are"
+func InflectHTML(text string) string {
+	return impl.InflectHTML(text)
+}
+
+// InflectHTMLCtx is like InflectHTML but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectHTMLCtx(ctx context.Context, text string) string {
+	return impl.InflectHTMLCtx(ctx, text)
+}
+
+// InflectMarkdown expands inflection function calls embedded in Markdown,
+// like Inflect, without touching code or URLs.
+//
+// As with Inflect, calls in fenced code blocks and inline code spans are
+// left unchanged. In addition, calls in the destination or title of a
+// link or image, in a link reference definition, in an autolink or inline
+// HTML tag, and in a bare URL are left unchanged, so that a URL such as
+// https://example.com/plural(cat) is never rewritten. Calls in link text
+// are expanded.
+//
+// Examples:
+//   - InflectMarkdown("See [plural('cat')](https://example.com/plural(cat))") returns "See [cats](https://example.com/plural(cat))"
+//   - InflectMarkdown("Use `plural('cat')` to get plural('cat')") returns "Use `plural('cat')` to get cats"
+//   - InflectMarkdown("[docs]: https://example.com/ordinal(1)\nThe ordinal(1) item") returns "[docs]: https://example.com/ordinal(1)\nThe 1st item"
+func InflectMarkdown(text string) string {
+	return impl.InflectMarkdown(text)
+}
+
+// InflectMarkdownCtx is like InflectMarkdown but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectMarkdownCtx(ctx context.Context, text string) string {
+	return impl.InflectMarkdownCtx(ctx, text)
+}
+
+// InflectWithOptions expands inflection function calls embedded in text,
+// like Inflect, with the given options.
+//
+// With UnknownFuncError, it returns ErrUnknownFunc and an empty string if
+// the text calls an unknown function outside Markdown code. Escaped calls,
+// such as \foo('bar'), are never an error.
+//
+// Examples:
+//   - InflectWithOptions("plural('cat')", InflectOptions{}) returns "cats", nil
+//   - InflectWithOptions("plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns an error
+//   - InflectWithOptions("\\plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns "plurl('cat')", nil
+func InflectWithOptions(text string, opts InflectOptions) (string, error) {
+	return impl.InflectWithOptions(text, opts)
+}
+
+// Inflectf formats according to a format specifier, like fmt.Sprintf, and
+// additionally inflects words to agree with the count arguments.
+//
+// Besides the usual fmt verbs, the format may contain two inflection
+// markers, which do not consume arguments:
+//   - %p{noun} is replaced by the noun in the form that agrees with the
+//     most recent integer argument: singular for 1 and plural otherwise
+//   - %v{verb} is replaced by the present-tense verb that agrees with the
+//     most recent integer argument, as with AgreeVerb
+//
+// Markers that appear before any integer argument use the default count
+// set by Num(), or the plural form if there is none. A plain %p or %v
+// without braces is an ordinary fmt verb.
+//
+// Examples:
+//   - Inflectf("Deleted %d %p{file} that %v{was} outdated", 3) returns "Deleted 3 files that were outdated"
+//   - Inflectf("Deleted %d %p{file} that %v{was} outdated", 1) returns "Deleted 1 file that was outdated"
+//   - Inflectf("%s: %d %p{child} %v{is} waiting", "Room 4", 2) returns "Room 4: 2 children are waiting"
+func Inflectf(format string, args ...any) string {
+	return impl.Inflectf(format, args...)
+}
+
+// InflectfCtx is like Inflectf but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectfCtx(ctx context.Context, format string, args ...any) string {
+	return impl.InflectfCtx(ctx, format, args...)
+}
+
 // IntToRoman converts an integer to its Roman numeral representation.
 //
 // Roman numerals are only defined for integers from 1 to 3999.
@@ -1149,6 +3311,34 @@ func IntToRoman(n int) string {
 	return impl.IntToRoman(n)
 }
 
+// IntToRomanCtx is like IntToRoman but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func IntToRomanCtx(ctx context.Context, n int) string {
+	return impl.IntToRomanCtx(ctx, n)
+}
+
+// Interrogate returns a yes/no question formed from a subject, a verb or
+// verb phrase, and the rest of the sentence.
+//
+// Forms of "be", modal verbs, and auxiliaries followed by another verb are
+// moved before the subject ("is" -> "Is the build green?"). Other verbs
+// take a form of "do" that carries their tense, and revert to their base
+// form ("has" -> "Does the user have access?"). The question starts with a
+// capital letter and ends with a question mark, replacing any final
+// punctuation of rest. Determiners and pronouns starting the subject are
+// lowercased, but names are left alone.
+//
+// Examples:
+//   - Interrogate("the user", "has", "access") returns "Does the user have access?"
+//   - Interrogate("the build", "is", "green") returns "Is the build green?"
+//   - Interrogate("the job", "ran", "overnight") returns "Did the job run overnight?"
+//   - Interrogate("you", "can", "see it") returns "Can you see it?"
+//   - Interrogate("the file", "has been", "deleted") returns "Has the file been deleted?"
+//   - Interrogate("Alice", "isn't", "here.") returns "Isn't Alice here?"
+func Interrogate(subject string, verb string, rest string) string {
+	return impl.Interrogate(subject, verb, rest)
+}
+
 // IsAcronym checks if a word is a registered acronym.
 //
 // The check is case-insensitive.
@@ -1273,6 +3463,54 @@ func IsClassicalZero() bool {
 	return impl.IsClassicalZero()
 }
 
+// IsLenient returns whether lenient mode is enabled.
+//
+// Examples:
+//
+//	IsLenient() // returns false (default)
+//	SetLenient(true)
+//	IsLenient() // returns true
+func IsLenient() bool {
+	return impl.IsLenient()
+}
+
+// IsMassNoun reports whether noun is a known mass noun, such as "water" or
+// "information", which is not normally counted. For a phrase, the last word
+// is checked.
+//
+// Examples:
+//   - IsMassNoun("water") returns true
+//   - IsMassNoun("customer feedback") returns true
+//   - IsMassNoun("item") returns false
+//   - IsMassNoun("sheep") returns false
+func IsMassNoun(noun string) bool {
+	return impl.IsMassNoun(noun)
+}
+
+// IsNormalizeInput returns whether input to Plural, Singular, and An is
+// normalized to NFC.
+//
+// Examples:
+//
+//	IsNormalizeInput() // returns false (default)
+//	NormalizeInput(true)
+//	IsNormalizeInput() // returns true
+func IsNormalizeInput() bool {
+	return impl.IsNormalizeInput()
+}
+
+// IsNumPropagation returns whether the default count set by Num() is used
+// by functions that take an optional count.
+//
+// Examples:
+//
+//	IsNumPropagation() // returns true (default)
+//	NumPropagation(false)
+//	IsNumPropagation() // returns false
+func IsNumPropagation() bool {
+	return impl.IsNumPropagation()
+}
+
 // IsOrdinal checks if a string is an ordinal (either numeric like "1st" or word like "first").
 //
 // Examples:
@@ -1329,6 +3567,18 @@ func IsSingular(word string) bool {
 	return impl.IsSingular(word)
 }
 
+// IsTechnicalPlurals returns whether technical plurals of Latin nouns
+// ending in -ex and -ix are enabled.
+//
+// Examples:
+//
+//	IsTechnicalPlurals() // returns false (default)
+//	SetTechnicalPlurals(true)
+//	IsTechnicalPlurals() // returns true
+func IsTechnicalPlurals() bool {
+	return impl.IsTechnicalPlurals()
+}
+
 // Join combines a slice of strings into a grammatically correct English list.
 //
 // The function uses the Oxford comma (serial comma) for lists of three or more items.
@@ -1343,6 +3593,34 @@ func Join(words []string) string {
 	return impl.Join(words)
 }
 
+// JoinAuthors formats a list of author names for a citation, cutting lists
+// longer than maxNames short with "et al.".
+//
+// When a list is cut short, the first maxNames names are kept; a single
+// kept name is followed directly by "et al.". A maxNames of zero or less
+// lists every name. Use JoinAuthorsWithOptions for other citation styles.
+//
+// Examples:
+//   - JoinAuthors([]string{"Smith", "Jones"}, 2) returns "Smith and Jones"
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown"}, 3) returns "Smith, Jones, and Brown"
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown", "Lee"}, 2) returns "Smith, Jones, et al."
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown"}, 1) returns "Smith et al."
+//   - JoinAuthors([]string{"Smith, J.", "Jones, K."}, 0) returns "Smith, J.; and Jones, K."
+func JoinAuthors(names []string, maxNames int) string {
+	return impl.JoinAuthors(names, maxNames)
+}
+
+// JoinAuthorsWithOptions formats a list of author names for a citation,
+// following the truncation rules in opts.
+//
+// Examples:
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones"}, JoinAuthorsOptions{Conj: "&"}) returns "Smith & Jones"
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones", "Brown"}, JoinAuthorsOptions{Max: 2, Keep: 1}) returns "Smith et al."
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones", "Brown"}, JoinAuthorsOptions{Max: 2, EtAl: "and others"}) returns "Smith, Jones, and others"
+func JoinAuthorsWithOptions(names []string, opts JoinAuthorsOptions) string {
+	return impl.JoinAuthorsWithOptions(names, opts)
+}
+
 // JoinNoOxford combines a slice of strings without the Oxford comma.
 //
 // Unlike Join, this function omits the comma before the final conjunction.
@@ -1365,6 +3643,71 @@ func JoinNoOxfordWithConj(words []string, conj string) string {
 	return impl.JoinNoOxfordWithConj(words, conj)
 }
 
+// JoinPossessive lists owners who each have their own noun, such as
+// "Alice's and Bob's reports".
+//
+// Every name becomes possessive, and the noun is made plural when there is
+// more than one owner. Use JoinPossessiveShared for something the owners
+// have together. The noun may be empty, leaving just the possessives.
+//
+// Examples:
+//   - JoinPossessive([]string{"Alice", "Bob"}, "report") returns "Alice's and Bob's reports"
+//   - JoinPossessive([]string{"Alice", "Bob", "James"}, "car") returns "Alice's, Bob's, and James's cars"
+//   - JoinPossessive([]string{"Alice"}, "report") returns "Alice's report"
+//   - JoinPossessive([]string{"Alice", "me"}, "report") returns "Alice's and my reports"
+//   - JoinPossessive([]string{"Alice", "Bob"}, "") returns "Alice's and Bob's"
+func JoinPossessive(names []string, noun string) string {
+	return impl.JoinPossessive(names, noun)
+}
+
+// JoinPossessiveCtx is like JoinPossessive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinPossessiveCtx(ctx context.Context, names []string, noun string) string {
+	return impl.JoinPossessiveCtx(ctx, names, noun)
+}
+
+// JoinPossessiveShared lists owners who have a noun together, such as
+// "Alice and Bob's report".
+//
+// Only the last name becomes possessive, and the noun is left as given.
+// Use JoinPossessive when each owner has their own.
+//
+// Examples:
+//   - JoinPossessiveShared([]string{"Alice", "Bob"}, "report") returns "Alice and Bob's report"
+//   - JoinPossessiveShared([]string{"Alice", "Bob", "James"}, "house") returns "Alice, Bob, and James's house"
+//   - JoinPossessiveShared([]string{"Alice"}, "report") returns "Alice's report"
+//   - JoinPossessiveShared([]string{"Alice", "Bob"}, "") returns "Alice and Bob's"
+func JoinPossessiveShared(names []string, noun string) string {
+	return impl.JoinPossessiveShared(names, noun)
+}
+
+// JoinPossessiveSharedCtx is like JoinPossessiveShared but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinPossessiveSharedCtx(ctx context.Context, names []string, noun string) string {
+	return impl.JoinPossessiveSharedCtx(ctx, names, noun)
+}
+
+// JoinTableName returns the name of the table joining two types in a
+// many-to-many relation: their table names in alphabetical order, joined
+// by an underscore, as Rails names join tables.
+//
+// Sorting makes the name the same whichever side of the relation declares
+// it.
+//
+// Examples:
+//   - JoinTableName("User", "Role") returns "roles_users"
+//   - JoinTableName("Role", "User") returns "roles_users"
+//   - JoinTableName("Person", "Team") returns "people_teams"
+func JoinTableName(a string, b string) string {
+	return impl.JoinTableName(a, b)
+}
+
+// JoinTableNameCtx is like JoinTableName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinTableNameCtx(ctx context.Context, a string, b string) string {
+	return impl.JoinTableNameCtx(ctx, a, b)
+}
+
 // JoinWithAutoSep combines a slice of strings into a grammatically correct English list
 // with a custom conjunction, automatically choosing the separator based on content.
 //
@@ -1427,6 +3770,180 @@ func KebabCase(s string) string {
 	return impl.KebabCase(s)
 }
 
+// Lemma returns the dictionary form of an inflected word.
+//
+// The part of speech selects the analysis:
+//   - POSNoun: plural nouns are singularized, as with Singular
+//   - POSVerb: past tenses, participles, and third-person forms are reduced
+//     to the base verb, using the irregular verb tables and BaseVerb
+//   - POSAdjective: comparatives and superlatives are reduced to the base
+//     adjective, including "more"/"most" forms
+//   - POSPronoun and POSOther: the word is returned unchanged
+//
+// Words already in dictionary form are returned unchanged, and the case of
+// the input is preserved.
+//
+// Examples:
+//   - Lemma("children", POSNoun) returns "child"
+//   - Lemma("ran", POSVerb) returns "run"
+//   - Lemma("running", POSVerb) returns "run"
+//   - Lemma("tried", POSVerb) returns "try"
+//   - Lemma("watches", POSVerb) returns "watch"
+//   - Lemma("was", POSVerb) returns "be"
+//   - Lemma("happier", POSAdjective) returns "happy"
+//   - Lemma("biggest", POSAdjective) returns "big"
+//   - Lemma("best", POSAdjective) returns "good"
+//   - Lemma("more beautiful", POSAdjective) returns "beautiful"
+func Lemma(word string, pos PartOfSpeech) string {
+	return impl.Lemma(word, pos)
+}
+
+// LemmaCtx is like Lemma but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func LemmaCtx(ctx context.Context, word string, pos PartOfSpeech) string {
+	return impl.LemmaCtx(ctx, word, pos)
+}
+
+// LoadRulePack defines the plurals used in a technical domain, as if each
+// were passed to DefNoun.
+//
+// The packs are:
+//   - "medical": anatomical Latin, such as "vertebra" -> "vertebrae"
+//   - "legal": titles such as "attorney general" -> "attorneys general"
+//   - "biology": taxonomy and zoology, such as "larva" -> "larvae"
+//   - "computing": "index" -> "indexes", "schema" -> "schemata", and
+//     "lemma" -> "lemmata"
+//
+// Packs are never loaded by default, and loading one affects only the
+// engine it is loaded into. Later calls to DefNoun override a pack's
+// plurals, and Reset removes them. The name is not case sensitive; an
+// unknown name returns an error wrapping ErrUnknownRulePack and defines
+// nothing.
+//
+// Examples:
+//
+//	LoadRulePack("computing")
+//	Plural("index")     // returns "indexes"
+//	Singular("indexes") // returns "index"
+//	LoadRulePack("chemistry") // returns an error
+func LoadRulePack(name string) error {
+	return impl.LoadRulePack(name)
+}
+
+// ManyOrMuch returns "much" for a mass noun and "many" for any other noun.
+//
+// Examples:
+//   - ManyOrMuch("items") returns "many"
+//   - ManyOrMuch("water") returns "much"
+//   - ManyOrMuch("minutes") returns "many"
+func ManyOrMuch(noun string) string {
+	return impl.ManyOrMuch(noun)
+}
+
+// MessageNamePlural returns the plural of a Protobuf message name, keeping
+// its PascalCase: "UserProfile" -> "UserProfiles".
+//
+// Only the last word is made plural, and a name that is already plural is
+// kept.
+//
+// Examples:
+//   - MessageNamePlural("UserProfile") returns "UserProfiles"
+//   - MessageNamePlural("Person") returns "People"
+//   - MessageNamePlural("HTTPRoute") returns "HTTPRoutes"
+func MessageNamePlural(name string) string {
+	return impl.MessageNamePlural(name)
+}
+
+// MessageNamePluralCtx is like MessageNamePlural but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MessageNamePluralCtx(ctx context.Context, name string) string {
+	return impl.MessageNamePluralCtx(ctx, name)
+}
+
+// MessageNameSingular returns the singular of a Protobuf message name,
+// keeping its PascalCase: "UserProfiles" -> "UserProfile".
+//
+// Examples:
+//   - MessageNameSingular("UserProfiles") returns "UserProfile"
+//   - MessageNameSingular("People") returns "Person"
+//   - MessageNameSingular("Status") returns "Status"
+func MessageNameSingular(name string) string {
+	return impl.MessageNameSingular(name)
+}
+
+// MessageNameSingularCtx is like MessageNameSingular but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MessageNameSingularCtx(ctx context.Context, name string) string {
+	return impl.MessageNameSingularCtx(ctx, name)
+}
+
+// MutationFieldName returns the name of the GraphQL mutation field acting
+// on one object of a type: the action followed by the type's singular, in
+// camelCase.
+//
+// Examples:
+//   - MutationFieldName("create", "Order") returns "createOrder"
+//   - MutationFieldName("delete", "UserProfiles") returns "deleteUserProfile"
+//   - MutationFieldName("Update", "Person") returns "updatePerson"
+func MutationFieldName(action string, typeName string) string {
+	return impl.MutationFieldName(action, typeName)
+}
+
+// MutationFieldNameCtx is like MutationFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MutationFieldNameCtx(ctx context.Context, action string, typeName string) string {
+	return impl.MutationFieldNameCtx(ctx, action, typeName)
+}
+
+// Negate returns the negative of a verb, or of a verb phrase starting with
+// one, using a contraction where English has one.
+//
+// Auxiliary and modal verbs take "not" directly ("is" -> "isn't"); other
+// verbs take a form of "do" followed by the base verb ("runs" -> "doesn't
+// run"). Forms of "have" are treated as auxiliaries. A phrase that is
+// already negative is returned unchanged, and the case of the first word
+// is preserved.
+//
+// Examples:
+//   - Negate("is") returns "isn't"
+//   - Negate("can") returns "can't"
+//   - Negate("will") returns "won't"
+//   - Negate("am") returns "am not"
+//   - Negate("has been") returns "hasn't been"
+//   - Negate("runs") returns "doesn't run"
+//   - Negate("went home") returns "didn't go home"
+func Negate(verb string) string {
+	return impl.Negate(verb)
+}
+
+// NegateWithOptions returns the negative of a verb, or of a verb phrase
+// starting with one, as Negate does. If contract is false, "not" is
+// written out ("is not", "does not run"), and "can" becomes "cannot".
+//
+// Examples:
+//   - NegateWithOptions("is", false) returns "is not"
+//   - NegateWithOptions("can", false) returns "cannot"
+//   - NegateWithOptions("runs", false) returns "does not run"
+//   - NegateWithOptions("is", true) returns "isn't"
+func NegateWithOptions(verb string, contract bool) string {
+	return impl.NegateWithOptions(verb, contract)
+}
+
+// NextInName returns a name, such as a file name, with its last numeric
+// ordinal incremented by one.
+//
+// This is IncrementOrdinalInString(name, 1).
+//
+// Examples:
+//   - NextInName("report_2nd_draft") returns "report_3rd_draft"
+//   - NextInName("report_3rd_draft.docx") returns "report_4th_draft.docx"
+//   - NextInName("11th-hour") returns "12th-hour"
+//   - NextInName("20th_century") returns "21st_century"
+//   - NextInName("report.txt") returns "report.txt"
+func NextInName(name string) string {
+	return impl.NextInName(name)
+}
+
 // No returns a count and noun phrase in English, using "no" for zero counts.
 //
 // The function handles pluralization automatically:
@@ -1450,18 +3967,181 @@ func No(word string, count int) string {
 	return impl.No(word, count)
 }
 
-// Num stores and retrieves a default count for number-related operations.
+// NoCapitalized returns a count and noun phrase for the start of a
+// sentence, with the first word capitalized.
+//
+// Since a sentence should not start with a numeral, the count is spelled
+// out as in NoWords(). Only the first letter of the phrase is changed, so
+// acronyms and proper names keep their case.
+//
+// Examples:
+//   - NoCapitalized("error", 0) returns "No errors"
+//   - NoCapitalized("error", 1) returns "One error"
+//   - NoCapitalized("child", 3) returns "Three children"
+//   - NoCapitalized("URL", 0) returns "No URLs"
+//   - NoCapitalized("iPhone", 2) returns "Two iPhones"
+func NoCapitalized(word string, count int) string {
+	return impl.NoCapitalized(word, count)
+}
+
+// NoCapitalizedCtx is like NoCapitalized but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoCapitalizedCtx(ctx context.Context, word string, count int) string {
+	return impl.NoCapitalizedCtx(ctx, word, count)
+}
+
+// NoCtx is like No but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoCtx(ctx context.Context, word string, count int) string {
+	return impl.NoCtx(ctx, word, count)
+}
+
+// NoThreshold returns a count and noun phrase in English, spelling out counts
+// below the threshold in words and leaving larger counts as digits, using
+// "no" for zero counts.
+//
+// This follows the common style rule of spelling out small numbers, with
+// the count formatted by NumberToWordsThreshold(). Pluralization follows
+// the same rules as No().
+//
+// Examples:
+//   - NoThreshold("error", 0, 10) returns "no errors"
+//   - NoThreshold("error", 1, 10) returns "one error"
+//   - NoThreshold("error", 9, 10) returns "nine errors"
+//   - NoThreshold("error", 12, 10) returns "12 errors"
+func NoThreshold(word string, count int, threshold int) string {
+	return impl.NoThreshold(word, count, threshold)
+}
+
+// NoThresholdCtx is like NoThreshold but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoThresholdCtx(ctx context.Context, word string, count int, threshold int) string {
+	return impl.NoThresholdCtx(ctx, word, count, threshold)
+}
+
+// NoWords returns a count and noun phrase in English with the count spelled
+// out in words, using "no" for zero counts.
+//
+// Pluralization follows the same rules as No().
+//
+// Examples:
+//   - NoWords("error", 0) returns "no errors"
+//   - NoWords("error", 1) returns "one error"
+//   - NoWords("error", 3) returns "three errors"
+//   - NoWords("child", 21) returns "twenty-one children"
+func NoWords(word string, count int) string {
+	return impl.NoWords(word, count)
+}
+
+// NoWordsCtx is like NoWords but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoWordsCtx(ctx context.Context, word string, count int) string {
+	return impl.NoWordsCtx(ctx, word, count)
+}
+
+// NormalizeInput enables or disables Unicode normalization of the words
+// passed to Plural, Singular, and An.
+//
+// Text from different sources can spell the same word with different code
+// points: "café" may end in the single character "é" (NFC, the usual form)
+// or in "e" followed by a combining accent (NFD, as produced by macOS file
+// names and some input methods). Both forms are inflected correctly, and
+// each result keeps the form of its input. When enabled, input is first
+// converted to NFC, so that equal words give identical results, which
+// matters when comparing or caching them. Normalization is disabled by
+// default so that input is never changed beyond its inflection.
+//
+// Examples:
+//
+//	Plural("cafe\u0301")   // returns "cafe\u0301s"
+//	NormalizeInput(true)
+//	Plural("cafe\u0301")   // returns "cafés"
+func NormalizeInput(enabled bool) {
+	impl.NormalizeInput(enabled)
+}
+
+// Num stores and retrieves a default count for number-related operations.
+//
+// When called with a positive integer, it stores that value as the default
+// count and returns it. When called with 0 or no arguments, it clears the
+// default count and returns 0.
+//
+// Examples:
+//   - Num(5) stores 5 as default count, returns 5
+//   - Num(0) clears the default count, returns 0
+//   - Num() clears the default count, returns 0
+func Num(n ...int) int {
+	return impl.Num(n...)
+}
+
+// NumPropagation enables or disables use of the default count set by Num().
+//
+// When enabled (true, the default), functions that take an optional count
+// use the default count when none is given, matching Python inflect:
+//   - Plural() returns the word unchanged when the default count is 1
+//   - PluralNoun(), PluralVerb(), PluralAdj(), and SingularNoun() use it
+//     in place of a missing count argument
+//
+// When disabled (false), the default count is stored but ignored, which
+// restores the behavior of earlier versions.
+//
+// Examples:
+//
+//	Num(1)
+//	Plural("cat") // returns "cat"
+//	NumPropagation(false)
+//	Plural("cat") // returns "cats"
+func NumPropagation(enabled bool) {
+	impl.NumPropagation(enabled)
+}
+
+// NumberOfPhrase returns "the number of" followed by the plural of noun, the
+// form of verb that agrees with it, and n spelled out in words.
+//
+// "The number of" names a single count and takes a singular verb, unlike "a
+// number of", which means "several" and takes a plural verb (see
+// ANumberOf). The noun may be given in the singular or plural, and only its
+// last word is changed. The verb is matched with AgreeVerb; an empty verb
+// returns the subject alone.
+//
+// Examples:
+//   - NumberOfPhrase(3, "error", "were") returns "the number of errors was three"
+//   - NumberOfPhrase(0, "failed test", "are") returns "the number of failed tests is zero"
+//   - NumberOfPhrase(1, "children", "") returns "the number of children"
+func NumberOfPhrase(n int, noun string, verb string) string {
+	return impl.NumberOfPhrase(n, noun, verb)
+}
+
+// NumberOfPhraseCtx is like NumberOfPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NumberOfPhraseCtx(ctx context.Context, n int, noun string, verb string) string {
+	return impl.NumberOfPhraseCtx(ctx, n, noun, verb)
+}
+
+// NumberStringToWordsGrouped converts a string of digits to English words
+// by splitting it into groups of the specified size and converting each
+// group independently, like NumberToWordsGrouped.
 //
-// When called with a positive integer, it stores that value as the default
-// count and returns it. When called with 0 or no arguments, it clears the
-// default count and returns 0.
+// Unlike NumberToWordsGrouped, which takes an int, this keeps leading
+// zeros, so it suits account numbers, PINs, and codes that are read back
+// to a user. Zeros at the start of a group are read one by one before the
+// rest of the group: "0123" in groups of 2 is "zero one twenty-three". The
+// string is split from right to left. A group too long to fit in an int is
+// read digit by digit. Surrounding spaces are ignored.
+//
+// It returns ErrNotDigits if the string is empty or contains anything
+// other than the digits 0-9, including signs and separators, and
+// ErrInvalidGroupSize if groupSize is less than 1.
 //
 // Examples:
-//   - Num(5) stores 5 as default count, returns 5
-//   - Num(0) clears the default count, returns 0
-//   - Num() clears the default count, returns 0
-func Num(n ...int) int {
-	return impl.Num(n...)
+//   - NumberStringToWordsGrouped("0123", 2) returns ("zero one twenty-three", nil)
+//   - NumberStringToWordsGrouped("007", 3) returns ("zero zero seven", nil)
+//   - NumberStringToWordsGrouped("4152", 2) returns ("forty-one fifty-two", nil)
+//   - NumberStringToWordsGrouped("12345", 2) returns ("one twenty-three forty-five", nil)
+//   - NumberStringToWordsGrouped("12-34", 2) returns ("", ErrNotDigits)
+//   - NumberStringToWordsGrouped("0123", 0) returns ("", ErrInvalidGroupSize)
+func NumberStringToWordsGrouped(s string, groupSize int) (string, error) {
+	return impl.NumberStringToWordsGrouped(s, groupSize)
 }
 
 // NumberToWords converts an integer to its English word representation.
@@ -1477,6 +4157,40 @@ func NumberToWords(n int) string {
 	return impl.NumberToWords(n)
 }
 
+// NumberToWordsBare reads an integer aloud one thousands group at a time,
+// without the scale words "thousand", "million", and so on.
+//
+// Each group of three digits is read as its first digit followed by the
+// number formed by the last two, the way numbers such as room numbers and
+// flight numbers are said, and zeros within a group are spoken digit by
+// digit. Unlike NumberToWordsGrouped, which reads each group as a number,
+// this keeps every digit: 100 is "one zero zero", not "one hundred".
+//
+// Examples:
+//   - NumberToWordsBare(120034) returns "one twenty zero thirty-four"
+//   - NumberToWordsBare(2024) returns "two zero twenty-four"
+//   - NumberToWordsBare(555) returns "five fifty-five"
+//   - NumberToWordsBare(105) returns "one zero five"
+//   - NumberToWordsBare(-42) returns "negative forty-two"
+func NumberToWordsBare(n int) string {
+	return impl.NumberToWordsBare(n)
+}
+
+// NumberToWordsBareWithOptions reads an integer aloud one thousands group
+// at a time, like NumberToWordsBare, using the word for zero and the
+// handling of leading zeros in opts.
+//
+// Examples:
+//   - NumberToWordsBareWithOptions(120034, NumberToWordsBareOptions{Zero: "oh"})
+//     returns "one twenty oh thirty-four"
+//   - NumberToWordsBareWithOptions(120034, NumberToWordsBareOptions{OmitLeadingZeros: true})
+//     returns "one twenty thirty-four"
+//   - NumberToWordsBareWithOptions(1000, NumberToWordsBareOptions{OmitLeadingZeros: true})
+//     returns "one zero"
+func NumberToWordsBareWithOptions(n int, opts NumberToWordsBareOptions) string {
+	return impl.NumberToWordsBareWithOptions(n, opts)
+}
+
 // NumberToWordsFloat converts a floating-point number to its English word representation.
 //
 // The integer part is converted using NumberToWords, followed by "point",
@@ -1556,6 +4270,48 @@ func NumberToWordsWithAnd(n int) string {
 	return impl.NumberToWordsWithAnd(n)
 }
 
+// NumberToWordsWithOptions converts an integer to its English word
+// representation in the style of opts.
+//
+// Examples:
+//   - NumberToWordsWithOptions(121, NumberWordOptions{}) returns "one hundred twenty-one"
+//   - NumberToWordsWithOptions(121, NumberWordOptions{And: true}) returns "one hundred and twenty-one"
+//   - NumberToWordsWithOptions(1001, NumberWordOptions{And: true}) returns "one thousand and one"
+//   - NumberToWordsWithOptions(42, NumberWordOptions{OmitHyphens: true}) returns "forty two"
+//   - NumberToWordsWithOptions(-21, NumberWordOptions{And: true}) returns "negative twenty-one"
+func NumberToWordsWithOptions(n int, opts NumberWordOptions) string {
+	return impl.NumberToWordsWithOptions(n, opts)
+}
+
+// ObjectFieldName returns the name of the GraphQL query field fetching one
+// object of a type: its singular in camelCase.
+//
+// Examples:
+//   - ObjectFieldName("Order") returns "order"
+//   - ObjectFieldName("UserProfiles") returns "userProfile"
+//   - ObjectFieldName("People") returns "person"
+func ObjectFieldName(typeName string) string {
+	return impl.ObjectFieldName(typeName)
+}
+
+// ObjectFieldNameCtx is like ObjectFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ObjectFieldNameCtx(ctx context.Context, typeName string) string {
+	return impl.ObjectFieldNameCtx(ctx, typeName)
+}
+
+// OnesWord returns the word for a number from 0 to 19, the numbers that
+// have a word of their own, or "" for any other number.
+//
+// Examples:
+//   - OnesWord(0) returns "zero"
+//   - OnesWord(7) returns "seven"
+//   - OnesWord(13) returns "thirteen"
+//   - OnesWord(20) returns ""
+func OnesWord(n int) string {
+	return impl.OnesWord(n)
+}
+
 // Ordinal converts an integer to its ordinal string representation.
 //
 // Examples:
@@ -1569,6 +4325,104 @@ func Ordinal(n int) string {
 	return impl.Ordinal(n)
 }
 
+// OrdinalDay writes a day of the month with its ordinal, in the given
+// style, for use in running text such as "on July 4th".
+//
+// The day must exist in the month, counting February 29th; invalid months
+// and days return an empty string.
+//
+// Examples:
+//   - OrdinalDay(time.July, 4, OrdinalDayMonthFirst) returns "July 4th"
+//   - OrdinalDay(time.July, 4, OrdinalDayDayFirst) returns "4th July"
+//   - OrdinalDay(time.July, 4, OrdinalDaySpelledOut) returns "the fourth of July"
+//   - OrdinalDay(time.March, 22, OrdinalDayDayFirst) returns "22nd March"
+//   - OrdinalDay(time.April, 31, OrdinalDayMonthFirst) returns ""
+func OrdinalDay(month time.Month, day int, style OrdinalDayStyle) string {
+	return impl.OrdinalDay(month, day, style)
+}
+
+// OrdinalFromEnd describes a 1-based position in a list of total items
+// relative to the end of the list.
+//
+// Returns an empty string if n is outside the range 1 to total.
+//
+// Examples:
+//   - OrdinalFromEnd(10, 10) returns "last"
+//   - OrdinalFromEnd(9, 10) returns "second to last"
+//   - OrdinalFromEnd(8, 10) returns "third to last"
+//   - OrdinalFromEnd(1, 10) returns "tenth to last"
+//   - OrdinalFromEnd(11, 10) returns ""
+func OrdinalFromEnd(n int, total int) string {
+	return impl.OrdinalFromEnd(n, total)
+}
+
+// OrdinalInString returns the value of the last numeric ordinal in s, and
+// reports whether one was found.
+//
+// An ordinal counts only if its suffix is right for its number and it is
+// not followed by a letter, so "2th" and "4thly" are not ordinals.
+//
+// Examples:
+//   - OrdinalInString("report_2nd_draft") returns (2, true)
+//   - OrdinalInString("1st-pass-22nd.txt") returns (22, true)
+//   - OrdinalInString("THE_3RD_MAN") returns (3, true)
+//   - OrdinalInString("report_2th_draft") returns (0, false)
+//   - OrdinalInString("report.txt") returns (0, false)
+func OrdinalInString(s string) (int, bool) {
+	return impl.OrdinalInString(s)
+}
+
+// OrdinalRange returns a range of positions as ordinals joined by an en
+// dash. If from is greater than to, they are swapped.
+//
+// Examples:
+//   - OrdinalRange(1, 3) returns "1st–3rd"
+//   - OrdinalRange(21, 23) returns "21st–23rd"
+//   - OrdinalRange(5, 5) returns "5th"
+//   - OrdinalRange(3, 1) returns "1st–3rd"
+func OrdinalRange(from int, to int) string {
+	return impl.OrdinalRange(from, to)
+}
+
+// OrdinalRangeWords returns a range of positions as ordinal words joined
+// by "through". If from is greater than to, they are swapped.
+//
+// Examples:
+//   - OrdinalRangeWords(1, 3) returns "first through third"
+//   - OrdinalRangeWords(20, 21) returns "twentieth through twenty-first"
+//   - OrdinalRangeWords(5, 5) returns "fifth"
+func OrdinalRangeWords(from int, to int) string {
+	return impl.OrdinalRangeWords(from, to)
+}
+
+// OrdinalRanges lists positions as ordinals, collapsing runs of three or
+// more consecutive positions into ranges like OrdinalRange.
+//
+// The positions are sorted and duplicates are dropped. The result is
+// joined with "and", using an Oxford comma for three or more parts.
+//
+// Examples:
+//   - OrdinalRanges([]int{1, 2, 3, 7}) returns "1st–3rd and 7th"
+//   - OrdinalRanges([]int{1, 2, 5, 6, 7, 8}) returns "1st, 2nd, and 5th–8th"
+//   - OrdinalRanges([]int{7, 3, 2, 1, 3}) returns "1st–3rd and 7th"
+//   - OrdinalRanges(nil) returns ""
+func OrdinalRanges(positions []int) string {
+	return impl.OrdinalRanges(positions)
+}
+
+// OrdinalRangesWords lists positions as ordinal words, collapsing runs of
+// three or more consecutive positions into ranges like OrdinalRangeWords.
+//
+// The positions are sorted and duplicates are dropped. The result is
+// joined with "and", using an Oxford comma for three or more parts.
+//
+// Examples:
+//   - OrdinalRangesWords([]int{1, 2, 3, 7}) returns "first through third and seventh"
+//   - OrdinalRangesWords([]int{1, 3}) returns "first and third"
+func OrdinalRangesWords(positions []int) string {
+	return impl.OrdinalRangesWords(positions)
+}
+
 // OrdinalSuffix returns the ordinal suffix for a number ("st", "nd", "rd", or "th").
 //
 // This is useful when you need just the suffix without the number.
@@ -1620,6 +4474,21 @@ func OrdinalWord(n int) string {
 	return impl.OrdinalWord(n)
 }
 
+// OrdinalWordWithOptions converts an integer to its ordinal word representation,
+// with control over zero and negative numbers and the style of the words.
+//
+// Examples:
+//   - OrdinalWordWithOptions(3, OrdinalWordOptions{}) returns "third"
+//   - OrdinalWordWithOptions(121, OrdinalWordOptions{NumberWordOptions: NumberWordOptions{And: true}})
+//     returns "one hundred and twenty-first"
+//   - OrdinalWordWithOptions(-1, OrdinalWordOptions{}) returns "negative first"
+//   - OrdinalWordWithOptions(-1, OrdinalWordOptions{FromEnd: true}) returns "last"
+//   - OrdinalWordWithOptions(-2, OrdinalWordOptions{FromEnd: true}) returns "second to last"
+//   - OrdinalWordWithOptions(0, OrdinalWordOptions{Zero: "none"}) returns "none"
+func OrdinalWordWithOptions(n int, opts OrdinalWordOptions) string {
+	return impl.OrdinalWordWithOptions(n, opts)
+}
+
 // Parameterize converts a string to a URL-safe slug using dashes as separators.
 //
 // This function is provided for compatibility with github.com/go-openapi/inflect
@@ -1661,6 +4530,50 @@ func PascalCase(s string) string {
 	return impl.PascalCase(s)
 }
 
+// Passivize turns an active clause into the passive voice, keeping the
+// tense of the verb.
+//
+// The object becomes the subject, the verb becomes a form of "be" that
+// agrees with it followed by the past participle, and the original subject
+// follows "by". The tense is read from the verb: a past form gives the
+// past tense, "has" or "have" with a participle the perfect, and "will"
+// the future. Pronouns change case ("she" -> "by her"). If subject is
+// empty, the "by" phrase is left out.
+//
+// Examples:
+//   - Passivize("the analyst", "wrote", "the report") returns "The report was written by the analyst"
+//   - Passivize("the team", "reviews", "all changes") returns "All changes are reviewed by the team"
+//   - Passivize("someone", "has taken", "the seat") returns "The seat has been taken by someone"
+//   - Passivize("she", "will send", "them") returns "They will be sent by her"
+//   - Passivize("", "deleted", "the file") returns "The file was deleted"
+func Passivize(subject string, verb string, object string) string {
+	return impl.Passivize(subject, verb, object)
+}
+
+// PassivizeCtx is like Passivize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PassivizeCtx(ctx context.Context, subject string, verb string, object string) string {
+	return impl.PassivizeCtx(ctx, subject, verb, object)
+}
+
+// PassivizeWithTense turns an active clause into the passive voice in the
+// given tense, whatever the tense of verb.
+//
+// Examples:
+//   - PassivizeWithTense("the analyst", "write", "the report", TensePresent) returns "The report is written by the analyst"
+//   - PassivizeWithTense("the analyst", "write", "the reports", TensePast) returns "The reports were written by the analyst"
+//   - PassivizeWithTense("the analyst", "wrote", "the report", TensePerfect) returns "The report has been written by the analyst"
+//   - PassivizeWithTense("I", "write", "the report", TenseFuture) returns "The report will be written by me"
+func PassivizeWithTense(subject string, verb string, object string, tense Tense) string {
+	return impl.PassivizeWithTense(subject, verb, object, tense)
+}
+
+// PassivizeWithTenseCtx is like PassivizeWithTense but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PassivizeWithTenseCtx(ctx context.Context, subject string, verb string, object string, tense Tense) string {
+	return impl.PassivizeWithTenseCtx(ctx, subject, verb, object, tense)
+}
+
 // PastParticiple converts a verb to its past participle form.
 //
 // Examples:
@@ -1670,10 +4583,46 @@ func PascalCase(s string) string {
 //   - PastParticiple("go") returns "gone" (irregular)
 //   - PastParticiple("take") returns "taken" (irregular)
 //   - PastParticiple("run") returns "run" (unchanged irregular)
+//
+// The spelling follows the dialect set with SetDialect, as for
+// PresentParticiple: travel -> traveled (US) or travelled (UK).
 func PastParticiple(verb string) string {
 	return impl.PastParticiple(verb)
 }
 
+// PastParticipleCtx is like PastParticiple but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastParticipleCtx(ctx context.Context, verb string) string {
+	return impl.PastParticipleCtx(ctx, verb)
+}
+
+// PastParticipleIn converts a verb to its past participle form in the given
+// dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PastParticipleIn("cancel", DialectUS) returns "canceled"
+//   - PastParticipleIn("cancel", DialectUK) returns "cancelled"
+//   - PastParticipleIn("organize", DialectUK) returns "organised"
+func PastParticipleIn(verb string, d Dialect) string {
+	return impl.PastParticipleIn(verb, d)
+}
+
+// PastParticiplePhrase converts a verb phrase, such as a phrasal verb, to
+// its past participle form by inflecting only its head verb.
+//
+// Examples:
+//   - PastParticiplePhrase("break down") returns "broken down"
+//   - PastParticiplePhrase("sign up for") returns "signed up for"
+func PastParticiplePhrase(phrase string) string {
+	return impl.PastParticiplePhrase(phrase)
+}
+
+// PastParticiplePhraseCtx is like PastParticiplePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastParticiplePhraseCtx(ctx context.Context, phrase string) string {
+	return impl.PastParticiplePhraseCtx(ctx, phrase)
+}
+
 // PastTense returns the simple past tense form of an English verb.
 //
 // Examples:
@@ -1681,17 +4630,124 @@ func PastParticiple(verb string) string {
 //   - PastTense("go") returns "went"
 //   - PastTense("try") returns "tried"
 //   - PastTense("stop") returns "stopped"
+//
+// The spelling follows the dialect set with SetDialect, as for
+// PresentParticiple: travel -> traveled (US) or travelled (UK).
 func PastTense(verb string) string {
 	return impl.PastTense(verb)
 }
 
+// PastTenseCtx is like PastTense but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastTenseCtx(ctx context.Context, verb string) string {
+	return impl.PastTenseCtx(ctx, verb)
+}
+
+// PastTenseIn returns the simple past tense form of a verb in the given
+// dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PastTenseIn("travel", DialectUS) returns "traveled"
+//   - PastTenseIn("travel", DialectUK) returns "travelled"
+//   - PastTenseIn("realise", DialectUS) returns "realized"
+//   - PastTenseIn("realize", DialectUK) returns "realised"
+func PastTenseIn(verb string, d Dialect) string {
+	return impl.PastTenseIn(verb, d)
+}
+
+// PastTensePhrase returns the simple past tense form of a verb phrase, such
+// as a phrasal verb, by inflecting only its head verb.
+//
+// Examples:
+//   - PastTensePhrase("check out") returns "checked out"
+//   - PastTensePhrase("give up on") returns "gave up on"
+//   - PastTensePhrase("log in") returns "logged in"
+func PastTensePhrase(phrase string) string {
+	return impl.PastTensePhrase(phrase)
+}
+
+// PastTensePhraseCtx is like PastTensePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastTensePhraseCtx(ctx context.Context, phrase string) string {
+	return impl.PastTensePhraseCtx(ctx, phrase)
+}
+
+// PercentToWords converts a percentage to its English word representation.
+//
+// The value is rounded to two decimal places before conversion. Use
+// PercentToWordsWithPrecision for a different rounding precision. NaN and
+// infinite values return an empty string.
+//
+// Examples:
+//   - PercentToWords(12.5) returns "twelve point five percent"
+//   - PercentToWords(100) returns "one hundred percent"
+//   - PercentToWords(33.3333) returns "thirty-three point three three percent"
+//   - PercentToWords(-4) returns "negative four percent"
+func PercentToWords(percent float64) string {
+	return impl.PercentToWords(percent)
+}
+
+// PercentToWordsWithPrecision converts a percentage to its English word
+// representation, rounded to the given number of decimal places.
+//
+// A negative precision disables rounding. NaN and infinite values return
+// an empty string.
+//
+// Examples:
+//   - PercentToWordsWithPrecision(33.3333, 0) returns "thirty-three percent"
+//   - PercentToWordsWithPrecision(33.3333, 1) returns "thirty-three point three percent"
+//   - PercentToWordsWithPrecision(99.96, 1) returns "one hundred percent"
+//   - PercentToWordsWithPrecision(0.125, -1) returns "zero point one two five percent"
+func PercentToWordsWithPrecision(percent float64, precision int) string {
+	return impl.PercentToWordsWithPrecision(percent, precision)
+}
+
+// Phrase builds a counted noun phrase in which the article or count, the
+// adjectives, and the noun agree.
+//
+// A count of 1 takes "a" or "an", chosen by the first adjective (or the
+// noun if there are none). A count of 0 uses "no", and any other count is
+// written as digits. Only the last word of the noun is made plural.
+// Blank adjectives are skipped.
+//
+// Examples:
+//   - Phrase(1, []string{"old", "oak"}, "tree") returns "an old oak tree"
+//   - Phrase(3, []string{"old", "oak"}, "tree") returns "3 old oak trees"
+//   - Phrase(0, []string{"old", "oak"}, "tree") returns "no old oak trees"
+//   - Phrase(1, nil, "hour") returns "an hour"
+//   - Phrase(2, []string{"little"}, "field mouse") returns "2 little field mice"
+func Phrase(count int, adjectives []string, noun string) string {
+	return impl.Phrase(count, adjectives, noun)
+}
+
+// PhraseCtx is like Phrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PhraseCtx(ctx context.Context, count int, adjectives []string, noun string) string {
+	return impl.PhraseCtx(ctx, count, adjectives, noun)
+}
+
 // Plural returns the plural form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// singular possessive becomes a plural possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - Plural("cat") returns "cats"
 //   - Plural("box") returns "boxes"
 //   - Plural("child") returns "children"
 //   - Plural("sheep") returns "sheep"
+//   - Plural("cat's") returns "cats'"
+//   - Plural("child's") returns "children's"
+//   - Plural("cat,") returns "cats,"
+//   - Plural("child.json") returns "children.json"
+//   - Plural("iPhone") returns "iPhones"
+//
+// If a default count of 1 has been set with Num() and count propagation is
+// enabled, the word is returned unchanged.
 func Plural(word string) string {
 	return impl.Plural(word)
 }
@@ -1705,7 +4761,8 @@ func Plural(word string) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -1720,6 +4777,100 @@ func PluralAdj(word string, count ...int) string {
 	return impl.PluralAdj(word, count...)
 }
 
+// PluralAdjCtx is like PluralAdj but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralAdjCtx(ctx context.Context, word string, count ...int) string {
+	return impl.PluralAdjCtx(ctx, word, count...)
+}
+
+// PluralCtx is like Plural but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralCtx(ctx context.Context, word string) string {
+	return impl.PluralCtx(ctx, word)
+}
+
+// PluralE returns the plural form of an English noun, like Plural, but
+// returns ErrUnknownWord instead of echoing input that has no letters to
+// inflect or is written in another script.
+//
+// Examples:
+//   - PluralE("cat") returns ("cats", nil)
+//   - PluralE("child") returns ("children", nil)
+//   - PluralE("") returns ("", ErrUnknownWord)
+//   - PluralE("42") returns ("", ErrUnknownWord)
+//   - PluralE("猫") returns ("", ErrUnknownWord)
+func PluralE(word string) (string, error) {
+	return impl.PluralE(word)
+}
+
+// PluralLastWord returns a phrase with only its last word made plural.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - PluralLastWord("blue bird") returns "blue birds"
+//   - PluralLastWord("baby child") returns "baby children"
+//   - PluralLastWord("field mouse") returns "field mice"
+//   - PluralLastWord("cat") returns "cats"
+func PluralLastWord(phrase string) string {
+	return impl.PluralLastWord(phrase)
+}
+
+// PluralLastWordCtx is like PluralLastWord but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralLastWordCtx(ctx context.Context, phrase string) string {
+	return impl.PluralLastWordCtx(ctx, phrase)
+}
+
+// PluralLower returns the plural form of a noun written in lowercase ASCII
+// letters, such as a table or field name, faster than Plural.
+//
+// Words of the form "[a-z]+" cannot contain punctuation, possessives, file
+// extensions, version numbers, or capitalization, so PluralLower skips
+// those steps, along with the result cache and case matching, and goes
+// straight to the noun tables and suffix rules. Any other word is passed
+// to Plural, so the result is always the same as Plural's.
+//
+// Examples:
+//   - PluralLower("user") returns "users"
+//   - PluralLower("category") returns "categories"
+//   - PluralLower("person") returns "people"
+//   - PluralLower("User") returns "Users" (through Plural)
+func PluralLower(word string) string {
+	return impl.PluralLower(word)
+}
+
+// PluralLowerCtx is like PluralLower but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralLowerCtx(ctx context.Context, word string) string {
+	return impl.PluralLowerCtx(ctx, word)
+}
+
+// PluralName returns the plural of a proper name, such as a family name,
+// regardless of the proper name detection mode.
+//
+// Names are never respelled: "-s" is added, or "-es" after s, x, z, ch, or
+// sh. Only the last word of a multi-word name is pluralized. With
+// ClassicalNames(true), names ending in "s" are returned unchanged.
+//
+// Examples:
+//   - PluralName("Mary") returns "Marys"
+//   - PluralName("Kennedy") returns "Kennedys"
+//   - PluralName("Jones") returns "Joneses"
+//   - PluralName("Wolf") returns "Wolfs"
+//   - PluralName("Church") returns "Churches"
+//   - PluralName("John Smith") returns "John Smiths"
+func PluralName(name string) string {
+	return impl.PluralName(name)
+}
+
+// PluralNameCtx is like PluralName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNameCtx(ctx context.Context, name string) string {
+	return impl.PluralNameCtx(ctx, name)
+}
+
 // PluralNoun returns the plural form of an English noun or pronoun.
 //
 // This function handles:
@@ -1731,7 +4882,8 @@ func PluralAdj(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -1745,6 +4897,92 @@ func PluralNoun(word string, count ...int) string {
 	return impl.PluralNoun(word, count...)
 }
 
+// PluralNounCtx is like PluralNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNounCtx(ctx context.Context, word string, count ...int) string {
+	return impl.PluralNounCtx(ctx, word, count...)
+}
+
+// PluralNounPhrase returns the plural form of a noun phrase by inflecting
+// its head noun, the word before the first preposition, and keeping the
+// complement that follows it. Phrases without a preposition have their last
+// word made plural, as with PluralLastWord.
+//
+// The same applies within hyphenated compounds, and to a compound as the
+// head of a longer phrase.
+//
+// Examples:
+//   - PluralNounPhrase("cup of coffee") returns "cups of coffee"
+//   - PluralNounPhrase("board of directors") returns "boards of directors"
+//   - PluralNounPhrase("piece of information") returns "pieces of information"
+//   - PluralNounPhrase("mother-in-law") returns "mothers-in-law"
+//   - PluralNounPhrase("blue bird") returns "blue birds"
+func PluralNounPhrase(phrase string) string {
+	return impl.PluralNounPhrase(phrase)
+}
+
+// PluralNounPhraseCtx is like PluralNounPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNounPhraseCtx(ctx context.Context, phrase string) string {
+	return impl.PluralNounPhraseCtx(ctx, phrase)
+}
+
+// PluralPeople returns the plural of "person" or "people" in a sense:
+// "people" for individuals, "persons" in formal writing, or "peoples" for
+// nations.
+//
+// Plural always uses one plural of "person", chosen by ClassicalPersons,
+// and treats "people" as a singular only when asked for its plural.
+// PluralPeople accepts any form of either word and returns the plural for
+// the sense, whatever the classical settings. Other words are made plural
+// by Plural.
+//
+// Examples:
+//   - PluralPeople("person", PersonIndividuals) returns "people"
+//   - PluralPeople("person", PersonFormal) returns "persons"
+//   - PluralPeople("people", PersonNations) returns "peoples"
+//   - PluralPeople("people", PersonIndividuals) returns "people"
+//   - PluralPeople("cat", PersonNations) returns "cats"
+func PluralPeople(word string, sense PersonSense) string {
+	return impl.PluralPeople(word, sense)
+}
+
+// PluralPeopleCtx is like PluralPeople but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralPeopleCtx(ctx context.Context, word string, sense PersonSense) string {
+	return impl.PluralPeopleCtx(ctx, word, sense)
+}
+
+// PluralSafe returns the plural form of an English noun, like Plural, or the
+// noun unchanged if it is already plural.
+//
+// Plural expects a singular noun and inflects whatever it is given, so
+// that Plural("cats") returns "catses". PluralSafe first checks whether
+// the noun is the plural of its singular form, including classical
+// plurals and nouns defined with DefNoun, which makes it safe to call on
+// words whose number is unknown and to call more than once.
+//
+// If count is provided and equals 1 or -1, the word is returned unchanged;
+// any other count makes it plural. If no count is provided, the default
+// count set by Num() is used, as in Plural.
+//
+// Examples:
+//   - PluralSafe("cat") returns "cats"
+//   - PluralSafe("cats") returns "cats"
+//   - PluralSafe("children") returns "children"
+//   - PluralSafe("formulae") returns "formulae"
+//   - PluralSafe("sheep") returns "sheep"
+//   - PluralSafe("cat", 1) returns "cat"
+func PluralSafe(word string, count ...int) string {
+	return impl.PluralSafe(word, count...)
+}
+
+// PluralSafeCtx is like PluralSafe but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralSafeCtx(ctx context.Context, word string, count ...int) string {
+	return impl.PluralSafeCtx(ctx, word, count...)
+}
+
 // PluralVerb returns the plural form of an English verb.
 //
 // This function handles:
@@ -1755,7 +4993,8 @@ func PluralNoun(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -1770,6 +5009,34 @@ func PluralVerb(word string, count ...int) string {
 	return impl.PluralVerb(word, count...)
 }
 
+// PluralVerbCtx is like PluralVerb but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralVerbCtx(ctx context.Context, word string, count ...int) string {
+	return impl.PluralVerbCtx(ctx, word, count...)
+}
+
+// PluralVerbPhrase returns the plural form of a verb phrase, such as a
+// phrasal verb, by inflecting only its first word, the head verb. The
+// particles and any other words are kept as they are.
+//
+// As for PluralVerb, a count of 1 or -1 returns the singular form, and
+// with no count the default count set by Num() is used.
+//
+// Examples:
+//   - PluralVerbPhrase("logs in") returns "log in"
+//   - PluralVerbPhrase("gives up on") returns "give up on"
+//   - PluralVerbPhrase("is set up") returns "are set up"
+//   - PluralVerbPhrase("are set up", 1) returns "is set up"
+func PluralVerbPhrase(phrase string, count ...int) string {
+	return impl.PluralVerbPhrase(phrase, count...)
+}
+
+// PluralVerbPhraseCtx is like PluralVerbPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralVerbPhraseCtx(ctx context.Context, phrase string, count ...int) string {
+	return impl.PluralVerbPhraseCtx(ctx, phrase, count...)
+}
+
 // Pluralize is an alias for Plural, provided for compatibility with
 // github.com/go-openapi/inflect.
 //
@@ -1780,6 +5047,35 @@ func Pluralize(word string) string {
 	return impl.Pluralize(word)
 }
 
+// PluralizeCtx is like Pluralize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralizeCtx(ctx context.Context, word string) string {
+	return impl.PluralizeCtx(ctx, word)
+}
+
+// PluralizeKeys returns a copy of m with each key made plural, for
+// reshaping JSON and API payloads: "user" -> "users".
+//
+// Keys are read as identifiers in any case style, and only their last word
+// is inflected, keeping the rest of the key and its separators:
+// "userProfile" -> "userProfiles", "line_item" -> "line_items". Keys that
+// are already plural are kept, so PluralizeKeys can be applied to a
+// payload more than once. If recursive is true, maps nested in values and
+// in []any values are converted too. The input is not modified, and the
+// default count set with Num does not apply.
+//
+// When two keys have the same plural, the key that was already plural
+// wins, and otherwise the key that sorts first.
+//
+// Examples:
+//   - PluralizeKeys(map[string]any{"user": 1, "postTag": 2}, false)
+//     returns map[string]any{"users": 1, "postTags": 2}
+//   - PluralizeKeys(map[string]any{"person": map[string]any{"child": 1}}, true)
+//     returns map[string]any{"people": map[string]any{"children": 1}}
+func PluralizeKeys(m map[string]any, recursive bool) map[string]any {
+	return impl.PluralizeKeys(m, recursive)
+}
+
 // Possessive returns the possessive form of an English noun.
 //
 // Rules applied:
@@ -1790,32 +5086,177 @@ func Pluralize(word string) string {
 //   - Words already in possessive form are returned unchanged
 //
 // Examples:
-//   - Possessive("cat") returns "cat's"
-//   - Possessive("cats") returns "cats'"
-//   - Possessive("children") returns "children's"
-//   - Possessive("James") returns "James's" (with PossessiveModern)
-//   - Possessive("James") returns "James'" (with PossessiveTraditional)
-func Possessive(word string) string {
-	return impl.Possessive(word)
+//   - Possessive("cat") returns "cat's"
+//   - Possessive("cats") returns "cats'"
+//   - Possessive("children") returns "children's"
+//   - Possessive("James") returns "James's" (with PossessiveModern)
+//   - Possessive("James") returns "James'" (with PossessiveTraditional)
+func Possessive(word string) string {
+	return impl.Possessive(word)
+}
+
+// PossessiveCtx is like Possessive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PossessiveCtx(ctx context.Context, word string) string {
+	return impl.PossessiveCtx(ctx, word)
+}
+
+// PossessiveStyle sets the style for forming possessives of words ending in s.
+// Use PossessiveModern (default) for "James's" or PossessiveTraditional for "James'".
+func PossessiveStyle(style impl.PossessiveStyleType) {
+	impl.PossessiveStyle(style)
+}
+
+// PresentParticiple converts a verb to its present participle (-ing) form.
+//
+// Examples:
+//   - PresentParticiple("run") returns "running" (double consonant)
+//   - PresentParticiple("make") returns "making" (drop silent e)
+//   - PresentParticiple("play") returns "playing" (just add -ing)
+//   - PresentParticiple("die") returns "dying" (ie -> ying)
+//   - PresentParticiple("see") returns "seeing" (ee -> eeing)
+//   - PresentParticiple("panic") returns "panicking" (c -> ck)
+//
+// The spelling follows the dialect set with SetDialect: in British English
+// a final l is doubled after a single vowel (travel -> travelling), and
+// verbs are spelled -ise rather than -ize (realize -> realising). Until a
+// dialect is set, verbs keep the -ise or -ize spelling they are given.
+func PresentParticiple(verb string) string {
+	return impl.PresentParticiple(verb)
+}
+
+// PresentParticipleCtx is like PresentParticiple but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PresentParticipleCtx(ctx context.Context, verb string) string {
+	return impl.PresentParticipleCtx(ctx, verb)
+}
+
+// PresentParticipleIn converts a verb to its present participle (-ing)
+// form in the given dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PresentParticipleIn("travel", DialectUS) returns "traveling"
+//   - PresentParticipleIn("travel", DialectUK) returns "travelling"
+//   - PresentParticipleIn("worship", DialectUK) returns "worshipping"
+//   - PresentParticipleIn("realise", DialectUS) returns "realizing"
+func PresentParticipleIn(verb string, d Dialect) string {
+	return impl.PresentParticipleIn(verb, d)
+}
+
+// PresentParticiplePhrase converts a verb phrase, such as a phrasal verb,
+// to its present participle (-ing) form by inflecting only its head verb.
+//
+// Examples:
+//   - PresentParticiplePhrase("set up") returns "setting up"
+//   - PresentParticiplePhrase("give up on") returns "giving up on"
+//   - PresentParticiplePhrase("make do with") returns "making do with"
+func PresentParticiplePhrase(phrase string) string {
+	return impl.PresentParticiplePhrase(phrase)
+}
+
+// PresentParticiplePhraseCtx is like PresentParticiplePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PresentParticiplePhraseCtx(ctx context.Context, phrase string) string {
+	return impl.PresentParticiplePhraseCtx(ctx, phrase)
+}
+
+// QuantifyCount describes a count of nouns with an approximate quantifier
+// instead of the exact number.
+//
+// Zero uses "no" and one uses an indefinite article, following No() and
+// An(). Larger counts use the default magnitude buckets:
+//   - 2 returns "a couple of"
+//   - 3-4 returns "a few"
+//   - 5-11 returns "several"
+//   - 12-99 returns "dozens of"
+//   - 100-999 returns "hundreds of"
+//   - 1,000 and up returns "thousands of", "millions of", or "billions of"
+//
+// A negative count, such as a change of -3 files, is treated as its
+// absolute value, so it is described by the size of the change.
+//
+// Examples:
+//   - QuantifyCount(0, "file") returns "no files"
+//   - QuantifyCount(1, "file") returns "a file"
+//   - QuantifyCount(2, "file") returns "a couple of files"
+//   - QuantifyCount(7, "file") returns "several files"
+//   - QuantifyCount(40, "file") returns "dozens of files"
+//   - QuantifyCount(250, "child") returns "hundreds of children"
+//   - QuantifyCount(-3, "file") returns "a few files"
+func QuantifyCount(n int, noun string) string {
+	return impl.QuantifyCount(n, noun)
+}
+
+// QuantifyCountCtx is like QuantifyCount but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QuantifyCountCtx(ctx context.Context, n int, noun string) string {
+	return impl.QuantifyCountCtx(ctx, n, noun)
+}
+
+// QuantifyCountWithBuckets describes a count of nouns with the quantifier of
+// the bucket the count falls in.
+//
+// The buckets must be sorted by Min. Zero uses "no" and one uses an
+// indefinite article; counts of two or more below the first bucket are
+// written as digits. A negative count is treated as its absolute value.
+//
+// Examples:
+//   - QuantifyCountWithBuckets(30, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "lots of files"
+//   - QuantifyCountWithBuckets(4, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "some files"
+//   - QuantifyCountWithBuckets(4, "file", []QuantityBucket{{10, "many"}}) returns "4 files"
+func QuantifyCountWithBuckets(n int, noun string, buckets []impl.QuantityBucket) string {
+	return impl.QuantifyCountWithBuckets(n, noun, buckets)
+}
+
+// QuantifyCountWithBucketsCtx is like QuantifyCountWithBuckets but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QuantifyCountWithBucketsCtx(ctx context.Context, n int, noun string, buckets []impl.QuantityBucket) string {
+	return impl.QuantifyCountWithBucketsCtx(ctx, n, noun, buckets)
+}
+
+// QueryFieldName returns the name of the GraphQL query field listing
+// objects of a type: its plural in camelCase.
+//
+// The name is put in camelCase before its last word is made plural, so
+// that acronyms stay whole, and the default count set with Num does not
+// apply.
+//
+// Examples:
+//   - QueryFieldName("Order") returns "orders"
+//   - QueryFieldName("UserProfile") returns "userProfiles"
+//   - QueryFieldName("Person") returns "people"
+//   - QueryFieldName("HTTPRoute") returns "httpRoutes"
+//   - QueryFieldName("URL") returns "urls"
+//   - QueryFieldName("UserID") returns "userIDs"
+func QueryFieldName(typeName string) string {
+	return impl.QueryFieldName(typeName)
 }
 
-// PossessiveStyle sets the style for forming possessives of words ending in s.
-// Use PossessiveModern (default) for "James's" or PossessiveTraditional for "James'".
-func PossessiveStyle(style impl.PossessiveStyleType) {
-	impl.PossessiveStyle(style)
+// QueryFieldNameCtx is like QueryFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QueryFieldNameCtx(ctx context.Context, typeName string) string {
+	return impl.QueryFieldNameCtx(ctx, typeName)
 }
 
-// PresentParticiple converts a verb to its present participle (-ing) form.
+// RatioToWords converts a ratio to English words using "out of".
 //
 // Examples:
-//   - PresentParticiple("run") returns "running" (double consonant)
-//   - PresentParticiple("make") returns "making" (drop silent e)
-//   - PresentParticiple("play") returns "playing" (just add -ing)
-//   - PresentParticiple("die") returns "dying" (ie -> ying)
-//   - PresentParticiple("see") returns "seeing" (ee -> eeing)
-//   - PresentParticiple("panic") returns "panicking" (c -> ck)
-func PresentParticiple(verb string) string {
-	return impl.PresentParticiple(verb)
+//   - RatioToWords(3, 4) returns "three out of four"
+//   - RatioToWords(1, 10) returns "one out of ten"
+//   - RatioToWords(9, 10) returns "nine out of ten"
+func RatioToWords(numerator int, denominator int) string {
+	return impl.RatioToWords(numerator, denominator)
+}
+
+// RatioToWordsWithSep converts a ratio to English words using a custom
+// word or phrase between the two numbers.
+//
+// Examples:
+//   - RatioToWordsWithSep(3, 4, "in") returns "three in four"
+//   - RatioToWordsWithSep(3, 4, "to") returns "three to four"
+//   - RatioToWordsWithSep(1, 1000, "in every") returns "one in every one thousand"
+func RatioToWordsWithSep(numerator int, denominator int, sep string) string {
+	return impl.RatioToWordsWithSep(numerator, denominator, sep)
 }
 
 // RemoveAcronym removes an acronym from the registry.
@@ -1858,6 +5299,59 @@ func ResetAcronyms() {
 	impl.ResetAcronyms()
 }
 
+// ResetCustomOnly removes the nouns added with DefNoun that have no built-in
+// plural, and keeps the built-in nouns that were redefined.
+//
+// Unlike DefNounReset, which restores every noun, it undoes additions while
+// keeping deliberate overrides, such as "index" -> "indexes".
+//
+// Examples:
+//
+//	DefNoun("index", "indexes") // override built-in
+//	DefNoun("foo", "fooz")      // add custom
+//	ResetCustomOnly()
+//	Plural("index") // returns "indexes" (kept)
+//	Plural("foo")   // returns "foos" (custom removed)
+func ResetCustomOnly() {
+	impl.ResetCustomOnly()
+}
+
+// ResetWord restores the built-in plural of a noun that was redefined with
+// DefNoun, or removes the definition of a noun that has no built-in plural,
+// leaving every other definition in place.
+//
+// Returns true if the noun was defined, false if it already had its
+// built-in plural or no definition.
+//
+// Examples:
+//
+//	DefNoun("child", "childs")
+//	DefNoun("foo", "fooz")
+//	ResetWord("child") // returns true
+//	Plural("child")    // returns "children" (restored)
+//	Plural("foo")      // returns "fooz" (kept)
+//	ResetWord("foo")   // returns true
+//	Plural("foo")      // returns "foos"
+func ResetWord(word string) bool {
+	return impl.ResetWord(word)
+}
+
+// Restore replaces the current settings and custom definitions with those
+// saved by Snapshot.
+//
+// The result cache, if enabled, is emptied, and the hook set with SetHook
+// is kept. Restoring a nil snapshot does nothing.
+//
+// Examples:
+//
+//	s := Snapshot()
+//	Classical(true)
+//	Restore(s)
+//	IsClassical() // returns false
+func Restore(s *EngineSnapshot) {
+	impl.Restore(s)
+}
+
 // RomanToInt converts a Roman numeral string to its integer value.
 //
 // The function accepts both uppercase and lowercase input.
@@ -1879,17 +5373,251 @@ func RomanToInt(s string) (int, error) {
 	return impl.RomanToInt(s)
 }
 
+// RulePacks returns the names of the rule packs that LoadRulePack accepts,
+// in alphabetical order.
+//
+// Examples:
+//   - RulePacks() returns ["biology" "computing" "legal" "medical"]
+func RulePacks() []string {
+	return impl.RulePacks()
+}
+
+// ServiceMethodName returns the name of a gRPC service method acting on a
+// message type, following the resource-oriented design of the Google API
+// Improvement Proposals.
+//
+// List and batch methods act on many resources and take the plural of the
+// message name; other methods take the singular.
+//
+// Examples:
+//   - ServiceMethodName(MethodList, "UserProfile") returns "ListUserProfiles"
+//   - ServiceMethodName(MethodGet, "UserProfiles") returns "GetUserProfile"
+//   - ServiceMethodName(MethodBatchGet, "Person") returns "BatchGetPeople"
+//   - ServiceMethodName("Archive", "Order") returns "ArchiveOrder"
+func ServiceMethodName(method StandardMethod, message string) string {
+	return impl.ServiceMethodName(method, message)
+}
+
+// ServiceMethodNameCtx is like ServiceMethodName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ServiceMethodNameCtx(ctx context.Context, method StandardMethod, message string) string {
+	return impl.ServiceMethodNameCtx(ctx, method, message)
+}
+
+// SetDialect sets the variety of English whose pronunciation An and whose
+// spelling PresentParticiple, PastTense, and PastParticiple follow.
+//
+// Only the article of words pronounced differently is affected, such as
+// "herb", whose "h" is silent in American English but not in British
+// English, the doubling of final consonants, such as "traveled" and
+// "travelled", and the -ize or -ise ending of verbs, such as "realizing"
+// and "realising". Until a dialect is set, the default, DialectDefault,
+// follows American English but keeps the -ise or -ize spelling of the
+// verb given. Definitions made with DefA, DefAn, DefSilentH, and
+// DefSoundedVowel take precedence. Unknown dialects are treated as
+// DialectUS.
+//
+// Examples:
+//
+//	An("herb") // returns "an herb"
+//	PastTense("realise") // returns "realised"
+//	SetDialect(DialectUK)
+//	An("herb") // returns "a herb"
+//	PastTense("cancel") // returns "cancelled"
+//	SetDialect(DialectUS)
+//	PastTense("realise") // returns "realized"
+func SetDialect(d Dialect) {
+	impl.SetDialect(d)
+}
+
+// SetDomainBias sets how strongly Singular prefers the words of a domain
+// when a plural has more than one singular, such as "bases" ("basis" or
+// "base").
+//
+// Each candidate singular has a frequency in general English, which is
+// multiplied by the largest weight of its domains, and the candidate with
+// the highest score wins. Every domain has a weight of 1 until it is set,
+// so that without a bias the more frequent reading is used. A weight of 0
+// rules out the readings of a domain, and negative weights are treated as
+// 0. DefNoun definitions take precedence.
+//
+// Examples:
+//
+//	Singular("bases") // returns "basis"
+//	SetDomainBias(DomainSports, 10)
+//	Singular("bases") // returns "base"
+//	SetDomainBias(DomainSports, 1)
+//	Singular("bases") // returns "basis"
+func SetDomainBias(domain Domain, weight float64) {
+	impl.SetDomainBias(domain, weight)
+}
+
+// SetHook registers a hook that observes every Plural, Singular, and An
+// lookup, replacing any previous hook. Pass nil to remove it.
+//
+// Hooks are disabled by default, and cost nothing beyond an atomic load
+// while disabled. Setting a hook clears the cache enabled by EnableCache,
+// so that cached results carry the rule that produced them.
+//
+// Examples:
+//
+//	SetHook(HookFunc(func(fn, input, output, rule string) {
+//		if rule == "suffix rule (default + -s)" {
+//			log.Printf("%s(%q) used the fallback rule", fn, input)
+//		}
+//	}))
+//	Plural("blorg") // logs: Plural("blorg") used the fallback rule
+func SetHook(h Hook) {
+	impl.SetHook(h)
+}
+
+// SetLenient enables or disables lenient mode, in which Plural and Singular
+// correct near-misses of irregular nouns, as found by CorrectNoun, before
+// inflecting them.
+//
+// Lenient mode is meant for normalizing user-generated text, where
+// "childs" and "mouses" mean "children" and "mice". When disabled (false,
+// the default), such words are inflected as written. ExplainPlural and
+// ExplainSingular note each correction.
+//
+// Examples:
+//
+//	Plural("childs")   // returns "childses"
+//	SetLenient(true)
+//	Plural("childs")   // returns "children"
+//	Singular("mouses") // returns "mouse"
+//	Plural("Chidren")  // returns "Children"
+func SetLenient(enabled bool) {
+	impl.SetLenient(enabled)
+}
+
+// SetProperNameDetection sets how proper names are recognized.
+//
+// Proper names are pluralized without spelling changes ("Mary" -> "Marys"),
+// are left unchanged by ClassicalNames(true) when they end in "s", and take
+// "'s" in possessives. The default, ProperNameHeuristic, treats every
+// capitalized word as a name, which can misfire at the start of a sentence.
+//
+// Examples:
+//
+//	SetProperNameDetection(ProperNameOff)
+//	Plural("Mary")  // returns "Maries"
+//	SetProperNameDetection(ProperNameDictionary)
+//	Plural("Mary")  // returns "Marys"
+//	Plural("Berry") // returns "Berries"
+func SetProperNameDetection(mode ProperNameDetection) {
+	impl.SetProperNameDetection(mode)
+}
+
+// SetRules replaces the suffix rules that Plural falls back to with rules,
+// which are checked in order.
+//
+// A noun that no rule applies to is unchanged in the plural, so the rules
+// usually end with a rule that applies to any noun. The rules do not affect
+// Singular. Reset restores the default rules.
+//
+// Examples:
+//
+//	rules := Rules()
+//	rule := SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+//	SetRules(slices.Insert(rules, 1, rule))
+//	Plural("hippopotamus") // returns "hippopotami"
+func SetRules(rules []SuffixRule) {
+	impl.SetRules(rules)
+}
+
+// SetTechnicalPlurals enables or disables technical plurals of Latin nouns
+// ending in -ex and -ix.
+//
+// When enabled, Plural() uses the -es plural common in technical writing,
+// such as database indexes and document appendixes:
+//   - index -> indexes (instead of indices)
+//   - appendix -> appendixes (instead of appendices)
+//   - apex -> apexes, cortex -> cortexes, vortex -> vortexes
+//
+// When disabled (false, the default), the -ices plurals are used. Singular()
+// accepts both forms either way.
+//
+// Classical Latin plurals take precedence: with ClassicalAncient(true), the
+// -ices plurals are used whether technical plurals are enabled or not. Nouns
+// given another plural with DefNoun or LoadRulePack keep that plural.
+//
+// Examples:
+//
+//	Plural("index")   // returns "indices"
+//	SetTechnicalPlurals(true)
+//	Plural("index")   // returns "indexes"
+//	Plural("matrix")  // returns "matrices"
+func SetTechnicalPlurals(enabled bool) {
+	impl.SetTechnicalPlurals(enabled)
+}
+
 // Singular returns the singular form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// plural possessive becomes a singular possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - Singular("cats") returns "cat"
 //   - Singular("boxes") returns "box"
 //   - Singular("children") returns "child"
 //   - Singular("sheep") returns "sheep"
+//   - Singular("dogs'") returns "dog's"
+//   - Singular("children's") returns "child's"
+//   - Singular("cats.") returns "cat."
+//   - Singular("children.json") returns "child.json"
+//   - Singular("iPhones") returns "iPhone"
 func Singular(word string) string {
 	return impl.Singular(word)
 }
 
+// SingularCtx is like Singular but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularCtx(ctx context.Context, word string) string {
+	return impl.SingularCtx(ctx, word)
+}
+
+// SingularE returns the singular form of an English noun, like Singular,
+// but returns ErrNotPlural if the word is not a plural and ErrUnknownWord
+// if it is not a word that can be inflected.
+//
+// Words whose plural is the same as their singular, such as "sheep", are
+// accepted and returned unchanged.
+//
+// Examples:
+//   - SingularE("cats") returns ("cat", nil)
+//   - SingularE("children") returns ("child", nil)
+//   - SingularE("sheep") returns ("sheep", nil)
+//   - SingularE("cat") returns ("", ErrNotPlural)
+//   - SingularE("") returns ("", ErrUnknownWord)
+func SingularE(word string) (string, error) {
+	return impl.SingularE(word)
+}
+
+// SingularLastWord returns a phrase with only its last word made singular.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - SingularLastWord("blue birds") returns "blue bird"
+//   - SingularLastWord("baby children") returns "baby child"
+//   - SingularLastWord("field mice") returns "field mouse"
+//   - SingularLastWord("cats") returns "cat"
+func SingularLastWord(phrase string) string {
+	return impl.SingularLastWord(phrase)
+}
+
+// SingularLastWordCtx is like SingularLastWord but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularLastWordCtx(ctx context.Context, phrase string) string {
+	return impl.SingularLastWordCtx(ctx, phrase)
+}
+
 // SingularNoun returns the singular form of an English noun or pronoun.
 //
 // This function handles:
@@ -1907,7 +5635,8 @@ func Singular(word string) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the singular form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the singular form.
 //
 // Examples:
 //
@@ -1922,6 +5651,83 @@ func SingularNoun(word string, count ...int) string {
 	return impl.SingularNoun(word, count...)
 }
 
+// SingularNounCtx is like SingularNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularNounCtx(ctx context.Context, word string, count ...int) string {
+	return impl.SingularNounCtx(ctx, word, count...)
+}
+
+// SingularNounPhrase returns the singular form of a noun phrase by
+// inflecting its head noun, the word before the first preposition, and
+// keeping the complement that follows it. Phrases without a preposition
+// have their last word made singular, as with SingularLastWord.
+//
+// Examples:
+//   - SingularNounPhrase("cups of coffee") returns "cup of coffee"
+//   - SingularNounPhrase("boards of directors") returns "board of directors"
+//   - SingularNounPhrase("mothers-in-law") returns "mother-in-law"
+func SingularNounPhrase(phrase string) string {
+	return impl.SingularNounPhrase(phrase)
+}
+
+// SingularNounPhraseCtx is like SingularNounPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularNounPhraseCtx(ctx context.Context, phrase string) string {
+	return impl.SingularNounPhraseCtx(ctx, phrase)
+}
+
+// SingularPeople returns the singular of "person" or "people" in a sense:
+// "person" for individuals and in formal writing, or "people" for
+// nations.
+//
+// Singular always reads "people" as the plural of "person". SingularPeople
+// accepts any form of either word and returns the singular for the sense,
+// so "people" stays unchanged when it names a nation. Other words are made
+// singular by Singular.
+//
+// Examples:
+//   - SingularPeople("people", PersonIndividuals) returns "person"
+//   - SingularPeople("persons", PersonFormal) returns "person"
+//   - SingularPeople("people", PersonNations) returns "people"
+//   - SingularPeople("peoples", PersonNations) returns "people"
+//   - SingularPeople("cats", PersonNations) returns "cat"
+func SingularPeople(word string, sense PersonSense) string {
+	return impl.SingularPeople(word, sense)
+}
+
+// SingularPeopleCtx is like SingularPeople but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularPeopleCtx(ctx context.Context, word string, sense PersonSense) string {
+	return impl.SingularPeopleCtx(ctx, word, sense)
+}
+
+// SingularSafe returns the singular form of an English noun, like
+// Singular, but never removes an s that may belong to a singular noun.
+//
+// Singular removes the final -s of a word it does not know, so an unlisted
+// singular noun such as "octothorpus" loses it. SingularSafe leaves words
+// ending in -us or -is unchanged unless a table gives their singular,
+// preferring to keep a rare plural such as "menus" over mangling a
+// singular. It is safe to call on words whose number is unknown and to
+// call more than once. Use ExplainSingularSafe to see why a word was kept.
+//
+// Examples:
+//   - SingularSafe("cats") returns "cat"
+//   - SingularSafe("bus") returns "bus"
+//   - SingularSafe("buses") returns "bus"
+//   - SingularSafe("lens") returns "lens"
+//   - SingularSafe("cacti") returns "cactus"
+//   - SingularSafe("octothorpus") returns "octothorpus"
+func SingularSafe(word string) string {
+	return impl.SingularSafe(word)
+}
+
+// SingularSafeCtx is like SingularSafe but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularSafeCtx(ctx context.Context, word string) string {
+	return impl.SingularSafeCtx(ctx, word)
+}
+
 // Singularize is an alias for Singular, provided for compatibility with
 // github.com/go-openapi/inflect.
 //
@@ -1932,6 +5738,61 @@ func Singularize(word string) string {
 	return impl.Singularize(word)
 }
 
+// SingularizeCtx is like Singularize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularizeCtx(ctx context.Context, word string) string {
+	return impl.SingularizeCtx(ctx, word)
+}
+
+// SingularizeKeys returns a copy of m with each key made singular, for
+// reshaping JSON and API payloads: "users" -> "user".
+//
+// Keys are read as identifiers in any case style, and only their last word
+// is inflected, keeping the rest of the key and its separators:
+// "userProfiles" -> "userProfile", "line_items" -> "line_item". Keys that
+// are already singular are kept, including nouns ending in s such as
+// "status". If recursive is true, maps nested in values and in []any values
+// are converted too. The input is not modified.
+//
+// When two keys have the same singular, the key that was already singular
+// wins, and otherwise the key that sorts first.
+//
+// Examples:
+//   - SingularizeKeys(map[string]any{"users": 1, "postTags": 2}, false)
+//     returns map[string]any{"user": 1, "postTag": 2}
+//   - SingularizeKeys(map[string]any{"status": 1}, false)
+//     returns map[string]any{"status": 1}
+func SingularizeKeys(m map[string]any, recursive bool) map[string]any {
+	return impl.SingularizeKeys(m, recursive)
+}
+
+// SingularizePhrase returns a short plural phrase or sentence made
+// singular, with its determiners, subject, and verb agreeing: the
+// determiners and adjectives are changed as PluralAdj does, the nouns as
+// SingularNoun does, and the first verb after them agrees with the
+// singular subject. Words after that verb, such as objects and
+// complements, are left as they are, as are nouns after a preposition in
+// the subject. Each sentence of the text is handled separately.
+//
+// Like InflectDocument, which it is built on, this is a heuristic for short
+// phrases such as messages and labels, not a parser.
+//
+// Examples:
+//   - SingularizePhrase("these files are ready") returns "this file is ready"
+//   - SingularizePhrase("Those children were here.") returns "That child was here."
+//   - SingularizePhrase("some apples have fallen") returns "an apple has fallen"
+//   - SingularizePhrase("all users log in") returns "every user logs in"
+//   - SingularizePhrase("the files in these folders aren't saved") returns "the file in these folders isn't saved"
+func SingularizePhrase(text string) string {
+	return impl.SingularizePhrase(text)
+}
+
+// SingularizePhraseCtx is like SingularizePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularizePhraseCtx(ctx context.Context, text string) string {
+	return impl.SingularizePhraseCtx(ctx, text)
+}
+
 // SnakeCase is an alias for Underscore.
 // It converts a string to snake_case.
 //
@@ -1973,6 +5834,28 @@ func Superlative(adj string) string {
 	return impl.Superlative(adj)
 }
 
+// TableName returns the SQL table name for a Go type name: the snake_case
+// plural most Go ORMs expect.
+//
+// Unlike Tableize, only the last word is made plural, and a name that is
+// already plural is kept, so TableName can be given either a type name or
+// an existing table name.
+//
+// Examples:
+//   - TableName("Person") returns "people"
+//   - TableName("UserProfile") returns "user_profiles"
+//   - TableName("HTTPServer") returns "http_servers"
+//   - TableName("people") returns "people"
+func TableName(typeName string) string {
+	return impl.TableName(typeName)
+}
+
+// TableNameCtx is like TableName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TableNameCtx(ctx context.Context, typeName string) string {
+	return impl.TableNameCtx(ctx, typeName)
+}
+
 // Tableize creates a table name from a type name. It underscores and pluralizes
 // the word.
 //
@@ -1988,6 +5871,60 @@ func Tableize(word string) string {
 	return impl.Tableize(word)
 }
 
+// TableizeCtx is like Tableize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TableizeCtx(ctx context.Context, word string) string {
+	return impl.TableizeCtx(ctx, word)
+}
+
+// TensWord returns the word for a tens digit: "ten" for 1 through "ninety"
+// for 9, or "" for any other digit.
+//
+// Together with OnesWord, it gives the words NumberToWords is built from,
+// for phrases such as "twenty-odd" or "the forties".
+//
+// Examples:
+//   - TensWord(2) returns "twenty"
+//   - TensWord(9) returns "ninety"
+//   - TensWord(1) returns "ten"
+//   - TensWord(0) returns ""
+func TensWord(digit int) string {
+	return impl.TensWord(digit)
+}
+
+// The returns the phrase prefixed with the definite article "the".
+//
+// A phrase that already starts with "the" is returned unchanged.
+//
+// Examples:
+//   - The("1st item") returns "the 1st item"
+//   - The("cat") returns "the cat"
+//   - The("the cat") returns "the cat"
+func The(phrase string) string {
+	return impl.The(phrase)
+}
+
+// TheOrAn returns the phrase prefixed with "the" if DefiniteArticleNeeded
+// reports that it needs a definite article, or with "a" or "an" otherwise.
+//
+// It is meant for generated prose that combines nouns with Ordinal or
+// Superlative.
+//
+// Examples:
+//   - TheOrAn("1st item") returns "the 1st item"
+//   - TheOrAn("2nd chance") returns "a 2nd chance"
+//   - TheOrAn("11th hour") returns "an 11th hour"
+//   - TheOrAn("oldest oak") returns "the oldest oak"
+func TheOrAn(phrase string) string {
+	return impl.TheOrAn(phrase)
+}
+
+// TheOrAnCtx is like TheOrAn but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TheOrAnCtx(ctx context.Context, phrase string) string {
+	return impl.TheOrAnCtx(ctx, phrase)
+}
+
 // TitleCase is an alias for PascalCase.
 // It converts a string to PascalCase (also known as TitleCase in some contexts).
 //
@@ -2026,6 +5963,12 @@ func Typeify(word string) string {
 	return impl.Typeify(word)
 }
 
+// TypeifyCtx is like Typeify but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TypeifyCtx(ctx context.Context, word string) string {
+	return impl.TypeifyCtx(ctx, word)
+}
+
 // UndefA removes a custom "a" pattern.
 //
 // Returns true if the pattern was removed, false if it didn't exist.
@@ -2099,6 +6042,20 @@ func UndefAnPattern(pattern string) bool {
 	return impl.UndefAnPattern(pattern)
 }
 
+// UndefHerd makes a noun registered with DefHerd, or a built-in herd
+// animal such as "elk", follow the regular rules in classical herd mode.
+//
+// Returns true if the noun was a herd animal, false otherwise.
+//
+// Examples:
+//
+//	ClassicalHerd(true)
+//	UndefHerd("elk") // returns true
+//	Plural("elk")    // returns "elks"
+func UndefHerd(word string) bool {
+	return impl.UndefHerd(word)
+}
+
 // UndefNoun removes a custom noun pluralization rule.
 //
 // This removes only user-defined rules; it cannot remove built-in irregular
@@ -2117,6 +6074,61 @@ func UndefNoun(singular string) bool {
 	return impl.UndefNoun(singular)
 }
 
+// UndefNounRule removes a pluralization rule defined with DefNounRule.
+//
+// The pattern string must match exactly as it was defined.
+// Returns true if the rule was found and removed, false otherwise.
+//
+// Examples:
+//
+//	DefNounRule("us$", "i", 0)
+//	Plural("hippopotamus") // returns "hippopotami"
+//	UndefNounRule("us$")
+//	Plural("hippopotamus") // returns "hippopotamuses" (default rule)
+func UndefNounRule(pattern string) bool {
+	return impl.UndefNounRule(pattern)
+}
+
+// UndefSilentH removes a prefix registered with DefSilentH.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	DefSilentH("homage")
+//	UndefSilentH("homage") // returns true
+//	An("homage")           // returns "a homage"
+func UndefSilentH(prefix string) bool {
+	return impl.UndefSilentH(prefix)
+}
+
+// UndefSoundedVowel removes a prefix registered with DefSoundedVowel.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	DefSoundedVowel("oaxac")
+//	UndefSoundedVowel("oaxac") // returns true
+//	An("Oaxaca")               // returns "an Oaxaca"
+func UndefSoundedVowel(prefix string) bool {
+	return impl.UndefSoundedVowel(prefix)
+}
+
+// UndefUnchanged makes a noun registered with DefUnchanged, or a built-in
+// unchanged noun such as "shrimp", follow the regular rules again.
+//
+// Returns true if the noun was unchanged in the plural, false otherwise.
+//
+// Examples:
+//
+//	UndefUnchanged("shrimp") // returns true
+//	Plural("shrimp")         // returns "shrimps"
+//	UndefUnchanged("cat")    // returns false
+func UndefUnchanged(word string) bool {
+	return impl.UndefUnchanged(word)
+}
+
 // UndefVerb removes a custom verb conjugation rule.
 //
 // NOTE: This is a placeholder stub for future implementation.
@@ -2147,6 +6159,52 @@ func Underscore(s string) string {
 	return impl.Underscore(s)
 }
 
+// Undo restores the settings and custom definitions saved by the most recent
+// Checkpoint and removes it from the undo stack. It returns false, and
+// changes nothing, if the stack is empty.
+//
+// Examples:
+//
+//	Undo() // returns false
+//	Checkpoint()
+//	Classical(true)
+//	Undo() // returns true
+//	IsClassical() // returns false
+func Undo() bool {
+	return impl.Undo()
+}
+
+// Unnegate returns the positive form of a negated verb or verb phrase,
+// reversing Negate. A phrase that is not negative is returned unchanged.
+//
+// Examples:
+//   - Unnegate("isn't") returns "is"
+//   - Unnegate("is not") returns "is"
+//   - Unnegate("cannot") returns "can"
+//   - Unnegate("won't") returns "will"
+//   - Unnegate("doesn't run") returns "runs"
+//   - Unnegate("didn't go home") returns "went home"
+func Unnegate(verb string) string {
+	return impl.Unnegate(verb)
+}
+
+// WithEngine returns a copy of ctx that carries the given Engine.
+//
+// This lets per-request settings such as the default count, gender, and
+// classical mode flow through call chains (for example, HTTP handlers)
+// without modifying the default engine. The Ctx variants of the package
+// functions, such as PluralCtx and AnCtx, use the Engine carried by ctx.
+//
+// Example:
+//
+//	e := NewEngine()
+//	e.SetGender("f")
+//	ctx := WithEngine(r.Context(), e)
+//	SingularNounCtx(ctx, "they") // returns "she"
+func WithEngine(ctx context.Context, e *impl.Engine) context.Context {
+	return impl.WithEngine(ctx, e)
+}
+
 // WordCount counts the number of words in a string.
 //
 // Words are separated by whitespace. This is a simple word count
@@ -2182,5 +6240,104 @@ func WordToOrdinal(s string) string {
 	return impl.WordToOrdinal(s)
 }
 
+// WordsToNumber converts English number words to an integer. It is the
+// inverse of NumberToWords and NumberToWordsWithAnd.
+//
+// Words may be separated by spaces, hyphens, or commas, and "and" is
+// ignored. It returns 0 if the words cannot be parsed; use WordsToNumberE
+// to tell a parse failure from zero.
+//
+// Examples:
+//   - WordsToNumber("forty-two") returns 42
+//   - WordsToNumber("one hundred and five") returns 105
+//   - WordsToNumber("fifteen hundred") returns 1500
+//   - WordsToNumber("negative three thousand, two") returns -3002
+//   - WordsToNumber("zero") returns 0
+//   - WordsToNumber("lots") returns 0
+func WordsToNumber(words string) int {
+	return impl.WordsToNumber(words)
+}
+
+// WordsToNumberE converts English number words to an integer, like
+// WordsToNumber, but reports input it cannot parse instead of returning 0.
+//
+// It returns ErrUnknownWord if a word is not a number word or is out of
+// place (as in "one two" or "hundred"), and ErrOverflow if the number does
+// not fit in an int.
+//
+// Examples:
+//   - WordsToNumberE("forty-two") returns (42, nil)
+//   - WordsToNumberE("two million three hundred thousand") returns (2300000, nil)
+//   - WordsToNumberE("forty-lots") returns (0, ErrUnknownWord)
+//   - WordsToNumberE("one two") returns (0, ErrUnknownWord)
+//   - WordsToNumberE("ten quintillion") returns (0, ErrOverflow)
+func WordsToNumberE(words string) (int, error) {
+	return impl.WordsToNumberE(words)
+}
+
+// YearToWords converts a year to words the way years are usually spoken.
+//
+// Four-digit years are read as two pairs of digits, with "hundred" for
+// round centuries and "oh" for single-digit years within a century.
+// Years that are multiples of 1000, and the first nine years of a
+// millennium, are read as ordinary numbers. Years outside 1000-9999 are
+// read with NumberToWords.
+//
+// Examples:
+//   - YearToWords(1976) returns "nineteen seventy-six"
+//   - YearToWords(1900) returns "nineteen hundred"
+//   - YearToWords(1905) returns "nineteen oh five"
+//   - YearToWords(2000) returns "two thousand"
+//   - YearToWords(2005) returns "two thousand five"
+//   - YearToWords(2024) returns "twenty twenty-four"
+//   - YearToWords(1066) returns "ten sixty-six"
+//   - YearToWords(800) returns "eight hundred"
+func YearToWords(year int) string {
+	return impl.YearToWords(year)
+}
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var ErrUnknownWord = impl.ErrUnknownWord
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var ErrNotPlural = impl.ErrNotPlural
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var ErrOverflow = impl.ErrOverflow
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var ErrNotDigits = impl.ErrNotDigits
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var ErrInvalidGroupSize = impl.ErrInvalidGroupSize
+
+// ErrInvalidArticle is returned when an article other than "a" or "an" is given.
+var ErrInvalidArticle = impl.ErrInvalidArticle
+
+// ErrInvalidCall is reported by InflectTokens for a call to a known
+// function with invalid arguments, such as number_to_words('x').
+var ErrInvalidCall = impl.ErrInvalidCall
+
 // ErrInvalidRoman is returned when a Roman numeral string is malformed.
 var ErrInvalidRoman = impl.ErrInvalidRoman
+
+// ErrNoVerb is returned by ChangeTense when it cannot find a verb to change.
+var ErrNoVerb = impl.ErrNoVerb
+
+// ErrNotStruct is returned by InflectStructNames when it is not given a
+// struct.
+var ErrNotStruct = impl.ErrNotStruct
+
+// ErrUnknownFunc is returned by InflectWithOptions with UnknownFuncError
+// when the text calls a function that is not part of the mini-language.
+// It is wrapped with the call, so compare it with errors.Is.
+var ErrUnknownFunc = impl.ErrUnknownFunc
+
+// ErrUnknownRulePack is returned by LoadRulePack for a name that is not
+// one of RulePacks.
+var ErrUnknownRulePack = impl.ErrUnknownRulePack
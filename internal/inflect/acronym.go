@@ -42,6 +42,7 @@ func AddAcronym(acronym string) {
 func (e *Engine) AddAcronym(acronym string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	if e.acronyms == nil {
 		e.acronyms = make(map[string]string)
 		// Initialize with defaults
@@ -52,6 +53,65 @@ func (e *Engine) AddAcronym(acronym string) {
 	e.acronyms[strings.ToUpper(acronym)] = acronym
 }
 
+// DefAcronym registers an acronym along with how it is pronounced.
+//
+// The acronym keeps its case in humanization, takes a plain "s" in the
+// plural, and An() chooses its article from the pronunciation rather than
+// from the names of its letters. An empty pronunciation reads the acronym
+// letter by letter, which is also how unregistered acronyms are read.
+//
+// Examples:
+//
+//	DefAcronym("SQL", "sequel")
+//	An("SQL query")   // returns "a SQL query"
+//	DefAcronym("SQL", "ess-cue-ell")
+//	An("SQL query")   // returns "an SQL query"
+//	Plural("SQL")     // returns "SQLs"
+func DefAcronym(acronym, pronunciation string) {
+	defaultEngine.DefAcronym(acronym, pronunciation)
+}
+
+// DefAcronym registers an acronym along with how it is pronounced.
+//
+// The acronym keeps its case in humanization, takes a plain "s" in the
+// plural, and e.An() chooses its article from the pronunciation rather than
+// from the names of its letters. An empty pronunciation reads the acronym
+// letter by letter, which is also how unregistered acronyms are read.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefAcronym("SQL", "sequel")
+//	e.An("SQL query")   // returns "a SQL query"
+//	e.DefAcronym("SQL", "ess-cue-ell")
+//	e.An("SQL query")   // returns "an SQL query"
+//	e.Plural("SQL")     // returns "SQLs"
+func (e *Engine) DefAcronym(acronym, pronunciation string) {
+	e.AddAcronym(acronym)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	upper := strings.ToUpper(acronym)
+	if pronunciation == "" {
+		delete(e.acronymPronunciations, upper)
+		return
+	}
+	if e.acronymPronunciations == nil {
+		e.acronymPronunciations = make(map[string]string)
+	}
+	e.acronymPronunciations[upper] = pronunciation
+}
+
+// acronymPronunciation returns the pronunciation registered for an acronym
+// with DefAcronym, matched case-insensitively.
+func (e *Engine) acronymPronunciation(word string) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	pronunciation, ok := e.acronymPronunciations[strings.ToUpper(word)]
+	return pronunciation, ok
+}
+
 // RemoveAcronym removes an acronym from the registry.
 //
 // Returns true if the acronym was removed, false if it wasn't registered.
@@ -76,6 +136,7 @@ func RemoveAcronym(acronym string) bool {
 func (e *Engine) RemoveAcronym(acronym string) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	if e.acronyms == nil {
 		return false
 	}
@@ -84,6 +145,7 @@ func (e *Engine) RemoveAcronym(acronym string) bool {
 		return false
 	}
 	delete(e.acronyms, upper)
+	delete(e.acronymPronunciations, upper)
 	return true
 }
 
@@ -107,7 +169,9 @@ func ClearAcronyms() {
 func (e *Engine) ClearAcronyms() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.acronyms = make(map[string]string)
+	e.acronymPronunciations = nil
 }
 
 // ResetAcronyms restores the acronym registry to its default state.
@@ -132,7 +196,9 @@ func ResetAcronyms() {
 func (e *Engine) ResetAcronyms() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.acronyms = make(map[string]string)
+	e.acronymPronunciations = nil
 	for _, a := range defaultAcronyms {
 		e.acronyms[strings.ToUpper(a)] = a
 	}
@@ -62,6 +62,77 @@ func TestAddAcronym(t *testing.T) {
 	}
 }
 
+func TestDefAcronym(t *testing.T) {
+	tests := []struct {
+		name          string
+		acronym       string
+		pronunciation string
+		input         string
+		want          string
+	}{
+		{name: "word pronunciation", acronym: "SQL", pronunciation: "sequel", input: "SQL query", want: "a SQL query"},
+		{name: "letter pronunciation", acronym: "SQL", pronunciation: "ess-cue-ell", input: "SQL query", want: "an SQL query"},
+		{name: "case-insensitive", acronym: "sql", pronunciation: "sequel", input: "Sql query", want: "a Sql query"},
+		{name: "new acronym", acronym: "NASA", pronunciation: "nassa", input: "NASA mission", want: "a NASA mission"},
+		{name: "vowel word", acronym: "URL", pronunciation: "earl", input: "URL", want: "an URL"},
+		{name: "letters by default", acronym: "URL", pronunciation: "", input: "URL", want: "a URL"},
+		{name: "wrapped", acronym: "SQL", pronunciation: "sequel", input: "`SQL` query", want: "a `SQL` query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine()
+			e.DefAcronym(tt.acronym, tt.pronunciation)
+			if got := e.An(tt.input); got != tt.want {
+				t.Errorf("An(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefAcronymRegisters(t *testing.T) {
+	e := NewEngine()
+	e.ClearAcronyms()
+	e.DefAcronym("SQL", "sequel")
+
+	if !e.IsAcronym("SQL") {
+		t.Error("DefAcronym should register the acronym")
+	}
+	if got := e.Plural("SQL"); got != "SQLs" {
+		t.Errorf("Plural(SQL) = %q, want %q", got, "SQLs")
+	}
+	if got := e.Humanize("SQLQuery"); got != "SQL query" {
+		t.Errorf("Humanize(SQLQuery) = %q, want %q", got, "SQL query")
+	}
+}
+
+func TestDefAcronymRemoved(t *testing.T) {
+	e := NewEngine()
+	e.DefAcronym("SQL", "sequel")
+	clone := e.Clone()
+
+	e.RemoveAcronym("SQL")
+	if got := e.An("SQL"); got != "an SQL" {
+		t.Errorf("after RemoveAcronym, An(SQL) = %q, want %q", got, "an SQL")
+	}
+
+	e.DefAcronym("SQL", "sequel")
+	e.Reset()
+	if got := e.An("SQL"); got != "an SQL" {
+		t.Errorf("after Reset, An(SQL) = %q, want %q", got, "an SQL")
+	}
+
+	e.DefAcronym("SQL", "sequel")
+	e.ResetAcronyms()
+	if got := e.An("SQL"); got != "an SQL" {
+		t.Errorf("after ResetAcronyms, An(SQL) = %q, want %q", got, "an SQL")
+	}
+
+	if got := clone.An("SQL"); got != "a SQL" {
+		t.Errorf("clone An(SQL) = %q, want %q", got, "a SQL")
+	}
+}
+
 func TestRemoveAcronym(t *testing.T) {
 	e := NewEngine()
 	e.ClearAcronyms()
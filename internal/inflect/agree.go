@@ -0,0 +1,368 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// AgreeVerb returns the form of a present-tense verb that agrees with a
+// subject of the given count.
+//
+// A count of 1 or -1 takes the third-person singular form; any other count
+// takes the plural form. The verb may be given in either form. Contractions
+// are supported, modal verbs ("can", "must", "won't") are returned
+// unchanged, and in a multi-word verb phrase only the first word is changed.
+//
+// Examples:
+//   - AgreeVerb(1, "have") returns "has"
+//   - AgreeVerb(3, "is") returns "are"
+//   - AgreeVerb(0, "was") returns "were"
+//   - AgreeVerb(1, "run") returns "runs"
+//   - AgreeVerb(2, "doesn't") returns "don't"
+//   - AgreeVerb(1, "can") returns "can"
+//   - AgreeVerb(3, "has been") returns "have been"
+func AgreeVerb(count int, verb string) string {
+	return defaultEngine.AgreeVerb(count, verb)
+}
+
+// AgreeVerb returns the form of a present-tense verb that agrees with a
+// subject of the given count.
+//
+// A count of 1 or -1 takes the third-person singular form; any other count
+// takes the plural form. The verb may be given in either form. Contractions
+// are supported, modal verbs ("can", "must", "won't") are returned
+// unchanged, and in a multi-word verb phrase only the first word is changed.
+// Custom verbs defined with DefVerb are used in both directions.
+//
+// Examples:
+//   - e.AgreeVerb(1, "have") returns "has"
+//   - e.AgreeVerb(3, "is") returns "are"
+//   - e.AgreeVerb(0, "was") returns "were"
+//   - e.AgreeVerb(1, "run") returns "runs"
+//   - e.AgreeVerb(2, "doesn't") returns "don't"
+//   - e.AgreeVerb(1, "can") returns "can"
+//   - e.AgreeVerb(3, "has been") returns "have been"
+func (e *Engine) AgreeVerb(count int, verb string) string {
+	prefix, trimmed, suffix := extractWhitespace(verb)
+	if trimmed == "" {
+		return verb
+	}
+
+	first, rest, hasRest := strings.Cut(trimmed, " ")
+	if count == 1 || count == -1 {
+		first = e.singularVerb(first)
+	} else {
+		first = e.pluralVerb(first)
+	}
+
+	if hasRest {
+		return prefix + first + " " + rest + suffix
+	}
+	return prefix + first + suffix
+}
+
+// pluralVerb returns the plural present-tense form of a single verb.
+func (e *Engine) pluralVerb(verb string) string {
+	if lower := strings.ToLower(verb); lower == "be" || lower == "am" {
+		return matchCase(verb, "are")
+	}
+	return e.PluralVerb(verb, 2)
+}
+
+// singularVerb returns the third-person singular present-tense form of a
+// single verb.
+func (e *Engine) singularVerb(verb string) string {
+	lower := strings.ToLower(verb)
+	if verbUnchanged[lower] {
+		return verb
+	}
+	if lower == "be" || lower == "am" {
+		return matchCase(verb, "is")
+	}
+	if singular, ok := verbPluralToSingular[lower]; ok {
+		return matchCase(verb, singular)
+	}
+
+	e.mu.RLock()
+	singular, ok := e.customVerbsReverse[lower]
+	e.mu.RUnlock()
+	if ok {
+		return matchCase(verb, singular)
+	}
+
+	// A verb whose plural differs from it is already singular ("runs")
+	if e.PluralVerb(verb, 2) != verb {
+		return verb
+	}
+	return thirdPersonSingular(verb)
+}
+
+// thirdPersonSingular adds the third-person singular ending to a regular
+// base verb: "run" -> "runs", "watch" -> "watches", "try" -> "tries".
+func thirdPersonSingular(verb string) string {
+	lower := strings.ToLower(verb)
+	switch {
+	case strings.HasSuffix(lower, "s") || strings.HasSuffix(lower, "sh") ||
+		strings.HasSuffix(lower, "ch") || strings.HasSuffix(lower, "x") ||
+		strings.HasSuffix(lower, "z"):
+		return verb + matchSuffix(verb, "es")
+	case strings.HasSuffix(lower, "o") && len(lower) > 1 && !isVowel(runeBefore(lower, 1)):
+		return verb + matchSuffix(verb, "es")
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !isVowel(runeBefore(lower, 1)):
+		return trimRunes(verb, 1) + matchSuffix(verb, "ies")
+	default:
+		return verb + matchSuffix(verb, "s")
+	}
+}
+
+// CompoundConj is the conjunction joining the subjects of AgreeCompound.
+type CompoundConj int
+
+const (
+	// CompoundAnd joins subjects with "and", which makes them plural.
+	// Example: "Alice and Bob are"
+	CompoundAnd CompoundConj = iota
+
+	// CompoundOr joins subjects with "or". The verb agrees with the
+	// nearest subject.
+	// Example: "the cat or the dogs are"
+	CompoundOr
+
+	// CompoundEitherOr joins subjects with "either ... or". The verb
+	// agrees with the nearest subject.
+	// Example: "either the dogs or the cat is"
+	CompoundEitherOr
+
+	// CompoundNeitherNor joins subjects with "neither ... nor". The verb
+	// agrees with the nearest subject.
+	// Example: "neither the dogs nor the cat is"
+	CompoundNeitherNor
+)
+
+// singularSubjects contains pronouns and determiners that make a subject
+// singular even when it ends in a plural-looking word.
+var singularSubjects = map[string]bool{
+	"he": true, "she": true, "it": true, "this": true, "that": true,
+	"one": true, "each": true, "every": true, "everyone": true,
+	"everybody": true, "everything": true, "someone": true,
+	"somebody": true, "something": true, "anyone": true, "anybody": true,
+	"anything": true, "no one": true, "nobody": true, "nothing": true,
+}
+
+// pluralSubjects contains pronouns that take a plural verb.
+var pluralSubjects = map[string]bool{
+	"you": true, "we": true, "they": true, "these": true, "those": true,
+}
+
+// AgreeCompound joins subjects with "and" and appends the form of verb that
+// agrees with them.
+//
+// Two or more subjects joined with "and" take a plural verb, unless the
+// first starts with "each" or "every". A single subject takes the verb
+// that agrees with it. See AgreeCompoundWithConj for "or", "either ...
+// or", and "neither ... nor".
+//
+// Examples:
+//   - AgreeCompound([]string{"Alice", "Bob"}, "is") returns "Alice and Bob are"
+//   - AgreeCompound([]string{"Alice", "Bob", "Carol"}, "has") returns "Alice, Bob, and Carol have"
+//   - AgreeCompound([]string{"the cat"}, "are") returns "the cat is"
+//   - AgreeCompound([]string{"every man", "woman"}, "are") returns "every man and woman is"
+func AgreeCompound(subjects []string, verb string) string {
+	return defaultEngine.AgreeCompound(subjects, verb)
+}
+
+// AgreeCompound joins subjects with "and" and appends the form of verb that
+// agrees with them. See the package-level AgreeCompound for details.
+//
+// Examples:
+//   - e.AgreeCompound([]string{"Alice", "Bob"}, "is") returns "Alice and Bob are"
+//   - e.AgreeCompound([]string{"the cat"}, "are") returns "the cat is"
+func (e *Engine) AgreeCompound(subjects []string, verb string) string {
+	return e.AgreeCompoundWithConj(subjects, CompoundAnd, verb)
+}
+
+// AgreeCompoundWithConj joins subjects with the given conjunction and
+// appends the form of verb that agrees with them.
+//
+// Subjects joined with CompoundAnd are plural, as in AgreeCompound. With
+// CompoundOr, CompoundEitherOr, and CompoundNeitherNor the verb agrees
+// with the nearest subject, which is the last one. A subject is plural if
+// its last word is a plural noun or a plural pronoun such as "they";
+// capitalized names are singular, and "I" takes "am" and "was".
+//
+// Examples:
+//   - AgreeCompoundWithConj([]string{"the cat", "the dogs"}, CompoundEitherOr, "is") returns "either the cat or the dogs are"
+//   - AgreeCompoundWithConj([]string{"the dogs", "the cat"}, CompoundNeitherNor, "are") returns "neither the dogs nor the cat is"
+//   - AgreeCompoundWithConj([]string{"Alice", "Bob"}, CompoundOr, "have") returns "Alice or Bob has"
+//   - AgreeCompoundWithConj([]string{"you", "I"}, CompoundEitherOr, "is") returns "either you or I am"
+func AgreeCompoundWithConj(subjects []string, conj CompoundConj, verb string) string {
+	return defaultEngine.AgreeCompoundWithConj(subjects, conj, verb)
+}
+
+// AgreeCompoundWithConj joins subjects with the given conjunction and
+// appends the form of verb that agrees with them. See the package-level
+// AgreeCompoundWithConj for details.
+//
+// Examples:
+//   - e.AgreeCompoundWithConj([]string{"the cat", "the dogs"}, CompoundEitherOr, "is") returns "either the cat or the dogs are"
+//   - e.AgreeCompoundWithConj([]string{"the dogs", "the cat"}, CompoundNeitherNor, "are") returns "neither the dogs nor the cat is"
+func (e *Engine) AgreeCompoundWithConj(subjects []string, conj CompoundConj, verb string) string {
+	items := make([]string, 0, len(subjects))
+	for _, s := range subjects {
+		if s = strings.TrimSpace(s); s != "" {
+			items = append(items, s)
+		}
+	}
+	if len(items) == 0 {
+		return verb
+	}
+
+	var subject string
+	switch conj {
+	case CompoundOr:
+		subject = JoinWithConj(items, "or")
+	case CompoundEitherOr:
+		subject = "either " + JoinWithConj(items, "or")
+	case CompoundNeitherNor:
+		subject = "neither " + JoinWithConj(items, "nor")
+	default:
+		subject = Join(items)
+	}
+	if strings.TrimSpace(verb) == "" {
+		return subject
+	}
+
+	nearest := items[len(items)-1]
+	if conj == CompoundAnd && len(items) > 1 {
+		first, _, _ := strings.Cut(strings.ToLower(items[0]), " ")
+		if first == "each" || first == "every" {
+			return subject + " " + e.AgreeVerb(1, verb)
+		}
+		return subject + " " + e.AgreeVerb(2, verb)
+	}
+	if strings.EqualFold(nearest, "I") {
+		return subject + " " + firstPersonVerb(e.AgreeVerb(2, verb))
+	}
+	if e.isPluralSubject(nearest) {
+		return subject + " " + e.AgreeVerb(2, verb)
+	}
+	return subject + " " + e.AgreeVerb(1, verb)
+}
+
+// NumberOfPhrase returns "the number of" followed by the plural of noun, the
+// form of verb that agrees with it, and n spelled out in words.
+//
+// "The number of" names a single count and takes a singular verb, unlike "a
+// number of", which means "several" and takes a plural verb (see
+// ANumberOf). The noun may be given in the singular or plural, and only its
+// last word is changed. The verb is matched with AgreeVerb; an empty verb
+// returns the subject alone.
+//
+// Examples:
+//   - NumberOfPhrase(3, "error", "were") returns "the number of errors was three"
+//   - NumberOfPhrase(0, "failed test", "are") returns "the number of failed tests is zero"
+//   - NumberOfPhrase(1, "children", "") returns "the number of children"
+func NumberOfPhrase(n int, noun, verb string) string {
+	return defaultEngine.NumberOfPhrase(n, noun, verb)
+}
+
+// NumberOfPhrase returns "the number of" followed by the plural of noun, the
+// form of verb that agrees with it, and n spelled out in words. See the
+// package-level NumberOfPhrase for details.
+//
+// Examples:
+//   - e.NumberOfPhrase(3, "error", "were") returns "the number of errors was three"
+func (e *Engine) NumberOfPhrase(n int, noun, verb string) string {
+	subject := "the number of " + e.numberOfNoun(noun)
+	if strings.TrimSpace(verb) == "" {
+		return subject
+	}
+	return subject + " " + e.AgreeVerb(1, verb) + " " + NumberToWords(n)
+}
+
+// ANumberOf returns "a number of" followed by the plural of noun and the
+// form of verb that agrees with it.
+//
+// "A number of" means "several" and takes a plural verb, unlike "the number
+// of", which takes a singular one (see NumberOfPhrase). The noun may be
+// given in the singular or plural, and only its last word is changed. The
+// verb is matched with AgreeVerb; an empty verb returns the subject alone.
+//
+// Examples:
+//   - ANumberOf("error", "was found") returns "a number of errors were found"
+//   - ANumberOf("users", "has") returns "a number of users have"
+//   - ANumberOf("child", "") returns "a number of children"
+func ANumberOf(noun, verb string) string {
+	return defaultEngine.ANumberOf(noun, verb)
+}
+
+// ANumberOf returns "a number of" followed by the plural of noun and the
+// form of verb that agrees with it. See the package-level ANumberOf for
+// details.
+//
+// Examples:
+//   - e.ANumberOf("error", "was found") returns "a number of errors were found"
+func (e *Engine) ANumberOf(noun, verb string) string {
+	subject := "a number of " + e.numberOfNoun(noun)
+	if strings.TrimSpace(verb) == "" {
+		return subject
+	}
+	return subject + " " + e.AgreeVerb(2, verb)
+}
+
+// numberOfNoun returns a noun phrase with its last word made plural, unless
+// it is plural already or a mass noun.
+func (e *Engine) numberOfNoun(noun string) string {
+	noun = strings.TrimSpace(noun)
+	head, last, _ := splitLastWord(noun)
+	if last == "" || IsMassNoun(last) || !strings.EqualFold(e.singular(last), last) {
+		return noun
+	}
+	return head + e.plural(last)
+}
+
+// isPluralSubject reports whether a subject takes a plural verb, judging by
+// its first word if that is a pronoun or determiner, by "the number of" or
+// "a number of" at its start, or else by its last word.
+func (e *Engine) isPluralSubject(subject string) bool {
+	lower := strings.ToLower(subject)
+	first, _, _ := strings.Cut(lower, " ")
+	switch {
+	case singularSubjects[lower] || singularSubjects[first]:
+		return false
+	case pluralSubjects[lower]:
+		return true
+	case strings.HasPrefix(lower, "the number of "):
+		// "the number of errors is"
+		return false
+	case strings.HasPrefix(lower, "a number of "):
+		// "a number of errors are"
+		return true
+	}
+	_, last, _ := splitLastWord(subject)
+	_, last, _ = extractPunctuation(last)
+	if last == "" || unicode.IsUpper([]rune(last)[0]) && !isAllUppercase(last) {
+		return false
+	}
+	return !strings.EqualFold(e.singular(last), last)
+}
+
+// firstPersonVerb turns the first word of a plural verb phrase into the
+// form taken by "I": "are" -> "am", "were" -> "was", "aren't" -> "am not".
+func firstPersonVerb(verb string) string {
+	first, rest, hasRest := strings.Cut(verb, " ")
+	switch strings.ToLower(first) {
+	case "are":
+		first = matchCase(first, "am")
+	case "were":
+		first = matchCase(first, "was")
+	case "weren't":
+		first = matchCase(first, "wasn't")
+	case "aren't":
+		first = matchCase(first, "am not")
+	}
+	if hasRest {
+		return first + " " + rest
+	}
+	return first
+}
@@ -0,0 +1,203 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestAgreeVerb(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+		verb  string
+		want  string
+	}{
+		// Irregular verbs
+		{name: "have singular", count: 1, verb: "have", want: "has"},
+		{name: "has plural", count: 2, verb: "has", want: "have"},
+		{name: "is plural", count: 3, verb: "is", want: "are"},
+		{name: "are singular", count: 1, verb: "are", want: "is"},
+		{name: "was zero", count: 0, verb: "was", want: "were"},
+		{name: "was singular", count: 1, verb: "was", want: "was"},
+		{name: "be singular", count: 1, verb: "be", want: "is"},
+		{name: "be plural", count: 5, verb: "be", want: "are"},
+		{name: "am plural", count: 2, verb: "am", want: "are"},
+		{name: "do singular", count: 1, verb: "do", want: "does"},
+		{name: "negative one", count: -1, verb: "are", want: "is"},
+
+		// Regular verbs
+		{name: "run singular", count: 1, verb: "run", want: "runs"},
+		{name: "runs plural", count: 2, verb: "runs", want: "run"},
+		{name: "runs singular", count: 1, verb: "runs", want: "runs"},
+		{name: "run plural", count: 2, verb: "run", want: "run"},
+		{name: "watch singular", count: 1, verb: "watch", want: "watches"},
+		{name: "watches plural", count: 4, verb: "watches", want: "watch"},
+		{name: "try singular", count: 1, verb: "try", want: "tries"},
+		{name: "play singular", count: 1, verb: "play", want: "plays"},
+		{name: "echo singular", count: 1, verb: "echo", want: "echoes"},
+		{name: "focus plural", count: 3, verb: "focus", want: "focus"},
+		{name: "focus singular", count: 1, verb: "focus", want: "focuses"},
+		{name: "focuses plural", count: 2, verb: "focuses", want: "focus"},
+		{name: "bias plural", count: 3, verb: "bias", want: "bias"},
+		{name: "bias singular", count: 1, verb: "bias", want: "biases"},
+		{name: "gasses plural", count: 2, verb: "gasses", want: "gas"},
+
+		// Contractions
+		{name: "don't singular", count: 1, verb: "don't", want: "doesn't"},
+		{name: "doesn't plural", count: 2, verb: "doesn't", want: "don't"},
+		{name: "isn't plural", count: 0, verb: "isn't", want: "aren't"},
+		{name: "haven't singular", count: 1, verb: "haven't", want: "hasn't"},
+
+		// Modals pass through
+		{name: "can singular", count: 1, verb: "can", want: "can"},
+		{name: "must plural", count: 2, verb: "must", want: "must"},
+		{name: "won't singular", count: 1, verb: "won't", want: "won't"},
+
+		// Verb phrases
+		{name: "has been plural", count: 3, verb: "has been", want: "have been"},
+		{name: "have been singular", count: 1, verb: "have been", want: "has been"},
+		{name: "is being plural", count: 2, verb: "is being", want: "are being"},
+
+		// Case and whitespace
+		{name: "titlecase", count: 1, verb: "Have", want: "Has"},
+		{name: "uppercase", count: 2, verb: "IS", want: "ARE"},
+		{name: "whitespace preserved", count: 1, verb: " have ", want: " has "},
+		{name: "empty", count: 1, verb: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AgreeVerb(tt.count, tt.verb))
+		})
+	}
+}
+
+func TestAgreeVerbCustom(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefVerb("grokz", "grok")
+	assert.Equal(t, "grokz", e.AgreeVerb(1, "grok"))
+	assert.Equal(t, "grok", e.AgreeVerb(2, "grokz"))
+}
+
+func TestAgreeVerbIgnoresNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "are", e.AgreeVerb(3, "is"))
+}
+
+func TestAgreeCompound(t *testing.T) {
+	tests := []struct {
+		name     string
+		subjects []string
+		verb     string
+		want     string
+	}{
+		{name: "two names", subjects: []string{"Alice", "Bob"}, verb: "is", want: "Alice and Bob are"},
+		{name: "three names", subjects: []string{"Alice", "Bob", "Carol"}, verb: "has", want: "Alice, Bob, and Carol have"},
+		{name: "singular nouns", subjects: []string{"the cat", "the dog"}, verb: "was", want: "the cat and the dog were"},
+		{name: "one singular subject", subjects: []string{"the cat"}, verb: "are", want: "the cat is"},
+		{name: "one plural subject", subjects: []string{"the dogs"}, verb: "is", want: "the dogs are"},
+		{name: "every", subjects: []string{"every man", "woman"}, verb: "are", want: "every man and woman is"},
+		{name: "each", subjects: []string{"each file", "folder"}, verb: "has", want: "each file and folder has"},
+		{name: "verb phrase", subjects: []string{"Alice", "Bob"}, verb: "has been notified", want: "Alice and Bob have been notified"},
+		{name: "blank subjects skipped", subjects: []string{"Alice", " ", "Bob"}, verb: "is", want: "Alice and Bob are"},
+		{name: "no subjects", subjects: nil, verb: "is", want: "is"},
+		{name: "no verb", subjects: []string{"Alice", "Bob"}, verb: "", want: "Alice and Bob"},
+		{name: "the number of", subjects: []string{"the number of errors"}, verb: "are", want: "the number of errors is"},
+		{name: "a number of", subjects: []string{"a number of user"}, verb: "is", want: "a number of user are"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AgreeCompound(tt.subjects, tt.verb))
+		})
+	}
+}
+
+func TestAgreeCompoundWithConj(t *testing.T) {
+	tests := []struct {
+		name     string
+		subjects []string
+		conj     inflect.CompoundConj
+		verb     string
+		want     string
+	}{
+		{name: "and", subjects: []string{"Alice", "Bob"}, conj: inflect.CompoundAnd, verb: "is", want: "Alice and Bob are"},
+
+		// The nearest subject decides
+		{name: "or singular", subjects: []string{"Alice", "Bob"}, conj: inflect.CompoundOr, verb: "have", want: "Alice or Bob has"},
+		{name: "or plural last", subjects: []string{"the manager", "the users"}, conj: inflect.CompoundOr, verb: "has", want: "the manager or the users have"},
+		{name: "either plural last", subjects: []string{"the cat", "the dogs"}, conj: inflect.CompoundEitherOr, verb: "is", want: "either the cat or the dogs are"},
+		{name: "either singular last", subjects: []string{"the dogs", "the cat"}, conj: inflect.CompoundEitherOr, verb: "are", want: "either the dogs or the cat is"},
+		{name: "neither singular last", subjects: []string{"the dogs", "the cat"}, conj: inflect.CompoundNeitherNor, verb: "are", want: "neither the dogs nor the cat is"},
+		{name: "neither plural last", subjects: []string{"the cat", "the dogs"}, conj: inflect.CompoundNeitherNor, verb: "was", want: "neither the cat nor the dogs were"},
+		{name: "neither three", subjects: []string{"Alice", "Bob", "the admins"}, conj: inflect.CompoundNeitherNor, verb: "has", want: "neither Alice, Bob, nor the admins have"},
+
+		// Pronouns and names
+		{name: "I", subjects: []string{"you", "I"}, conj: inflect.CompoundEitherOr, verb: "is", want: "either you or I am"},
+		{name: "I past", subjects: []string{"they", "I"}, conj: inflect.CompoundNeitherNor, verb: "were", want: "neither they nor I was"},
+		{name: "I negative", subjects: []string{"you", "I"}, conj: inflect.CompoundOr, verb: "isn't", want: "you or I am not"},
+		{name: "I other verb", subjects: []string{"she", "I"}, conj: inflect.CompoundOr, verb: "has", want: "she or I have"},
+		{name: "you", subjects: []string{"he", "you"}, conj: inflect.CompoundOr, verb: "is", want: "he or you are"},
+		{name: "they", subjects: []string{"he", "they"}, conj: inflect.CompoundNeitherNor, verb: "was", want: "neither he nor they were"},
+		{name: "he", subjects: []string{"they", "he"}, conj: inflect.CompoundOr, verb: "were", want: "they or he was"},
+		{name: "name ending in s", subjects: []string{"the admins", "Charles"}, conj: inflect.CompoundOr, verb: "approve", want: "the admins or Charles approves"},
+		{name: "mass noun in -s", subjects: []string{"the users", "the news"}, conj: inflect.CompoundOr, verb: "are", want: "the users or the news is"},
+		{name: "everyone", subjects: []string{"the admins", "everyone"}, conj: inflect.CompoundOr, verb: "have", want: "the admins or everyone has"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AgreeCompoundWithConj(tt.subjects, tt.conj, tt.verb))
+		})
+	}
+}
+
+func TestNumberOfPhrase(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		noun string
+		verb string
+		want string
+	}{
+		{name: "singular noun", n: 3, noun: "error", verb: "were", want: "the number of errors was three"},
+		{name: "plural noun", n: 3, noun: "errors", verb: "was", want: "the number of errors was three"},
+		{name: "irregular plural", n: 2, noun: "children", verb: "are", want: "the number of children is two"},
+		{name: "zero", n: 0, noun: "failed test", verb: "are", want: "the number of failed tests is zero"},
+		{name: "one", n: 1, noun: "file", verb: "have been", want: "the number of files has been one"},
+		{name: "mass noun", n: 2, noun: "equipment", verb: "is", want: "the number of equipment is two"},
+		{name: "no verb", n: 5, noun: "item", verb: "", want: "the number of items"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NumberOfPhrase(tt.n, tt.noun, tt.verb))
+		})
+	}
+}
+
+func TestANumberOf(t *testing.T) {
+	tests := []struct {
+		name string
+		noun string
+		verb string
+		want string
+	}{
+		{name: "singular noun", noun: "error", verb: "was found", want: "a number of errors were found"},
+		{name: "plural noun", noun: "users", verb: "has", want: "a number of users have"},
+		{name: "irregular", noun: "child", verb: "is", want: "a number of children are"},
+		{name: "phrase", noun: "open issue", verb: "remains", want: "a number of open issues remain"},
+		{name: "contraction", noun: "test", verb: "doesn't pass", want: "a number of tests don't pass"},
+		{name: "no verb", noun: "child", verb: "", want: "a number of children"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ANumberOf(tt.noun, tt.verb))
+		})
+	}
+}
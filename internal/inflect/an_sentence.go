@@ -0,0 +1,111 @@
+package inflect
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sentenceArticlePattern matches an indefinite article followed by the
+// space before the next word.
+var sentenceArticlePattern = regexp.MustCompile(`\b(?:[Aa][Nn]?)\s+`)
+
+// AnInSentence corrects every indefinite article in a piece of text, so
+// that each "a" or "an" agrees with the word after it, using the same rules
+// as An.
+//
+// Lowercase articles are corrected anywhere, capitalized ones at the start
+// of a sentence, and uppercase ones between uppercase words. A capital "A"
+// elsewhere, as in "plan A or plan B", is usually a letter rather than an
+// article and is left alone, although in text written in uppercase the two
+// cannot be told apart. Each corrected
+// article keeps its case ("A" -> "An", "AN" -> "A"), and the rest of the
+// text is unchanged. It is meant as a post-processing pass over generated
+// text, where articles are often chosen before the words that follow them.
+//
+// Examples:
+//   - AnInSentence("We saw a owl and an unicorn.") returns "We saw an owl and a unicorn."
+//   - AnInSentence("An user left. A hour later, a FBI agent came.") returns "A user left. An hour later, an FBI agent came."
+//   - AnInSentence("Plan A is an plan.") returns "Plan A is a plan."
+func AnInSentence(text string) string {
+	return defaultEngine.AnInSentence(text)
+}
+
+// AnInSentence corrects every indefinite article in a piece of text, so
+// that each "a" or "an" agrees with the word after it, using the same rules
+// as e.An. See the package-level AnInSentence for details.
+//
+// Examples:
+//   - e.AnInSentence("We saw a owl and an unicorn.") returns "We saw an owl and a unicorn."
+func (e *Engine) AnInSentence(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range sentenceArticlePattern.FindAllStringIndex(text, -1) {
+		article := strings.TrimRightFunc(text[m[0]:m[1]], unicode.IsSpace)
+		rest := text[m[1]:]
+		if !startsWord(rest) || article != strings.ToLower(article) && !startsSentence(text[:m[0]]) && !betweenUppercase(text[:m[0]], rest) {
+			continue
+		}
+		want := e.ArticleFor(rest)
+		if want == "" || strings.EqualFold(want, article) {
+			continue
+		}
+		b.WriteString(text[last:m[0]])
+		b.WriteString(articleCase(article, want, text[:m[0]], rest))
+		last = m[0] + len(article)
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// startsWord reports whether text starts with a word that an article can
+// come before, possibly in quotes, brackets, or markup.
+func startsWord(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '<' || strings.ContainsRune(leadingWrappers, r)
+}
+
+// startsSentence reports whether a word after before starts a sentence:
+// before is empty or ends with a period, question mark, or exclamation mark,
+// ignoring any spaces, quotes, or brackets after it.
+func startsSentence(before string) bool {
+	before = strings.TrimRightFunc(before, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(leadingWrappers+trailingWrappers, r)
+	})
+	r, _ := utf8.DecodeLastRuneInString(before)
+	return before == "" || r == '.' || r == '?' || r == '!'
+}
+
+// betweenUppercase reports whether the words before and after an article
+// are in uppercase, as in "AN ORANGE AND A APPLE".
+func betweenUppercase(before, rest string) bool {
+	prev, next := strings.Fields(before), strings.Fields(rest)
+	if len(prev) == 0 || len(next) == 0 {
+		return false
+	}
+	last := prev[len(prev)-1]
+	return len(last) > 1 && isAllUpper(last) && len(next[0]) > 1 && isAllUpper(next[0])
+}
+
+// articleCase returns the article want in the case of article, which comes
+// between before and rest. A single "A" is taken to be uppercase only if the
+// words around it are, or the next two words are, since the next word alone
+// may be an acronym: "A FBI agent".
+func articleCase(article, want, before, rest string) string {
+	switch {
+	case article == strings.ToLower(article):
+		return want
+	case article == "A":
+		if next := strings.Fields(rest); betweenUppercase(before, rest) || len(next) >= 2 && isAllUpper(next[0]) && isAllUpper(next[1]) {
+			return strings.ToUpper(want)
+		}
+		return Capitalize(want)
+	case article == strings.ToUpper(article):
+		return strings.ToUpper(want)
+	}
+	return Capitalize(want)
+}
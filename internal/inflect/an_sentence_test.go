@@ -0,0 +1,46 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestAnInSentence(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "both articles", input: "We saw a owl and an unicorn", want: "We saw an owl and a unicorn"},
+		{name: "correct articles", input: "We saw an owl and a unicorn.", want: "We saw an owl and a unicorn."},
+		{name: "sentence start", input: "An user left. A hour later, a FBI agent came.", want: "A user left. An hour later, an FBI agent came."},
+		{name: "quoted sentence start", input: `He said: "A apple." "An pear?"`, want: `He said: "A apple." "A pear?"`},
+		{name: "letter A", input: "Plan A is an plan.", want: "Plan A is a plan."},
+		{name: "grade A", input: "They sell grade A eggs.", want: "They sell grade A eggs."},
+		{name: "uppercase text", input: "A ORANGE AND A APPLE", want: "AN ORANGE AND AN APPLE"},
+		{name: "uppercase acronym", input: "A NGO worker", want: "An NGO worker"},
+		{name: "numbers", input: "a 8-hour day and an 10-minute break", want: "an 8-hour day and a 10-minute break"},
+		{name: "quotes and markup", input: `a "honest" answer in a <em>hour</em>`, want: `an "honest" answer in an <em>hour</em>`},
+		{name: "line break", input: "a\napple", want: "an\napple"},
+		{name: "no following word", input: "I need a", want: "I need a"},
+		{name: "punctuation after article", input: "an -- a apple", want: "an -- an apple"},
+		{name: "article inside word", input: "Santa ate banana apples", want: "Santa ate banana apples"},
+		{name: "empty", input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AnInSentence(tt.input))
+		})
+	}
+}
+
+func TestEngineAnInSentence(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefAn("yak")
+	assert.Equal(t, "We saw an yak and an ox.", e.AnInSentence("We saw a yak and a ox."))
+	assert.Equal(t, "We saw a yak and an ox.", inflect.AnInSentence("We saw a yak and a ox."))
+}
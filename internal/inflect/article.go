@@ -1,10 +1,13 @@
 package inflect
 
 import (
+	"errors"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // silentHWords contains words starting with silent 'h' that take "an".
@@ -29,6 +32,9 @@ var lowercaseAbbrevs = map[string]bool{
 //   - Silent 'h': "an honest person"
 //   - Vowels with consonant sounds: "a Ukrainian", "a unanimous decision"
 //   - Abbreviations: "a YAML file", "a JSON object"
+//   - Numbers and numeric ordinals, as read aloud: "an 8", "an 11th hour"
+//   - Quoted, bracketed, or markup-wrapped words: "an \"honest\" answer",
+//     "an <em>apple</em>"; the original text is returned unchanged
 //
 // Custom patterns defined via DefA(), DefAn(), DefAPattern(), and DefAnPattern()
 // take precedence over default rules.
@@ -46,6 +52,9 @@ func An(word string) string {
 //   - Silent 'h': "an honest person"
 //   - Vowels with consonant sounds: "a Ukrainian", "a unanimous decision"
 //   - Abbreviations: "a YAML file", "a JSON object"
+//   - Numbers and numeric ordinals, as read aloud: "an 8", "an 11th hour"
+//   - Quoted, bracketed, or markup-wrapped words: "an \"honest\" answer",
+//     "an <em>apple</em>"; the original text is returned unchanged
 //
 // Custom patterns defined via DefA(), DefAn(), DefAPattern(), and DefAnPattern()
 // take precedence over default rules.
@@ -58,16 +67,64 @@ func An(word string) string {
 //	e.An("hour")       // returns "an hour"
 //	e.An("university") // returns "a university"
 func (e *Engine) An(word string) string {
-	if word == "" {
-		return ""
+	return e.lookup(opAn, word)
+}
+
+// an implements An without the cache, recording each rule it applies in x.
+func (e *Engine) an(word string, x *explanation) string {
+	article := e.articleFor(word, x)
+	if article == "" {
+		return word
 	}
+	return article + " " + word
+}
 
-	// Get the first word for pattern matching
+// ArticleFor returns the indefinite article ("a" or "an") appropriate for the
+// word, without prefixing it.
+//
+// This uses the same rules as An(), including custom patterns. It returns
+// an empty string if word is empty or contains only whitespace.
+//
+// Examples:
+//   - ArticleFor("hour") returns "an"
+//   - ArticleFor("cat") returns "a"
+//   - ArticleFor("university") returns "a"
+//   - ArticleFor("FBI agent") returns "an"
+func ArticleFor(word string) string {
+	return defaultEngine.ArticleFor(word)
+}
+
+// ArticleFor returns the indefinite article ("a" or "an") appropriate for the
+// word, without prefixing it.
+//
+// This uses the same rules as An(), including custom patterns. It returns
+// an empty string if word is empty or contains only whitespace.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.ArticleFor("hour")       // returns "an"
+//	e.ArticleFor("cat")        // returns "a"
+//	e.ArticleFor("university") // returns "a"
+func (e *Engine) ArticleFor(word string) string {
+	return e.articleFor(word, nil)
+}
+
+// articleFor implements ArticleFor, recording the rule it applies in x.
+func (e *Engine) articleFor(word string, x *explanation) string {
+	// Get the first word for pattern matching, looking past any leading
+	// quotes, brackets, or markup
 	fields := strings.Fields(word)
 	if len(fields) == 0 {
-		return word
+		return ""
 	}
 	firstWord := fields[0]
+	if unwrapped := strings.Fields(unwrapWord(word)); len(unwrapped) > 0 {
+		firstWord = unwrapped[0]
+	}
+	if firstWord != fields[0] {
+		x.note("wrappers: analyzing %s", firstWord)
+	}
 	lowerFirst := strings.ToLower(firstWord)
 
 	// Lock for reading custom patterns
@@ -76,20 +133,23 @@ func (e *Engine) An(word string) string {
 	// Check custom "a" exact words first (highest priority)
 	if e.customAWords[lowerFirst] {
 		e.mu.RUnlock()
-		return "a " + word
+		x.note("custom a words (DefA): %s -> a", firstWord)
+		return "a"
 	}
 
 	// Check custom "an" exact words second
 	if e.customAnWords[lowerFirst] {
 		e.mu.RUnlock()
-		return "an " + word
+		x.note("custom an words (DefAn): %s -> an", firstWord)
+		return "an"
 	}
 
 	// Check custom "a" regex patterns third
 	for _, pat := range e.customAPatterns {
 		if pat.MatchString(lowerFirst) {
 			e.mu.RUnlock()
-			return "a " + word
+			x.note("custom a pattern %q: %s -> a", pat.String(), firstWord)
+			return "a"
 		}
 	}
 
@@ -97,40 +157,159 @@ func (e *Engine) An(word string) string {
 	for _, pat := range e.customAnPatterns {
 		if pat.MatchString(lowerFirst) {
 			e.mu.RUnlock()
-			return "an " + word
+			x.note("custom an pattern %q: %s -> an", pat.String(), firstWord)
+			return "an"
+		}
+	}
+
+	// Check prefixes registered with DefSilentH and DefSoundedVowel fifth
+	if an, prefix := e.customSound(lowerFirst); prefix != "" {
+		e.mu.RUnlock()
+		if an {
+			x.note("custom silent h (DefSilentH) %q: %s -> an", prefix, firstWord)
+			return "an"
 		}
+		x.note("custom sounded vowel (DefSoundedVowel) %q: %s -> a", prefix, firstWord)
+		return "a"
+	}
+
+	// Check words pronounced differently in the dialect set with SetDialect
+	if an, ok := dialectArticles[e.dialect][strings.TrimRight(lowerFirst, trailingPunctuation)]; ok {
+		e.mu.RUnlock()
+		article := "a"
+		if an {
+			article = "an"
+		}
+		x.note("dialect pronunciation (SetDialect): %s -> %s", firstWord, article)
+		return article
 	}
 
 	e.mu.RUnlock()
 
+	// Read registered acronyms as they are pronounced
+	if pronunciation, ok := e.acronymPronunciation(firstWord); ok {
+		article := "a"
+		if an, _ := needsAn(pronunciation); an {
+			article = "an"
+		}
+		x.note("acronym pronunciation (DefAcronym): %s read as %q -> %s", firstWord, pronunciation, article)
+		return article
+	}
+
 	// Fall back to default rules
-	if needsAn(word) {
-		return "an " + word
+	article := "a"
+	an, rule := needsAn(firstWord)
+	if an {
+		article = "an"
+	}
+	x.note("%s: %s -> %s", rule, firstWord, article)
+	return article
+}
+
+// AnCapitalized returns the word prefixed with a capitalized indefinite
+// article ("A" or "An"), for use at the start of a sentence.
+//
+// The word itself is left unchanged.
+//
+// Examples:
+//   - AnCapitalized("apple") returns "An apple"
+//   - AnCapitalized("cat") returns "A cat"
+//   - AnCapitalized("hour") returns "An hour"
+func AnCapitalized(word string) string {
+	return defaultEngine.AnCapitalized(word)
+}
+
+// AnCapitalized returns the word prefixed with a capitalized indefinite
+// article ("A" or "An"), for use at the start of a sentence.
+//
+// The word itself is left unchanged.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.AnCapitalized("apple") // returns "An apple"
+//	e.AnCapitalized("cat")   // returns "A cat"
+func (e *Engine) AnCapitalized(word string) string {
+	article := e.ArticleFor(word)
+	if article == "" {
+		return word
 	}
-	return "a " + word
+	return Capitalize(article) + " " + word
 }
 
-// needsAn determines if a word/phrase should be preceded by "an" (vs "a").
-func needsAn(text string) bool {
+// leadingWrappers and trailingWrappers are the quote, bracket, and
+// emphasis characters that can surround a word without affecting how it is
+// pronounced.
+const (
+	leadingWrappers  = "\"'`*_([{“‘«"
+	trailingWrappers = "\"'`*_)]}”’»"
+)
+
+// unwrapWord strips leading quotes, brackets, emphasis markers, and markup
+// tags from text, and removes any that close the first word, so that
+// "\"honest\" answer" and "<em>apple</em>" are analyzed as "honest answer"
+// and "apple".
+func unwrapWord(text string) string {
+	for {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		r, size := utf8.DecodeRuneInString(text)
+		switch {
+		case r == '<':
+			end := strings.IndexByte(text, '>')
+			if end < 0 {
+				return text
+			}
+			text = text[end+1:]
+		case size > 0 && strings.ContainsRune(leadingWrappers, r), isInvisible(r):
+			text = text[size:]
+		default:
+			// Cut the first word at a closing tag or wrapper
+			end := strings.IndexFunc(text, unicode.IsSpace)
+			if end < 0 {
+				end = len(text)
+			}
+			word := text[:end]
+			if tag := strings.IndexByte(word, '<'); tag > 0 {
+				word = word[:tag]
+			}
+			word = strings.TrimRight(word, trailingWrappers)
+			return word + text[end:]
+		}
+	}
+}
+
+// needsAn determines if a word/phrase should be preceded by "an" (vs "a"),
+// and returns a short description of the rule that decided it.
+func needsAn(text string) (an bool, rule string) {
 	// Get the first word to analyze
 	firstWord := strings.Fields(text)[0]
 	lower := strings.ToLower(firstWord)
 
+	// Numbers and numeric ordinals are read aloud: "an 8", "an 11th"
+	if lower[0] >= '0' && lower[0] <= '9' {
+		return numberNeedsAn(lower), "number"
+	}
+
+	// Check words whose pronunciation is known
+	if an, ok := pronunciationNeedsAn(lower); ok {
+		return an, "pronunciation dictionary"
+	}
+
 	// Check for silent 'h' words that take "an"
 	for h := range silentHWords {
 		if strings.HasPrefix(lower, h) {
-			return true
+			return true, "silent-h list"
 		}
 	}
 
 	// Check for abbreviations/acronyms (all uppercase or known patterns)
 	if isAbbreviation(firstWord) {
-		return abbreviationNeedsAn(firstWord)
+		return abbreviationNeedsAn(firstWord), "abbreviation"
 	}
 
 	// Check for known lowercase abbreviations pronounced letter-by-letter
 	if lowercaseAbbrevs[lower] {
-		return abbreviationNeedsAn(strings.ToUpper(lower))
+		return abbreviationNeedsAn(strings.ToUpper(lower)), "lowercase abbreviation"
 	}
 
 	// Check for special vowel patterns that sound like consonants (take "a")
@@ -142,7 +321,7 @@ func needsAn(text string) bool {
 	}
 	for _, pat := range consonantVowelPatterns {
 		if strings.HasPrefix(lower, pat) {
-			return false
+			return false, "consonant-sounding vowel"
 		}
 	}
 
@@ -150,18 +329,58 @@ func needsAn(text string) bool {
 	// e.g., Ugandan, Ukrainian, Unabomber, unanimous
 	if len(lower) >= 2 && lower[0] == 'u' {
 		if isConsonantYSound(lower) {
-			return false
+			return false, "\"you\" sound"
 		}
 	}
 
 	// Special case: single letters
 	if len(firstWord) == 1 {
-		return isVowelSound(rune(lower[0]))
+		return isVowelSound(rune(lower[0])), "single letter"
+	}
+
+	// Default: check if first letter is a vowel, ignoring any accent
+	return isVowelSound(firstBaseRune(lower)), "first letter"
+}
+
+// numberNeedsAn reports whether a word starting with a digit takes "an"
+// when read aloud. Numbers starting with 8 do ("an 8", "an 80th"), as do
+// 11 and 18 and their thousands ("an 11", "an 18,000").
+func numberNeedsAn(word string) bool {
+	end := strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) && r != ',' })
+	if end < 0 {
+		end = len(word)
 	}
+	digits := strings.ReplaceAll(word[:end], ",", "")
+	if digits[0] == '8' {
+		return true
+	}
+	return (strings.HasPrefix(digits, "11") || strings.HasPrefix(digits, "18")) && (len(digits)-2)%3 == 0
+}
 
-	// Default: check if first letter is a vowel
-	first := rune(lower[0])
-	return isVowelSound(first)
+// pronunciationNeedsAn looks up a lowercase word in the pronunciation
+// dictionary. Hyphenated words fall back to the part before the hyphen
+// ("one-off" is looked up as "one"), and a single letter before a hyphen is
+// read as its name ("an X-ray", "a U-turn"). The second result reports
+// whether the word was recognized.
+func pronunciationNeedsAn(lower string) (an, ok bool) {
+	key := strings.TrimFunc(lower, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if an, ok := pronunciationExceptions[key]; ok {
+		return an, true
+	}
+
+	head, _, hyphenated := strings.Cut(key, "-")
+	if !hyphenated {
+		return false, false
+	}
+	if an, ok := pronunciationExceptions[head]; ok {
+		return an, true
+	}
+	if len(head) == 1 && unicode.IsLetter(rune(head[0])) {
+		return abbreviationNeedsAn(head), true
+	}
+	return false, false
 }
 
 // isAbbreviation checks if a word appears to be an abbreviation/acronym.
@@ -232,6 +451,37 @@ func (e *Engine) A(word string) string {
 	return e.An(word)
 }
 
+// AnCount returns the word prefixed with its indefinite article if count is
+// 1, or with the count otherwise.
+//
+// This matches Python inflect's a(text, count): the word is not
+// pluralized, so pass a plural word for counts other than 1.
+//
+// Examples:
+//   - AnCount("apple", 1) returns "an apple"
+//   - AnCount("cat", 1) returns "a cat"
+//   - AnCount("cats", 2) returns "2 cats"
+//   - AnCount("cats", 0) returns "0 cats"
+func AnCount(word string, count int) string {
+	return defaultEngine.AnCount(word, count)
+}
+
+// AnCount returns the word prefixed with its indefinite article if count is
+// 1, or with the count otherwise.
+//
+// This matches Python inflect's a(text, count): the word is not
+// pluralized, so pass a plural word for counts other than 1.
+//
+// Examples:
+//   - e.AnCount("apple", 1) returns "an apple"
+//   - e.AnCount("cats", 2) returns "2 cats"
+func (e *Engine) AnCount(word string, count int) string {
+	if count != 1 {
+		return strconv.Itoa(count) + " " + word
+	}
+	return e.An(word)
+}
+
 // DefA defines a custom pattern that forces "a" instead of "an" for a word.
 //
 // The pattern is matched against the first word of the input (case-insensitive).
@@ -261,6 +511,7 @@ func (e *Engine) DefA(word string) {
 	lower := strings.ToLower(word)
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAWords[lower] = true
 	// Remove from customAnWords if present to avoid conflicts
 	delete(e.customAnWords, lower)
@@ -295,6 +546,7 @@ func (e *Engine) DefAn(word string) {
 	lower := strings.ToLower(word)
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAnWords[lower] = true
 	// Remove from customAWords if present to avoid conflicts
 	delete(e.customAWords, lower)
@@ -329,6 +581,7 @@ func (e *Engine) UndefA(word string) bool {
 	lower := strings.ToLower(word)
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	if e.customAWords[lower] {
 		delete(e.customAWords, lower)
 		return true
@@ -365,6 +618,7 @@ func (e *Engine) UndefAn(word string) bool {
 	lower := strings.ToLower(word)
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	if e.customAnWords[lower] {
 		delete(e.customAnWords, lower)
 		return true
@@ -372,6 +626,58 @@ func (e *Engine) UndefAn(word string) bool {
 	return false
 }
 
+// ErrInvalidArticle is returned when an article other than "a" or "an" is given.
+var ErrInvalidArticle = errors.New(`article must be "a" or "an"`)
+
+// DefAnException records the article to use for a word whose pronunciation
+// differs from what its spelling suggests, such as "herb" in British English
+// or a brand name.
+//
+// The article must be "a" or "an" (case-insensitive); otherwise
+// ErrInvalidArticle is returned. Exceptions are matched against the first
+// word of the input (case-insensitive) and take precedence over the built-in
+// pronunciation dictionary and default rules. They are stored alongside
+// DefA() and DefAn() definitions, so UndefA(), UndefAn(), and DefAReset()
+// remove them.
+//
+// Examples:
+//
+//	DefAnException("herb", "a")
+//	An("herb")   // returns "a herb" (British pronunciation)
+//	DefAnException("ytong", "an")
+//	An("Ytong")  // returns "an Ytong"
+func DefAnException(word, article string) error {
+	return defaultEngine.DefAnException(word, article)
+}
+
+// DefAnException records the article to use for a word whose pronunciation
+// differs from what its spelling suggests, such as "herb" in British English
+// or a brand name.
+//
+// The article must be "a" or "an" (case-insensitive); otherwise
+// ErrInvalidArticle is returned. Exceptions are matched against the first
+// word of the input (case-insensitive) and take precedence over the built-in
+// pronunciation dictionary and default rules. They are stored alongside
+// DefA() and DefAn() definitions, so UndefA(), UndefAn(), and DefAReset()
+// remove them.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefAnException("herb", "a")
+//	e.An("herb")  // returns "a herb" (British pronunciation)
+func (e *Engine) DefAnException(word, article string) error {
+	switch strings.ToLower(article) {
+	case "a":
+		e.DefA(word)
+	case "an":
+		e.DefAn(word)
+	default:
+		return ErrInvalidArticle
+	}
+	return nil
+}
+
 // DefAPattern defines a regex pattern that forces "a" instead of "an".
 //
 // The pattern is matched against the lowercase first word of the input.
@@ -428,6 +734,7 @@ func (e *Engine) DefAPattern(pattern string) error {
 	}
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAPatterns = append(e.customAPatterns, re)
 	return nil
 }
@@ -488,6 +795,7 @@ func (e *Engine) DefAnPattern(pattern string) error {
 	}
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAnPatterns = append(e.customAnPatterns, re)
 	return nil
 }
@@ -523,6 +831,7 @@ func (e *Engine) UndefAPattern(pattern string) bool {
 	anchored := "^(?:" + pattern + ")$"
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	for i, re := range e.customAPatterns {
 		if re.String() == anchored {
 			e.customAPatterns = append(e.customAPatterns[:i], e.customAPatterns[i+1:]...)
@@ -563,6 +872,7 @@ func (e *Engine) UndefAnPattern(pattern string) bool {
 	anchored := "^(?:" + pattern + ")$"
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	for i, re := range e.customAnPatterns {
 		if re.String() == anchored {
 			e.customAnPatterns = append(e.customAnPatterns[:i], e.customAnPatterns[i+1:]...)
@@ -575,7 +885,8 @@ func (e *Engine) UndefAnPattern(pattern string) bool {
 // DefAReset resets all custom a/an patterns to defaults (empty).
 //
 // This removes all custom patterns added via DefA(), DefAn(), DefAPattern(),
-// and DefAnPattern().
+// and DefAnPattern(), and the prefixes added via DefSilentH() and
+// DefSoundedVowel().
 //
 // Example:
 //
@@ -595,7 +906,8 @@ func DefAReset() {
 // DefAReset resets all custom a/an patterns to defaults (empty).
 //
 // This removes all custom patterns added via DefA(), DefAn(), DefAPattern(),
-// and DefAnPattern().
+// and DefAnPattern(), and the prefixes added via DefSilentH() and
+// DefSoundedVowel().
 //
 // Example:
 //
@@ -612,8 +924,10 @@ func DefAReset() {
 func (e *Engine) DefAReset() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAWords = make(map[string]bool)
 	e.customAnWords = make(map[string]bool)
 	e.customAPatterns = nil
 	e.customAnPatterns = nil
+	e.customSounds = nil
 }
@@ -0,0 +1,94 @@
+// Code generated by gen-an-exceptions. DO NOT EDIT.
+
+package inflect
+
+// pronunciationExceptions maps lowercase words to whether they take "an",
+// based on their pronunciation in tools/data/an-lexicon.dict.
+var pronunciationExceptions = map[string]bool{
+	"eucalyptus": false,
+	"eugenics":   false,
+	"eulogy":     false,
+	"eunuch":     false,
+	"euphemism":  false,
+	"euphoria":   false,
+	"eureka":     false,
+	"euro":       false,
+	"europe":     false,
+	"european":   false,
+	"euthanasia": false,
+	"ewe":        false,
+	"ewer":       false,
+	"faq":        true,
+	"heir":       true,
+	"heiress":    true,
+	"heirloom":   true,
+	"heirs":      true,
+	"herb":       true,
+	"herbal":     true,
+	"herbs":      true,
+	"hiv":        true,
+	"honest":     true,
+	"honestly":   true,
+	"honesty":    true,
+	"honor":      true,
+	"honorable":  true,
+	"honorary":   true,
+	"honour":     true,
+	"hors":       true,
+	"hour":       true,
+	"hourly":     true,
+	"hours":      true,
+	"html":       true,
+	"http":       true,
+	"hvac":       true,
+	"mba":        true,
+	"mri":        true,
+	"nasa":       false,
+	"nascar":     false,
+	"nato":       false,
+	"nfl":        true,
+	"once":       false,
+	"one":        false,
+	"oneness":    false,
+	"onerous":    true,
+	"onesie":     false,
+	"onetime":    false,
+	"ouija":      false,
+	"rsvp":       true,
+	"sql":        true,
+	"ubiquitous": false,
+	"ufo":        false,
+	"uganda":     false,
+	"ukraine":    false,
+	"ukrainian":  false,
+	"ukulele":    false,
+	"unanimous":  false,
+	"unicorn":    false,
+	"uniform":    false,
+	"union":      false,
+	"unique":     false,
+	"unit":       false,
+	"united":     false,
+	"universe":   false,
+	"university": false,
+	"uranium":    false,
+	"urea":       false,
+	"urinal":     false,
+	"url":        false,
+	"uruguay":    false,
+	"usage":      false,
+	"usb":        false,
+	"use":        false,
+	"used":       false,
+	"useful":     false,
+	"user":       false,
+	"usual":      false,
+	"utah":       false,
+	"utensil":    false,
+	"uterus":     false,
+	"utility":    false,
+	"utopia":     false,
+	"uvula":      false,
+	"ytterbium":  true,
+	"yttrium":    true,
+}
@@ -9,51 +9,107 @@ import (
 	inflect "github.com/cv/go-inflect/v2"
 )
 
-func TestAn(t *testing.T) {
+func TestAnPronunciationExceptions(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
 		want  string
 	}{
-		// Basic cases
-		{name: "consonant start", input: "cat", want: "a cat"},
-		{name: "vowel start", input: "ant", want: "an ant"},
-
-		// Single letters
-		{name: "vowel letter", input: "a", want: "an a"},
-		{name: "consonant letter", input: "b", want: "a b"},
-
-		// Silent H
-		{name: "silent h", input: "honest cat", want: "an honest cat"},
-		{name: "regular h", input: "dishonest cat", want: "a dishonest cat"},
-		{name: "h proper noun", input: "Honolulu sunset", want: "a Honolulu sunset"},
-
-		// Special pronunciation cases
-		{name: "mpeg abbreviation", input: "mpeg", want: "an mpeg"},
-		{name: "onetime exception", input: "onetime holiday", want: "a onetime holiday"},
-
-		// Vowels with consonant sounds (U variations)
-		{name: "Ugandan", input: "Ugandan person", want: "a Ugandan person"},
-		{name: "Ukrainian", input: "Ukrainian person", want: "a Ukrainian person"},
-		{name: "Unabomber", input: "Unabomber", want: "a Unabomber"},
-		{name: "unanimous", input: "unanimous decision", want: "a unanimous decision"},
-
-		// Abbreviations and acronyms
-		{name: "US abbreviation", input: "US farmer", want: "a US farmer"},
-		{name: "uppercase word", input: "wild PIKACHU appeared", want: "a wild PIKACHU appeared"},
-		{name: "YAML acronym", input: "YAML code block", want: "a YAML code block"},
-		{name: "Core ML", input: "Core ML function", want: "a Core ML function"},
-		{name: "JSON acronym", input: "JSON code block", want: "a JSON code block"},
+		// Initial h not pronounced (American English)
+		{name: "herb", input: "herb", want: "an herb"},
+		{name: "herbal tea", input: "herbal tea", want: "an herbal tea"},
+		{name: "heirloom", input: "heirloom", want: "an heirloom"},
+
+		// Vowels read as consonants
+		{name: "one-off", input: "one-off event", want: "a one-off event"},
+		{name: "eucalyptus", input: "eucalyptus tree", want: "a eucalyptus tree"},
+		{name: "ouija", input: "ouija board", want: "a ouija board"},
+		{name: "onerous", input: "onerous task", want: "an onerous task"},
+
+		// Consonants read as vowels
+		{name: "yttrium", input: "yttrium laser", want: "an yttrium laser"},
+
+		// Letter names before a hyphen
+		{name: "X-ray", input: "X-ray", want: "an X-ray"},
+		{name: "U-turn", input: "U-turn", want: "a U-turn"},
+		{name: "e-mail", input: "e-mail", want: "an e-mail"},
+		{name: "T-shirt", input: "T-shirt", want: "a T-shirt"},
+		{name: "F-16", input: "F-16", want: "an F-16"},
+
+		// Abbreviations
+		{name: "UTF-8", input: "UTF-8 string", want: "a UTF-8 string"},
+		{name: "lowercase usb", input: "usb drive", want: "a usb drive"},
+		{name: "lowercase url", input: "url", want: "a url"},
+		{name: "NASA read as a word", input: "NASA engineer", want: "a NASA engineer"},
+		{name: "MRI", input: "MRI scan", want: "an MRI scan"},
+
+		// Surrounding punctuation and case
+		{name: "trailing punctuation", input: "hour,", want: "an hour,"},
+		{name: "uppercase", input: "EUROPEAN", want: "a EUROPEAN"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := inflect.An(tt.input)
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, inflect.An(tt.input))
+		})
+	}
+}
+
+func TestAnWrappedWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "double quotes", input: `"honest" answer`, want: `an "honest" answer`},
+		{name: "single quotes", input: "'unicorn' emoji", want: "a 'unicorn' emoji"},
+		{name: "curly quotes", input: "\u201cumbrella\u201d", want: "an \u201cumbrella\u201d"},
+		{name: "guillemets", input: "\u00ab X-ray \u00bb", want: "an \u00ab X-ray \u00bb"},
+		{name: "parentheses", input: "(hour)", want: "an (hour)"},
+		{name: "nested brackets", input: "[(\"item\")]", want: "an [(\"item\")]"},
+		{name: "html tag", input: "<em>apple</em>", want: "an <em>apple</em>"},
+		{name: "html tag with attributes", input: `<a href="/u">user</a> profile`, want: `a <a href="/u">user</a> profile`},
+		{name: "nested tags", input: "<b><i>orange</i></b>", want: "an <b><i>orange</i></b>"},
+		{name: "markdown emphasis", input: "**egg**", want: "an **egg**"},
+		{name: "markdown code", input: "`int` value", want: "an `int` value"},
+		{name: "abbreviation in quotes", input: `"FBI" agent`, want: `an "FBI" agent`},
+		{name: "only punctuation", input: `"`, want: `a "`},
+		{name: "only markup", input: "<br>", want: "a <br>"},
+		{name: "unclosed tag", input: "<em apple", want: "a <em apple"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.An(tt.input))
 		})
 	}
 }
 
+func TestAnWrappedWordsCustomPatterns(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefA("ape")
+	assert.Equal(t, `a "ape"`, e.An(`"ape"`))
+	assert.Equal(t, "a <em>ape</em>", e.An("<em>ape</em>"))
+}
+
+func TestDefAnException(t *testing.T) {
+	e := inflect.NewEngine()
+
+	require.NoError(t, e.DefAnException("herb", "a"))
+	assert.Equal(t, "a herb", e.An("herb"))
+	assert.Equal(t, "a Herb", e.An("Herb"))
+
+	require.NoError(t, e.DefAnException("ytong", "AN"))
+	assert.Equal(t, "an Ytong block", e.An("Ytong block"))
+
+	err := e.DefAnException("cat", "the")
+	require.ErrorIs(t, err, inflect.ErrInvalidArticle)
+	assert.Equal(t, "a cat", e.An("cat"))
+
+	assert.True(t, e.UndefA("herb"))
+	assert.Equal(t, "an herb", e.An("herb"))
+}
+
 func TestA(t *testing.T) {
 	tests := []struct {
 		input string
@@ -71,6 +127,59 @@ func TestA(t *testing.T) {
 	}
 }
 
+func TestArticleFor(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "consonant", input: "cat", want: "a"},
+		{name: "vowel", input: "apple", want: "an"},
+		{name: "silent h", input: "hour", want: "an"},
+		{name: "consonant vowel", input: "university", want: "a"},
+		{name: "abbreviation", input: "FBI agent", want: "an"},
+		{name: "phrase", input: "honest mistake", want: "an"},
+		{name: "empty", input: "", want: ""},
+		{name: "whitespace only", input: "   ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ArticleFor(tt.input))
+		})
+	}
+}
+
+func TestArticleForCustomPatterns(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefA("ape")
+	e.DefAn("hero")
+
+	assert.Equal(t, "a", e.ArticleFor("ape"))
+	assert.Equal(t, "an", e.ArticleFor("hero"))
+	assert.Equal(t, "an", e.ArticleFor("apple"))
+}
+
+func TestAnCapitalized(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "vowel", input: "apple", want: "An apple"},
+		{name: "consonant", input: "cat", want: "A cat"},
+		{name: "silent h", input: "hour", want: "An hour"},
+		{name: "preserves word", input: "iPhone case", want: "An iPhone case"},
+		{name: "empty", input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AnCapitalized(tt.input))
+		})
+	}
+}
+
 func TestDefA(t *testing.T) {
 	// Reset to defaults after this test
 	defer inflect.DefAReset()
@@ -649,6 +758,7 @@ func BenchmarkAn(b *testing.B) {
 
 	for _, bm := range benchmarks {
 		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
 			for range b.N {
 				inflect.An(bm.input)
 			}
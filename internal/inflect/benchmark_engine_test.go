@@ -27,6 +27,26 @@ func BenchmarkEnginePluralSerial(b *testing.B) {
 	}
 }
 
+// BenchmarkEnginePluralCachedSerial measures Engine.Plural performance in
+// serial with the result cache enabled, so every call after the first hits.
+func BenchmarkEnginePluralCachedSerial(b *testing.B) {
+	e := NewEngine()
+	e.EnableCache(100)
+	b.ReportAllocs()
+	for b.Loop() {
+		e.Plural("child")
+	}
+}
+
+// BenchmarkPluralLowerSerial measures package-level PluralLower performance
+// in serial.
+func BenchmarkPluralLowerSerial(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		PluralLower("cat")
+	}
+}
+
 // BenchmarkSingularSerial measures package-level Singular performance in serial.
 func BenchmarkSingularSerial(b *testing.B) {
 	for b.Loop() {
@@ -41,6 +61,25 @@ func BenchmarkAnSerial(b *testing.B) {
 	}
 }
 
+// BenchmarkMatchCase measures matchCase, which every inflection calls, for
+// ASCII and non-ASCII words.
+func BenchmarkMatchCase(b *testing.B) {
+	pairs := []struct{ name, original, replacement string }{
+		{"lower", "child", "children"},
+		{"title", "Child", "children"},
+		{"upper", "CHILD", "children"},
+		{"unicode", "Élan", "élans"},
+	}
+	for _, p := range pairs {
+		b.Run(p.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				matchCase(p.original, p.replacement)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Parallel Benchmarks - Measure read performance under contention
 // =============================================================================
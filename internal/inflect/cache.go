@@ -0,0 +1,286 @@
+package inflect
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheStats reports how well an engine's result cache is working.
+type CacheStats struct {
+	// Hits is the number of lookups answered from the cache.
+	Hits uint64
+
+	// Misses is the number of lookups that had to be computed.
+	Misses uint64
+
+	// Evictions is the number of results dropped to stay within the size.
+	Evictions uint64
+
+	// Size is the number of results currently cached.
+	Size int
+
+	// Capacity is the maximum number of results cached, or 0 if caching
+	// is disabled.
+	Capacity int
+}
+
+// HitRate returns the fraction of lookups answered from the cache, between
+// 0 and 1. It returns 0 if there have been no lookups.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// lookupOp identifies a function whose results are cached and reported to
+// the hook.
+type lookupOp uint8
+
+const (
+	opPlural lookupOp = iota
+	opSingular
+	opAn
+)
+
+// lookupOpNames holds the function names reported to Hook.OnLookup.
+var lookupOpNames = [...]string{opPlural: "Plural", opSingular: "Singular", opAn: "An"}
+
+// cacheFlags holds the engine settings that affect cached results. Custom
+// definitions are not part of the key; changing them clears the cache.
+type cacheFlags struct {
+	classicalMode, classicalAll, classicalZero, classicalHerd bool
+	classicalNames, classicalAncient, classicalPersons        bool
+
+	properNameDetection ProperNameDetection
+	defaultNum          int
+	numPropagation      bool
+	technicalPlurals    bool
+	lenient             bool
+	dialect             Dialect
+}
+
+// cacheKey identifies a cached result.
+type cacheKey struct {
+	op    lookupOp
+	word  string
+	flags cacheFlags
+}
+
+// cacheEntry is an element of resultCache.order.
+type cacheEntry struct {
+	key   cacheKey
+	value string
+	rule  string
+}
+
+// resultCache is a least recently used cache of inflection results.
+//
+// It has its own lock so that lookups do not contend on Engine.mu. A nil
+// *resultCache caches nothing.
+type resultCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[cacheKey]*list.Element
+	order    *list.List // most recently used first
+
+	// gen is incremented by clear, so that results computed before a
+	// definition changed are not stored after it
+	gen uint64
+
+	hits, misses, evictions uint64
+}
+
+// newResultCache returns an empty cache holding up to capacity results.
+func newResultCache(capacity int) *resultCache {
+	return &resultCache{
+		capacity: capacity,
+		entries:  make(map[cacheKey]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// get returns the cached result for key and the rule that produced it, and
+// the generation to pass to put if there is none.
+func (c *resultCache) get(key cacheKey) (value, rule string, gen uint64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, found := c.entries[key]; found {
+		c.hits++
+		c.order.MoveToFront(el)
+		entry := el.Value.(*cacheEntry)
+		return entry.value, entry.rule, c.gen, true
+	}
+	c.misses++
+	return "", "", c.gen, false
+}
+
+// put stores a result computed during generation gen, evicting the least
+// recently used result if the cache is full.
+func (c *resultCache) put(key cacheKey, value, rule string, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if el, found := c.entries[key]; found {
+		el.Value = &cacheEntry{key: key, value: value, rule: rule}
+		c.order.MoveToFront(el)
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.evictions++
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value, rule: rule})
+}
+
+// clear removes all cached results, keeping the statistics.
+func (c *resultCache) clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.order.Init()
+	c.gen++
+}
+
+// stats returns the cache statistics.
+func (c *resultCache) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.order.Len(),
+		Capacity:  c.capacity,
+	}
+}
+
+// EnableCache caches up to size results of Plural, Singular, and An, or
+// disables caching if size is 0 or less.
+//
+// Real workloads often inflect the same few hundred words over and over,
+// and the cache turns those repeats into a map lookup. When it is full, the
+// least recently used result is dropped. Results are cached separately for
+// each combination of classical, proper name, and Num() settings, and
+// defining nouns, verbs, adjectives, articles, acronyms, or proper
+// names clears the cache. Calling EnableCache again replaces the cache and
+// resets its statistics.
+//
+// Examples:
+//
+//	EnableCache(1000)
+//	Plural("child") // computed: "children"
+//	Plural("child") // cached: "children"
+//	GetCacheStats() // returns Hits: 1, Misses: 1, Size: 1, Capacity: 1000
+func EnableCache(size int) {
+	defaultEngine.EnableCache(size)
+}
+
+// EnableCache caches up to size results of e.Plural, e.Singular, and e.An,
+// or disables caching if size is 0 or less.
+//
+// When the cache is full, the least recently used result is dropped.
+// Results are cached separately for each combination of classical, proper
+// name, and e.Num() settings, and defining nouns, verbs, adjectives,
+// articles, acronyms, or proper names clears the cache. Calling EnableCache
+// again replaces the cache and resets its statistics. Clone gives the copy
+// an empty cache of the same size.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.EnableCache(1000)
+//	e.Plural("child") // computed: "children"
+//	e.Plural("child") // cached: "children"
+//	e.GetCacheStats() // returns Hits: 1, Misses: 1, Size: 1, Capacity: 1000
+func (e *Engine) EnableCache(size int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if size <= 0 {
+		e.cache = nil
+		return
+	}
+	e.cache = newResultCache(size)
+}
+
+// GetCacheStats returns the statistics of the cache enabled by EnableCache.
+// It returns zero statistics if caching is disabled.
+func GetCacheStats() CacheStats {
+	return defaultEngine.GetCacheStats()
+}
+
+// GetCacheStats returns the statistics of the cache enabled by
+// e.EnableCache. It returns zero statistics if caching is disabled.
+func (e *Engine) GetCacheStats() CacheStats {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.cache.stats()
+}
+
+// cached returns the result of op for word, through the cache if one is
+// enabled, and the rule that produced it if x is not nil.
+func (e *Engine) cached(op lookupOp, word string, x *explanation) (value, rule string) {
+	c, key := e.cacheLookupKey(op, word)
+	if c == nil {
+		return e.uncached(op, word, x), x.rule()
+	}
+	value, rule, gen, ok := c.get(key)
+	if !ok {
+		value, rule = e.uncached(op, word, x), x.rule()
+		// Skip storing if a setting changed while computing
+		if _, now := e.cacheLookupKey(op, word); now == key {
+			c.put(key, value, rule, gen)
+		}
+	}
+	return value, rule
+}
+
+// uncached computes the result of op for word, recording the rules it
+// applies in x.
+func (e *Engine) uncached(op lookupOp, word string, x *explanation) string {
+	switch op {
+	case opPlural:
+		return e.pluralCounted(word, x)
+	case opSingular:
+		return e.singularExplained(word, x)
+	case opAn:
+		return e.an(word, x)
+	}
+	return word
+}
+
+// cacheLookupKey returns the engine's cache, if any, and the key for word
+// under the current settings.
+func (e *Engine) cacheLookupKey(op lookupOp, word string) (*resultCache, cacheKey) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.cache == nil {
+		return nil, cacheKey{}
+	}
+	return e.cache, cacheKey{op: op, word: word, flags: cacheFlags{
+		classicalMode:       e.classicalMode,
+		classicalAll:        e.classicalAll,
+		classicalZero:       e.classicalZero,
+		classicalHerd:       e.classicalHerd,
+		classicalNames:      e.classicalNames,
+		classicalAncient:    e.classicalAncient,
+		classicalPersons:    e.classicalPersons,
+		properNameDetection: e.properNameDetection,
+		defaultNum:          e.defaultNum,
+		numPropagation:      e.numPropagation,
+		technicalPlurals:    e.technicalPlurals,
+		lenient:             e.lenient,
+		dialect:             e.dialect,
+	}}
+}
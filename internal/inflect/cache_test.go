@@ -0,0 +1,146 @@
+package inflect_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestEnableCache(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, inflect.CacheStats{}, e.GetCacheStats())
+
+	e.EnableCache(10)
+	assert.Equal(t, "children", e.Plural("child"))
+	assert.Equal(t, "children", e.Plural("child"))
+	assert.Equal(t, "child", e.Singular("children"))
+	assert.Equal(t, "an apple", e.An("apple"))
+	assert.Equal(t, "an apple", e.An("apple"))
+
+	stats := e.GetCacheStats()
+	assert.Equal(t, inflect.CacheStats{Hits: 2, Misses: 3, Size: 3, Capacity: 10}, stats)
+	assert.InDelta(t, 0.4, stats.HitRate(), 1e-9)
+
+	e.EnableCache(0)
+	assert.Equal(t, "children", e.Plural("child"))
+	assert.Equal(t, inflect.CacheStats{}, e.GetCacheStats())
+}
+
+func TestCacheEviction(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(2)
+
+	e.Plural("cat")
+	e.Plural("dog")
+	e.Plural("cat") // dog is now least recently used
+	e.Plural("cow") // evicts dog
+	e.Plural("cat")
+	e.Plural("dog")
+
+	assert.Equal(t, inflect.CacheStats{Hits: 2, Misses: 4, Evictions: 2, Size: 2, Capacity: 2}, e.GetCacheStats())
+}
+
+func TestCacheSettings(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(e *inflect.Engine)
+		got    func(e *inflect.Engine) string
+		before string
+		after  string
+	}{
+		{
+			name:   "classical",
+			change: func(e *inflect.Engine) { e.Classical(true) },
+			got:    func(e *inflect.Engine) string { return e.Plural("formula") },
+			before: "formulas",
+			after:  "formulae",
+		},
+		{
+			name:   "num",
+			change: func(e *inflect.Engine) { e.Num(1) },
+			got:    func(e *inflect.Engine) string { return e.Plural("cat") },
+			before: "cats",
+			after:  "cat",
+		},
+		{
+			name:   "proper names",
+			change: func(e *inflect.Engine) { e.SetProperNameDetection(inflect.ProperNameOff) },
+			got:    func(e *inflect.Engine) string { return e.Plural("Mary") },
+			before: "Marys",
+			after:  "Maries",
+		},
+		{
+			name:   "DefNoun",
+			change: func(e *inflect.Engine) { e.DefNoun("regex", "regexen") },
+			got:    func(e *inflect.Engine) string { return e.Plural("regex") },
+			before: "regexes",
+			after:  "regexen",
+		},
+		{
+			name:   "DefAn",
+			change: func(e *inflect.Engine) { e.DefAn("ewe") },
+			got:    func(e *inflect.Engine) string { return e.An("ewe") },
+			before: "a ewe",
+			after:  "an ewe",
+		},
+		{
+			name:   "AddAcronym",
+			change: func(e *inflect.Engine) { e.AddAcronym("NPU") },
+			got:    func(e *inflect.Engine) string { return e.Plural("NPU") },
+			before: "NPUS",
+			after:  "NPUs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.EnableCache(10)
+			assert.Equal(t, tt.before, tt.got(e))
+			tt.change(e)
+			assert.Equal(t, tt.after, tt.got(e))
+		})
+	}
+}
+
+func TestCacheCloneAndReset(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "regexen", e.Plural("regex"))
+
+	clone := e.Clone()
+	assert.Equal(t, inflect.CacheStats{Capacity: 10}, clone.GetCacheStats())
+	assert.Equal(t, "regexen", clone.Plural("regex"))
+
+	e.Reset()
+	assert.Equal(t, "regexes", e.Plural("regex"))
+	assert.Equal(t, 1, e.GetCacheStats().Size)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(4)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 200 {
+				assert.Equal(t, "children", e.Plural("child"))
+				assert.Equal(t, "an hour", e.An("hour"))
+				if i == 0 && j%50 == 0 {
+					e.DefNoun("regex", "regexen")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := e.GetCacheStats()
+	assert.Equal(t, uint64(3200), stats.Hits+stats.Misses)
+}
@@ -0,0 +1,195 @@
+package inflect
+
+import "strings"
+
+// collectiveNouns maps singular nouns to the collective noun for a group of them.
+var collectiveNouns = map[string]string{
+	"ant":          "colony",
+	"ape":          "shrewdness",
+	"bat":          "colony",
+	"bear":         "sloth",
+	"bee":          "swarm",
+	"bird":         "flock",
+	"buffalo":      "herd",
+	"camel":        "caravan",
+	"cat":          "clowder",
+	"cattle":       "herd",
+	"cow":          "herd",
+	"crow":         "murder",
+	"deer":         "herd",
+	"dog":          "pack",
+	"dolphin":      "pod",
+	"donkey":       "drove",
+	"duck":         "flock",
+	"eagle":        "convocation",
+	"elephant":     "herd",
+	"ferret":       "business",
+	"fish":         "school",
+	"flamingo":     "flamboyance",
+	"fox":          "skulk",
+	"frog":         "army",
+	"giraffe":      "tower",
+	"goat":         "herd",
+	"goose":        "gaggle",
+	"gorilla":      "band",
+	"hippopotamus": "bloat",
+	"horse":        "herd",
+	"hyena":        "cackle",
+	"jellyfish":    "smack",
+	"kangaroo":     "mob",
+	"kitten":       "litter",
+	"lark":         "exaltation",
+	"lion":         "pride",
+	"locust":       "plague",
+	"monkey":       "troop",
+	"mouse":        "mischief",
+	"owl":          "parliament",
+	"parrot":       "pandemonium",
+	"penguin":      "colony",
+	"pig":          "drift",
+	"puppy":        "litter",
+	"rat":          "mischief",
+	"raven":        "unkindness",
+	"rhinoceros":   "crash",
+	"sheep":        "flock",
+	"shark":        "shiver",
+	"ship":         "fleet",
+	"soldier":      "troop",
+	"starling":     "murmuration",
+	"swan":         "bevy",
+	"tiger":        "streak",
+	"toad":         "knot",
+	"turkey":       "rafter",
+	"turtle":       "bale",
+	"whale":        "pod",
+	"wolf":         "pack",
+	"zebra":        "dazzle",
+}
+
+// defaultCollective is the collective noun used for nouns without an entry.
+const defaultCollective = "group"
+
+// CollectiveNoun returns the collective noun for a group of the given noun.
+//
+// The noun may be singular or plural. Nouns without a known collective
+// return "group". Use DefCollective to add or override entries.
+//
+// Examples:
+//   - CollectiveNoun("lion") returns "pride"
+//   - CollectiveNoun("crows") returns "murder"
+//   - CollectiveNoun("owl") returns "parliament"
+//   - CollectiveNoun("Goose") returns "gaggle"
+//   - CollectiveNoun("engineer") returns "group"
+func CollectiveNoun(noun string) string {
+	return defaultEngine.CollectiveNoun(noun)
+}
+
+// CollectiveNoun returns the collective noun for a group of the given noun.
+//
+// The noun may be singular or plural. Nouns without a known collective
+// return "group". Use e.DefCollective to add or override entries.
+//
+// Examples:
+//   - e.CollectiveNoun("lion") returns "pride"
+//   - e.CollectiveNoun("crows") returns "murder"
+//   - e.CollectiveNoun("owl") returns "parliament"
+//   - e.CollectiveNoun("Goose") returns "gaggle"
+//   - e.CollectiveNoun("engineer") returns "group"
+func (e *Engine) CollectiveNoun(noun string) string {
+	lower := strings.ToLower(strings.TrimSpace(noun))
+	if lower == "" {
+		return ""
+	}
+	if collective, ok := e.lookupCollective(lower); ok {
+		return collective
+	}
+	if collective, ok := e.lookupCollective(e.Singular(lower)); ok {
+		return collective
+	}
+	return defaultCollective
+}
+
+// CollectivePhrase returns a phrase naming a group of the given noun, such
+// as "pride of lions".
+//
+// The noun may be singular or plural and keeps its case. Nouns without a
+// known collective use "group".
+//
+// Examples:
+//   - CollectivePhrase("lion") returns "pride of lions"
+//   - CollectivePhrase("crows") returns "murder of crows"
+//   - CollectivePhrase("goose") returns "gaggle of geese"
+//   - CollectivePhrase("engineer") returns "group of engineers"
+func CollectivePhrase(noun string) string {
+	return defaultEngine.CollectivePhrase(noun)
+}
+
+// CollectivePhrase returns a phrase naming a group of the given noun, such
+// as "pride of lions".
+//
+// The noun may be singular or plural and keeps its case. Nouns without a
+// known collective use "group".
+//
+// Examples:
+//   - e.CollectivePhrase("lion") returns "pride of lions"
+//   - e.CollectivePhrase("crows") returns "murder of crows"
+//   - e.CollectivePhrase("goose") returns "gaggle of geese"
+//   - e.CollectivePhrase("engineer") returns "group of engineers"
+func (e *Engine) CollectivePhrase(noun string) string {
+	collective := e.CollectiveNoun(noun)
+	if collective == "" {
+		return ""
+	}
+	plural := strings.TrimSpace(noun)
+	if e.Singular(plural) == plural {
+		plural = e.plural(plural)
+	}
+	return collective + " of " + plural
+}
+
+// DefCollective defines the collective noun for a group of the given noun.
+//
+// The noun should be singular; both words are stored in lowercase.
+// Custom definitions take precedence over the built-in table.
+//
+// Examples:
+//
+//	DefCollective("developer", "standup")
+//	CollectiveNoun("developers")   // returns "standup"
+//	CollectivePhrase("developer")  // returns "standup of developers"
+func DefCollective(noun, collective string) {
+	defaultEngine.DefCollective(noun, collective)
+}
+
+// DefCollective defines the collective noun for a group of the given noun.
+//
+// The noun should be singular; both words are stored in lowercase.
+// Custom definitions take precedence over the built-in table.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefCollective("developer", "standup")
+//	e.CollectiveNoun("developers")   // returns "standup"
+//	e.CollectivePhrase("developer")  // returns "standup of developers"
+func (e *Engine) DefCollective(noun, collective string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.customCollectives == nil {
+		e.customCollectives = make(map[string]string)
+	}
+	e.customCollectives[strings.ToLower(noun)] = strings.ToLower(collective)
+}
+
+// lookupCollective returns the collective noun for a lowercase singular noun,
+// checking custom definitions before the built-in table.
+func (e *Engine) lookupCollective(lower string) (string, bool) {
+	e.mu.RLock()
+	collective, ok := e.customCollectives[lower]
+	e.mu.RUnlock()
+	if ok {
+		return collective, true
+	}
+	collective, ok = collectiveNouns[lower]
+	return collective, ok
+}
@@ -0,0 +1,74 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestCollectiveNoun(t *testing.T) {
+	tests := []struct {
+		name string
+		noun string
+		want string
+	}{
+		{name: "lion", noun: "lion", want: "pride"},
+		{name: "crow", noun: "crow", want: "murder"},
+		{name: "plural input", noun: "crows", want: "murder"},
+		{name: "irregular plural input", noun: "geese", want: "gaggle"},
+		{name: "uppercase", noun: "Goose", want: "gaggle"},
+		{name: "whitespace", noun: "  owl ", want: "parliament"},
+		{name: "unchanged plural", noun: "sheep", want: "flock"},
+		{name: "unknown", noun: "engineer", want: "group"},
+		{name: "empty", noun: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CollectiveNoun(tt.noun))
+		})
+	}
+}
+
+func TestCollectivePhrase(t *testing.T) {
+	tests := []struct {
+		name string
+		noun string
+		want string
+	}{
+		{name: "lion", noun: "lion", want: "pride of lions"},
+		{name: "plural input", noun: "crows", want: "murder of crows"},
+		{name: "irregular plural", noun: "goose", want: "gaggle of geese"},
+		{name: "irregular plural input", noun: "mice", want: "mischief of mice"},
+		{name: "case preserved", noun: "Wolf", want: "pack of Wolves"},
+		{name: "unknown", noun: "engineer", want: "group of engineers"},
+		{name: "empty", noun: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CollectivePhrase(tt.noun))
+		})
+	}
+}
+
+func TestDefCollective(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefCollective("Developer", "Standup")
+	e.DefCollective("lion", "sawt")
+
+	assert.Equal(t, "standup", e.CollectiveNoun("developers"))
+	assert.Equal(t, "standup of developers", e.CollectivePhrase("developer"))
+	assert.Equal(t, "sawt", e.CollectiveNoun("lion"))
+
+	// Other engines are unaffected
+	assert.Equal(t, "group", inflect.CollectiveNoun("developer"))
+	assert.Equal(t, "pride", inflect.CollectiveNoun("lion"))
+
+	clone := e.Clone()
+	e.Reset()
+	assert.Equal(t, "group", e.CollectiveNoun("developer"))
+	assert.Equal(t, "standup", clone.CollectiveNoun("developer"))
+}
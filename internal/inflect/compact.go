@@ -0,0 +1,130 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// compactScale describes a magnitude used for compact number formatting.
+type compactScale struct {
+	value  float64
+	suffix string
+	word   string
+}
+
+// compactScales lists the supported magnitudes from smallest to largest.
+var compactScales = []compactScale{
+	{1e3, "K", "thousand"},
+	{1e6, "M", "million"},
+	{1e9, "B", "billion"},
+	{1e12, "T", "trillion"},
+}
+
+// CompactNumber formats an integer in a short human-readable form using
+// K, M, B, and T suffixes, rounded to one decimal place.
+//
+// Trailing zeros after the decimal point are dropped, and numbers below
+// 1,000 are returned unchanged.
+//
+// Examples:
+//   - CompactNumber(999) returns "999"
+//   - CompactNumber(1000) returns "1K"
+//   - CompactNumber(1234) returns "1.2K"
+//   - CompactNumber(3400000) returns "3.4M"
+//   - CompactNumber(999999) returns "1M"
+//   - CompactNumber(-1500) returns "-1.5K"
+func CompactNumber(n int) string {
+	return compactNumber(n, 1, false)
+}
+
+// CompactNumberWithPrecision formats an integer in a short human-readable
+// form using K, M, B, and T suffixes, rounded to the given number of decimal
+// places. A negative precision is treated as zero.
+//
+// Examples:
+//   - CompactNumberWithPrecision(1234, 0) returns "1K"
+//   - CompactNumberWithPrecision(1234, 2) returns "1.23K"
+//   - CompactNumberWithPrecision(1234567, 3) returns "1.235M"
+//   - CompactNumberWithPrecision(1500000, 2) returns "1.5M"
+func CompactNumberWithPrecision(n, precision int) string {
+	return compactNumber(n, precision, false)
+}
+
+// CompactNumberWords formats an integer in a short human-readable form using
+// scale words (thousand, million, billion, trillion), rounded to one decimal
+// place.
+//
+// Examples:
+//   - CompactNumberWords(999) returns "999"
+//   - CompactNumberWords(1234) returns "1.2 thousand"
+//   - CompactNumberWords(1234567) returns "1.2 million"
+//   - CompactNumberWords(2000000000) returns "2 billion"
+//   - CompactNumberWords(-3400000) returns "-3.4 million"
+func CompactNumberWords(n int) string {
+	return compactNumber(n, 1, true)
+}
+
+// CompactNumberWordsWithPrecision formats an integer in a short human-readable
+// form using scale words, rounded to the given number of decimal places.
+// A negative precision is treated as zero.
+//
+// Examples:
+//   - CompactNumberWordsWithPrecision(1234567, 0) returns "1 million"
+//   - CompactNumberWordsWithPrecision(1234567, 2) returns "1.23 million"
+//   - CompactNumberWordsWithPrecision(1250000000, 2) returns "1.25 billion"
+func CompactNumberWordsWithPrecision(n, precision int) string {
+	return compactNumber(n, precision, true)
+}
+
+// compactNumber is the shared implementation for the CompactNumber family.
+func compactNumber(n, precision int, words bool) string {
+	precision = max(precision, 0)
+
+	f := float64(n)
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	if f < compactScales[0].value {
+		return strconv.Itoa(n)
+	}
+
+	// Pick the largest scale that fits, then promote to the next scale if
+	// rounding pushes the value to 1000 (e.g., 999,999 -> "1M", not "1000K").
+	idx := 0
+	for i, s := range compactScales {
+		if f >= s.value {
+			idx = i
+		}
+	}
+	scaled := roundToPrecision(f/compactScales[idx].value, precision)
+	if scaled >= 1000 && idx < len(compactScales)-1 {
+		idx++
+		scaled = roundToPrecision(f/compactScales[idx].value, precision)
+	}
+
+	digits := trimDecimalZeros(strconv.FormatFloat(scaled, 'f', precision, 64))
+	if words {
+		return sign + digits + " " + compactScales[idx].word
+	}
+	return sign + digits + compactScales[idx].suffix
+}
+
+// roundToPrecision rounds f to the given number of decimal places.
+func roundToPrecision(f float64, precision int) float64 {
+	pow := math.Pow(10, float64(precision))
+	return math.Round(f*pow) / pow
+}
+
+// trimDecimalZeros removes trailing zeros after a decimal point, and the
+// decimal point itself if nothing remains after it.
+func trimDecimalZeros(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
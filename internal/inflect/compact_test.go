@@ -0,0 +1,121 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestCompactNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "zero", input: 0, want: "0"},
+		{name: "below thousand", input: 999, want: "999"},
+		{name: "exact thousand", input: 1000, want: "1K"},
+		{name: "thousands", input: 1234, want: "1.2K"},
+		{name: "thousands round up", input: 1250, want: "1.3K"},
+		{name: "tens of thousands", input: 45600, want: "45.6K"},
+		{name: "hundreds of thousands", input: 999000, want: "999K"},
+		{name: "promotes to millions", input: 999999, want: "1M"},
+		{name: "millions", input: 3400000, want: "3.4M"},
+		{name: "billions", input: 2500000000, want: "2.5B"},
+		{name: "trillions", input: 7100000000000, want: "7.1T"},
+		{name: "beyond trillions", input: 1234000000000000, want: "1234T"},
+		{name: "negative", input: -1500, want: "-1.5K"},
+		{name: "negative small", input: -42, want: "-42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CompactNumber(tt.input))
+		})
+	}
+}
+
+func TestCompactNumberWithPrecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     int
+		precision int
+		want      string
+	}{
+		{name: "zero precision", input: 1234, precision: 0, want: "1K"},
+		{name: "two places", input: 1234, precision: 2, want: "1.23K"},
+		{name: "three places", input: 1234567, precision: 3, want: "1.235M"},
+		{name: "trailing zeros trimmed", input: 1500000, precision: 2, want: "1.5M"},
+		{name: "negative precision", input: 1999, precision: -1, want: "2K"},
+		{name: "promotion with precision", input: 999950, precision: 1, want: "1M"},
+		{name: "no promotion with more precision", input: 999950, precision: 2, want: "999.95K"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CompactNumberWithPrecision(tt.input, tt.precision))
+		})
+	}
+}
+
+func TestCompactNumberWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "below thousand", input: 999, want: "999"},
+		{name: "thousands", input: 1234, want: "1.2 thousand"},
+		{name: "millions", input: 1234567, want: "1.2 million"},
+		{name: "exact billions", input: 2000000000, want: "2 billion"},
+		{name: "trillions", input: 4500000000000, want: "4.5 trillion"},
+		{name: "negative", input: -3400000, want: "-3.4 million"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CompactNumberWords(tt.input))
+		})
+	}
+}
+
+func TestCompactNumberWordsWithPrecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     int
+		precision int
+		want      string
+	}{
+		{name: "zero precision", input: 1234567, precision: 0, want: "1 million"},
+		{name: "two places", input: 1234567, precision: 2, want: "1.23 million"},
+		{name: "billions", input: 1250000000, precision: 2, want: "1.25 billion"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CompactNumberWordsWithPrecision(tt.input, tt.precision))
+		})
+	}
+}
+
+func BenchmarkCompactNumber(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		input int
+	}{
+		{"small", 999},
+		{"thousands", 1234},
+		{"millions", 1234567},
+		{"promoted", 999999},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for range b.N {
+				inflect.CompactNumber(bm.input)
+			}
+		})
+	}
+}
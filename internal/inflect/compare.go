@@ -1,29 +1,79 @@
 package inflect
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
+
+// Relation is how two words compared with Compare are related. Its values
+// are the strings Compare returns, so a Relation can be compared with them
+// directly: Relation(Compare("cat", "cats")) == RelSingularPlural.
+type Relation string
 
-// Compare result constants.
 const (
-	compareEq           = "eq"
-	compareSingToPlural = "s:p"
-	comparePluralToSing = "p:s"
-	comparePluralPlural = "p:p"
+	// RelNone means the words are not related.
+	// Example: "cat", "dog"
+	RelNone Relation = ""
+
+	// RelEqual means the words are equal, ignoring case.
+	// Example: "cat", "Cat"
+	RelEqual Relation = "eq"
+
+	// RelSingularPlural means the first word is singular and the second
+	// is its plural.
+	// Example: "cat", "cats"
+	RelSingularPlural Relation = "s:p"
+
+	// RelPluralSingular means the first word is plural and the second is
+	// its singular.
+	// Example: "cats", "cat"
+	RelPluralSingular Relation = "p:s"
+
+	// RelPluralPlural means the words are different plurals of the same
+	// word.
+	// Example: "indexes", "indices"
+	RelPluralPlural Relation = "p:p"
 )
 
+// CompareResult is the result of CompareDetailed: how two words are
+// related, and the singular they share.
+type CompareResult struct {
+	// Relation is how the words are related, as returned by Compare.
+	Relation Relation
+
+	// Base is the singular both words are forms of, in the case it is
+	// written in the input, or "" if the words are not related. Equal words
+	// may be singular or plural, so Base is the first word as given.
+	Base string
+}
+
+// String returns the relation as the string Compare returns.
+func (r CompareResult) String() string {
+	return string(r.Relation)
+}
+
 // Compare compares two words for singular/plural equality.
 //
 // It returns:
-//   - "eq" if the words are equal (case-insensitive)
-//   - "s:p" if word1 is singular and word2 is its plural form
-//   - "p:s" if word1 is plural and word2 is its singular form
-//   - "p:p" if both words are different plural forms of the same word
-//   - "" if the words are not related
+//   - "eq" (RelEqual) if the words are equal (case-insensitive)
+//   - "s:p" (RelSingularPlural) if word1 is singular and word2 is its plural form
+//   - "p:s" (RelPluralSingular) if word1 is plural and word2 is its singular form
+//   - "p:p" (RelPluralPlural) if both words are different plural forms of the same word
+//   - "" (RelNone) if the words are not related
+//
+// Both the modern and the classical plurals count, whatever the classical
+// mode, as do plurals defined with DefNoun and DefNounMulti, so that
+// "formula" and "formulae" are related even when Plural("formula") returns
+// "formulas". CompareDetailed returns the Relation together with the
+// shared singular.
 //
 // Examples:
 //   - Compare("cat", "cat") returns "eq"
 //   - Compare("cat", "cats") returns "s:p"
 //   - Compare("cats", "cat") returns "p:s"
 //   - Compare("indexes", "indices") returns "p:p"
+//   - Compare("formula", "formulae") returns "s:p"
+//   - Compare("formulas", "formulae") returns "p:p"
 //   - Compare("cat", "dog") returns ""
 func Compare(word1, word2 string) string {
 	return defaultEngine.Compare(word1, word2)
@@ -32,11 +82,17 @@ func Compare(word1, word2 string) string {
 // Compare compares two words for singular/plural equality using this engine's settings.
 //
 // It returns:
-//   - "eq" if the words are equal (case-insensitive)
-//   - "s:p" if word1 is singular and word2 is its plural form
-//   - "p:s" if word1 is plural and word2 is its singular form
-//   - "p:p" if both words are different plural forms of the same word
-//   - "" if the words are not related
+//   - "eq" (RelEqual) if the words are equal (case-insensitive)
+//   - "s:p" (RelSingularPlural) if word1 is singular and word2 is its plural form
+//   - "p:s" (RelPluralSingular) if word1 is plural and word2 is its singular form
+//   - "p:p" (RelPluralPlural) if both words are different plural forms of the same word
+//   - "" (RelNone) if the words are not related
+//
+// Both the modern and the classical plurals count, whatever the classical
+// mode, as do plurals defined with DefNoun and DefNounMulti, so that
+// "formula" and "formulae" are related even when Plural("formula") returns
+// "formulas". CompareDetailed returns the Relation together with the
+// shared singular.
 //
 // Examples:
 //   - e.Compare("cat", "cat") returns "eq"
@@ -45,12 +101,35 @@ func Compare(word1, word2 string) string {
 //   - e.Compare("indexes", "indices") returns "p:p"
 //   - e.Compare("cat", "dog") returns ""
 func (e *Engine) Compare(word1, word2 string) string {
+	return e.CompareDetailed(word1, word2).String()
+}
+
+// CompareDetailed compares two nouns as Compare does, and also returns the
+// singular they are forms of.
+//
+// Examples:
+//   - CompareDetailed("cat", "cats") returns {RelSingularPlural, "cat"}
+//   - CompareDetailed("Mice", "mouse") returns {RelPluralSingular, "mouse"}
+//   - CompareDetailed("indexes", "indices") returns {RelPluralPlural, "index"}
+//   - CompareDetailed("cat", "Cat") returns {RelEqual, "cat"}
+//   - CompareDetailed("cat", "dog") returns {RelNone, ""}
+func CompareDetailed(word1, word2 string) CompareResult {
+	return defaultEngine.CompareDetailed(word1, word2)
+}
+
+// CompareDetailed compares two nouns as e.Compare does, and also returns the
+// singular they are forms of. See the package-level CompareDetailed for
+// details.
+//
+// Examples:
+//   - e.CompareDetailed("cat", "cats") returns {RelSingularPlural, "cat"}
+func (e *Engine) CompareDetailed(word1, word2 string) CompareResult {
 	// Handle empty strings
 	if word1 == "" || word2 == "" {
 		if word1 == "" && word2 == "" {
-			return compareEq
+			return CompareResult{Relation: RelEqual}
 		}
-		return ""
+		return CompareResult{}
 	}
 
 	// Normalize for comparison
@@ -59,39 +138,79 @@ func (e *Engine) Compare(word1, word2 string) string {
 
 	// Same word
 	if lower1 == lower2 {
-		return compareEq
+		return CompareResult{Relation: RelEqual, Base: word1}
 	}
 
 	// Check if word1 is singular and word2 is its plural
 	// Use Plural() for verification since Singular() has edge cases
-	if strings.ToLower(e.Plural(word1)) == lower2 {
-		return compareSingToPlural
+	if slices.Contains(e.pluralVariants(word1), lower2) {
+		return CompareResult{Relation: RelSingularPlural, Base: word1}
 	}
 
 	// Check if word2 is singular and word1 is its plural
-	if strings.ToLower(e.Plural(word2)) == lower1 {
-		return comparePluralToSing
+	if slices.Contains(e.pluralVariants(word2), lower1) {
+		return CompareResult{Relation: RelPluralSingular, Base: word2}
 	}
 
 	// Check if both are different plural forms of the same singular word
-	singular1 := strings.ToLower(e.Singular(word1))
+	base := e.Singular(word1)
+	singular1 := strings.ToLower(base)
 	singular2 := strings.ToLower(e.Singular(word2))
 
 	if singular1 == singular2 {
 		// Verify both are actually plurals (different from their singular form)
 		// by checking that pluralizing the singular gives us something related
-		pluralOfSingular := strings.ToLower(e.Plural(singular1))
+		plurals := e.pluralVariants(singular1)
 		// If both words singularize to the same thing, and that singular
 		// can be pluralized, they're both plural forms
 		if lower1 != singular1 && lower2 != singular2 {
 			// Additional verification: ensure the singular is valid
-			if pluralOfSingular == lower1 || pluralOfSingular == lower2 {
-				return comparePluralPlural
+			if slices.Contains(plurals, lower1) || slices.Contains(plurals, lower2) {
+				return CompareResult{Relation: RelPluralPlural, Base: base}
 			}
 		}
 	}
 
-	return ""
+	return CompareResult{}
+}
+
+// pluralVariants returns the lowercase plurals of a noun in any mode: the
+// plural e.Plural returns, the modern and classical plurals of nouns whose
+// plural depends on classical or technical mode, and plurals defined with
+// DefNoun and DefNounMulti.
+func (e *Engine) pluralVariants(word string) []string {
+	lower := strings.ToLower(word)
+	variants := []string{strings.ToLower(e.plural(word))}
+
+	e.mu.RLock()
+	irregular, isIrregular := e.irregularPlurals[lower]
+	classicalNoun, isClassicalNoun := e.classicalNouns[lower]
+	herd := e.isHerdLocked(lower)
+	e.mu.RUnlock()
+
+	if isIrregular {
+		variants = append(variants, irregular)
+	}
+	if isClassicalNoun {
+		variants = append(variants, classicalNoun)
+	}
+	if plural, ok := technicalPlurals[lower]; ok {
+		variants = append(variants, plural)
+	}
+	if lower == "person" {
+		variants = append(variants, "persons")
+	}
+	if classical, ok := classicalLatinPlurals[lower]; ok {
+		variants = append(variants, classical)
+		if !isIrregular {
+			// The modern plural: formula -> formulas
+			variants = append(variants, strings.ToLower(e.applySuffixRules(word, lower, false, nil)))
+		}
+	}
+	if herd {
+		variants = append(variants, lower, strings.ToLower(e.applySuffixRules(word, lower, false, nil)))
+	}
+	return variants
 }
 
 // CompareNouns compares two nouns for singular/plural equality.
@@ -170,7 +289,7 @@ func (e *Engine) CompareVerbs(verb1, verb2 string) string {
 	// Handle empty strings
 	if verb1 == "" || verb2 == "" {
 		if verb1 == "" && verb2 == "" {
-			return compareEq
+			return string(RelEqual)
 		}
 		return ""
 	}
@@ -181,18 +300,18 @@ func (e *Engine) CompareVerbs(verb1, verb2 string) string {
 
 	// Same verb
 	if lower1 == lower2 {
-		return compareEq
+		return string(RelEqual)
 	}
 
 	// Check if verb1 is singular (3rd person) and verb2 is its plural (base form)
 	// PluralVerb converts 3rd person singular to base form
 	if strings.ToLower(e.PluralVerb(verb1)) == lower2 {
-		return compareSingToPlural
+		return string(RelSingularPlural)
 	}
 
 	// Check if verb2 is singular and verb1 is its plural
 	if strings.ToLower(e.PluralVerb(verb2)) == lower1 {
-		return comparePluralToSing
+		return string(RelPluralSingular)
 	}
 
 	return ""
@@ -236,7 +355,7 @@ func (e *Engine) CompareAdjs(adj1, adj2 string) string {
 	// Handle empty strings
 	if adj1 == "" || adj2 == "" {
 		if adj1 == "" && adj2 == "" {
-			return compareEq
+			return string(RelEqual)
 		}
 		return ""
 	}
@@ -247,17 +366,17 @@ func (e *Engine) CompareAdjs(adj1, adj2 string) string {
 
 	// Same adjective
 	if lower1 == lower2 {
-		return compareEq
+		return string(RelEqual)
 	}
 
 	// Check if adj1 is singular and adj2 is its plural form
 	if strings.ToLower(e.PluralAdj(adj1)) == lower2 {
-		return compareSingToPlural
+		return string(RelSingularPlural)
 	}
 
 	// Check if adj2 is singular and adj1 is its plural form
 	if strings.ToLower(e.PluralAdj(adj2)) == lower1 {
-		return comparePluralToSing
+		return string(RelPluralSingular)
 	}
 
 	return ""
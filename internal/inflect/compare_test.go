@@ -79,6 +79,91 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+func TestCompareVariants(t *testing.T) {
+	tests := []struct {
+		name      string
+		word1     string
+		word2     string
+		classical bool
+		want      string
+	}{
+		{name: "classical plural in modern mode", word1: "formula", word2: "formulae", want: "s:p"},
+		{name: "classical plural reversed", word1: "Formulae", word2: "formula", want: "p:s"},
+		{name: "modern plural in modern mode", word1: "formula", word2: "formulas", want: "s:p"},
+		{name: "modern plural in classical mode", word1: "formula", word2: "formulas", classical: true, want: "s:p"},
+		{name: "classical plural in classical mode", word1: "formula", word2: "formulae", classical: true, want: "s:p"},
+		{name: "modern and classical plurals", word1: "formulas", word2: "formulae", want: "p:p"},
+		{name: "persons", word1: "person", word2: "persons", want: "s:p"},
+		{name: "people", word1: "person", word2: "people", classical: true, want: "s:p"},
+		{name: "herd unchanged", word1: "wildebeest", word2: "wildebeests", want: "s:p"},
+		{name: "herd modern in classical mode", word1: "wildebeest", word2: "wildebeests", classical: true, want: "s:p"},
+		{name: "technical plural", word1: "index", word2: "indexes", want: "s:p"},
+		{name: "regularized irregular is not related", word1: "child", word2: "childs", want: ""},
+		{name: "unrelated", word1: "formula", word2: "formulary", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.Classical(tt.classical)
+			assert.Equal(t, tt.want, e.Compare(tt.word1, tt.word2))
+		})
+	}
+}
+
+func TestCompareCustomNouns(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNounMulti("virus", "viruses", "viri")
+	e.DefNoun("gizmo", "gizmata")
+
+	assert.Equal(t, "s:p", e.Compare("virus", "viri"))
+	assert.Equal(t, "s:p", e.Compare("virus", "viruses"))
+	assert.Equal(t, "p:s", e.Compare("viri", "virus"))
+	assert.Equal(t, "p:p", e.Compare("viri", "viruses"))
+	assert.Equal(t, "s:p", e.Compare("gizmo", "gizmata"))
+
+	e.Classical(true)
+	assert.Equal(t, "s:p", e.Compare("virus", "viruses"))
+	assert.Equal(t, "s:p", e.Compare("virus", "viri"))
+}
+
+func TestCompareDetailed(t *testing.T) {
+	tests := []struct {
+		name  string
+		word1 string
+		word2 string
+		want  inflect.CompareResult
+	}{
+		{name: "equal", word1: "cat", word2: "Cat", want: inflect.CompareResult{Relation: inflect.RelEqual, Base: "cat"}},
+		{name: "equal plurals", word1: "Cats", word2: "cats", want: inflect.CompareResult{Relation: inflect.RelEqual, Base: "Cats"}},
+		{name: "equal bus", word1: "bus", word2: "bus", want: inflect.CompareResult{Relation: inflect.RelEqual, Base: "bus"}},
+		{name: "both empty", word1: "", word2: "", want: inflect.CompareResult{Relation: inflect.RelEqual}},
+		{name: "singular plural", word1: "cat", word2: "cats", want: inflect.CompareResult{Relation: inflect.RelSingularPlural, Base: "cat"}},
+		{name: "plural singular", word1: "Mice", word2: "mouse", want: inflect.CompareResult{Relation: inflect.RelPluralSingular, Base: "mouse"}},
+		{name: "plural plural", word1: "indexes", word2: "indices", want: inflect.CompareResult{Relation: inflect.RelPluralPlural, Base: "index"}},
+		{name: "unrelated", word1: "cat", word2: "dog", want: inflect.CompareResult{Relation: inflect.RelNone}},
+		{name: "empty", word1: "cat", word2: "", want: inflect.CompareResult{Relation: inflect.RelNone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.CompareDetailed(tt.word1, tt.word2)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, inflect.Compare(tt.word1, tt.word2), got.String())
+		})
+	}
+}
+
+func TestRelationStrings(t *testing.T) {
+	assert.Equal(t, "", string(inflect.RelNone))
+	assert.Equal(t, "eq", string(inflect.RelEqual))
+	assert.Equal(t, "s:p", string(inflect.RelSingularPlural))
+	assert.Equal(t, "p:s", string(inflect.RelPluralSingular))
+	assert.Equal(t, "p:p", string(inflect.RelPluralPlural))
+	assert.Equal(t, inflect.RelSingularPlural, inflect.Relation(inflect.CompareVerbs("runs", "run")))
+	assert.Equal(t, inflect.RelPluralSingular, inflect.Relation(inflect.CompareAdjs("these", "this")))
+}
+
 func TestCompareNouns(t *testing.T) {
 	// CompareNouns is an alias for Compare, so we just verify it behaves the same
 	tests := []struct {
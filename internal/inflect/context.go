@@ -0,0 +1,37 @@
+package inflect
+
+import "context"
+
+// engineContextKey is the context key under which WithEngine stores an Engine.
+type engineContextKey struct{}
+
+// WithEngine returns a copy of ctx that carries the given Engine.
+//
+// This lets per-request settings such as the default count, gender, and
+// classical mode flow through call chains (for example, HTTP handlers)
+// without modifying the default engine. The Ctx variants of the package
+// functions, such as PluralCtx and AnCtx, use the Engine carried by ctx.
+//
+// Example:
+//
+//	e := NewEngine()
+//	e.SetGender("f")
+//	ctx := WithEngine(r.Context(), e)
+//	SingularNounCtx(ctx, "they") // returns "she"
+func WithEngine(ctx context.Context, e *Engine) context.Context {
+	return context.WithValue(ctx, engineContextKey{}, e)
+}
+
+// EngineFromContext returns the Engine carried by ctx, or the default engine
+// used by the package-level functions if ctx does not carry one.
+//
+// Example:
+//
+//	EngineFromContext(context.Background()) // returns DefaultEngine()
+//	EngineFromContext(WithEngine(ctx, e))   // returns e
+func EngineFromContext(ctx context.Context) *Engine {
+	if e, ok := ctx.Value(engineContextKey{}).(*Engine); ok && e != nil {
+		return e
+	}
+	return defaultEngine
+}
@@ -0,0 +1,497 @@
+// Code generated by gen-context. DO NOT EDIT.
+
+package inflect
+
+import "context"
+
+// ACtx is like A but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ACtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).A(word)
+}
+
+// ANumberOfCtx is like ANumberOf but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ANumberOfCtx(ctx context.Context, noun string, verb string) string {
+	return EngineFromContext(ctx).ANumberOf(noun, verb)
+}
+
+// AgreeCompoundCtx is like AgreeCompound but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeCompoundCtx(ctx context.Context, subjects []string, verb string) string {
+	return EngineFromContext(ctx).AgreeCompound(subjects, verb)
+}
+
+// AgreeCompoundWithConjCtx is like AgreeCompoundWithConj but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeCompoundWithConjCtx(ctx context.Context, subjects []string, conj CompoundConj, verb string) string {
+	return EngineFromContext(ctx).AgreeCompoundWithConj(subjects, conj, verb)
+}
+
+// AgreeVerbCtx is like AgreeVerb but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AgreeVerbCtx(ctx context.Context, count int, verb string) string {
+	return EngineFromContext(ctx).AgreeVerb(count, verb)
+}
+
+// AmountPhraseCtx is like AmountPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AmountPhraseCtx(ctx context.Context, n int, noun string) string {
+	return EngineFromContext(ctx).AmountPhrase(n, noun)
+}
+
+// AnCtx is like An but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).An(word)
+}
+
+// AnCapitalizedCtx is like AnCapitalized but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCapitalizedCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).AnCapitalized(word)
+}
+
+// AnCountCtx is like AnCount but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnCountCtx(ctx context.Context, word string, count int) string {
+	return EngineFromContext(ctx).AnCount(word, count)
+}
+
+// AnInSentenceCtx is like AnInSentence but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func AnInSentenceCtx(ctx context.Context, text string) string {
+	return EngineFromContext(ctx).AnInSentence(text)
+}
+
+// ArticleForCtx is like ArticleFor but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ArticleForCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).ArticleFor(word)
+}
+
+// CollectiveNounCtx is like CollectiveNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CollectiveNounCtx(ctx context.Context, noun string) string {
+	return EngineFromContext(ctx).CollectiveNoun(noun)
+}
+
+// CollectivePhraseCtx is like CollectivePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CollectivePhraseCtx(ctx context.Context, noun string) string {
+	return EngineFromContext(ctx).CollectivePhrase(noun)
+}
+
+// CompareCtx is like Compare but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareCtx(ctx context.Context, word1 string, word2 string) string {
+	return EngineFromContext(ctx).Compare(word1, word2)
+}
+
+// CompareAdjsCtx is like CompareAdjs but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareAdjsCtx(ctx context.Context, adj1 string, adj2 string) string {
+	return EngineFromContext(ctx).CompareAdjs(adj1, adj2)
+}
+
+// CompareNounsCtx is like CompareNouns but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareNounsCtx(ctx context.Context, noun1 string, noun2 string) string {
+	return EngineFromContext(ctx).CompareNouns(noun1, noun2)
+}
+
+// CompareVerbsCtx is like CompareVerbs but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func CompareVerbsCtx(ctx context.Context, verb1 string, verb2 string) string {
+	return EngineFromContext(ctx).CompareVerbs(verb1, verb2)
+}
+
+// ConnectionFieldNameCtx is like ConnectionFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ConnectionFieldNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).ConnectionFieldName(typeName)
+}
+
+// ConnectionNameCtx is like ConnectionName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ConnectionNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).ConnectionName(typeName)
+}
+
+// DiminutiveCtx is like Diminutive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func DiminutiveCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Diminutive(word)
+}
+
+// EdgeNameCtx is like EdgeName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func EdgeNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).EdgeName(typeName)
+}
+
+// EnumValueNameCtx is like EnumValueName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func EnumValueNameCtx(ctx context.Context, enumName string, value string) string {
+	return EngineFromContext(ctx).EnumValueName(enumName, value)
+}
+
+// GoCamelCaseCtx is like GoCamelCase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func GoCamelCaseCtx(ctx context.Context, s string) string {
+	return EngineFromContext(ctx).GoCamelCase(s)
+}
+
+// GoPascalCaseCtx is like GoPascalCase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func GoPascalCaseCtx(ctx context.Context, s string) string {
+	return EngineFromContext(ctx).GoPascalCase(s)
+}
+
+// HumanizeCtx is like Humanize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func HumanizeCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Humanize(word)
+}
+
+// InflectCtx is like Inflect but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectCtx(ctx context.Context, text string) string {
+	return EngineFromContext(ctx).Inflect(text)
+}
+
+// InflectDocumentCtx is like InflectDocument but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectDocumentCtx(ctx context.Context, text string, rule func(token Token) Transform) string {
+	return EngineFromContext(ctx).InflectDocument(text, rule)
+}
+
+// InflectHTMLCtx is like InflectHTML but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectHTMLCtx(ctx context.Context, text string) string {
+	return EngineFromContext(ctx).InflectHTML(text)
+}
+
+// InflectMarkdownCtx is like InflectMarkdown but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectMarkdownCtx(ctx context.Context, text string) string {
+	return EngineFromContext(ctx).InflectMarkdown(text)
+}
+
+// InflectfCtx is like Inflectf but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func InflectfCtx(ctx context.Context, format string, args ...any) string {
+	return EngineFromContext(ctx).Inflectf(format, args...)
+}
+
+// IntToRomanCtx is like IntToRoman but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func IntToRomanCtx(ctx context.Context, n int) string {
+	return EngineFromContext(ctx).IntToRoman(n)
+}
+
+// JoinPossessiveCtx is like JoinPossessive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinPossessiveCtx(ctx context.Context, names []string, noun string) string {
+	return EngineFromContext(ctx).JoinPossessive(names, noun)
+}
+
+// JoinPossessiveSharedCtx is like JoinPossessiveShared but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinPossessiveSharedCtx(ctx context.Context, names []string, noun string) string {
+	return EngineFromContext(ctx).JoinPossessiveShared(names, noun)
+}
+
+// JoinTableNameCtx is like JoinTableName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func JoinTableNameCtx(ctx context.Context, a string, b string) string {
+	return EngineFromContext(ctx).JoinTableName(a, b)
+}
+
+// LemmaCtx is like Lemma but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func LemmaCtx(ctx context.Context, word string, pos PartOfSpeech) string {
+	return EngineFromContext(ctx).Lemma(word, pos)
+}
+
+// MessageNamePluralCtx is like MessageNamePlural but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MessageNamePluralCtx(ctx context.Context, name string) string {
+	return EngineFromContext(ctx).MessageNamePlural(name)
+}
+
+// MessageNameSingularCtx is like MessageNameSingular but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MessageNameSingularCtx(ctx context.Context, name string) string {
+	return EngineFromContext(ctx).MessageNameSingular(name)
+}
+
+// MutationFieldNameCtx is like MutationFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func MutationFieldNameCtx(ctx context.Context, action string, typeName string) string {
+	return EngineFromContext(ctx).MutationFieldName(action, typeName)
+}
+
+// NoCtx is like No but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoCtx(ctx context.Context, word string, count int) string {
+	return EngineFromContext(ctx).No(word, count)
+}
+
+// NoCapitalizedCtx is like NoCapitalized but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoCapitalizedCtx(ctx context.Context, word string, count int) string {
+	return EngineFromContext(ctx).NoCapitalized(word, count)
+}
+
+// NoThresholdCtx is like NoThreshold but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoThresholdCtx(ctx context.Context, word string, count int, threshold int) string {
+	return EngineFromContext(ctx).NoThreshold(word, count, threshold)
+}
+
+// NoWordsCtx is like NoWords but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NoWordsCtx(ctx context.Context, word string, count int) string {
+	return EngineFromContext(ctx).NoWords(word, count)
+}
+
+// NumberOfPhraseCtx is like NumberOfPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func NumberOfPhraseCtx(ctx context.Context, n int, noun string, verb string) string {
+	return EngineFromContext(ctx).NumberOfPhrase(n, noun, verb)
+}
+
+// ObjectFieldNameCtx is like ObjectFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ObjectFieldNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).ObjectFieldName(typeName)
+}
+
+// PassivizeCtx is like Passivize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PassivizeCtx(ctx context.Context, subject string, verb string, object string) string {
+	return EngineFromContext(ctx).Passivize(subject, verb, object)
+}
+
+// PassivizeWithTenseCtx is like PassivizeWithTense but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PassivizeWithTenseCtx(ctx context.Context, subject string, verb string, object string, tense Tense) string {
+	return EngineFromContext(ctx).PassivizeWithTense(subject, verb, object, tense)
+}
+
+// PastParticipleCtx is like PastParticiple but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastParticipleCtx(ctx context.Context, verb string) string {
+	return EngineFromContext(ctx).PastParticiple(verb)
+}
+
+// PastParticiplePhraseCtx is like PastParticiplePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastParticiplePhraseCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).PastParticiplePhrase(phrase)
+}
+
+// PastTenseCtx is like PastTense but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastTenseCtx(ctx context.Context, verb string) string {
+	return EngineFromContext(ctx).PastTense(verb)
+}
+
+// PastTensePhraseCtx is like PastTensePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PastTensePhraseCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).PastTensePhrase(phrase)
+}
+
+// PhraseCtx is like Phrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PhraseCtx(ctx context.Context, count int, adjectives []string, noun string) string {
+	return EngineFromContext(ctx).Phrase(count, adjectives, noun)
+}
+
+// PluralCtx is like Plural but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Plural(word)
+}
+
+// PluralAdjCtx is like PluralAdj but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralAdjCtx(ctx context.Context, word string, count ...int) string {
+	return EngineFromContext(ctx).PluralAdj(word, count...)
+}
+
+// PluralLastWordCtx is like PluralLastWord but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralLastWordCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).PluralLastWord(phrase)
+}
+
+// PluralLowerCtx is like PluralLower but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralLowerCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).PluralLower(word)
+}
+
+// PluralNameCtx is like PluralName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNameCtx(ctx context.Context, name string) string {
+	return EngineFromContext(ctx).PluralName(name)
+}
+
+// PluralNounCtx is like PluralNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNounCtx(ctx context.Context, word string, count ...int) string {
+	return EngineFromContext(ctx).PluralNoun(word, count...)
+}
+
+// PluralNounPhraseCtx is like PluralNounPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralNounPhraseCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).PluralNounPhrase(phrase)
+}
+
+// PluralPeopleCtx is like PluralPeople but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralPeopleCtx(ctx context.Context, word string, sense PersonSense) string {
+	return EngineFromContext(ctx).PluralPeople(word, sense)
+}
+
+// PluralSafeCtx is like PluralSafe but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralSafeCtx(ctx context.Context, word string, count ...int) string {
+	return EngineFromContext(ctx).PluralSafe(word, count...)
+}
+
+// PluralVerbCtx is like PluralVerb but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralVerbCtx(ctx context.Context, word string, count ...int) string {
+	return EngineFromContext(ctx).PluralVerb(word, count...)
+}
+
+// PluralVerbPhraseCtx is like PluralVerbPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralVerbPhraseCtx(ctx context.Context, phrase string, count ...int) string {
+	return EngineFromContext(ctx).PluralVerbPhrase(phrase, count...)
+}
+
+// PluralizeCtx is like Pluralize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PluralizeCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Pluralize(word)
+}
+
+// PossessiveCtx is like Possessive but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PossessiveCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Possessive(word)
+}
+
+// PresentParticipleCtx is like PresentParticiple but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PresentParticipleCtx(ctx context.Context, verb string) string {
+	return EngineFromContext(ctx).PresentParticiple(verb)
+}
+
+// PresentParticiplePhraseCtx is like PresentParticiplePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func PresentParticiplePhraseCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).PresentParticiplePhrase(phrase)
+}
+
+// QuantifyCountCtx is like QuantifyCount but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QuantifyCountCtx(ctx context.Context, n int, noun string) string {
+	return EngineFromContext(ctx).QuantifyCount(n, noun)
+}
+
+// QuantifyCountWithBucketsCtx is like QuantifyCountWithBuckets but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QuantifyCountWithBucketsCtx(ctx context.Context, n int, noun string, buckets []QuantityBucket) string {
+	return EngineFromContext(ctx).QuantifyCountWithBuckets(n, noun, buckets)
+}
+
+// QueryFieldNameCtx is like QueryFieldName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func QueryFieldNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).QueryFieldName(typeName)
+}
+
+// ServiceMethodNameCtx is like ServiceMethodName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func ServiceMethodNameCtx(ctx context.Context, method StandardMethod, message string) string {
+	return EngineFromContext(ctx).ServiceMethodName(method, message)
+}
+
+// SingularCtx is like Singular but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Singular(word)
+}
+
+// SingularLastWordCtx is like SingularLastWord but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularLastWordCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).SingularLastWord(phrase)
+}
+
+// SingularNounCtx is like SingularNoun but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularNounCtx(ctx context.Context, word string, count ...int) string {
+	return EngineFromContext(ctx).SingularNoun(word, count...)
+}
+
+// SingularNounPhraseCtx is like SingularNounPhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularNounPhraseCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).SingularNounPhrase(phrase)
+}
+
+// SingularPeopleCtx is like SingularPeople but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularPeopleCtx(ctx context.Context, word string, sense PersonSense) string {
+	return EngineFromContext(ctx).SingularPeople(word, sense)
+}
+
+// SingularSafeCtx is like SingularSafe but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularSafeCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).SingularSafe(word)
+}
+
+// SingularizeCtx is like Singularize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularizeCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Singularize(word)
+}
+
+// SingularizePhraseCtx is like SingularizePhrase but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func SingularizePhraseCtx(ctx context.Context, text string) string {
+	return EngineFromContext(ctx).SingularizePhrase(text)
+}
+
+// TableNameCtx is like TableName but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TableNameCtx(ctx context.Context, typeName string) string {
+	return EngineFromContext(ctx).TableName(typeName)
+}
+
+// TableizeCtx is like Tableize but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TableizeCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Tableize(word)
+}
+
+// TheOrAnCtx is like TheOrAn but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TheOrAnCtx(ctx context.Context, phrase string) string {
+	return EngineFromContext(ctx).TheOrAn(phrase)
+}
+
+// TypeifyCtx is like Typeify but uses the Engine carried by ctx.
+// See WithEngine and EngineFromContext.
+func TypeifyCtx(ctx context.Context, word string) string {
+	return EngineFromContext(ctx).Typeify(word)
+}
@@ -0,0 +1,61 @@
+package inflect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestEngineFromContext(t *testing.T) {
+	e := inflect.NewEngine()
+
+	assert.Same(t, inflect.DefaultEngine(), inflect.EngineFromContext(context.Background()))
+	assert.Same(t, e, inflect.EngineFromContext(inflect.WithEngine(context.Background(), e)))
+	assert.Same(t, inflect.DefaultEngine(), inflect.EngineFromContext(inflect.WithEngine(context.Background(), nil)))
+}
+
+func TestCtxFunctions(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Classical(true)
+	e.SetGender("f")
+	e.DefNoun("regex", "regexen")
+	e.DefA("ape")
+	ctx := inflect.WithEngine(context.Background(), e)
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "PluralCtx classical", got: inflect.PluralCtx(ctx, "formula"), want: "formulae"},
+		{name: "PluralCtx custom noun", got: inflect.PluralCtx(ctx, "regex"), want: "regexen"},
+		{name: "SingularCtx custom noun", got: inflect.SingularCtx(ctx, "regexen"), want: "regex"},
+		{name: "AnCtx custom article", got: inflect.AnCtx(ctx, "ape"), want: "a ape"},
+		{name: "SingularNounCtx gender", got: inflect.SingularNounCtx(ctx, "they"), want: "she"},
+		{name: "PluralNounCtx count", got: inflect.PluralNounCtx(ctx, "cat", 1), want: "cat"},
+		{name: "NoCtx", got: inflect.NoCtx(ctx, "formula", 2), want: "2 formulae"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+
+	// The default engine is unaffected
+	assert.Equal(t, "formulas", inflect.PluralCtx(context.Background(), "formula"))
+	assert.Equal(t, "an ape", inflect.AnCtx(context.Background(), "ape"))
+}
+
+func TestCtxFunctionsNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	ctx := inflect.WithEngine(context.Background(), e)
+
+	assert.Equal(t, "cat", inflect.PluralCtx(ctx, "cat"))
+	assert.Equal(t, "is", inflect.PluralVerbCtx(ctx, "is"))
+	assert.Equal(t, "cats", inflect.PluralCtx(context.Background(), "cat"))
+}
@@ -0,0 +1,174 @@
+package inflect
+
+import "strings"
+
+// contractionSuffixes maps the endings of contractions that have only one
+// expansion to the word they stand for: "we'll" -> "we will".
+var contractionSuffixes = []struct{ suffix, word string }{
+	{"'m", "am"},
+	{"'re", "are"},
+	{"'ve", "have"},
+	{"'ll", "will"},
+}
+
+// contractionExceptions contains contractions that Expand leaves unchanged
+// because they have more than one expansion ("ain't" is "am not", "is
+// not", or "have not"), or that expand irregularly. Words ending in "'s"
+// ("is", "has", or a possessive) and "'d" ("had" or "would") are also left
+// unchanged, except for those listed here.
+var contractionExceptions = map[string]string{
+	"ain't": "",
+	"let's": "let us",
+}
+
+// contractionPronouns contains the words that Contract joins with a
+// following auxiliary: "I am" -> "I'm".
+var contractionPronouns = map[string]bool{
+	"i": true, "you": true, "he": true, "she": true, "it": true, "we": true,
+	"they": true, "that": true, "there": true, "who": true, "what": true,
+}
+
+// pronounContractions maps auxiliaries to the endings Contract gives them
+// after a pronoun. Forms of "have" are contracted only before a past
+// participle, so that "I have a car" is unchanged.
+var pronounContractions = map[string]string{
+	"am": "'m", "are": "'re", "is": "'s", "will": "'ll", "would": "'d",
+	"have": "'ve", "has": "'s", "had": "'d",
+}
+
+// Expand replaces the contractions in text with the words they stand for.
+//
+// Negative contractions are expanded with "not" ("don't" -> "do not"),
+// except that "can't" becomes "cannot". Contractions that have more than
+// one expansion are left unchanged: "'s" may be "is", "has", or a
+// possessive, "'d" may be "had" or "would", and "ain't" may be almost
+// anything. Both straight and curly apostrophes are recognized, and the
+// case of each contraction is preserved.
+//
+// Examples:
+//   - Expand("can't stop won't stop") returns "cannot stop will not stop"
+//   - Expand("I'm sure they're here") returns "I am sure they are here"
+//   - Expand("We'll see") returns "We will see"
+//   - Expand("It's done") returns "It's done"
+//   - Expand("let's go") returns "let us go"
+func Expand(text string) string {
+	spans := sentenceWordPattern.FindAllStringIndex(text, -1)
+	var b strings.Builder
+	last := 0
+	for _, span := range spans {
+		word := text[span[0]:span[1]]
+		expanded, ok := expandContraction(word)
+		if !ok {
+			continue
+		}
+		b.WriteString(text[last:span[0]])
+		b.WriteString(expanded)
+		last = span[1]
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// Contract replaces auxiliaries followed by "not", and pronouns followed by
+// an auxiliary, with their contractions.
+//
+// "X not" becomes "Xn't" for the auxiliaries and modals that have a
+// negative contraction ("do not" -> "don't", "will not" -> "won't"), and
+// "cannot" becomes "can't". A pronoun followed by "am", "are", "is",
+// "will", or "would" is contracted ("I am" -> "I'm") when another word
+// follows, since an auxiliary at the end of a clause cannot be contracted
+// ("I know who you are"). Forms of "have" are contracted only before a
+// past participle ("we have gone" -> "we've gone"). When both are
+// possible, the negative is preferred: "it is not" -> "it isn't".
+//
+// Examples:
+//   - Contract("do not") returns "don't"
+//   - Contract("I cannot go") returns "I can't go"
+//   - Contract("I am sure it is not here") returns "I'm sure it isn't here"
+//   - Contract("We have gone") returns "We've gone"
+//   - Contract("I have a car") returns "I have a car"
+func Contract(text string) string {
+	spans := sentenceWordPattern.FindAllStringIndex(text, -1)
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		words[i] = strings.ToLower(text[span[0]:span[1]])
+	}
+	// adjacent reports whether words i and i+1 are separated by one space
+	adjacent := func(i int) bool {
+		return i+1 < len(spans) && text[spans[i][1]:spans[i+1][0]] == " "
+	}
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(spans); i++ {
+		var contracted string
+		end := i
+		switch {
+		case words[i] == "cannot":
+			contracted = "can't"
+		case adjacent(i) && words[i+1] == "not" && verbNegatives[words[i]] != "":
+			contracted, end = verbNegatives[words[i]], i+1
+		case adjacent(i) && contractionPronouns[words[i]] && pronounContractions[words[i+1]] != "":
+			aux := words[i+1]
+			if !adjacent(i+1) || words[i+2] == "not" && verbNegatives[aux] != "" {
+				continue
+			}
+			if (aux == "have" || aux == "has" || aux == "had") && !isPastParticiple(words[i+2]) {
+				continue
+			}
+			contracted, end = words[i]+pronounContractions[aux], i+1
+		default:
+			continue
+		}
+
+		original := text[spans[i][0]:spans[end][1]]
+		b.WriteString(text[last:spans[i][0]])
+		if isAllUpper(original) && len(original) > 1 {
+			b.WriteString(strings.ToUpper(contracted))
+		} else {
+			b.WriteString(matchCase(text[spans[i][0]:spans[i][1]], contracted))
+		}
+		last = spans[end][1]
+		i = end
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// expandContraction returns the expansion of a single contraction, in the
+// case of the original, and whether it has one.
+func expandContraction(word string) (string, bool) {
+	lower := strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+	if !strings.Contains(lower, "'") {
+		return "", false
+	}
+
+	expanded, ok := contractionExceptions[lower]
+	switch {
+	case ok:
+	case lower == "can't":
+		expanded = "cannot"
+	case verbPositives[lower] != "":
+		expanded = verbPositives[lower] + " not"
+	default:
+		for _, c := range contractionSuffixes {
+			if stem, found := strings.CutSuffix(lower, c.suffix); found && stem != "" {
+				expanded = stem + " " + c.word
+				break
+			}
+		}
+	}
+	if expanded == "" {
+		return "", false
+	}
+	if isAllUpper(word) && len(lower) > 2 {
+		return strings.ToUpper(expanded), true
+	}
+	return matchCase(word, expanded), true
+}
@@ -0,0 +1,102 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		// Negatives
+		{name: "can't and won't", text: "can't stop won't stop", want: "cannot stop will not stop"},
+		{name: "don't", text: "don't", want: "do not"},
+		{name: "isn't", text: "it isn't", want: "it is not"},
+		{name: "shan't", text: "we shan't", want: "we shall not"},
+		{name: "couldn't", text: "couldn't", want: "could not"},
+
+		// Pronoun contractions
+		{name: "I'm", text: "I'm here", want: "I am here"},
+		{name: "they're", text: "they're here", want: "they are here"},
+		{name: "we've", text: "we've gone", want: "we have gone"},
+		{name: "you'll", text: "you'll see", want: "you will see"},
+		{name: "could've", text: "could've been", want: "could have been"},
+		{name: "let's", text: "let's go", want: "let us go"},
+
+		// Ambiguous contractions are left alone
+		{name: "it's", text: "it's done", want: "it's done"},
+		{name: "he'd", text: "he'd known", want: "he'd known"},
+		{name: "possessive", text: "the cat's toy", want: "the cat's toy"},
+		{name: "ain't", text: "ain't it", want: "ain't it"},
+
+		// Case, apostrophes, and punctuation
+		{name: "capitalized", text: "Can't stop", want: "Cannot stop"},
+		{name: "uppercase", text: "DON'T PANIC", want: "DO NOT PANIC"},
+		{name: "uppercase I'm", text: "I'M HERE", want: "I AM HERE"},
+		{name: "curly apostrophe", text: "don’t", want: "do not"},
+		{name: "punctuation", text: "No, I won't!", want: "No, I will not!"},
+		{name: "no contractions", text: "hello world", want: "hello world"},
+		{name: "empty", text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Expand(tt.text))
+		})
+	}
+}
+
+func TestContract(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		// Negatives
+		{name: "do not", text: "do not", want: "don't"},
+		{name: "will not", text: "will not", want: "won't"},
+		{name: "cannot", text: "I cannot go", want: "I can't go"},
+		{name: "does not", text: "it does not work", want: "it doesn't work"},
+		{name: "am not", text: "I am not sure", want: "I'm not sure"},
+		{name: "may not", text: "you may not", want: "you may not"},
+
+		// Pronoun contractions
+		{name: "I am", text: "I am sure", want: "I'm sure"},
+		{name: "they are", text: "they are here", want: "they're here"},
+		{name: "it is", text: "it is done", want: "it's done"},
+		{name: "we will", text: "we will see", want: "we'll see"},
+		{name: "have participle", text: "we have gone", want: "we've gone"},
+		{name: "have noun", text: "I have a car", want: "I have a car"},
+		{name: "end of clause", text: "I know who you are", want: "I know who you are"},
+		{name: "before punctuation", text: "that is what it is.", want: "that's what it is."},
+		{name: "prefer negative", text: "it is not here", want: "it isn't here"},
+
+		// Case and spacing
+		{name: "capitalized", text: "Do not touch", want: "Don't touch"},
+		{name: "uppercase", text: "DO NOT TOUCH", want: "DON'T TOUCH"},
+		{name: "two spaces", text: "do  not", want: "do  not"},
+		{name: "across a comma", text: "do, not", want: "do, not"},
+		{name: "no contractions", text: "hello world", want: "hello world"},
+		{name: "empty", text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Contract(tt.text))
+		})
+	}
+}
+
+func TestExpandContractRoundTrip(t *testing.T) {
+	for _, text := range []string{"do not", "will not", "I am here", "they are late", "we have gone"} {
+		t.Run(text, func(t *testing.T) {
+			assert.Equal(t, text, inflect.Expand(inflect.Contract(text)))
+		})
+	}
+}
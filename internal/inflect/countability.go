@@ -0,0 +1,135 @@
+package inflect
+
+import "strings"
+
+// massNouns contains common nouns that are uncountable when used in their
+// usual sense, so they take "less" and "much" rather than "fewer" and
+// "many". Words like "sheep" that are countable but do not change in the
+// plural are not included.
+var massNouns = map[string]bool{
+	// Substances and food
+	"air": true, "blood": true, "bread": true, "butter": true, "cheese": true,
+	"coffee": true, "flour": true, "fuel": true, "gasoline": true,
+	"gold": true, "ice": true, "juice": true, "meat": true, "milk": true,
+	"oil": true, "oxygen": true, "petrol": true, "rice": true, "salt": true,
+	"sand": true, "silver": true, "snow": true, "soup": true, "steel": true,
+	"sugar": true, "tea": true, "water": true, "wood": true, "wool": true,
+	// Collections
+	"baggage": true, "clothing": true, "equipment": true, "furniture": true,
+	"garbage": true, "hardware": true, "jewelry": true, "luggage": true,
+	"machinery": true, "mail": true, "merchandise": true, "rubbish": true,
+	"software": true, "spam": true, "trash": true, "waste": true,
+	// Abstractions
+	"advice": true, "anger": true, "attention": true, "bandwidth": true,
+	"cash": true, "confidence": true, "content": true, "courage": true,
+	"damage": true, "documentation": true, "education": true,
+	"electricity": true, "energy": true, "evidence": true, "feedback": true,
+	"fun": true, "happiness": true, "health": true, "heat": true,
+	"help": true, "homework": true, "housework": true, "income": true,
+	"information": true, "knowledge": true, "money": true, "music": true,
+	"news": true, "noise": true, "patience": true, "pollution": true,
+	"progress": true, "research": true, "revenue": true, "safety": true,
+	"security": true, "space": true, "storage": true, "stuff": true,
+	"traffic": true, "vocabulary": true, "wealth": true, "weather": true,
+	"work": true,
+}
+
+// measureNouns contains units of time, money, distance, and quantity.
+// Although countable, they take "less" when they describe an amount, as in
+// "less than five minutes".
+var measureNouns = map[string]bool{
+	"second": true, "minute": true, "hour": true, "day": true, "week": true,
+	"month": true, "year": true,
+	"cent": true, "dollar": true, "euro": true, "pound": true, "yen": true,
+	"inch": true, "foot": true, "feet": true, "yard": true, "mile": true,
+	"meter": true, "metre": true, "kilometer": true, "kilometre": true,
+	"gram": true, "kilogram": true, "ounce": true, "ton": true,
+	"liter": true, "litre": true, "gallon": true, "percent": true,
+}
+
+// IsMassNoun reports whether noun is a known mass noun, such as "water" or
+// "information", which is not normally counted. For a phrase, the last word
+// is checked.
+//
+// Examples:
+//   - IsMassNoun("water") returns true
+//   - IsMassNoun("customer feedback") returns true
+//   - IsMassNoun("item") returns false
+//   - IsMassNoun("sheep") returns false
+func IsMassNoun(noun string) bool {
+	return massNouns[headNoun(noun)]
+}
+
+// FewerOrLess returns "less" for a mass noun or unit of measure, and
+// "fewer" for any other noun.
+//
+// Examples:
+//   - FewerOrLess("items") returns "fewer"
+//   - FewerOrLess("water") returns "less"
+//   - FewerOrLess("minutes") returns "less"
+func FewerOrLess(noun string) string {
+	head := headNoun(noun)
+	if massNouns[head] || isMeasureNoun(head) {
+		return "less"
+	}
+	return "fewer"
+}
+
+// ManyOrMuch returns "much" for a mass noun and "many" for any other noun.
+//
+// Examples:
+//   - ManyOrMuch("items") returns "many"
+//   - ManyOrMuch("water") returns "much"
+//   - ManyOrMuch("minutes") returns "many"
+func ManyOrMuch(noun string) string {
+	if IsMassNoun(noun) {
+		return "much"
+	}
+	return "many"
+}
+
+// AmountPhrase returns a phrase for an amount below n of noun, using
+// FewerOrLess and the count spelled out in words, for messages such as
+// validation errors. A countable noun is made plural unless n is 1 or -1;
+// a mass noun is left as it is.
+//
+// Examples:
+//   - AmountPhrase(5, "item") returns "fewer than five items"
+//   - AmountPhrase(5, "water") returns "less than five water"
+//   - AmountPhrase(10, "minute") returns "less than ten minutes"
+//   - AmountPhrase(1, "child") returns "fewer than one child"
+func AmountPhrase(n int, noun string) string {
+	return defaultEngine.AmountPhrase(n, noun)
+}
+
+// AmountPhrase returns a phrase for an amount below n of noun, using
+// FewerOrLess and the count spelled out in words. A countable noun is made
+// plural unless n is 1 or -1; a mass noun is left as it is.
+//
+// Examples:
+//   - e.AmountPhrase(5, "item") returns "fewer than five items"
+//   - e.AmountPhrase(5, "water") returns "less than five water"
+//   - e.AmountPhrase(10, "minute") returns "less than ten minutes"
+func (e *Engine) AmountPhrase(n int, noun string) string {
+	noun = strings.TrimSpace(noun)
+	if noun != "" && n != 1 && n != -1 && !IsMassNoun(noun) {
+		head, last, _ := splitLastWord(noun)
+		noun = head + e.plural(last)
+	}
+	return strings.TrimSpace(FewerOrLess(noun) + " than " + NumberToWords(n) + " " + noun)
+}
+
+// headNoun returns the last word of a noun phrase in lowercase, without
+// surrounding punctuation.
+func headNoun(noun string) string {
+	_, last, _ := splitLastWord(noun)
+	_, last, _ = extractPunctuation(last)
+	return strings.ToLower(last)
+}
+
+// isMeasureNoun reports whether a lowercase noun is a unit in measureNouns,
+// in the singular or plural.
+func isMeasureNoun(head string) bool {
+	return measureNouns[head] || measureNouns[strings.TrimSuffix(head, "s")] ||
+		measureNouns[strings.TrimSuffix(head, "es")]
+}
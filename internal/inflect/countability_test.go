@@ -0,0 +1,82 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestIsMassNoun(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "water", input: "water", want: true},
+		{name: "information", input: "information", want: true},
+		{name: "capitalized", input: "Furniture", want: true},
+		{name: "phrase", input: "customer feedback", want: true},
+		{name: "punctuation", input: "advice,", want: true},
+		{name: "countable", input: "item", want: false},
+		{name: "unchanged plural", input: "sheep", want: false},
+		{name: "unit", input: "minute", want: false},
+		{name: "empty", input: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.IsMassNoun(tt.input))
+		})
+	}
+}
+
+func TestFewerOrLessAndManyOrMuch(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantFewer string
+		wantMany  string
+	}{
+		{name: "plural noun", input: "items", wantFewer: "fewer", wantMany: "many"},
+		{name: "singular noun", input: "child", wantFewer: "fewer", wantMany: "many"},
+		{name: "mass noun", input: "water", wantFewer: "less", wantMany: "much"},
+		{name: "mass noun phrase", input: "office furniture", wantFewer: "less", wantMany: "much"},
+		{name: "unit", input: "minutes", wantFewer: "less", wantMany: "many"},
+		{name: "unit ending in -es", input: "inches", wantFewer: "less", wantMany: "many"},
+		{name: "irregular unit", input: "feet", wantFewer: "less", wantMany: "many"},
+		{name: "empty", input: "", wantFewer: "fewer", wantMany: "many"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantFewer, inflect.FewerOrLess(tt.input))
+			assert.Equal(t, tt.wantMany, inflect.ManyOrMuch(tt.input))
+		})
+	}
+}
+
+func TestAmountPhrase(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		noun string
+		want string
+	}{
+		{name: "countable", n: 5, noun: "item", want: "fewer than five items"},
+		{name: "irregular", n: 3, noun: "child", want: "fewer than three children"},
+		{name: "one", n: 1, noun: "child", want: "fewer than one child"},
+		{name: "zero", n: 0, noun: "error", want: "fewer than zero errors"},
+		{name: "mass noun", n: 5, noun: "water", want: "less than five water"},
+		{name: "unit", n: 10, noun: "minute", want: "less than ten minutes"},
+		{name: "phrase", n: 2, noun: "field mouse", want: "fewer than two field mice"},
+		{name: "no noun", n: 4, noun: "", want: "fewer than four"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AmountPhrase(tt.n, tt.noun))
+		})
+	}
+}
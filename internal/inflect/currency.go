@@ -1,7 +1,9 @@
 package inflect
 
 import (
+	"fmt"
 	"math"
+	"strconv"
 	"strings"
 )
 
@@ -77,6 +79,35 @@ var currencies = map[string]currencyInfo{
 	"GHS": {"cedi", "cedis", "pesewa", "pesewas", true},
 }
 
+// RoundingMode is how CurrencyToWordsWithOptions rounds an amount to a whole
+// number of minor units.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, and halves away from
+	// zero.
+	// Example: 2.345 -> 2.35, 2.344 -> 2.34
+	RoundHalfUp RoundingMode = iota
+
+	// RoundTruncate drops the digits after the minor unit.
+	// Example: 2.349 -> 2.34
+	RoundTruncate
+)
+
+// CurrencyOptions configures CurrencyToWordsWithOptions. The zero value
+// gives the same result as CurrencyToWords.
+type CurrencyOptions struct {
+	// Rounding is how the amount is rounded to a whole number of minor
+	// units. The default is RoundHalfUp.
+	Rounding RoundingMode
+
+	// Check writes the amount the way it is written on a check: the major
+	// units in words, starting with a capital, followed by the minor units
+	// as a fraction of 100 ("and 50/100", or "and no/100" for none) and
+	// the plural major unit. Negative amounts return an empty string.
+	Check bool
+}
+
 // CurrencyToWords converts a currency amount to its English word representation.
 //
 // The function handles various cases:
@@ -97,6 +128,20 @@ var currencies = map[string]currencyInfo{
 //   - CurrencyToWords(123.45, "GBP") returns "one hundred twenty-three pounds and forty-five pence"
 //   - CurrencyToWords(-5.00, "USD") returns "negative five dollars"
 func CurrencyToWords(amount float64, currency string) string {
+	return CurrencyToWordsWithOptions(amount, currency, CurrencyOptions{})
+}
+
+// CurrencyToWordsWithOptions converts a currency amount to its English word
+// representation, like CurrencyToWords, with the given options.
+//
+// Returns empty string for unknown currency codes.
+//
+// Examples:
+//   - CurrencyToWordsWithOptions(2.349, "USD", CurrencyOptions{}) returns "two dollars and thirty-five cents"
+//   - CurrencyToWordsWithOptions(2.349, "USD", CurrencyOptions{Rounding: RoundTruncate}) returns "two dollars and thirty-four cents"
+//   - CurrencyToWordsWithOptions(1234.5, "USD", CurrencyOptions{Check: true}) returns "One thousand two hundred thirty-four and 50/100 dollars"
+//   - CurrencyToWordsWithOptions(100, "EUR", CurrencyOptions{Check: true}) returns "One hundred and no/100 euros"
+func CurrencyToWordsWithOptions(amount float64, currency string, opts CurrencyOptions) string {
 	// Handle special float values
 	if math.IsNaN(amount) || math.IsInf(amount, 0) {
 		return ""
@@ -115,17 +160,20 @@ func CurrencyToWords(amount float64, currency string) string {
 		amount = -amount
 	}
 
-	// Round to 2 decimal places
-	amount = math.Round(amount*100) / 100
+	// Split into major and minor units
+	major, minor := splitCurrency(amount, opts.Rounding)
 
 	// If amount rounds to zero, it's not negative
-	if amount == 0 {
+	if major == 0 && minor == 0 {
 		negative = false
 	}
 
-	// Split into major and minor units
-	major := int(amount)
-	minor := int(math.Round((amount - float64(major)) * 100))
+	if opts.Check {
+		if negative {
+			return ""
+		}
+		return checkAmount(major, minor, info)
+	}
 
 	// Build the result
 	var result string
@@ -151,6 +199,52 @@ func CurrencyToWords(amount float64, currency string) string {
 	return result
 }
 
+// CheckAmount writes an amount in US dollars the way it is written on a
+// printed check. It is CurrencyToWordsWithOptions with Check set, for
+// "USD".
+//
+// Examples:
+//   - CheckAmount(1234.5) returns "One thousand two hundred thirty-four and 50/100 dollars"
+//   - CheckAmount(100) returns "One hundred and no/100 dollars"
+//   - CheckAmount(0.99) returns "Zero and 99/100 dollars"
+//   - CheckAmount(-5) returns ""
+func CheckAmount(amount float64) string {
+	return CurrencyToWordsWithOptions(amount, "USD", CurrencyOptions{Check: true})
+}
+
+// splitCurrency splits a non-negative amount into whole major units and
+// minor units, rounded to two decimal places.
+func splitCurrency(amount float64, mode RoundingMode) (major, minor int) {
+	if mode == RoundTruncate {
+		// Truncate the shortest decimal representation, so that 0.29,
+		// stored as 0.28999..., keeps its 29 cents
+		whole, frac, _ := strings.Cut(strconv.FormatFloat(amount, 'f', -1, 64), ".")
+		frac = (frac + "00")[:2]
+		major, _ = strconv.Atoi(whole)
+		minor, _ = strconv.Atoi(frac)
+		return major, minor
+	}
+
+	amount = math.Round(amount*100) / 100
+	major = int(amount)
+	minor = int(math.Round((amount - float64(major)) * 100))
+	return major, minor
+}
+
+// checkAmount formats an amount in the check-writing style of
+// CurrencyOptions.Check.
+func checkAmount(major, minor int, info currencyInfo) string {
+	words := Capitalize(NumberToWords(major))
+	if !info.hasMinorUnit {
+		return words + " " + info.majorPlural
+	}
+	cents := "no"
+	if minor > 0 {
+		cents = fmt.Sprintf("%02d", minor)
+	}
+	return words + " and " + cents + "/100 " + info.majorPlural
+}
+
 // formatMajorUnit formats the major currency unit with proper singular/plural.
 func formatMajorUnit(amount int, info currencyInfo) string {
 	word := NumberToWords(amount)
@@ -272,3 +272,54 @@ func BenchmarkCurrencyToWords(b *testing.B) {
 		})
 	}
 }
+
+func TestCurrencyToWordsWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		opts     inflect.CurrencyOptions
+		want     string
+	}{
+		{name: "default rounds half up", amount: 2.345, currency: "USD", want: "two dollars and thirty-five cents"},
+		{name: "round half up", amount: 2.344, currency: "USD", opts: inflect.CurrencyOptions{Rounding: inflect.RoundHalfUp}, want: "two dollars and thirty-four cents"},
+		{name: "truncate", amount: 2.349, currency: "USD", opts: inflect.CurrencyOptions{Rounding: inflect.RoundTruncate}, want: "two dollars and thirty-four cents"},
+		{name: "truncate exact", amount: 0.29, currency: "USD", opts: inflect.CurrencyOptions{Rounding: inflect.RoundTruncate}, want: "twenty-nine cents"},
+		{name: "truncate one decimal", amount: 1.5, currency: "GBP", opts: inflect.CurrencyOptions{Rounding: inflect.RoundTruncate}, want: "one pound and fifty pence"},
+		{name: "truncate to zero", amount: -0.009, currency: "USD", opts: inflect.CurrencyOptions{Rounding: inflect.RoundTruncate}, want: "zero dollars"},
+		{name: "truncate negative", amount: -1.999, currency: "USD", opts: inflect.CurrencyOptions{Rounding: inflect.RoundTruncate}, want: "negative one dollar and ninety-nine cents"},
+		{name: "check", amount: 1234.5, currency: "USD", opts: inflect.CurrencyOptions{Check: true}, want: "One thousand two hundred thirty-four and 50/100 dollars"},
+		{name: "check single cent", amount: 1.01, currency: "USD", opts: inflect.CurrencyOptions{Check: true}, want: "One and 01/100 dollars"},
+		{name: "check whole", amount: 100, currency: "EUR", opts: inflect.CurrencyOptions{Check: true}, want: "One hundred and no/100 euros"},
+		{name: "check truncate", amount: 9.999, currency: "USD", opts: inflect.CurrencyOptions{Check: true, Rounding: inflect.RoundTruncate}, want: "Nine and 99/100 dollars"},
+		{name: "check rounds up", amount: 9.999, currency: "USD", opts: inflect.CurrencyOptions{Check: true}, want: "Ten and no/100 dollars"},
+		{name: "check no minor unit", amount: 5000, currency: "JPY", opts: inflect.CurrencyOptions{Check: true}, want: "Five thousand yen"},
+		{name: "check negative", amount: -5, currency: "USD", opts: inflect.CurrencyOptions{Check: true}, want: ""},
+		{name: "unknown currency", amount: 5, currency: "XYZ", opts: inflect.CurrencyOptions{Check: true}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CurrencyToWordsWithOptions(tt.amount, tt.currency, tt.opts))
+		})
+	}
+}
+
+func TestCheckAmount(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   string
+	}{
+		{amount: 1234.5, want: "One thousand two hundred thirty-four and 50/100 dollars"},
+		{amount: 100, want: "One hundred and no/100 dollars"},
+		{amount: 0.99, want: "Zero and 99/100 dollars"},
+		{amount: 0, want: "Zero and no/100 dollars"},
+		{amount: -5, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CheckAmount(tt.amount))
+		})
+	}
+}
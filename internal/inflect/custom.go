@@ -1,6 +1,10 @@
 package inflect
 
-import "strings"
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
 
 // DefNoun defines a custom noun pluralization rule.
 //
@@ -32,12 +36,81 @@ func DefNoun(singular, plural string) {
 func (e *Engine) DefNoun(singular, plural string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 	lowerPlural := strings.ToLower(plural)
+	e.undefClassical(lower)
 	e.irregularPlurals[lower] = lowerPlural
 	e.singularIrregulars[lowerPlural] = lower
 }
 
+// DefNounMulti defines a custom noun with both a modern and a classical
+// plural, so that it follows classical mode like the built-in Latin and
+// Greek nouns.
+//
+// Plural() returns the classical plural when Classical(true) or
+// ClassicalAncient(true) is in effect, and the modern plural otherwise.
+// Singular() accepts either form. Like DefNoun, the forms are stored in
+// lowercase and the results keep the case of the input, and a later DefNoun
+// for the same singular replaces both plurals.
+//
+// Examples:
+//
+//	DefNounMulti("virus", "viruses", "viri")
+//	Plural("virus")   // returns "viruses"
+//	ClassicalAncient(true)
+//	Plural("virus")   // returns "viri"
+//	Singular("viri")  // returns "virus"
+func DefNounMulti(singular, modernPlural, classicalPlural string) {
+	defaultEngine.DefNounMulti(singular, modernPlural, classicalPlural)
+}
+
+// DefNounMulti defines a custom noun with both a modern and a classical
+// plural, so that it follows classical mode like the built-in Latin and
+// Greek nouns. See the package-level DefNounMulti for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNounMulti("virus", "viruses", "viri")
+//	e.Plural("virus")   // returns "viruses"
+//	e.Classical(true)
+//	e.Plural("virus")   // returns "viri"
+func (e *Engine) DefNounMulti(singular, modernPlural, classicalPlural string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	lower := strings.ToLower(singular)
+	modern := strings.ToLower(modernPlural)
+	classical := strings.ToLower(classicalPlural)
+	e.undefClassical(lower)
+	e.irregularPlurals[lower] = modern
+	e.singularIrregulars[modern] = lower
+	e.singularIrregulars[classical] = lower
+	if e.classicalNouns == nil {
+		e.classicalNouns = make(map[string]string)
+	}
+	e.classicalNouns[lower] = classical
+}
+
+// classicalNoun returns the classical plural of a noun defined with
+// DefNounMulti.
+func (e *Engine) classicalNoun(lower string) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	plural, ok := e.classicalNouns[lower]
+	return plural, ok
+}
+
+// undefClassical removes the classical plural of a noun defined with
+// DefNounMulti, if any. The caller must hold e.mu.
+func (e *Engine) undefClassical(lower string) {
+	if plural, ok := e.classicalNouns[lower]; ok {
+		delete(e.classicalNouns, lower)
+		e.unmapPlural(plural, lower)
+	}
+}
+
 // UndefNoun removes a custom noun pluralization rule.
 //
 // This removes only user-defined rules; it cannot remove built-in irregular
@@ -74,6 +147,7 @@ func UndefNoun(singular string) bool {
 func (e *Engine) UndefNoun(singular string) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 
 	// Check if this is a built-in rule
@@ -90,6 +164,7 @@ func (e *Engine) UndefNoun(singular string) bool {
 	// Remove from both maps
 	delete(e.irregularPlurals, lower)
 	delete(e.singularIrregulars, plural)
+	e.undefClassical(lower)
 	return true
 }
 
@@ -125,12 +200,254 @@ func DefNounReset() {
 func (e *Engine) DefNounReset() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.irregularPlurals = copyMap(defaultIrregularPlurals)
 	// Build singularIrregulars as reverse of irregularPlurals
 	e.singularIrregulars = make(map[string]string, len(e.irregularPlurals))
 	for singular, plural := range e.irregularPlurals {
 		e.singularIrregulars[plural] = singular
 	}
+	e.classicalNouns = nil
+}
+
+// ResetWord restores the built-in plural of a noun that was redefined with
+// DefNoun, or removes the definition of a noun that has no built-in plural,
+// leaving every other definition in place.
+//
+// Returns true if the noun was defined, false if it already had its
+// built-in plural or no definition.
+//
+// Examples:
+//
+//	DefNoun("child", "childs")
+//	DefNoun("foo", "fooz")
+//	ResetWord("child") // returns true
+//	Plural("child")    // returns "children" (restored)
+//	Plural("foo")      // returns "fooz" (kept)
+//	ResetWord("foo")   // returns true
+//	Plural("foo")      // returns "foos"
+func ResetWord(word string) bool {
+	return defaultEngine.ResetWord(word)
+}
+
+// ResetWord restores the built-in plural of a noun that was redefined with
+// e.DefNoun, or removes the definition of a noun that has no built-in
+// plural, leaving every other definition in place. See the package-level
+// ResetWord for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("child", "childs")
+//	e.ResetWord("child") // returns true
+//	e.Plural("child")    // returns "children"
+func (e *Engine) ResetWord(word string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	lower := strings.ToLower(word)
+	plural, ok := e.irregularPlurals[lower]
+	builtin, isBuiltIn := defaultIrregularPlurals[lower]
+	_, multi := e.classicalNouns[lower]
+	if !ok || isBuiltIn && plural == builtin && !multi {
+		return false
+	}
+	e.cache.clear()
+	e.undefClassical(lower)
+	e.unmapPlural(plural, lower)
+	if !isBuiltIn {
+		delete(e.irregularPlurals, lower)
+		return true
+	}
+	e.irregularPlurals[lower] = builtin
+	e.singularIrregulars[builtin] = lower
+	return true
+}
+
+// ResetCustomOnly removes the nouns added with DefNoun that have no built-in
+// plural, and keeps the built-in nouns that were redefined.
+//
+// Unlike DefNounReset, which restores every noun, it undoes additions while
+// keeping deliberate overrides, such as "index" -> "indexes".
+//
+// Examples:
+//
+//	DefNoun("index", "indexes") // override built-in
+//	DefNoun("foo", "fooz")      // add custom
+//	ResetCustomOnly()
+//	Plural("index") // returns "indexes" (kept)
+//	Plural("foo")   // returns "foos" (custom removed)
+func ResetCustomOnly() {
+	defaultEngine.ResetCustomOnly()
+}
+
+// ResetCustomOnly removes the nouns added with e.DefNoun that have no
+// built-in plural, and keeps the built-in nouns that were redefined. See
+// the package-level ResetCustomOnly for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("index", "indexes")
+//	e.DefNoun("foo", "fooz")
+//	e.ResetCustomOnly()
+//	e.Plural("index") // returns "indexes"
+//	e.Plural("foo")   // returns "foos"
+func (e *Engine) ResetCustomOnly() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	for singular, plural := range e.irregularPlurals {
+		if _, isBuiltIn := defaultIrregularPlurals[singular]; !isBuiltIn {
+			delete(e.irregularPlurals, singular)
+			e.unmapPlural(plural, singular)
+			e.undefClassical(singular)
+		}
+	}
+}
+
+// unmapPlural removes the mapping of plural back to singular, restoring the
+// built-in singular of plural, if it has one that is still in effect. The
+// caller must hold e.mu.
+func (e *Engine) unmapPlural(plural, singular string) {
+	if e.singularIrregulars[plural] != singular {
+		return
+	}
+	delete(e.singularIrregulars, plural)
+	for s, p := range defaultIrregularPlurals {
+		if p == plural && e.irregularPlurals[s] == p {
+			e.singularIrregulars[plural] = s
+			return
+		}
+	}
+}
+
+// nounRule is a custom pluralization rule defined with DefNounRule.
+type nounRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+	priority    int
+}
+
+// DefNounRule defines a custom pluralization rule that matches nouns by
+// pattern rather than by exact word.
+//
+// The pattern is a Go regex matched against the lowercase noun, and the
+// plural is formed by replacing the match with replacement, which may
+// refer to capture groups ("$1"). The result keeps the case of the noun.
+//
+// Rules are checked after exact definitions (DefNoun) and the built-in
+// irregular and uncountable tables, but before the default suffix rules.
+// Rules with a higher priority are checked first; among rules with the same
+// priority, the most recently defined is checked first. Defining a rule
+// with an existing pattern replaces it.
+//
+// Returns an error if the pattern is invalid.
+//
+// Examples:
+//
+//	DefNounRule("us$", "i", 0)
+//	Plural("hippopotamus") // returns "hippopotami"
+//	Plural("Octopus")      // returns "Octopi"
+//	DefNounRule("(ph|x)ylum$", "${1}yla", 1)
+//	Plural("phylum") // returns "phyla"
+func DefNounRule(pattern, replacement string, priority int) error {
+	return defaultEngine.DefNounRule(pattern, replacement, priority)
+}
+
+// DefNounRule defines a custom pluralization rule that matches nouns by
+// pattern rather than by exact word.
+//
+// The pattern is a Go regex matched against the lowercase noun, and the
+// plural is formed by replacing the match with replacement, which may
+// refer to capture groups ("$1"). The result keeps the case of the noun.
+//
+// Rules are checked after exact definitions (e.DefNoun) and the built-in
+// irregular and uncountable tables, but before the default suffix rules.
+// Rules with a higher priority are checked first; among rules with the same
+// priority, the most recently defined is checked first. Defining a rule
+// with an existing pattern replaces it.
+//
+// Returns an error if the pattern is invalid.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNounRule("us$", "i", 0)
+//	e.Plural("hippopotamus") // returns "hippopotami"
+//	e.Plural("Octopus")      // returns "Octopi"
+//	e.DefNounRule("(ph|x)ylum$", "${1}yla", 1)
+//	e.Plural("phylum") // returns "phyla"
+func (e *Engine) DefNounRule(pattern, replacement string, priority int) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	e.nounRules = slices.DeleteFunc(e.nounRules, func(r nounRule) bool {
+		return r.pattern.String() == pattern
+	})
+	// Insert ahead of rules with the same or lower priority
+	i := 0
+	for i < len(e.nounRules) && e.nounRules[i].priority > priority {
+		i++
+	}
+	e.nounRules = slices.Insert(e.nounRules, i, nounRule{re, replacement, priority})
+	return nil
+}
+
+// UndefNounRule removes a pluralization rule defined with DefNounRule.
+//
+// The pattern string must match exactly as it was defined.
+// Returns true if the rule was found and removed, false otherwise.
+//
+// Examples:
+//
+//	DefNounRule("us$", "i", 0)
+//	Plural("hippopotamus") // returns "hippopotami"
+//	UndefNounRule("us$")
+//	Plural("hippopotamus") // returns "hippopotamuses" (default rule)
+func UndefNounRule(pattern string) bool {
+	return defaultEngine.UndefNounRule(pattern)
+}
+
+// UndefNounRule removes a pluralization rule defined with e.DefNounRule.
+//
+// The pattern string must match exactly as it was defined.
+// Returns true if the rule was found and removed, false otherwise.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNounRule("us$", "i", 0)
+//	e.Plural("hippopotamus") // returns "hippopotami"
+//	e.UndefNounRule("us$")
+//	e.Plural("hippopotamus") // returns "hippopotamuses" (default rule)
+func (e *Engine) UndefNounRule(pattern string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	n := len(e.nounRules)
+	e.nounRules = slices.DeleteFunc(e.nounRules, func(r nounRule) bool {
+		return r.pattern.String() == pattern
+	})
+	return len(e.nounRules) < n
+}
+
+// applyNounRules returns the plural from the first matching rule defined
+// with DefNounRule, and false if no rule matches.
+func (e *Engine) applyNounRules(word, lower string, x *explanation) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for _, r := range e.nounRules {
+		if r.pattern.MatchString(lower) {
+			plural := matchCase(word, r.pattern.ReplaceAllString(lower, r.replacement))
+			x.note("custom noun rule %q: %s -> %s", r.pattern.String(), word, plural)
+			return plural, true
+		}
+	}
+	return "", false
 }
 
 // DefVerb defines a custom verb conjugation rule.
@@ -165,6 +482,7 @@ func DefVerb(singular, plural string) {
 func (e *Engine) DefVerb(singular, plural string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 	lowerPlural := strings.ToLower(plural)
 	e.customVerbs[lower] = lowerPlural
@@ -201,6 +519,7 @@ func UndefVerb(singular string) bool {
 func (e *Engine) UndefVerb(singular string) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 	plural, exists := e.customVerbs[lower]
 	if !exists {
@@ -235,6 +554,7 @@ func DefVerbReset() {
 func (e *Engine) DefVerbReset() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customVerbs = make(map[string]string)
 	e.customVerbsReverse = make(map[string]string)
 }
@@ -271,6 +591,7 @@ func DefAdj(singular, plural string) {
 func (e *Engine) DefAdj(singular, plural string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 	lowerPlural := strings.ToLower(plural)
 	e.customAdjs[lower] = lowerPlural
@@ -307,6 +628,7 @@ func UndefAdj(singular string) bool {
 func (e *Engine) UndefAdj(singular string) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	lower := strings.ToLower(singular)
 	plural, exists := e.customAdjs[lower]
 	if !exists {
@@ -341,6 +663,7 @@ func DefAdjReset() {
 func (e *Engine) DefAdjReset() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 	e.customAdjs = make(map[string]string)
 	e.customAdjsReverse = make(map[string]string)
 }
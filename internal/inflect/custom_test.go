@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	inflect "github.com/cv/go-inflect/v2"
 )
@@ -257,6 +258,139 @@ func TestDefNounReset(t *testing.T) {
 	}
 }
 
+func TestDefNounMulti(t *testing.T) {
+	tests := []struct {
+		name      string
+		singular  string
+		modern    string
+		classical string
+		enable    func(e *inflect.Engine)
+	}{
+		{name: "classical ancient", singular: "virus", modern: "viruses", classical: "viri",
+			enable: func(e *inflect.Engine) { e.ClassicalAncient(true) }},
+		{name: "classical", singular: "virus", modern: "viruses", classical: "viri",
+			enable: func(e *inflect.Engine) { e.Classical(true) }},
+		{name: "classical all", singular: "prospectus", modern: "prospectuses", classical: "prospectus",
+			enable: func(e *inflect.Engine) { e.ClassicalAll(true) }},
+		{name: "overrides classical table", singular: "formula", modern: "formulas", classical: "formulæ",
+			enable: func(e *inflect.Engine) { e.ClassicalAncient(true) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.DefNounMulti(tt.singular, tt.modern, tt.classical)
+			assert.Equal(t, tt.modern, e.Plural(tt.singular))
+			assert.Equal(t, tt.singular, e.Singular(tt.modern))
+			assert.Equal(t, tt.singular, e.Singular(tt.classical))
+
+			tt.enable(e)
+			assert.Equal(t, tt.classical, e.Plural(tt.singular))
+			assert.Equal(t, tt.singular, e.Singular(tt.modern))
+			assert.Equal(t, tt.singular, e.Singular(tt.classical))
+		})
+	}
+}
+
+func TestDefNounMultiLifecycle(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	e.ClassicalAncient(true)
+	assert.Equal(t, "viruses", e.Plural("virus"))
+
+	e.DefNounMulti("Virus", "Viruses", "Viri")
+	assert.Equal(t, "viri", e.Plural("virus"), "cached result is cleared")
+	assert.Equal(t, "Viri", e.Plural("Virus"))
+	assert.Equal(t, "viri", e.Clone().Plural("virus"))
+	assert.Equal(t, "viruses", inflect.Plural("virus"), "default engine is unaffected")
+
+	e.DefNoun("virus", "virii")
+	assert.Equal(t, "virii", e.Plural("virus"), "DefNoun replaces both plurals")
+	assert.Equal(t, "viri", e.Singular("viri"))
+
+	e.DefNounMulti("virus", "viruses", "viri")
+	assert.True(t, e.UndefNoun("virus"))
+	assert.Equal(t, "viruses", e.Plural("virus"))
+	assert.Equal(t, "viri", e.Singular("viri"))
+
+	e.DefNounMulti("virus", "viruses", "viri")
+	e.DefNounMulti("child", "children", "childer")
+	assert.True(t, e.ResetWord("child"))
+	assert.Equal(t, "children", e.Plural("child"))
+	e.ResetCustomOnly()
+	assert.Equal(t, "viruses", e.Plural("virus"))
+
+	e.DefNounMulti("virus", "viruses", "viri")
+	e.DefNounReset()
+	assert.Equal(t, "viruses", e.Plural("virus"))
+
+	e.DefNounMulti("virus", "viruses", "viri")
+	e.Reset()
+	e.ClassicalAncient(true)
+	assert.Equal(t, "viruses", e.Plural("virus"))
+}
+
+func TestResetWord(t *testing.T) {
+	tests := []struct {
+		name     string
+		word     string
+		want     bool
+		plural   string
+		singular string
+	}{
+		{name: "overridden builtin", word: "child", want: true, plural: "children", singular: "child"},
+		{name: "case insensitive", word: "Child", want: true, plural: "children", singular: "child"},
+		{name: "custom noun", word: "foo", want: true, plural: "foos", singular: "foo"},
+		{name: "unchanged builtin", word: "mouse", want: false, plural: "mice", singular: "mouse"},
+		{name: "undefined noun", word: "cat", want: false, plural: "cats", singular: "cat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.DefNoun("child", "childs")
+			e.DefNoun("foo", "fooz")
+			e.DefNoun("widget", "widgetz")
+
+			assert.Equal(t, tt.want, e.ResetWord(tt.word))
+			assert.Equal(t, tt.plural, e.Plural(tt.singular))
+			assert.Equal(t, tt.singular, e.Singular(tt.plural))
+			assert.Equal(t, "widgetz", e.Plural("widget"), "other definitions are kept")
+		})
+	}
+}
+
+func TestResetWordRestoresSharedPlural(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	e.DefNoun("foo", "children")
+	assert.Equal(t, "foo", e.Singular("children"))
+
+	assert.True(t, e.ResetWord("foo"))
+	assert.Equal(t, "child", e.Singular("children"), "built-in singular is restored")
+	assert.Equal(t, "foos", e.Plural("foo"))
+}
+
+func TestResetCustomOnly(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	e.DefNoun("index", "indexes")
+	e.DefNoun("child", "childs")
+	e.DefNoun("foo", "fooz")
+	e.DefNoun("bar", "mice")
+	assert.Equal(t, "fooz", e.Plural("foo"))
+
+	e.ResetCustomOnly()
+	assert.Equal(t, "indexes", e.Plural("index"), "overridden builtins are kept")
+	assert.Equal(t, "childs", e.Plural("child"))
+	assert.Equal(t, "index", e.Singular("indexes"))
+	assert.Equal(t, "foos", e.Plural("foo"), "custom nouns are removed")
+	assert.Equal(t, "foo", e.Singular("foos"))
+	assert.Equal(t, "bars", e.Plural("bar"))
+	assert.Equal(t, "mouse", e.Singular("mice"))
+	assert.Equal(t, "children", inflect.Plural("child"), "default engine is unaffected")
+}
+
 func TestDefNounIntegration(t *testing.T) {
 	// Reset to defaults after this test
 	defer inflect.DefNounReset()
@@ -291,6 +425,90 @@ func TestDefNounIntegration(t *testing.T) {
 	})
 }
 
+func TestDefNounRule(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("us$", "i", 0))
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "rule applies", input: "hippopotamus", want: "hippopotami"},
+		{name: "case preserved", input: "Octopus", want: "Octopi"},
+		{name: "uppercase", input: "CAMPUS", want: "CAMPI"},
+		{name: "irregular table wins", input: "genus", want: "genera"},
+		{name: "uncountable wins", input: "apparatus", want: "apparatus"},
+		{name: "other words unaffected", input: "cat", want: "cats"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, e.Plural(tt.input))
+		})
+	}
+
+	// Package-level engine is unaffected
+	assert.Equal(t, "hippopotamuses", inflect.Plural("hippopotamus"))
+}
+
+func TestDefNounRuleCaptureGroups(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("(ph|x)ylum$", "${1}yla", 0))
+	assert.Equal(t, "phyla", e.Plural("phylum"))
+	assert.Equal(t, "xyla", e.Plural("xylum"))
+}
+
+func TestDefNounRulePriority(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("um$", "a", 0))
+	require.NoError(t, e.DefNounRule("ium$", "ii", 1))
+	assert.Equal(t, "fora", e.Plural("forum"))
+	assert.Equal(t, "premii", e.Plural("premium"), "higher priority rule checked first")
+
+	// Same priority: most recently defined wins
+	require.NoError(t, e.DefNounRule("mium$", "miums", 1))
+	assert.Equal(t, "premiums", e.Plural("premium"))
+	assert.Equal(t, "condominii", e.Plural("condominium"))
+
+	// Redefining a pattern replaces it
+	require.NoError(t, e.DefNounRule("mium$", "mia", 1))
+	assert.Equal(t, "premia", e.Plural("premium"))
+}
+
+func TestDefNounRuleExactWordsWin(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("us$", "i", 0))
+	e.DefNoun("octopus", "octopuses")
+	assert.Equal(t, "octopuses", e.Plural("octopus"))
+}
+
+func TestDefNounRuleInvalidPattern(t *testing.T) {
+	e := inflect.NewEngine()
+	require.Error(t, e.DefNounRule("(", "x", 0))
+	assert.Equal(t, "cats", e.Plural("cat"))
+}
+
+func TestUndefNounRule(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("us$", "i", 0))
+	assert.Equal(t, "hippopotami", e.Plural("hippopotamus"))
+
+	assert.True(t, e.UndefNounRule("us$"))
+	assert.Equal(t, "hippopotamuses", e.Plural("hippopotamus"))
+	assert.False(t, e.UndefNounRule("us$"), "already removed")
+}
+
+func TestNounRuleCloneAndReset(t *testing.T) {
+	e := inflect.NewEngine()
+	require.NoError(t, e.DefNounRule("us$", "i", 0))
+
+	clone := e.Clone()
+	e.Reset()
+	assert.Equal(t, "hippopotamuses", e.Plural("hippopotamus"))
+	assert.Equal(t, "hippopotami", clone.Plural("hippopotamus"))
+}
+
 func TestDefVerb(t *testing.T) {
 	// Reset to defaults after this test
 	defer inflect.DefVerbReset()
@@ -0,0 +1,130 @@
+package inflect
+
+import "strings"
+
+// definiteModifiers contains words that single out one thing, and so take
+// "the" when they start a noun phrase: "the first item", "the only way".
+var definiteModifiers = map[string]bool{
+	"first": true, "1st": true, "last": true, "only": true, "same": true,
+	"next": true, "previous": true, "following": true, "former": true,
+	"latter": true, "best": true, "worst": true, "farthest": true, "furthest": true,
+}
+
+// notSuperlatives contains common words ending in -est that are not
+// superlatives, although a regular adjective could produce them.
+var notSuperlatives = map[string]bool{
+	"arrest": true, "attest": true, "behest": true, "chest": true,
+	"conquest": true, "contest": true, "crest": true, "digest": true,
+	"earnest": true, "forest": true, "guest": true, "harvest": true,
+	"honest": true, "infest": true, "interest": true, "invest": true,
+	"jest": true, "lest": true, "manifest": true, "modest": true,
+	"nest": true, "pest": true, "priest": true, "protest": true,
+	"quest": true, "request": true, "rest": true, "suggest": true,
+	"test": true, "unrest": true, "vest": true, "west": true, "zest": true,
+}
+
+// The returns the phrase prefixed with the definite article "the".
+//
+// A phrase that already starts with "the" is returned unchanged.
+//
+// Examples:
+//   - The("1st item") returns "the 1st item"
+//   - The("cat") returns "the cat"
+//   - The("the cat") returns "the cat"
+func The(phrase string) string {
+	if phrase == "" || hasDefiniteArticle(phrase) {
+		return phrase
+	}
+	return "the " + phrase
+}
+
+// DefiniteArticleNeeded reports whether a noun phrase should take "the"
+// rather than "a" or "an", judging by its first word.
+//
+// Phrases that pick out a single thing need "the": those starting with
+// "first" or "1st", "last", "only", "same", "next", or a superlative such
+// as "best", "biggest", or "most" followed by an adjective. Other
+// ordinals usually mean "another", as in "a second chance", so they do
+// not. This is a heuristic; context can always call for either article.
+//
+// Examples:
+//   - DefiniteArticleNeeded("1st item") returns true
+//   - DefiniteArticleNeeded("2nd chance") returns false
+//   - DefiniteArticleNeeded("biggest dog") returns true
+//   - DefiniteArticleNeeded("most useful tool") returns true
+//   - DefiniteArticleNeeded("honest answer") returns false
+//   - DefiniteArticleNeeded("cat") returns false
+func DefiniteArticleNeeded(phrase string) bool {
+	fields := strings.Fields(phrase)
+	if len(fields) == 0 {
+		return false
+	}
+	_, first, _ := extractPunctuation(fields[0])
+	first = strings.ToLower(first)
+	switch {
+	case definiteModifiers[first]:
+		return true
+	case first == "most" || first == "least":
+		// "most useful tool", but not "most people"
+		return len(fields) > 2
+	}
+	return isSuperlative(first)
+}
+
+// TheOrAn returns the phrase prefixed with "the" if DefiniteArticleNeeded
+// reports that it needs a definite article, or with "a" or "an" otherwise.
+//
+// It is meant for generated prose that combines nouns with Ordinal or
+// Superlative.
+//
+// Examples:
+//   - TheOrAn("1st item") returns "the 1st item"
+//   - TheOrAn("2nd chance") returns "a 2nd chance"
+//   - TheOrAn("11th hour") returns "an 11th hour"
+//   - TheOrAn("oldest oak") returns "the oldest oak"
+func TheOrAn(phrase string) string {
+	return defaultEngine.TheOrAn(phrase)
+}
+
+// TheOrAn returns the phrase prefixed with "the" if DefiniteArticleNeeded
+// reports that it needs a definite article, or with e.An otherwise.
+//
+// Examples:
+//   - e.TheOrAn("1st item") returns "the 1st item"
+//   - e.TheOrAn("2nd chance") returns "a 2nd chance"
+//   - e.TheOrAn("11th hour") returns "an 11th hour"
+//   - e.TheOrAn("oldest oak") returns "the oldest oak"
+func (e *Engine) TheOrAn(phrase string) string {
+	if hasDefiniteArticle(phrase) || DefiniteArticleNeeded(phrase) {
+		return The(phrase)
+	}
+	return e.An(phrase)
+}
+
+// hasDefiniteArticle reports whether phrase starts with the word "the".
+func hasDefiniteArticle(phrase string) bool {
+	first, _, _ := strings.Cut(strings.TrimSpace(phrase), " ")
+	return strings.EqualFold(first, "the")
+}
+
+// isSuperlative reports whether a lowercase word is the -est superlative
+// of a regular adjective, such as "biggest", "largest", or "happiest".
+func isSuperlative(word string) bool {
+	if !strings.HasSuffix(word, "est") || notSuperlatives[word] {
+		return false
+	}
+	stem := strings.TrimSuffix(word, "est")
+	candidates := []string{stem, stem + "e"}
+	if strings.HasSuffix(stem, "i") {
+		candidates = append(candidates, strings.TrimSuffix(stem, "i")+"y")
+	}
+	if n := len(stem); n >= 2 && stem[n-1] == stem[n-2] {
+		candidates = append(candidates, stem[:n-1])
+	}
+	for _, adj := range candidates {
+		if len(adj) >= 2 && Superlative(adj) == word {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestThe(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "noun", input: "cat", want: "the cat"},
+		{name: "ordinal", input: "1st item", want: "the 1st item"},
+		{name: "already definite", input: "the cat", want: "the cat"},
+		{name: "already definite capitalized", input: "The cat", want: "The cat"},
+		{name: "starts with the letters", input: "theory", want: "the theory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.The(tt.input))
+		})
+	}
+}
+
+func TestDefiniteArticleNeeded(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		// First, last, and other words that single out one thing
+		{name: "1st", input: "1st item", want: true},
+		{name: "first", input: "first item", want: true},
+		{name: "First", input: "First place", want: true},
+		{name: "last", input: "last chance", want: true},
+		{name: "only", input: "only way", want: true},
+		{name: "same", input: "same reason", want: true},
+		{name: "next", input: "next step", want: true},
+
+		// Superlatives
+		{name: "best", input: "best answer", want: true},
+		{name: "worst", input: "worst case", want: true},
+		{name: "regular", input: "oldest oak", want: true},
+		{name: "doubled consonant", input: "biggest dog", want: true},
+		{name: "silent e", input: "largest city", want: true},
+		{name: "y to i", input: "happiest day", want: true},
+		{name: "most adjective", input: "most useful tool", want: true},
+		{name: "least adjective", input: "least likely outcome", want: true},
+		{name: "most noun", input: "most people", want: false},
+
+		// Other ordinals mean "another"
+		{name: "2nd", input: "2nd chance", want: false},
+		{name: "second", input: "second opinion", want: false},
+		{name: "21st", input: "21st attempt", want: false},
+
+		// Words ending in -est that are not superlatives
+		{name: "honest", input: "honest answer", want: false},
+		{name: "interest", input: "interest rate", want: false},
+		{name: "test", input: "test case", want: false},
+		{name: "forest", input: "forest path", want: false},
+
+		{name: "noun", input: "cat", want: false},
+		{name: "adjective", input: "big dog", want: false},
+		{name: "empty", input: "", want: false},
+		{name: "quoted", input: "\"first\" attempt", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DefiniteArticleNeeded(tt.input))
+		})
+	}
+}
+
+func TestTheOrAn(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "first", input: inflect.Ordinal(1) + " item", want: "the 1st item"},
+		{name: "second", input: inflect.Ordinal(2) + " chance", want: "a 2nd chance"},
+		{name: "vowel sound ordinal", input: inflect.Ordinal(11) + " hour", want: "an 11th hour"},
+		{name: "eighth", input: "8th attempt", want: "an 8th attempt"},
+		{name: "superlative", input: inflect.Superlative("old") + " oak", want: "the oldest oak"},
+		{name: "plain noun", input: "apple", want: "an apple"},
+		{name: "already definite", input: "the end", want: "the end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.TheOrAn(tt.input))
+		})
+	}
+}
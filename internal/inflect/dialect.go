@@ -0,0 +1,197 @@
+package inflect
+
+import "strings"
+
+// Dialect is a variety of English whose pronunciation An and whose verb
+// spelling PresentParticiple, PastTense, and PastParticiple follow where
+// varieties differ.
+type Dialect int
+
+const (
+	// DialectDefault is the default: American English, except that verbs
+	// keep the -ise or -ize spelling they are given.
+	// Example: "herb" -> "an herb", "travel" -> "traveling",
+	// "realise" -> "realising"
+	DialectDefault Dialect = iota
+
+	// DialectUS is American English, in which verbs are spelled -ize.
+	// Example: "herb" -> "an herb", "travel" -> "traveling",
+	// "realise" -> "realizing"
+	DialectUS
+
+	// DialectUK is British English, in which the "h" of "herb" is sounded,
+	// a final l is doubled before -ing and -ed, and verbs are spelled -ise.
+	// Example: "herb" -> "a herb", "travel" -> "travelling",
+	// "realize" -> "realising"
+	DialectUK
+)
+
+// dialects lists the supported dialects, for analyses that accept the
+// spellings of any of them.
+var dialects = []Dialect{DialectUS, DialectUK}
+
+// dialectArticles maps each dialect to the lowercase words whose article
+// in it differs from the pronunciation dictionary, and whether they take
+// "an".
+var dialectArticles = map[Dialect]map[string]bool{
+	DialectUK: {
+		"herb": false, "herbs": false, "herbal": false, "herbalism": false,
+		"herbalist": false, "herbalists": false,
+	},
+}
+
+// ukDoubleConsonantWords contains verbs ending in a consonant other than l
+// whose final consonant is doubled in British English only.
+var ukDoubleConsonantWords = map[string]bool{
+	"kidnap": true, "worship": true,
+}
+
+// izeVerbStems contains the lowercase stems of verbs spelled -ize or -yze in
+// American English and -ise or -yse in British English, without the final
+// "ze" or "se". Verbs spelled -ise in both, such as "advise" and
+// "surprise", and -ize in both, such as "size" and "seize", are not listed.
+var izeVerbStems = map[string]bool{
+	"agoni": true, "apologi": true, "authori": true, "capitali": true,
+	"categori": true, "centrali": true, "characteri": true, "civili": true,
+	"coloni": true, "commerciali": true, "critici": true, "customi": true,
+	"democrati": true, "digiti": true, "dramati": true, "economi": true,
+	"emphasi": true, "energi": true, "familiari": true, "fantasi": true,
+	"finali": true, "formali": true, "generali": true, "globali": true,
+	"harmoni": true, "hospitali": true, "humani": true, "hypnoti": true,
+	"hypothesi": true, "ideali": true, "immuni": true, "industriali": true,
+	"initiali": true, "itemi": true, "jeopardi": true, "legali": true,
+	"legitimi": true, "liberali": true, "locali": true, "magneti": true,
+	"maximi": true, "memori": true, "minimi": true, "mobili": true,
+	"moderni": true, "monopoli": true, "morali": true, "naturali": true,
+	"neutrali": true, "normali": true, "optimi": true, "organi": true,
+	"patroni": true, "penali": true, "personali": true, "polari": true,
+	"populari": true, "prioriti": true, "randomi": true, "rationali": true,
+	"reali": true, "recogni": true, "regulari": true, "revolutioni": true,
+	"saniti": true, "scandali": true, "scrutini": true, "seriali": true,
+	"sociali": true, "speciali": true, "stabili": true, "standardi": true,
+	"sterili": true, "stigmati": true, "subsidi": true, "summari": true,
+	"symboli": true, "sympathi": true, "synchroni": true, "terrori": true,
+	"theori": true, "tokeni": true, "unioni": true, "urbani": true,
+	"utili": true, "vandali": true, "visuali": true, "vocali": true,
+
+	// -yze and -yse
+	"analy": true, "breathaly": true, "cataly": true, "dialy": true,
+	"electroly": true, "hydroly": true, "paraly": true,
+}
+
+// verbInDialect respells a verb with the -ize or -ise ending of a dialect
+// (realise -> realize in American English, realize -> realise in British
+// English). Verbs not in izeVerbStems, and all verbs in DialectDefault, are
+// returned unchanged.
+func verbInDialect(verb string, d Dialect) string {
+	if d == DialectDefault {
+		return verb
+	}
+	lower := strings.ToLower(verb)
+	n := len(lower)
+	if n != len(verb) || n < 4 || lower[n-1] != 'e' || !izeVerbStems[lower[:n-2]] {
+		return verb
+	}
+	from, to := byte('s'), byte('z')
+	if d == DialectUK {
+		from, to = 'z', 's'
+	}
+	if lower[n-2] != from {
+		return verb
+	}
+	if verb[n-2] >= 'A' && verb[n-2] <= 'Z' {
+		to -= 'a' - 'A'
+	}
+	return verb[:n-2] + string(to) + verb[n-1:]
+}
+
+// doublesInDialect reports whether the final consonant of a lowercase verb
+// is doubled before -ing and -ed in a dialect, where American English does
+// not double it: British English doubles a final l after a single vowel
+// whatever the stress (travel -> travelling, fuel -> fuelled).
+func doublesInDialect(lower string, d Dialect) bool {
+	if d != DialectUK {
+		return false
+	}
+	if ukDoubleConsonantWords[lower] {
+		return true
+	}
+	n := len(lower)
+	if n < 4 || lower[n-1] != 'l' || !isVowel(rune(lower[n-2])) || lower == "parallel" {
+		return false
+	}
+	// Vowel pairs sounded as one vowel do not double (feel, conceal, boil),
+	// but those sounded as two do (dial, equal, fuel). Words of one
+	// syllable follow the general rule, so "spelled" is read as "spell",
+	// not "spel".
+	switch lower[n-3 : n-1] {
+	case "ia", "ua", "ue":
+		return true
+	}
+	return !isVowel(rune(lower[n-3])) && countSyllables(lower) >= 2
+}
+
+// SetDialect sets the variety of English whose pronunciation An and whose
+// spelling PresentParticiple, PastTense, and PastParticiple follow.
+//
+// Only the article of words pronounced differently is affected, such as
+// "herb", whose "h" is silent in American English but not in British
+// English, the doubling of final consonants, such as "traveled" and
+// "travelled", and the -ize or -ise ending of verbs, such as "realizing"
+// and "realising". Until a dialect is set, the default, DialectDefault,
+// follows American English but keeps the -ise or -ize spelling of the
+// verb given. Definitions made with DefA, DefAn, DefSilentH, and
+// DefSoundedVowel take precedence. Unknown dialects are treated as
+// DialectUS.
+//
+// Examples:
+//
+//	An("herb") // returns "an herb"
+//	PastTense("realise") // returns "realised"
+//	SetDialect(DialectUK)
+//	An("herb") // returns "a herb"
+//	PastTense("cancel") // returns "cancelled"
+//	SetDialect(DialectUS)
+//	PastTense("realise") // returns "realized"
+func SetDialect(d Dialect) {
+	defaultEngine.SetDialect(d)
+}
+
+// SetDialect sets the variety of English whose pronunciation e.An and
+// whose spelling e.PresentParticiple, e.PastTense, and e.PastParticiple
+// follow.
+// See the package-level SetDialect for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetDialect(DialectUK)
+//	e.An("herbal tea") // returns "a herbal tea"
+func (e *Engine) SetDialect(d Dialect) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dialect = d
+}
+
+// GetDialect returns the dialect set with SetDialect.
+//
+// Examples:
+//
+//	GetDialect() // returns DialectDefault
+//	SetDialect(DialectUK)
+//	GetDialect() // returns DialectUK
+func GetDialect() Dialect {
+	return defaultEngine.GetDialect()
+}
+
+// GetDialect returns the dialect set with e.SetDialect.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.GetDialect() // returns DialectDefault
+func (e *Engine) GetDialect() Dialect {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.dialect
+}
@@ -0,0 +1,142 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestSetDialect(t *testing.T) {
+	tests := []struct {
+		word string
+		us   string
+		uk   string
+	}{
+		{word: "herb", us: "an herb", uk: "a herb"},
+		{word: "Herbs", us: "an Herbs", uk: "a Herbs"},
+		{word: "herbal tea", us: "an herbal tea", uk: "a herbal tea"},
+		{word: "herb,", us: "an herb,", uk: "a herb,"},
+		{word: "hour", us: "an hour", uk: "an hour"},
+		{word: "house", us: "a house", uk: "a house"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.EnableCache(10)
+			assert.Equal(t, tt.us, e.An(tt.word))
+
+			e.SetDialect(inflect.DialectUK)
+			assert.Equal(t, tt.uk, e.An(tt.word), "cached result is not used")
+			assert.Equal(t, tt.uk, e.Clone().An(tt.word))
+
+			e.Reset()
+			assert.Equal(t, tt.us, e.An(tt.word))
+		})
+	}
+}
+
+func TestSetDialectCustomWordsWin(t *testing.T) {
+	e := inflect.NewEngine()
+	e.SetDialect(inflect.DialectUK)
+	e.DefAn("herb")
+	assert.Equal(t, "an herb", e.An("herb"))
+}
+
+func TestGetDialect(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, inflect.DialectDefault, e.GetDialect())
+	e.SetDialect(inflect.DialectUK)
+	assert.Equal(t, inflect.DialectUK, e.GetDialect())
+}
+
+func TestDialectVerbSpelling(t *testing.T) {
+	tests := []struct {
+		verb   string
+		usIng  string
+		ukIng  string
+		usPast string
+		ukPast string
+	}{
+		{verb: "travel", usIng: "traveling", ukIng: "travelling", usPast: "traveled", ukPast: "travelled"},
+		{verb: "Cancel", usIng: "Canceling", ukIng: "Cancelling", usPast: "Canceled", ukPast: "Cancelled"},
+		{verb: "model", usIng: "modeling", ukIng: "modelling", usPast: "modeled", ukPast: "modelled"},
+		{verb: "fuel", usIng: "fueling", ukIng: "fuelling", usPast: "fueled", ukPast: "fuelled"},
+		{verb: "dial", usIng: "dialing", ukIng: "dialling", usPast: "dialed", ukPast: "dialled"},
+		{verb: "equal", usIng: "equaling", ukIng: "equalling", usPast: "equaled", ukPast: "equalled"},
+		{verb: "worship", usIng: "worshiping", ukIng: "worshipping", usPast: "worshiped", ukPast: "worshipped"},
+		{verb: "kidnap", usIng: "kidnaping", ukIng: "kidnapping", usPast: "kidnaped", ukPast: "kidnapped"},
+		{verb: "compel", usIng: "compelling", ukIng: "compelling", usPast: "compelled", ukPast: "compelled"},
+		{verb: "conceal", usIng: "concealing", ukIng: "concealing", usPast: "concealed", ukPast: "concealed"},
+		{verb: "parallel", usIng: "paralleling", ukIng: "paralleling", usPast: "paralleled", ukPast: "paralleled"},
+		{verb: "realise", usIng: "realizing", ukIng: "realising", usPast: "realized", ukPast: "realised"},
+		{verb: "realize", usIng: "realizing", ukIng: "realising", usPast: "realized", ukPast: "realised"},
+		{verb: "Organize", usIng: "Organizing", ukIng: "Organising", usPast: "Organized", ukPast: "Organised"},
+		{verb: "PRIORITISE", usIng: "PRIORITIZING", ukIng: "PRIORITISING", usPast: "PRIORITIZED", ukPast: "PRIORITISED"},
+		{verb: "analyse", usIng: "analyzing", ukIng: "analysing", usPast: "analyzed", ukPast: "analysed"},
+		{verb: "advise", usIng: "advising", ukIng: "advising", usPast: "advised", ukPast: "advised"},
+		{verb: "seize", usIng: "seizing", ukIng: "seizing", usPast: "seized", ukPast: "seized"},
+		{verb: "walk", usIng: "walking", ukIng: "walking", usPast: "walked", ukPast: "walked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.verb, func(t *testing.T) {
+			assert.Equal(t, tt.usIng, inflect.PresentParticipleIn(tt.verb, inflect.DialectUS))
+			assert.Equal(t, tt.ukIng, inflect.PresentParticipleIn(tt.verb, inflect.DialectUK))
+			assert.Equal(t, tt.usPast, inflect.PastTenseIn(tt.verb, inflect.DialectUS))
+			assert.Equal(t, tt.ukPast, inflect.PastTenseIn(tt.verb, inflect.DialectUK))
+			assert.Equal(t, tt.usPast, inflect.PastParticipleIn(tt.verb, inflect.DialectUS))
+			assert.Equal(t, tt.ukPast, inflect.PastParticipleIn(tt.verb, inflect.DialectUK))
+
+			e := inflect.NewEngine(inflect.WithDialect(inflect.DialectUS))
+			assert.Equal(t, tt.usIng, e.PresentParticiple(tt.verb))
+			e.SetDialect(inflect.DialectUK)
+			assert.Equal(t, tt.ukIng, e.PresentParticiple(tt.verb))
+			assert.Equal(t, tt.ukPast, e.PastTense(tt.verb))
+			assert.Equal(t, tt.ukPast, e.PastParticiple(tt.verb))
+		})
+	}
+}
+
+func TestDialectDefaultKeepsVerbSpelling(t *testing.T) {
+	tests := []struct {
+		verb string
+		ing  string
+		past string
+	}{
+		{verb: "realise", ing: "realising", past: "realised"},
+		{verb: "realize", ing: "realizing", past: "realized"},
+		{verb: "Organise", ing: "Organising", past: "Organised"},
+		{verb: "analyse", ing: "analysing", past: "analysed"},
+		{verb: "analyze", ing: "analyzing", past: "analyzed"},
+		{verb: "travel", ing: "traveling", past: "traveled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.verb, func(t *testing.T) {
+			assert.Equal(t, tt.ing, inflect.PresentParticiple(tt.verb))
+			assert.Equal(t, tt.past, inflect.PastTense(tt.verb))
+			assert.Equal(t, tt.past, inflect.PastParticiple(tt.verb))
+			assert.Equal(t, tt.ing, inflect.PresentParticipleIn(tt.verb, inflect.DialectDefault))
+
+			e := inflect.NewEngine()
+			e.SetDialect(inflect.DialectUK)
+			e.Reset()
+			assert.Equal(t, tt.ing, e.PresentParticiple(tt.verb), "Reset restores the default")
+		})
+	}
+}
+
+func TestDialectVerbSpellingDefaultEngine(t *testing.T) {
+	defer inflect.SetDialect(inflect.DialectDefault)
+
+	assert.Equal(t, "traveled", inflect.PastTense("travel"))
+	inflect.SetDialect(inflect.DialectUK)
+	assert.Equal(t, "travelled", inflect.PastTense("travel"))
+	assert.Equal(t, "travelling", inflect.PresentParticiple("travel"))
+	assert.Equal(t, "traveling", inflect.PresentParticipleIn("travel", inflect.DialectUS), "per-call dialect wins")
+	assert.Equal(t, "realised", inflect.PastTense("realize"))
+	assert.Equal(t, "realized", inflect.PastTenseIn("realize", inflect.DialectUS), "per-call dialect wins")
+}
@@ -0,0 +1,72 @@
+package inflect
+
+import "strings"
+
+// DigitsToWordsOptions controls how DigitsToWordsWithOptions reads a digit string.
+//
+// The zero value reads zero as "zero" and separates digit groups with ", ".
+type DigitsToWordsOptions struct {
+	// Zero is the word used for the digit 0, such as "oh". Empty means "zero".
+	Zero string
+
+	// Separator is placed between digit groups to mark a pause when read
+	// aloud. Empty means ", ". Use " " to read all digits without pauses.
+	Separator string
+}
+
+// DigitsToWords reads a string of digits aloud, one digit at a time.
+//
+// Unlike NumberToWordsGrouped, which works on an int, this operates on the
+// string directly and so preserves leading zeros. Runs of non-digit
+// characters (dashes, spaces, parentheses, dots, and so on) split the digits
+// into groups, which are separated by commas. Strings without any digits
+// return an empty string.
+//
+// Examples:
+//   - DigitsToWords("415-555-0123") returns "four one five, five five five, zero one two three"
+//   - DigitsToWords("(020) 7946 0000") returns "zero two zero, seven nine four six, zero zero zero zero"
+//   - DigitsToWords("007") returns "zero zero seven"
+//   - DigitsToWords("no digits") returns ""
+func DigitsToWords(s string) string {
+	return DigitsToWordsWithOptions(s, DigitsToWordsOptions{})
+}
+
+// DigitsToWordsWithOptions reads a string of digits aloud, one digit at a
+// time, using the word for zero and group separator in opts.
+//
+// Examples:
+//   - DigitsToWordsWithOptions("415-555-0123", DigitsToWordsOptions{Zero: "oh"})
+//     returns "four one five, five five five, oh one two three"
+//   - DigitsToWordsWithOptions("415-555-0123", DigitsToWordsOptions{Separator: " "})
+//     returns "four one five five five five zero one two three"
+//   - DigitsToWordsWithOptions("1.2.3", DigitsToWordsOptions{Separator: " dot "})
+//     returns "one dot two dot three"
+func DigitsToWordsWithOptions(s string, opts DigitsToWordsOptions) string {
+	zero := opts.Zero
+	if zero == "" {
+		zero = wordZero
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = ", "
+	}
+
+	groups := strings.FieldsFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+
+	words := make([]string, 0, len(groups))
+	for _, group := range groups {
+		digits := make([]string, 0, len(group))
+		for _, ch := range group {
+			if ch == '0' {
+				digits = append(digits, zero)
+				continue
+			}
+			digits = append(digits, digitWords[ch-'0'])
+		}
+		words = append(words, strings.Join(digits, " "))
+	}
+
+	return strings.Join(words, sep)
+}
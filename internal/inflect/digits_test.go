@@ -0,0 +1,80 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestDigitsToWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "phone number", input: "415-555-0123", want: "four one five, five five five, zero one two three"},
+		{name: "parentheses and spaces", input: "(020) 7946 0000", want: "zero two zero, seven nine four six, zero zero zero zero"},
+		{name: "leading zeros", input: "007", want: "zero zero seven"},
+		{name: "all digits", input: "0123456789", want: "zero one two three four five six seven eight nine"},
+		{name: "international prefix", input: "+1 415", want: "one, four one five"},
+		{name: "repeated separators", input: "12--34", want: "one two, three four"},
+		{name: "leading and trailing separators", input: "-12-", want: "one two"},
+		{name: "non-ASCII digits ignored", input: "12٣4", want: "one two, four"},
+		{name: "no digits", input: "no digits", want: ""},
+		{name: "empty", input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DigitsToWords(tt.input))
+		})
+	}
+}
+
+func TestDigitsToWordsWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  inflect.DigitsToWordsOptions
+		want  string
+	}{
+		{
+			name:  "zero options",
+			input: "415-555-0123",
+			opts:  inflect.DigitsToWordsOptions{},
+			want:  "four one five, five five five, zero one two three",
+		},
+		{
+			name:  "oh for zero",
+			input: "415-555-0123",
+			opts:  inflect.DigitsToWordsOptions{Zero: "oh"},
+			want:  "four one five, five five five, oh one two three",
+		},
+		{
+			name:  "no pauses",
+			input: "415-555-0123",
+			opts:  inflect.DigitsToWordsOptions{Separator: " "},
+			want:  "four one five five five five zero one two three",
+		},
+		{
+			name:  "custom separator",
+			input: "1.2.3",
+			opts:  inflect.DigitsToWordsOptions{Separator: " dot "},
+			want:  "one dot two dot three",
+		},
+		{
+			name:  "oh and custom separator",
+			input: "10 20",
+			opts:  inflect.DigitsToWordsOptions{Zero: "oh", Separator: "; "},
+			want:  "one oh; two oh",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DigitsToWordsWithOptions(tt.input, tt.opts))
+		})
+	}
+}
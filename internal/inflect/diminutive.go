@@ -0,0 +1,153 @@
+package inflect
+
+import "strings"
+
+// diminutives maps nouns to their conventional diminutive forms.
+var diminutives = map[string]string{
+	"bear":    "bear cub",
+	"bird":    "birdie",
+	"book":    "booklet",
+	"brook":   "brooklet",
+	"bull":    "bullock",
+	"cat":     "kitty",
+	"cigar":   "cigarette",
+	"cow":     "calf",
+	"disk":    "diskette",
+	"dog":     "doggy",
+	"drop":    "droplet",
+	"duck":    "duckling",
+	"goose":   "gosling",
+	"hill":    "hillock",
+	"horse":   "foal",
+	"island":  "islet",
+	"kitchen": "kitchenette",
+	"lamb":    "lambkin",
+	"leaf":    "leaflet",
+	"lion":    "lion cub",
+	"man":     "manikin",
+	"owl":     "owlet",
+	"pig":     "piglet",
+	"ring":    "ringlet",
+	"river":   "rivulet",
+	"sheep":   "lamb",
+	"star":    "starlet",
+	"statue":  "statuette",
+	"towel":   "towelette",
+	"wolf":    "wolf cub",
+}
+
+// diminutiveSuffixes lists endings of words that are already diminutive.
+var diminutiveSuffixes = []string{"let", "ling", "ette", "kin"}
+
+// lingEndings lists the noun endings that take "-ling" rather than "-let".
+var lingEndings = []string{"ck", "eed", "st"}
+
+// Diminutive returns a diminutive form of a noun, naming a small or young
+// version of it.
+//
+// Common nouns are looked up in a built-in table ("dog" -> "doggy",
+// "goose" -> "gosling"). Other nouns take a suffix: "-ling" after "ck",
+// "eed", and "st", and "-let" otherwise. Words that already end in a
+// diminutive suffix are returned unchanged. Use DefDiminutive to add or
+// override entries.
+//
+// Examples:
+//   - Diminutive("dog") returns "doggy"
+//   - Diminutive("pig") returns "piglet"
+//   - Diminutive("goose") returns "gosling"
+//   - Diminutive("plate") returns "platelet"
+//   - Diminutive("chick") returns "chickling"
+//   - Diminutive("Book") returns "Booklet"
+//   - Diminutive("piglet") returns "piglet"
+func Diminutive(word string) string {
+	return defaultEngine.Diminutive(word)
+}
+
+// Diminutive returns a diminutive form of a noun, naming a small or young
+// version of it.
+//
+// Common nouns are looked up in a built-in table ("dog" -> "doggy",
+// "goose" -> "gosling"). Other nouns take a suffix: "-ling" after "ck",
+// "eed", and "st", and "-let" otherwise. Words that already end in a
+// diminutive suffix are returned unchanged. Use e.DefDiminutive to add or
+// override entries.
+//
+// Examples:
+//   - e.Diminutive("dog") returns "doggy"
+//   - e.Diminutive("pig") returns "piglet"
+//   - e.Diminutive("goose") returns "gosling"
+//   - e.Diminutive("plate") returns "platelet"
+//   - e.Diminutive("chick") returns "chickling"
+//   - e.Diminutive("Book") returns "Booklet"
+//   - e.Diminutive("piglet") returns "piglet"
+func (e *Engine) Diminutive(word string) string {
+	prefix, trimmed, suffix := extractWhitespace(word)
+	if trimmed == "" {
+		return word
+	}
+	lower := strings.ToLower(trimmed)
+
+	e.mu.RLock()
+	custom, ok := e.customDiminutives[lower]
+	e.mu.RUnlock()
+	if ok {
+		return prefix + matchCase(trimmed, custom) + suffix
+	}
+	if dim, ok := diminutives[lower]; ok {
+		return prefix + matchCase(trimmed, dim) + suffix
+	}
+
+	for _, s := range diminutiveSuffixes {
+		if strings.HasSuffix(lower, s) {
+			return word
+		}
+	}
+	if isNonLatinWord(trimmed) {
+		return word
+	}
+
+	ending := "let"
+	for _, s := range lingEndings {
+		if strings.HasSuffix(lower, s) {
+			ending = "ling"
+			break
+		}
+	}
+	return prefix + trimmed + matchSuffix(trimmed, ending) + suffix
+}
+
+// DefDiminutive defines the diminutive form of a noun.
+//
+// Both words are stored in lowercase, and the result of Diminutive matches
+// the case of its input. Custom definitions take precedence over the
+// built-in table and suffix rules.
+//
+// Examples:
+//
+//	DefDiminutive("cat", "kitten")
+//	Diminutive("cat")  // returns "kitten"
+//	Diminutive("Cat")  // returns "Kitten"
+func DefDiminutive(word, diminutive string) {
+	defaultEngine.DefDiminutive(word, diminutive)
+}
+
+// DefDiminutive defines the diminutive form of a noun.
+//
+// Both words are stored in lowercase, and the result of e.Diminutive matches
+// the case of its input. Custom definitions take precedence over the
+// built-in table and suffix rules.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefDiminutive("cat", "kitten")
+//	e.Diminutive("cat")  // returns "kitten"
+//	e.Diminutive("Cat")  // returns "Kitten"
+func (e *Engine) DefDiminutive(word, diminutive string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.customDiminutives == nil {
+		e.customDiminutives = make(map[string]string)
+	}
+	e.customDiminutives[strings.ToLower(word)] = strings.ToLower(diminutive)
+}
@@ -0,0 +1,70 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestDiminutive(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want string
+	}{
+		// Table lookups
+		{name: "dog", word: "dog", want: "doggy"},
+		{name: "cat", word: "cat", want: "kitty"},
+		{name: "goose", word: "goose", want: "gosling"},
+		{name: "pig", word: "pig", want: "piglet"},
+		{name: "kitchen", word: "kitchen", want: "kitchenette"},
+		{name: "multi-word", word: "lion", want: "lion cub"},
+
+		// Suffix heuristics
+		{name: "let default", word: "plate", want: "platelet"},
+		{name: "let consonant", word: "stream", want: "streamlet"},
+		{name: "ling after ck", word: "chick", want: "chickling"},
+		{name: "ling after eed", word: "seed", want: "seedling"},
+		{name: "ling after st", word: "nest", want: "nestling"},
+
+		// Already diminutive
+		{name: "let suffix", word: "piglet", want: "piglet"},
+		{name: "ling suffix", word: "duckling", want: "duckling"},
+		{name: "ette suffix", word: "statuette", want: "statuette"},
+		{name: "kin suffix", word: "lambkin", want: "lambkin"},
+
+		// Case and whitespace
+		{name: "titlecase", word: "Book", want: "Booklet"},
+		{name: "uppercase table", word: "DOG", want: "DOGGY"},
+		{name: "uppercase heuristic", word: "PLATE", want: "PLATELET"},
+		{name: "whitespace", word: " pig ", want: " piglet "},
+		{name: "empty", word: "", want: ""},
+		{name: "non-Latin", word: "собака", want: "собака"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Diminutive(tt.word))
+		})
+	}
+}
+
+func TestDefDiminutive(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefDiminutive("Cat", "Kitten")
+	e.DefDiminutive("cloud", "cloudlet")
+
+	assert.Equal(t, "kitten", e.Diminutive("cat"))
+	assert.Equal(t, "Kitten", e.Diminutive("Cat"))
+	assert.Equal(t, "cloudlet", e.Diminutive("cloud"))
+
+	// Other engines are unaffected
+	assert.Equal(t, "kitty", inflect.Diminutive("cat"))
+
+	clone := e.Clone()
+	e.Reset()
+	assert.Equal(t, "kitty", e.Diminutive("cat"))
+	assert.Equal(t, "kitten", clone.Diminutive("cat"))
+}
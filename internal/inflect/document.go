@@ -0,0 +1,440 @@
+package inflect
+
+import "strings"
+
+// Token is a word of a document, classified by InflectDocument.
+type Token struct {
+	// Text is the word as written, including any apostrophe.
+	Text string
+
+	// Index is the position of the word among the words of the document,
+	// and Offset its byte offset in the document.
+	Index, Offset int
+
+	// POS is the guessed part of speech. Articles and other determiners,
+	// such as "a" and "these", are classified as adjectives.
+	POS PartOfSpeech
+
+	// Confidence is the confidence of the guess, between 0 and 1.
+	Confidence float64
+
+	// Plural reports whether a noun, pronoun, or determiner is plural, or
+	// a present tense verb agrees with a plural subject.
+	Plural bool
+
+	// Prev and Next are the words before and after this one in the same
+	// sentence, in lowercase and with straight apostrophes, or "" at the
+	// start or end of a sentence.
+	Prev, Next string
+}
+
+// Transform is the change InflectDocument makes to a token, as chosen by
+// the caller's rule.
+type Transform int
+
+const (
+	// TransformNone leaves the token unchanged.
+	// Example: "cat" -> "cat"
+	TransformNone Transform = iota
+
+	// TransformPlural makes a singular noun, pronoun, verb, or determiner
+	// plural. Tokens that are already plural are left unchanged.
+	// Example: "a" -> "some", "cat" -> "cats", "runs" -> "run"
+	TransformPlural
+
+	// TransformSingular makes a plural noun, pronoun, verb, or determiner
+	// singular. Tokens that are already singular are left unchanged.
+	// Example: "these" -> "this", "children" -> "child", "are" -> "is"
+	TransformSingular
+)
+
+// documentDeterminers contains the determiners that InflectDocument
+// classifies as adjectives, in addition to questionDeterminers.
+var documentDeterminers = map[string]bool{
+	"this": true, "that": true, "these": true, "those": true, "each": true,
+	"every": true,
+}
+
+// otherWords contains conjunctions, prepositions, and adverbs that
+// InflectDocument classifies as POSOther, in addition to functionWords.
+var otherWords = map[string]bool{
+	"again": true, "against": true, "although": true, "around": true,
+	"because": true, "between": true, "down": true, "if": true, "just": true,
+	"nor": true, "off": true, "out": true, "since": true, "so": true,
+	"than": true, "though": true, "through": true, "too": true,
+	"until": true, "up": true, "very": true, "when": true, "where": true,
+	"while": true, "yet": true,
+}
+
+// personalSubjects contains the subject pronouns that are followed by a
+// verb.
+var personalSubjects = map[string]bool{
+	"i": true, "you": true, "he": true, "she": true, "it": true, "we": true,
+	"they": true,
+}
+
+// InflectDocument applies a rule to every word of a piece of prose and
+// returns the text with the transformed words.
+//
+// The text is split into words, and each word is classified as a noun,
+// verb, adjective, or pronoun using GuessPOS, adjusted by its neighbors: a
+// word after a determiner such as "the" is taken for a noun, and a word
+// after a subject such as "it" or "the user" is taken for a verb when it
+// can be one. The rule is called with each classified Token and returns the
+// Transform to apply. Prepositions, conjunctions, and adverbs are
+// classified as POSOther and are never changed. Everything between the
+// words is kept as it is, and the case of each word is preserved.
+//
+// This is a lightweight heuristic meant for experiments such as
+// pluralizing every noun of a paragraph, not a parser: words that can be
+// more than one part of speech are sometimes misclassified, and rules
+// should check Confidence where that matters. A nil rule returns the text
+// unchanged.
+//
+// Examples:
+//
+//	nouns := func(t Token) Transform {
+//		if t.POS == POSNoun {
+//			return TransformPlural
+//		}
+//		return TransformNone
+//	}
+//	InflectDocument("The cat sat on the mat.", nouns) // returns "The cats sat on the mats."
+//
+//	all := func(t Token) Transform { return TransformPlural }
+//	InflectDocument("A user logs in.", all) // returns "Some users log in."
+func InflectDocument(text string, rule func(token Token) Transform) string {
+	return defaultEngine.InflectDocument(text, rule)
+}
+
+// InflectDocument applies a rule to every word of a piece of prose and
+// returns the text with the transformed words. See the package-level
+// InflectDocument for details.
+//
+// Examples:
+//
+//	all := func(t Token) Transform { return TransformPlural }
+//	e.InflectDocument("A user logs in.", all) // returns "Some users log in."
+func (e *Engine) InflectDocument(text string, rule func(token Token) Transform) string {
+	if rule == nil {
+		return text
+	}
+	tokens := e.tokenize(text)
+
+	var b strings.Builder
+	last := 0
+	results := make([]string, len(tokens))
+	for i, tok := range tokens {
+		t := rule(tok)
+		results[i] = e.transform(tok, t)
+		if t != TransformNone && tok.POS == POSVerb && personalSubjects[tok.Prev] {
+			// The verb agrees with its subject as transformed: "they are" -> "it is"
+			subject := strings.ToLower(results[i-1])
+			results[i] = e.inflectVerb(tok.Text, pluralSubjects[subject] || subject == "i")
+			if subject == "i" {
+				results[i] = firstPersonVerb(results[i])
+			}
+		}
+		if results[i] == tok.Text {
+			continue
+		}
+		b.WriteString(text[last:tok.Offset])
+		b.WriteString(results[i])
+		last = tok.Offset + len(tok.Text)
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// singularQuantifiers maps lowercase quantifiers that have no singular in
+// adjPluralToSingular to the determiner SingularizePhrase uses instead.
+var singularQuantifiers = map[string]string{
+	"all": "every", "both": "each", "several": "a", "many": "many a",
+}
+
+// SingularizePhrase returns a short plural phrase or sentence made
+// singular, with its determiners, subject, and verb agreeing: the
+// determiners and adjectives are changed as PluralAdj does, the nouns as
+// SingularNoun does, and the first verb after them agrees with the
+// singular subject. Words after that verb, such as objects and
+// complements, are left as they are, as are nouns after a preposition in
+// the subject. Each sentence of the text is handled separately.
+//
+// Like InflectDocument, which it is built on, this is a heuristic for short
+// phrases such as messages and labels, not a parser.
+//
+// Examples:
+//   - SingularizePhrase("these files are ready") returns "this file is ready"
+//   - SingularizePhrase("Those children were here.") returns "That child was here."
+//   - SingularizePhrase("some apples have fallen") returns "an apple has fallen"
+//   - SingularizePhrase("all users log in") returns "every user logs in"
+//   - SingularizePhrase("the files in these folders aren't saved") returns "the file in these folders isn't saved"
+func SingularizePhrase(text string) string {
+	return defaultEngine.SingularizePhrase(text)
+}
+
+// SingularizePhrase returns a short plural phrase or sentence made
+// singular, with its determiners, subject, and verb agreeing. See the
+// package-level SingularizePhrase for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SingularizePhrase("our users log in") // returns "my user logs in"
+func (e *Engine) SingularizePhrase(text string) string {
+	tokens := e.tokenize(text)
+	results := make([]string, len(tokens))
+	inSubject, beforeVerb := true, true
+	for i, tok := range tokens {
+		results[i] = tok.Text
+		lower := lowerWord(tok.Text)
+		if i > 0 && tok.Prev == "" {
+			// A new sentence
+			inSubject, beforeVerb = true, true
+		}
+		if !beforeVerb {
+			continue
+		}
+
+		_, isVerb := verbPluralToSingular[lower]
+		switch {
+		case tok.POS == POSVerb || isVerb:
+			if !isAuxiliary(tok.Prev) {
+				results[i] = e.inflectVerb(tok.Text, false)
+			}
+			beforeVerb = false
+		case inSubject && singularQuantifiers[lower] != "":
+			results[i] = matchCase(tok.Text, singularQuantifiers[lower])
+		case tok.POS == POSOther:
+			// "the files in these folders": only the head of the subject
+			inSubject = false
+		case inSubject:
+			results[i] = e.transform(tok, TransformSingular)
+		}
+	}
+
+	// "some apples" -> "an apple", not "a apple"
+	for i, tok := range tokens[:max(len(tokens)-1, 0)] {
+		if results[i] == tok.Text || tokens[i+1].Prev == "" {
+			continue
+		}
+		head, article, _ := splitLastWord(results[i])
+		if lower := strings.ToLower(article); lower == "a" || lower == "an" {
+			results[i] = head + matchCase(article, e.ArticleFor(results[i+1]))
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for i, tok := range tokens {
+		b.WriteString(text[last:tok.Offset])
+		b.WriteString(results[i])
+		last = tok.Offset + len(tok.Text)
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// tokenize splits text into classified tokens.
+func (e *Engine) tokenize(text string) []Token {
+	spans := sentenceWordPattern.FindAllStringIndex(text, -1)
+	tokens := make([]Token, len(spans))
+	for i, span := range spans {
+		tokens[i] = Token{Text: text[span[0]:span[1]], Index: i, Offset: span[0]}
+	}
+	for i := range tokens {
+		tok := &tokens[i]
+		if i > 0 && !endsSentence(text[spans[i-1][1]:spans[i][0]]) {
+			tok.Prev = lowerWord(tokens[i-1].Text)
+		}
+		if i+1 < len(tokens) && !endsSentence(text[spans[i][1]:spans[i+1][0]]) {
+			tok.Next = lowerWord(tokens[i+1].Text)
+		}
+		var prev *Token
+		if tok.Prev != "" {
+			prev = &tokens[i-1]
+		}
+		e.classify(tok, prev)
+	}
+	return tokens
+}
+
+// lowerWord returns word in lowercase, with a straight apostrophe.
+func lowerWord(word string) string {
+	return strings.ToLower(strings.ReplaceAll(word, "’", "'"))
+}
+
+// endsSentence reports whether the text between two words ends a sentence.
+func endsSentence(between string) bool {
+	return strings.ContainsAny(between, ".!?;:\n")
+}
+
+// classify sets the part of speech and number of tok, given the token
+// before it in the same sentence, if any.
+func (e *Engine) classify(tok, prev *Token) {
+	lower := lowerWord(tok.Text)
+	tok.POS, tok.Confidence = e.GuessPOS(lower)
+
+	switch {
+	case (questionDeterminers[lower] || documentDeterminers[lower]) && (lower != "that" || !personalSubjects[tok.Next]):
+		tok.POS, tok.Confidence = POSAdjective, 1.0
+	case functionWords[lower] || otherWords[lower] || isPronounContraction(lower):
+		tok.POS, tok.Confidence = POSOther, 0.8
+	case commonModifiers[lower]:
+		tok.POS, tok.Confidence = POSAdjective, 0.8
+	case prev == nil:
+	case isAuxiliary(tok.Prev):
+		// "doesn't like", "will run", "to see"
+		if isBaseVerb(lower) {
+			tok.POS, tok.Confidence = POSVerb, 0.8
+			return
+		}
+	case questionDeterminers[tok.Prev] || documentDeterminers[tok.Prev]:
+		// "the run", "a walk"
+		if tok.POS == POSVerb && tok.Confidence < 1 {
+			tok.POS, tok.Confidence = POSNoun, 0.7
+		}
+	case prev.POS == POSVerb && tok.Confidence <= 0.3 && strings.HasSuffix(lower, "ly"):
+		// "runs nightly"
+		tok.POS, tok.Confidence = POSOther, 0.6
+	case prev.POS == POSVerb && tok.Confidence <= 0.3 && verbBase(verbPositive(tok.Prev)) == "be":
+		// "is large"
+		tok.POS, tok.Confidence = POSAdjective, 0.5
+	case personalSubjects[tok.Prev] || prev.POS == POSNoun && prev.Confidence > 0:
+		// "it works", "the server restarts", "the users log in"
+		if tok.POS == POSNoun && tok.Confidence < 0.9 && e.followsSubject(lower, prev) {
+			tok.POS, tok.Confidence = POSVerb, 0.7
+		}
+	}
+
+	switch tok.POS {
+	case POSNoun:
+		tok.Plural = !strings.EqualFold(e.singular(lower), lower)
+	case POSPronoun:
+		tok.Plural = pluralPronouns[lower]
+	case POSAdjective:
+		_, tok.Plural = adjPluralToSingular[lower]
+	case POSVerb:
+		tok.Plural = isPluralVerb(lower)
+	}
+}
+
+// followsSubject reports whether a lowercase word can be the verb of the
+// subject that ends with prev.
+func (e *Engine) followsSubject(lower string, prev *Token) bool {
+	if _, ok := pastTenseBase(lower); ok {
+		return true
+	}
+	plural := prev.Plural || pluralSubjects[prev.Text] || strings.EqualFold(prev.Text, "i")
+	if _, ok := thirdPersonBase(lower); ok && !plural {
+		return true
+	}
+	pos, _ := e.GuessPOS(lower)
+	return plural && pos == POSNoun && isBaseVerb(lower) && e.Singular(lower) == lower
+}
+
+// isPluralVerb reports whether a lowercase present tense verb agrees with a
+// plural subject.
+func isPluralVerb(lower string) bool {
+	if lower == "am" {
+		return false
+	}
+	if _, ok := verbPluralToSingular[lower]; ok {
+		return true
+	}
+	if _, ok := verbSingularToPlural[lower]; ok {
+		return false
+	}
+	if _, ok := pastTenseBase(lower); ok {
+		return false
+	}
+	_, third := thirdPersonBase(lower)
+	return !third && !verbUnchanged[lower]
+}
+
+// isPronounContraction reports whether a lowercase word is a pronoun
+// joined with an auxiliary, like "i'm" or "they've".
+func isPronounContraction(w string) bool {
+	stem, _, ok := strings.Cut(w, "'")
+	return ok && contractionPronouns[stem]
+}
+
+// isAuxiliary reports whether a lowercase word is followed by the base form
+// of a verb, like "does", "can't", or "to".
+func isAuxiliary(w string) bool {
+	switch w = verbPositive(w); w {
+	case "do", "does", "did", "to", "not":
+		return true
+	}
+	return verbUnchanged[w] || w == "may"
+}
+
+// verbPositive returns the positive form of a lowercase negative
+// contraction, such as "is" for "isn't", or w itself.
+func verbPositive(w string) string {
+	if positive, ok := verbPositives[w]; ok {
+		return positive
+	}
+	return w
+}
+
+// pluralPronouns contains the pronouns that refer to more than one person
+// or thing.
+var pluralPronouns = map[string]bool{
+	"we": true, "us": true, "our": true, "ours": true, "ourselves": true,
+	"they": true, "them": true, "their": true, "theirs": true,
+	"themselves": true, "you": true, "yourselves": true,
+}
+
+// transform returns tok.Text with t applied.
+func (e *Engine) transform(tok Token, t Transform) string {
+	if t == TransformNone || tok.Plural == (t == TransformPlural) {
+		return tok.Text
+	}
+	word := tok.Text
+	plural := t == TransformPlural
+	switch tok.POS {
+	case POSNoun:
+		if plural {
+			return e.plural(word)
+		}
+		return e.singular(word)
+	case POSPronoun:
+		if plural {
+			return e.PluralNoun(word, 2)
+		}
+		return e.SingularNoun(word, 1)
+	case POSAdjective:
+		if plural {
+			return e.PluralAdj(word, 2)
+		}
+		return e.PluralAdj(word, 1)
+	case POSVerb:
+		if isAuxiliary(tok.Prev) {
+			return word
+		}
+		return e.inflectVerb(word, plural)
+	}
+	return word
+}
+
+// inflectVerb returns the present tense verb word in the form that agrees
+// with a plural or singular subject. Other verbs are returned unchanged.
+func (e *Engine) inflectVerb(word string, plural bool) string {
+	lower := strings.ToLower(word)
+	switch {
+	case isPluralVerb(lower) == plural:
+		return word
+	case plural && lower == "am":
+		return matchCase(word, "are")
+	case plural:
+		return e.PluralVerb(word, 2)
+	}
+	if singular, ok := verbPluralToSingular[lower]; ok {
+		return matchCase(word, singular)
+	}
+	return matchCase(word, conjugate(lower, TensePresent, 1, false))
+}
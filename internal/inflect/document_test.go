@@ -0,0 +1,140 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+// pluralizeNouns is a rule that makes every noun plural.
+func pluralizeNouns(t inflect.Token) inflect.Transform {
+	if t.POS == inflect.POSNoun {
+		return inflect.TransformPlural
+	}
+	return inflect.TransformNone
+}
+
+// pluralizeAll is a rule that makes every word plural.
+func pluralizeAll(inflect.Token) inflect.Transform {
+	return inflect.TransformPlural
+}
+
+// singularizeAll is a rule that makes every word singular.
+func singularizeAll(inflect.Token) inflect.Transform {
+	return inflect.TransformSingular
+}
+
+func TestInflectDocument(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		rule func(inflect.Token) inflect.Transform
+		want string
+	}{
+		// Nouns only
+		{name: "nouns", text: "The cat sat on the mat.", rule: pluralizeNouns, want: "The cats sat on the mats."},
+		{name: "irregular nouns", text: "The mouse eats the cheese.", rule: pluralizeNouns, want: "The mice eats the cheeses."},
+		{name: "prepositions kept", text: "The child plays with a box!", rule: pluralizeNouns, want: "The children plays with a boxes!"},
+		{name: "adjective after be", text: "The file is large.", rule: pluralizeNouns, want: "The files is large."},
+
+		// Every word
+		{name: "determiner and verb", text: "A user logs in.", rule: pluralizeAll, want: "Some users log in."},
+		{name: "adverb kept", text: "The server restarts nightly.", rule: pluralizeAll, want: "The servers restart nightly."},
+		{name: "auxiliary", text: "My friend doesn't like the new policy.", rule: pluralizeAll, want: "Our friends don't like the new policies."},
+		{name: "modal", text: "He will run.", rule: pluralizeAll, want: "They will run."},
+		{name: "pronoun subject", text: "It works.", rule: pluralizeAll, want: "They work."},
+		{name: "first person", text: "I am here.", rule: pluralizeAll, want: "We are here."},
+		{name: "contraction kept", text: "I'm here.", rule: pluralizeAll, want: "I'm here."},
+		{name: "two sentences", text: "This file is large; the report has an error.", rule: pluralizeAll, want: "These files are large; the reports have some errors."},
+
+		// Singular
+		{name: "singular", text: "These users log in.", rule: singularizeAll, want: "This user logs in."},
+		{name: "singular irregular", text: "The children play with the boxes.", rule: singularizeAll, want: "The child plays with the box."},
+		{name: "singular auxiliary", text: "The users don't log in.", rule: singularizeAll, want: "The user doesn't log in."},
+		{name: "singular they", text: "They are happy.", rule: singularizeAll, want: "They are happy."},
+		{name: "singular we", text: "We are here.", rule: singularizeAll, want: "I am here."},
+
+		// Edge cases
+		{name: "empty", text: "", rule: pluralizeAll, want: ""},
+		{name: "no words", text: "... 42 !", rule: pluralizeAll, want: "... 42 !"},
+		{name: "nil rule", text: "The cat sat.", rule: nil, want: "The cat sat."},
+		{name: "case kept", text: "THE CAT", rule: pluralizeNouns, want: "THE CATS"},
+		{name: "spacing kept", text: "the  cat\n\tsat", rule: pluralizeNouns, want: "the  cats\n\tsat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.InflectDocument(tt.text, tt.rule))
+		})
+	}
+}
+
+func TestInflectDocumentTokens(t *testing.T) {
+	var tokens []inflect.Token
+	inflect.InflectDocument("The users log in. It works", func(tok inflect.Token) inflect.Transform {
+		tokens = append(tokens, tok)
+		return inflect.TransformNone
+	})
+
+	require.Len(t, tokens, 6)
+	assert.Equal(t, inflect.Token{
+		Text: "users", Index: 1, Offset: 4, POS: inflect.POSNoun, Confidence: tokens[1].Confidence,
+		Plural: true, Prev: "the", Next: "log",
+	}, tokens[1])
+	assert.Equal(t, inflect.POSAdjective, tokens[0].POS)
+	assert.Equal(t, inflect.POSVerb, tokens[2].POS)
+	assert.True(t, tokens[2].Plural)
+	assert.Equal(t, inflect.POSOther, tokens[3].POS)
+	assert.Empty(t, tokens[3].Next, "a period ends the sentence")
+	assert.Empty(t, tokens[4].Prev)
+	assert.Equal(t, inflect.POSPronoun, tokens[4].POS)
+	assert.Equal(t, inflect.POSVerb, tokens[5].POS)
+	assert.False(t, tokens[5].Plural)
+}
+
+func TestEngineInflectDocument(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "The regexen match.", e.InflectDocument("The regex matches.", pluralizeAll))
+	assert.Equal(t, "The regexes match.", inflect.InflectDocument("The regex matches.", pluralizeAll))
+}
+
+func TestSingularizePhrase(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "these files are ready", want: "this file is ready"},
+		{text: "Those children were here.", want: "That child was here."},
+		{text: "those boxes aren't empty", want: "that box isn't empty"},
+		{text: "these are mine", want: "this is mine"},
+		{text: "our users log in", want: "my user logs in"},
+		{text: "the users don't log in", want: "the user doesn't log in"},
+		{text: "the files have been saved", want: "the file has been saved"},
+		{text: "the files in these folders aren't saved", want: "the file in these folders isn't saved"},
+		{text: "some apples have fallen", want: "an apple has fallen"},
+		{text: "Some Apples Are Red", want: "An Apple Is Red"},
+		{text: "all users log in", want: "every user logs in"},
+		{text: "both apples are red", want: "each apple is red"},
+		{text: "many apples fall", want: "many an apple falls"},
+		{text: "These files are ready. Those are not.", want: "This file is ready. That is not."},
+		{text: "this file is ready", want: "this file is ready"},
+		{text: "files", want: "file"},
+		{text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.SingularizePhrase(tt.text))
+		})
+	}
+}
+
+func TestSingularizePhraseEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("datum", "data")
+	assert.Equal(t, "this datum is stale", e.SingularizePhrase("these data are stale"))
+}
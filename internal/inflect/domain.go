@@ -0,0 +1,176 @@
+package inflect
+
+// Domain is a subject area whose vocabulary SetDomainBias can make
+// Singular prefer, for plurals with more than one singular.
+type Domain int
+
+const (
+	// DomainGeneral is everyday English, the readings Singular returns when
+	// no bias is set.
+	// Example: "bases" -> "basis"
+	DomainGeneral Domain = iota
+
+	// DomainSports is sports writing.
+	// Example: "bases" -> "base"
+	DomainSports
+
+	// DomainScience is the natural sciences.
+	// Example: "bases" -> "base" (in chemistry)
+	DomainScience
+
+	// DomainMath is mathematics and geometry.
+	// Example: "ellipses" -> "ellipse"
+	DomainMath
+
+	// DomainComputing is software and computing, where "data" and "media"
+	// are mass nouns.
+	// Example: "data" -> "data"
+	DomainComputing
+
+	// DomainBusiness is business and office writing.
+	// Example: "leaves" -> "leave" (as in "sick leaves")
+	DomainBusiness
+)
+
+// singularCandidate is one of the singulars of an ambiguous plural, with
+// its relative frequency in general text and the domains it belongs to.
+type singularCandidate struct {
+	word      string
+	frequency float64
+	domains   []Domain
+}
+
+// ambiguousSingulars maps plurals with more than one singular to their
+// candidates. The first candidate is the one Singular returns without a
+// bias, and has the highest frequency.
+var ambiguousSingulars = map[string][]singularCandidate{
+	"bases": {
+		{word: "basis", frequency: 0.6, domains: []Domain{DomainGeneral}},
+		{word: "base", frequency: 0.4, domains: []Domain{DomainSports, DomainScience, DomainMath}},
+	},
+	"data": {
+		{word: "datum", frequency: 0.6, domains: []Domain{DomainGeneral, DomainScience}},
+		{word: "data", frequency: 0.4, domains: []Domain{DomainComputing, DomainBusiness}},
+	},
+	"ellipses": {
+		{word: "ellipsis", frequency: 0.6, domains: []Domain{DomainGeneral}},
+		{word: "ellipse", frequency: 0.4, domains: []Domain{DomainMath, DomainScience}},
+	},
+	"leaves": {
+		{word: "leaf", frequency: 0.9, domains: []Domain{DomainGeneral, DomainScience}},
+		{word: "leave", frequency: 0.1, domains: []Domain{DomainBusiness}},
+	},
+	"media": {
+		{word: "medium", frequency: 0.6, domains: []Domain{DomainGeneral, DomainScience}},
+		{word: "media", frequency: 0.4, domains: []Domain{DomainComputing, DomainBusiness}},
+	},
+}
+
+// SetDomainBias sets how strongly Singular prefers the words of a domain
+// when a plural has more than one singular, such as "bases" ("basis" or
+// "base").
+//
+// Each candidate singular has a frequency in general English, which is
+// multiplied by the largest weight of its domains, and the candidate with
+// the highest score wins. Every domain has a weight of 1 until it is set,
+// so that without a bias the more frequent reading is used. A weight of 0
+// rules out the readings of a domain, and negative weights are treated as
+// 0. DefNoun definitions take precedence.
+//
+// Examples:
+//
+//	Singular("bases") // returns "basis"
+//	SetDomainBias(DomainSports, 10)
+//	Singular("bases") // returns "base"
+//	SetDomainBias(DomainSports, 1)
+//	Singular("bases") // returns "basis"
+func SetDomainBias(domain Domain, weight float64) {
+	defaultEngine.SetDomainBias(domain, weight)
+}
+
+// SetDomainBias sets how strongly e.Singular prefers the words of a domain
+// when a plural has more than one singular. See the package-level
+// SetDomainBias for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetDomainBias(DomainComputing, 10)
+//	e.Singular("data") // returns "data"
+func (e *Engine) SetDomainBias(domain Domain, weight float64) {
+	weight = max(weight, 0)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if weight == 1 {
+		delete(e.domainBias, domain)
+		return
+	}
+	if e.domainBias == nil {
+		e.domainBias = make(map[Domain]float64)
+	}
+	e.domainBias[domain] = weight
+}
+
+// DomainBias returns the weight set for a domain with SetDomainBias, or 1
+// if none is set.
+//
+// Examples:
+//
+//	DomainBias(DomainSports) // returns 1
+//	SetDomainBias(DomainSports, 10)
+//	DomainBias(DomainSports) // returns 10
+func DomainBias(domain Domain) float64 {
+	return defaultEngine.DomainBias(domain)
+}
+
+// DomainBias returns the weight set for a domain with e.SetDomainBias, or
+// 1 if none is set.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DomainBias(DomainSports) // returns 1
+func (e *Engine) DomainBias(domain Domain) float64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.domainBiasLocked(domain)
+}
+
+// domainBiasLocked is DomainBias for callers that hold e.mu.
+func (e *Engine) domainBiasLocked(domain Domain) float64 {
+	if weight, ok := e.domainBias[domain]; ok {
+		return weight
+	}
+	return 1
+}
+
+// domainSingular returns the singular of a lowercase ambiguous plural that
+// scores highest with the domain weights of e, or false if the plural is not
+// ambiguous, no weight is set, or the plural was defined with DefNoun.
+func (e *Engine) domainSingular(lower string) (string, bool) {
+	candidates, ok := ambiguousSingulars[lower]
+	if !ok {
+		return "", false
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if len(e.domainBias) == 0 {
+		return "", false
+	}
+	if singular, ok := e.singularIrregulars[lower]; ok && defaultIrregularPlurals[singular] != lower {
+		return "", false
+	}
+
+	best, bestScore := "", -1.0
+	for _, c := range candidates {
+		weight := 0.0
+		for _, d := range c.domains {
+			weight = max(weight, e.domainBiasLocked(d))
+		}
+		if score := c.frequency * weight; score > bestScore {
+			best, bestScore = c.word, score
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,76 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestSetDomainBias(t *testing.T) {
+	tests := []struct {
+		domain inflect.Domain
+		word   string
+		want   string
+	}{
+		{domain: inflect.DomainGeneral, word: "bases", want: "basis"},
+		{domain: inflect.DomainSports, word: "bases", want: "base"},
+		{domain: inflect.DomainSports, word: "Bases", want: "Base"},
+		{domain: inflect.DomainScience, word: "bases", want: "base"},
+		{domain: inflect.DomainMath, word: "ellipses", want: "ellipse"},
+		{domain: inflect.DomainComputing, word: "data", want: "data"},
+		{domain: inflect.DomainComputing, word: "media", want: "media"},
+		{domain: inflect.DomainScience, word: "data", want: "datum"},
+		{domain: inflect.DomainBusiness, word: "leaves", want: "leave"},
+		{domain: inflect.DomainSports, word: "leaves", want: "leaf"},
+		{domain: inflect.DomainSports, word: "home runs", want: "home run"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.EnableCache(10)
+			e.Singular(tt.word)
+
+			e.SetDomainBias(tt.domain, 10)
+			assert.Equal(t, tt.want, e.Singular(tt.word), "cached result is cleared")
+			assert.Equal(t, tt.want, e.Clone().Singular(tt.word))
+		})
+	}
+}
+
+func TestDomainBiasWeights(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.InDelta(t, 1.0, e.DomainBias(inflect.DomainSports), 0)
+
+	// A small bias is not enough to outweigh the frequency of "basis"
+	e.SetDomainBias(inflect.DomainSports, 1.2)
+	assert.Equal(t, "basis", e.Singular("bases"))
+	e.SetDomainBias(inflect.DomainSports, 2)
+	assert.Equal(t, "base", e.Singular("bases"))
+
+	e.SetDomainBias(inflect.DomainSports, 1)
+	e.SetDomainBias(inflect.DomainGeneral, 0)
+	assert.Equal(t, "base", e.Singular("bases"), "general readings ruled out")
+
+	e.SetDomainBias(inflect.DomainGeneral, -5)
+	assert.InDelta(t, 0.0, e.DomainBias(inflect.DomainGeneral), 0)
+
+	e.Reset()
+	assert.InDelta(t, 1.0, e.DomainBias(inflect.DomainGeneral), 0)
+	assert.Equal(t, "basis", e.Singular("bases"))
+}
+
+func TestDomainBiasDefNoun(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("bass", "bases")
+	e.SetDomainBias(inflect.DomainSports, 10)
+	assert.Equal(t, "bass", e.Singular("bases"), "DefNoun takes precedence")
+
+	s := e.Snapshot()
+	e.Reset()
+	assert.Equal(t, "basis", e.Singular("bases"))
+	e.Restore(s)
+	assert.InDelta(t, 10.0, e.DomainBias(inflect.DomainSports), 0)
+}
@@ -3,7 +3,9 @@ package inflect
 import (
 	"maps"
 	"regexp"
+	"slices"
 	"sync"
+	"sync/atomic"
 )
 
 // Engine holds all mutable state for inflection operations.
@@ -21,13 +23,30 @@ import (
 // The following state is mutable and protected by Engine.mu:
 //   - Classical mode flags: classicalMode, classicalAll, classicalZero,
 //     classicalHerd, classicalNames, classicalAncient, classicalPersons
-//   - Custom noun mappings: irregularPlurals, singularIrregulars
+//   - Custom noun mappings: irregularPlurals, singularIrregulars, nounRules,
+//     classicalNouns
+//   - Custom invariant nouns: customUnchanged, customHerd
+//   - Plural suffix rules: suffixRules (replaced, never modified in place)
 //   - Custom verb mappings: customVerbs, customVerbsReverse
 //   - Custom adjective mappings: customAdjs, customAdjsReverse
-//   - Custom article patterns: customAWords, customAnWords, customAPatterns, customAnPatterns
+//   - Custom article patterns: customAWords, customAnWords, customAPatterns, customAnPatterns,
+//     customSounds
+//   - Custom collective nouns: customCollectives
+//   - Custom diminutives: customDiminutives
+//   - Stylized words: customStylized, customStylizedPlurals
+//   - Acronym registry: acronyms, acronymPronunciations
 //   - Gender setting: gender (for third-person pronoun singularization)
+//   - Dialect: dialect (for the articles of words like "herb")
 //   - Possessive style: possessiveStyle (modern vs traditional)
-//   - Default number: defaultNum (for Num/GetNum)
+//   - Proper names: properNameDetection, customProperNames
+//   - Default number: defaultNum (for Num/GetNum), numPropagation
+//   - Unicode normalization: normalizeInput
+//   - Technical plurals: technicalPlurals
+//   - Domain bias: domainBias
+//   - Lenient mode: lenient
+//   - Undo stack: undoStack (kept by Reset, not copied by Clone)
+//   - Result cache: cache (its contents have their own lock)
+//   - Lookup hook: hook (an atomic pointer, so lookups read it without locking)
 //
 // # Immutable State (package-level variables)
 //
@@ -38,23 +57,51 @@ import (
 //   - adjective.go: irregularComparatives, irregularSuperlatives, twoSyllableWithSuffix
 //   - adverb.go: irregularAdverbs, unchangedAdverbs
 //   - article.go: silentHWords, lowercaseAbbrevs
+//   - article_exceptions_gen.go: pronunciationExceptions
+//   - an_sentence.go: sentenceArticlePattern
+//   - collective.go: collectiveNouns
+//   - compact.go: compactScales
 //   - currency.go: currencies
-//   - number.go: onesCardinal, onesOrdinal, tensCardinal, tensOrdinal
+//   - dialect.go: dialectArticles, dialects, ukDoubleConsonantWords
+//   - diminutive.go: diminutives, diminutiveSuffixes, lingEndings
+//   - document.go: documentDeterminers, otherWords, personalSubjects, pluralPronouns,
+//     singularQuantifiers
+//   - domain.go: ambiguousSingulars
+//   - inflect_html.go: htmlSkipElements, htmlTextEscaper
+//   - guess.go: posSuffixes, plainPronouns
+//   - lemma.go: irregularVerbBases, irregularAdjectiveBases, lemmaUnchanged
+//   - lenient.go: regularizableNouns, regularizedPlurals, correctLookalikes
+//   - names.go: properNames
+//   - stylized.go: stylizedWords, stylizedPlurals
+//   - technical.go: technicalPlurals
+//   - nounphrase.go: phrasePrepositions
+//   - number.go: onesCardinal, onesOrdinal, tensCardinal, tensOrdinal, digitWords,
+//     scaleValues, unitValues, numberScales
 //   - ordinal.go: cardinalToOrdinal, ordinalToCardinalMap, ordinalWords
 //   - participle.go: doubleConsonantWords, irregularPastParticiples, knownParticiples
 //   - past_tense.go: irregularPastTense
+//   - people.go: personForms, personWords
+//   - pipeline.go: eitherNumberNouns
+//   - rulepack.go: rulePacks
+//   - rules.go: defaultSuffixRules
 //   - plural.go: changeToVesWords, oExceptionWords, unchangedPlurals, herdAnimals,
-//     classicalLatinPlurals, defaultIrregularPlurals
+//     eseNouns, classicalLatinPlurals, defaultIrregularPlurals
 //   - possessive.go: irregularPluralNoS, singularEndsInS, commonNouns, truncatedNames, validShortA
+//   - quantify.go: quantityBuckets
 //   - pronouns.go: pronounNominativePlural, pronounAccusativePlural, pronounPossessivePlural,
 //     pronounReflexivePlural, allPronounsToPlural, pronoun*SingularByGender maps
-//   - singular.go: feWordBases
+//   - singular.go: knownSingulars
+//   - time.go: durationUnits
 //   - verbs.go: verbSingularToPlural, verbPluralToSingular, verbUnchanged,
-//     adjSingularToPlural, adjPluralToSingular, adjPluralToSingularByGender
+//     verbNegatives, verbPositives, adjSingularToPlural, adjPluralToSingular,
+//     adjPluralToSingularByGender
 //
 // Compiled regular expressions (immutable after compilation):
 //   - inflect_funcs.go: inflectFuncPattern
+//   - markdown.go: markdownLinkPattern
+//   - ordinal.go: ordinalInStringPattern
 //   - rails.go: notURLSafe, multiSep
+//   - tense.go: sentenceWordPattern
 //
 // Function lookup tables (immutable after init):
 //   - inflect_funcs.go: inflectFuncs
@@ -81,6 +128,20 @@ type Engine struct {
 	irregularPlurals   map[string]string
 	singularIrregulars map[string]string
 
+	// Classical plurals of nouns defined with DefNounMulti
+	classicalNouns map[string]string
+
+	// Nouns marked with DefUnchanged and DefHerd (true) or UndefUnchanged
+	// and UndefHerd (false), overriding unchangedPlurals and herdAnimals
+	customUnchanged map[string]bool
+	customHerd      map[string]bool
+
+	// Custom noun suffix rules, in the order they are checked
+	nounRules []nounRule
+
+	// Suffix rules for Plural, in the order they are checked
+	suffixRules []SuffixRule
+
 	// Custom verb definitions
 	customVerbs        map[string]string
 	customVerbsReverse map[string]string
@@ -95,18 +156,67 @@ type Engine struct {
 	customAPatterns  []*regexp.Regexp
 	customAnPatterns []*regexp.Regexp
 
+	// Word prefixes registered with DefSilentH (true) and DefSoundedVowel
+	// (false)
+	customSounds map[string]bool
+
 	// Gender for singular third-person pronouns
 	// Valid values: "m" (masculine), "f" (feminine), "n" (neuter), "t" (they/singular they)
 	gender string
 
+	// Dialect whose pronunciation An follows
+	dialect Dialect
+
 	// Possessive style: PossessiveModern or PossessiveTraditional
 	possessiveStyle PossessiveStyleType
 
+	// How proper names are recognized, and names added for ProperNameDictionary
+	properNameDetection ProperNameDetection
+	customProperNames   map[string]bool
+
 	// Default number for Num/GetNum
 	defaultNum int
 
+	// Whether count-aware functions fall back to defaultNum
+	numPropagation bool
+
+	// Whether input to Plural, Singular, and An is normalized to NFC
+	normalizeInput bool
+
+	// Whether Plural prefers "indexes" to "indices"
+	technicalPlurals bool
+
+	// Weights set with SetDomainBias, by domain
+	domainBias map[Domain]float64
+
+	// Whether Plural and Singular correct near-misses of irregular nouns
+	lenient bool
+
 	// Acronym registry: maps uppercase acronym to preferred case
 	acronyms map[string]string
+
+	// Acronym pronunciations: maps uppercase acronym to how it is read aloud
+	acronymPronunciations map[string]string
+
+	// Custom collective nouns: maps lowercase noun to its collective
+	customCollectives map[string]string
+
+	// Custom diminutives: maps lowercase noun to its diminutive
+	customDiminutives map[string]string
+
+	// Stylized words: maps each word registered with DefStylized to its
+	// plural, and back
+	customStylized        map[string]string
+	customStylizedPlurals map[string]string
+
+	// Snapshots saved by Checkpoint, most recent last
+	undoStack []*EngineSnapshot
+
+	// Cache of Plural, Singular, and An results, or nil if disabled
+	cache *resultCache
+
+	// Hook registered with SetHook, or nil; loaded without taking mu
+	hook atomic.Pointer[Hook]
 }
 
 // NewEngine creates a new Engine instance with default settings.
@@ -118,13 +228,20 @@ type Engine struct {
 //   - All custom maps are empty
 //   - Gender is "t" (singular they)
 //   - Possessive style is PossessiveModern
-//   - Default number is 0
+//   - Proper name detection is ProperNameHeuristic
+//   - Default number is 0, and count propagation is enabled
+//   - Dialect is DialectUS
+//
+// Options such as WithClassical and WithGender change these settings, and
+// are applied in order.
 //
 // Example:
 //
 //	e := NewEngine()
 //	e.Plural("cat") // returns "cats"
-func NewEngine() *Engine {
+//	e = NewEngine(WithClassical(ClassicalModeAncient), WithGender("f"))
+//	e.Plural("formula") // returns "formulae"
+func NewEngine(opts ...Option) *Engine {
 	irregulars := copyMap(defaultIrregularPlurals)
 
 	// Build singularIrregulars as reverse of irregularPlurals
@@ -133,7 +250,7 @@ func NewEngine() *Engine {
 		singulars[plural] = singular
 	}
 
-	return &Engine{
+	e := &Engine{
 		// Classical mode settings - all false by default
 		classicalMode:    false,
 		classicalAll:     false,
@@ -146,6 +263,7 @@ func NewEngine() *Engine {
 		// Noun mappings
 		irregularPlurals:   irregulars,
 		singularIrregulars: singulars,
+		suffixRules:        defaultSuffixRules,
 
 		// Custom verb definitions - empty by default
 		customVerbs:        make(map[string]string),
@@ -167,9 +285,19 @@ func NewEngine() *Engine {
 		// Possessive style - default to modern
 		possessiveStyle: PossessiveModern,
 
+		// Proper names - detected by capitalization
+		properNameDetection: ProperNameHeuristic,
+
 		// Default number - 0 means not set
-		defaultNum: 0,
+		defaultNum:     0,
+		numPropagation: true,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(e)
+		}
 	}
+	return e
 }
 
 // Clone creates a deep copy of the Engine.
@@ -226,6 +354,9 @@ func (e *Engine) Clone() *Engine {
 		copy(anPatterns, e.customAnPatterns)
 	}
 
+	// Copy noun rules (compiled patterns are immutable, so a shallow copy is safe)
+	nounRules := slices.Clone(e.nounRules)
+
 	// Copy acronyms map
 	var acronyms map[string]string
 	if e.acronyms != nil {
@@ -233,28 +364,91 @@ func (e *Engine) Clone() *Engine {
 		maps.Copy(acronyms, e.acronyms)
 	}
 
+	// Copy acronym pronunciations map
+	var pronunciations map[string]string
+	if e.acronymPronunciations != nil {
+		pronunciations = make(map[string]string, len(e.acronymPronunciations))
+		maps.Copy(pronunciations, e.acronymPronunciations)
+	}
+
+	// Copy collective nouns map
+	var collectives map[string]string
+	if e.customCollectives != nil {
+		collectives = make(map[string]string, len(e.customCollectives))
+		maps.Copy(collectives, e.customCollectives)
+	}
+
+	// Copy diminutives map
+	var diminutives map[string]string
+	if e.customDiminutives != nil {
+		diminutives = make(map[string]string, len(e.customDiminutives))
+		maps.Copy(diminutives, e.customDiminutives)
+	}
+
+	// Copy stylized word maps
+	var stylized, stylizedPlurals map[string]string
+	if e.customStylized != nil {
+		stylized = make(map[string]string, len(e.customStylized))
+		maps.Copy(stylized, e.customStylized)
+		stylizedPlurals = make(map[string]string, len(e.customStylizedPlurals))
+		maps.Copy(stylizedPlurals, e.customStylizedPlurals)
+	}
+
+	// Copy proper names map
+	var names map[string]bool
+	if e.customProperNames != nil {
+		names = make(map[string]bool, len(e.customProperNames))
+		maps.Copy(names, e.customProperNames)
+	}
+
+	// Give the copy an empty cache of the same size
+	var cache *resultCache
+	if e.cache != nil {
+		cache = newResultCache(e.cache.capacity)
+	}
+
 	return &Engine{
-		classicalMode:      e.classicalMode,
-		classicalAll:       e.classicalAll,
-		classicalZero:      e.classicalZero,
-		classicalHerd:      e.classicalHerd,
-		classicalNames:     e.classicalNames,
-		classicalAncient:   e.classicalAncient,
-		classicalPersons:   e.classicalPersons,
-		irregularPlurals:   irregulars,
-		singularIrregulars: singulars,
-		customVerbs:        verbs,
-		customVerbsReverse: verbsReverse,
-		customAdjs:         adjs,
-		customAdjsReverse:  adjsReverse,
-		customAWords:       aWords,
-		customAnWords:      anWords,
-		customAPatterns:    aPatterns,
-		customAnPatterns:   anPatterns,
-		gender:             e.gender,
-		possessiveStyle:    e.possessiveStyle,
-		defaultNum:         e.defaultNum,
-		acronyms:           acronyms,
+		classicalMode:         e.classicalMode,
+		classicalAll:          e.classicalAll,
+		classicalZero:         e.classicalZero,
+		classicalHerd:         e.classicalHerd,
+		classicalNames:        e.classicalNames,
+		classicalAncient:      e.classicalAncient,
+		classicalPersons:      e.classicalPersons,
+		irregularPlurals:      irregulars,
+		singularIrregulars:    singulars,
+		classicalNouns:        maps.Clone(e.classicalNouns),
+		customUnchanged:       maps.Clone(e.customUnchanged),
+		customHerd:            maps.Clone(e.customHerd),
+		nounRules:             nounRules,
+		suffixRules:           e.suffixRules,
+		customVerbs:           verbs,
+		customVerbsReverse:    verbsReverse,
+		customAdjs:            adjs,
+		customAdjsReverse:     adjsReverse,
+		customAWords:          aWords,
+		customAnWords:         anWords,
+		customAPatterns:       aPatterns,
+		customAnPatterns:      anPatterns,
+		customSounds:          maps.Clone(e.customSounds),
+		gender:                e.gender,
+		dialect:               e.dialect,
+		possessiveStyle:       e.possessiveStyle,
+		properNameDetection:   e.properNameDetection,
+		customProperNames:     names,
+		defaultNum:            e.defaultNum,
+		numPropagation:        e.numPropagation,
+		normalizeInput:        e.normalizeInput,
+		technicalPlurals:      e.technicalPlurals,
+		domainBias:            maps.Clone(e.domainBias),
+		lenient:               e.lenient,
+		acronyms:              acronyms,
+		acronymPronunciations: pronunciations,
+		customCollectives:     collectives,
+		customDiminutives:     diminutives,
+		customStylized:        stylized,
+		customStylizedPlurals: stylizedPlurals,
+		cache:                 cache,
 	}
 }
 
@@ -265,10 +459,14 @@ func (e *Engine) Clone() *Engine {
 //   - All classical flags are set to false
 //   - irregularPlurals is restored from defaultIrregularPlurals
 //   - singularIrregulars is rebuilt as the reverse of irregularPlurals
+//   - suffixRules is restored to defaultSuffixRules
 //   - All custom maps (verbs, adjectives, article patterns) are cleared
 //   - Gender is reset to "t" (singular they)
 //   - Possessive style is reset to PossessiveModern
-//   - Default number is reset to 0
+//   - Proper name detection is reset to ProperNameHeuristic
+//   - Default number is reset to 0, and count propagation is enabled
+//
+// A cache enabled by EnableCache stays enabled, but is emptied.
 //
 // Example:
 //
@@ -281,6 +479,7 @@ func (e *Engine) Clone() *Engine {
 func (e *Engine) Reset() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	e.cache.clear()
 
 	// Reset classical options
 	e.classicalMode = false
@@ -298,6 +497,11 @@ func (e *Engine) Reset() {
 	for singular, plural := range e.irregularPlurals {
 		e.singularIrregulars[plural] = singular
 	}
+	e.classicalNouns = nil
+	e.customUnchanged = nil
+	e.customHerd = nil
+	e.nounRules = nil
+	e.suffixRules = defaultSuffixRules
 
 	// Reset custom definitions
 	e.customVerbs = make(map[string]string)
@@ -310,14 +514,34 @@ func (e *Engine) Reset() {
 	e.customAnWords = make(map[string]bool)
 	e.customAPatterns = nil
 	e.customAnPatterns = nil
+	e.customSounds = nil
 
-	// Reset gender
+	// Reset gender and dialect
 	e.gender = "t"
+	e.dialect = DialectDefault
 
 	// Reset other state
 	e.defaultNum = 0
+	e.numPropagation = true
+	e.normalizeInput = false
+	e.technicalPlurals = false
+	e.domainBias = nil
+	e.lenient = false
 	e.possessiveStyle = PossessiveModern
+	e.properNameDetection = ProperNameHeuristic
+	e.customProperNames = nil
 
 	// Reset acronyms to nil (will use defaults)
 	e.acronyms = nil
+	e.acronymPronunciations = nil
+
+	// Reset collective nouns
+	e.customCollectives = nil
+
+	// Reset diminutives
+	e.customDiminutives = nil
+
+	// Reset stylized words
+	e.customStylized = nil
+	e.customStylizedPlurals = nil
 }
@@ -2,8 +2,12 @@ package inflect_test
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"text/template"
+	"time"
 
 	inflect "github.com/cv/go-inflect/v2"
 )
@@ -20,6 +24,26 @@ func ExampleAn() {
 	// a university
 }
 
+func ExampleAn_wrapped() {
+	fmt.Println(inflect.An(`"honest" answer`))
+	fmt.Println(inflect.An("<em>apple</em>"))
+	fmt.Println(inflect.An("(unicorn)"))
+	// Output:
+	// an "honest" answer
+	// an <em>apple</em>
+	// a (unicorn)
+}
+
+func ExampleAnCount() {
+	fmt.Println(inflect.AnCount("apple", 1))
+	fmt.Println(inflect.AnCount("apples", 3))
+	fmt.Println(inflect.AnCount("apples", 0))
+	// Output:
+	// an apple
+	// 3 apples
+	// 0 apples
+}
+
 func ExampleA() {
 	fmt.Println(inflect.A("cat"))
 	fmt.Println(inflect.A("elephant"))
@@ -32,6 +56,24 @@ func ExampleA() {
 	// a European
 }
 
+func ExampleArticleFor() {
+	fmt.Println(inflect.ArticleFor("hour"))
+	fmt.Println(inflect.ArticleFor("cat"))
+	fmt.Println(inflect.ArticleFor("university"))
+	// Output:
+	// an
+	// a
+	// a
+}
+
+func ExampleAnCapitalized() {
+	fmt.Println(inflect.AnCapitalized("apple"))
+	fmt.Println(inflect.AnCapitalized("cat"))
+	// Output:
+	// An apple
+	// A cat
+}
+
 func ExamplePlural() {
 	fmt.Println(inflect.Plural("cat"))
 	fmt.Println(inflect.Plural("box"))
@@ -60,6 +102,582 @@ func ExampleSingular() {
 	// cactus
 }
 
+func ExamplePlural_possessive() {
+	fmt.Println(inflect.Plural("cat's"))
+	fmt.Println(inflect.Plural("child's"))
+	fmt.Println(inflect.Plural("box,"))
+	// Output:
+	// cats'
+	// children's
+	// boxes,
+}
+
+func ExampleSingular_possessive() {
+	fmt.Println(inflect.Singular("dogs'"))
+	fmt.Println(inflect.Singular("children's"))
+	fmt.Println(inflect.Singular(`"cats"`))
+	// Output:
+	// dog's
+	// child's
+	// "cat"
+}
+
+func ExamplePluralSafe() {
+	fmt.Println(inflect.PluralSafe("cat"))
+	fmt.Println(inflect.PluralSafe("cats"))
+	fmt.Println(inflect.PluralSafe("children"))
+	// Output:
+	// cats
+	// cats
+	// children
+}
+
+func ExampleSingularSafe() {
+	fmt.Println(inflect.SingularSafe("buses"))
+	fmt.Println(inflect.SingularSafe("bus"))
+	fmt.Println(inflect.SingularSafe("octothorpus"))
+	// Output:
+	// bus
+	// bus
+	// octothorpus
+}
+
+func ExamplePluralizeKeys() {
+	payload := map[string]any{"user": map[string]any{"postTag": 1}}
+	fmt.Println(inflect.PluralizeKeys(payload, true))
+	// Output:
+	// map[users:map[postTags:1]]
+}
+
+func ExampleInflectStructNames() {
+	type Category struct {
+		Name   string
+		Parent string `inflect:"ancestors"`
+	}
+
+	names, err := inflect.InflectStructNames(Category{}, inflect.NamePlural)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(names.Type.Inflected)
+	for _, f := range names.Fields {
+		fmt.Println(f.Name, f.Inflected)
+	}
+	// Output:
+	// Categories
+	// Name Names
+	// Parent ancestors
+}
+
+func ExamplePluralLastWord() {
+	fmt.Println(inflect.PluralLastWord("blue bird"))
+	fmt.Println(inflect.PluralLastWord("baby child"))
+	// Output:
+	// blue birds
+	// baby children
+}
+
+func ExampleSingularLastWord() {
+	fmt.Println(inflect.SingularLastWord("field mice"))
+	// Output:
+	// field mouse
+}
+
+func ExamplePhrase() {
+	adjectives := []string{"old", "oak"}
+	fmt.Println(inflect.Phrase(1, adjectives, "tree"))
+	fmt.Println(inflect.Phrase(3, adjectives, "tree"))
+	fmt.Println(inflect.Phrase(0, adjectives, "tree"))
+	// Output:
+	// an old oak tree
+	// 3 old oak trees
+	// no old oak trees
+}
+
+func ExampleAgreeVerb() {
+	for _, n := range []int{1, 3} {
+		fmt.Printf("%d %s %s been deleted\n", n, inflect.PluralNoun("file", n), inflect.AgreeVerb(n, "has"))
+	}
+	// Output:
+	// 1 file has been deleted
+	// 3 files have been deleted
+}
+
+func ExampleInflectf() {
+	for _, n := range []int{1, 3} {
+		fmt.Println(inflect.Inflectf("Deleted %d %p{file} that %v{was} outdated", n))
+	}
+	// Output:
+	// Deleted 1 file that was outdated
+	// Deleted 3 files that were outdated
+}
+
+func ExampleQuantifyCount() {
+	for _, n := range []int{0, 1, 2, 7, 40, 250} {
+		fmt.Println(inflect.QuantifyCount(n, "file"))
+	}
+	// Output:
+	// no files
+	// a file
+	// a couple of files
+	// several files
+	// dozens of files
+	// hundreds of files
+}
+
+func ExampleCollectiveNoun() {
+	fmt.Println(inflect.CollectiveNoun("lion"))
+	fmt.Println(inflect.CollectiveNoun("crows"))
+	fmt.Println(inflect.CollectivePhrase("owl"))
+	fmt.Println(inflect.CollectivePhrase("engineer"))
+	// Output:
+	// pride
+	// murder
+	// parliament of owls
+	// group of engineers
+}
+
+func ExampleDiminutive() {
+	fmt.Println(inflect.Diminutive("dog"))
+	fmt.Println(inflect.Diminutive("goose"))
+	fmt.Println(inflect.Diminutive("plate"))
+	fmt.Println(inflect.Diminutive("chick"))
+	// Output:
+	// doggy
+	// gosling
+	// platelet
+	// chickling
+}
+
+func ExampleExplainPlural() {
+	fmt.Println(inflect.ExplainPlural("ox"))
+	for _, step := range inflect.ExplainPlural("formula") {
+		fmt.Println(step)
+	}
+	// Output:
+	// [irregular table: ox -> oxen]
+	// classical table: skipped formula -> formulae, classical mode is off
+	// suffix rule (default + -s): formula -> formulas
+}
+
+func ExampleExplainAn() {
+	fmt.Println(inflect.ExplainAn("hourglass"))
+	fmt.Println(inflect.ExplainAn("FBI agent"))
+	// Output:
+	// [silent-h list: hourglass -> an]
+	// [abbreviation: FBI -> an]
+}
+
+func ExampleSetProperNameDetection() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("Berry"))
+	e.SetProperNameDetection(inflect.ProperNameDictionary)
+	fmt.Println(e.Plural("Berry"))
+	fmt.Println(e.Plural("Mary"))
+	// Output:
+	// Berrys
+	// Berries
+	// Marys
+}
+
+func ExamplePluralName() {
+	fmt.Println(inflect.PluralName("Jones"))
+	fmt.Println(inflect.PluralName("Kennedy"))
+	fmt.Println(inflect.PluralName("John Smith"))
+	// Output:
+	// Joneses
+	// Kennedys
+	// John Smiths
+}
+
+func ExampleEnableCache() {
+	inflect.EnableCache(1000)
+	defer inflect.EnableCache(0)
+
+	fmt.Println(inflect.Plural("child"))
+	fmt.Println(inflect.Plural("child"))
+	stats := inflect.GetCacheStats()
+	fmt.Println(stats.Hits, stats.Misses, stats.Size)
+	// Output:
+	// children
+	// children
+	// 1 1 1
+}
+
+func ExampleSetHook() {
+	e := inflect.NewEngine()
+	e.SetHook(inflect.HookFunc(func(fn, input, output, rule string) {
+		fmt.Printf("%s(%q) = %q by %s\n", fn, input, output, rule)
+	}))
+
+	e.Plural("child")
+	e.Plural("blorg")
+	e.An("hour")
+	// Output:
+	// Plural("child") = "children" by irregular table
+	// Plural("blorg") = "blorgs" by suffix rule (default + -s)
+	// An("hour") = "an hour" by pronunciation dictionary
+}
+
+func ExampleNextInName() {
+	fmt.Println(inflect.NextInName("report_2nd_draft.docx"))
+	fmt.Println(inflect.NextInName("20th_century"))
+	// Output:
+	// report_3rd_draft.docx
+	// 21st_century
+}
+
+func ExampleJoinPossessive() {
+	fmt.Println(inflect.JoinPossessive([]string{"Alice", "Bob"}, "report"))
+	fmt.Println(inflect.JoinPossessiveShared([]string{"Alice", "Bob"}, "report"))
+	// Output:
+	// Alice's and Bob's reports
+	// Alice and Bob's report
+}
+
+func ExampleJoinAuthors() {
+	authors := []string{"Smith", "Jones", "Brown", "Lee"}
+	fmt.Println(inflect.JoinAuthors(authors[:2], 3))
+	fmt.Println(inflect.JoinAuthors(authors, 2))
+	fmt.Println(inflect.JoinAuthorsWithOptions(authors, inflect.JoinAuthorsOptions{Max: 2, Keep: 1}))
+	// Output:
+	// Smith and Jones
+	// Smith, Jones, et al.
+	// Smith et al.
+}
+
+func ExampleOrdinalRanges() {
+	fmt.Println(inflect.OrdinalRange(1, 3))
+	fmt.Println(inflect.OrdinalRangeWords(1, 3))
+	fmt.Println(inflect.OrdinalRanges([]int{1, 2, 3, 7}))
+	// Output:
+	// 1st–3rd
+	// first through third
+	// 1st–3rd and 7th
+}
+
+func ExampleCapitalizeSentence() {
+	count := 0
+	fmt.Println(inflect.CapitalizeSentence(inflect.No("error", count) + " were found. " + inflect.An("iPhone") + " is required."))
+	fmt.Println(inflect.NoCapitalized("error", 3) + " were found.")
+	// Output:
+	// No errors were found. An iPhone is required.
+	// Three errors were found.
+}
+
+func ExampleAmountPhrase() {
+	fmt.Println("Upload " + inflect.AmountPhrase(5, "file"))
+	fmt.Println("Use " + inflect.AmountPhrase(10, "minute"))
+	fmt.Printf("How %s %s?\n", inflect.ManyOrMuch("information"), "information")
+	// Output:
+	// Upload fewer than five files
+	// Use less than ten minutes
+	// How much information?
+}
+
+func ExampleAgreeCompound() {
+	fmt.Println(inflect.AgreeCompound([]string{"Alice", "Bob"}, "has") + " joined the project")
+	fmt.Println(inflect.AgreeCompoundWithConj([]string{"the dogs", "the cat"}, inflect.CompoundNeitherNor, "are") + " hungry")
+	// Output:
+	// Alice and Bob have joined the project
+	// neither the dogs nor the cat is hungry
+}
+
+func ExampleNumberOfPhrase() {
+	fmt.Println(inflect.ANumberOf("error", "was found"))
+	fmt.Println(inflect.NumberOfPhrase(3, "error", "were"))
+	// Output:
+	// a number of errors were found
+	// the number of errors was three
+}
+
+func ExampleNegate() {
+	fmt.Println("The file " + inflect.Negate("is") + " readable")
+	fmt.Println("The job " + inflect.Negate("runs") + " on weekends")
+	fmt.Println("You " + inflect.NegateWithOptions("can", false) + " undo this")
+	fmt.Println("It " + inflect.Unnegate("didn't fail"))
+	// Output:
+	// The file isn't readable
+	// The job doesn't run on weekends
+	// You cannot undo this
+	// It failed
+}
+
+func ExampleInterrogate() {
+	fmt.Println(inflect.Interrogate("the user", "has", "access"))
+	fmt.Println(inflect.Interrogate("The build", "is", "green."))
+	fmt.Println(inflect.Interrogate("the file", "has been", "deleted"))
+	// Output:
+	// Does the user have access?
+	// Is the build green?
+	// Has the file been deleted?
+}
+
+func ExamplePassivize() {
+	fmt.Println(inflect.Passivize("the analyst", "wrote", "the report"))
+	fmt.Println(inflect.PassivizeWithTense("the analyst", "write", "the reports", inflect.TensePerfect))
+	fmt.Println(inflect.Passivize("", "will delete", "old backups"))
+	// Output:
+	// The report was written by the analyst
+	// The reports have been written by the analyst
+	// Old backups will be deleted
+}
+
+func ExampleChangeTense() {
+	past, _ := inflect.ChangeTense("The job runs nightly", inflect.TensePast)
+	fmt.Println(past)
+	perfect, _ := inflect.ChangeTense("The users log in", inflect.TensePerfect)
+	fmt.Println(perfect)
+	_, err := inflect.ChangeTense("Nightly", inflect.TensePast)
+	fmt.Println(errors.Is(err, inflect.ErrNoVerb))
+	// Output:
+	// The job ran nightly
+	// The users have logged in
+	// true
+}
+
+func ExampleExpand() {
+	fmt.Println(inflect.Expand("can't stop won't stop"))
+	fmt.Println(inflect.Expand("I'm sure it's fine"))
+	fmt.Println(inflect.Contract("I am sure it is not broken"))
+	// Output:
+	// cannot stop will not stop
+	// I am sure it's fine
+	// I'm sure it isn't broken
+}
+
+func ExampleDefStylized() {
+	fmt.Println(inflect.Plural("iPhone"), inflect.Plural("macOS"), inflect.An("iPhone"))
+
+	e := inflect.NewEngine()
+	e.DefStylized("GmbH", "GmbHs")
+	fmt.Println(e.Plural("GmbH"))
+	// Output:
+	// iPhones macOS an iPhone
+	// GmbHs
+}
+
+func ExampleNormalizeInput() {
+	e := inflect.NewEngine()
+	nfd := "cafe\u0301"
+	fmt.Println(e.Plural(nfd) == "cafés")
+
+	e.NormalizeInput(true)
+	fmt.Println(e.Plural(nfd) == "cafés")
+	// Output:
+	// false
+	// true
+}
+
+func ExamplePluralLower() {
+	for _, table := range []string{"user", "category", "person"} {
+		fmt.Println(inflect.PluralLower(table))
+	}
+	// Output:
+	// users
+	// categories
+	// people
+}
+
+func ExampleInflectDocument() {
+	nouns := func(t inflect.Token) inflect.Transform {
+		if t.POS == inflect.POSNoun {
+			return inflect.TransformPlural
+		}
+		return inflect.TransformNone
+	}
+	fmt.Println(inflect.InflectDocument("The cat sat on the mat.", nouns))
+
+	all := func(inflect.Token) inflect.Transform { return inflect.TransformPlural }
+	fmt.Println(inflect.InflectDocument("A user logs in. It works.", all))
+	// Output:
+	// The cats sat on the mats.
+	// Some users log in. They work.
+}
+
+func ExampleSingularizePhrase() {
+	fmt.Println(inflect.SingularizePhrase("these files are ready"))
+	fmt.Println(inflect.SingularizePhrase("Some apples have fallen."))
+	// Output:
+	// this file is ready
+	// An apple has fallen.
+}
+
+func ExampleInflectHTML() {
+	fmt.Println(inflect.InflectHTML(`<p title="plural('cat')">I saw plural('cat', 3)</p>`))
+	// Output:
+	// <p title="plural('cat')">I saw cats</p>
+}
+
+func ExampleInflectMarkdown() {
+	fmt.Println(inflect.InflectMarkdown("See [plural('cat')](https://example.com/plural(cat)) or `plural('cat')`"))
+	// Output:
+	// See [cats](https://example.com/plural(cat)) or `plural('cat')`
+}
+
+func ExampleLoadRulePack() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("index"), e.Plural("schema"))
+
+	if err := e.LoadRulePack("computing"); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(e.Plural("index"), e.Plural("schema"))
+
+	err := e.LoadRulePack("chemistry")
+	fmt.Println(errors.Is(err, inflect.ErrUnknownRulePack))
+	// Output:
+	// indices schemas
+	// indexes schemata
+	// true
+}
+
+func ExampleSetTechnicalPlurals() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("index"), e.Plural("appendix"))
+
+	e.SetTechnicalPlurals(true)
+	fmt.Println(e.Plural("index"), e.Plural("appendix"), e.Plural("matrix"))
+
+	e.ClassicalAncient(true)
+	fmt.Println(e.Plural("index"))
+	// Output:
+	// indices appendices
+	// indexes appendixes matrices
+	// indices
+}
+
+func ExampleSetRules() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("hippopotamus"))
+
+	rule := inflect.SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+	e.SetRules(slices.Insert(e.Rules(), 1, rule))
+	fmt.Println(e.Plural("hippopotamus"))
+	fmt.Println(e.ExplainPlural("hippopotamus"))
+	// Output:
+	// hippopotamuses
+	// hippopotami
+	// [suffix rule (-us -> -i): hippopotamus -> hippopotami]
+}
+
+func ExampleEngine_Checkpoint() {
+	e := inflect.NewEngine()
+	e.DefNoun("gadget", "gadgetz")
+
+	// Try out a definition, then roll it back
+	e.Checkpoint()
+	e.DefNoun("widget", "widgetz")
+	fmt.Println(e.Plural("widget"), e.Plural("gadget"))
+	e.Undo()
+	fmt.Println(e.Plural("widget"), e.Plural("gadget"))
+	// Output:
+	// widgetz gadgetz
+	// widgets gadgetz
+}
+
+func ExampleEngine_ResetCustomOnly() {
+	e := inflect.NewEngine()
+	e.DefNoun("index", "indexes")  // override a built-in
+	e.DefNoun("widget", "widgetz") // add a noun
+	e.DefNoun("child", "childs")
+
+	e.ResetWord("child")
+	fmt.Println(e.Plural("index"), e.Plural("widget"), e.Plural("child"))
+
+	e.ResetCustomOnly()
+	fmt.Println(e.Plural("index"), e.Plural("widget"), e.Plural("child"))
+	// Output:
+	// indexes widgetz children
+	// indexes widgets children
+}
+
+func ExampleDefNounMulti() {
+	e := inflect.NewEngine()
+	e.DefNounMulti("virus", "viruses", "viri")
+	fmt.Println(e.Plural("virus"))
+
+	e.ClassicalAncient(true)
+	fmt.Println(e.Plural("virus"))
+	fmt.Println(e.Singular("viri"))
+	// Output:
+	// viruses
+	// viri
+	// virus
+}
+
+func ExampleDefUnchanged() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("kudos"))
+
+	e.DefUnchanged("kudos")
+	fmt.Println(e.Plural("kudos"))
+
+	e.DefHerd("yak")
+	e.ClassicalHerd(true)
+	fmt.Println(e.Plural("yak"))
+	// Output:
+	// kudoses
+	// kudos
+	// yak
+}
+
+func ExampleDefSilentH() {
+	e := inflect.NewEngine()
+	e.DefSilentH("homage")
+	e.DefSoundedVowel("oaxac")
+	fmt.Println(e.An("homage to Oaxaca"))
+	fmt.Println(e.An("Oaxacan homage"))
+	// Output:
+	// an homage to Oaxaca
+	// a Oaxacan homage
+}
+
+func ExampleAnInSentence() {
+	fmt.Println(inflect.AnInSentence("We saw a owl and an unicorn."))
+	fmt.Println(inflect.AnInSentence("An user left. Plan A is an plan."))
+	// Output:
+	// We saw an owl and a unicorn.
+	// A user left. Plan A is a plan.
+}
+
+func ExampleNewPipeline() {
+	p := inflect.NewPipeline(inflect.FixArticles(), inflect.FixAgreement(), inflect.ExpandContractions())
+	fmt.Println(p.Apply("They doesn't want a apple. The cats is asleep."))
+
+	// Any func(string) string can be a stage
+	fmt.Println(p.Then(inflect.Contract).Apply("He have not seen a owl."))
+	// Output:
+	// They do not want an apple. The cats are asleep.
+	// He hasn't seen an owl.
+}
+
+func ExampleSetDomainBias() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Singular("bases"))
+
+	e.SetDomainBias(inflect.DomainSports, 10)
+	fmt.Println(e.Singular("bases"))
+	// Output:
+	// basis
+	// base
+}
+
+func ExampleSetLenient() {
+	e := inflect.NewEngine()
+	fmt.Println(e.Plural("childs"))
+
+	e.SetLenient(true)
+	fmt.Println(e.Plural("childs"), e.Singular("mouses"), e.Plural("chidren"))
+
+	c, _ := e.CorrectNoun("chidren")
+	fmt.Println(c.Note)
+	// Output:
+	// childses
+	// children mouse children
+	// "chidren" is a misspelling of "children"
+}
+
 func ExampleNumberToWords() {
 	fmt.Println(inflect.NumberToWords(1))
 	fmt.Println(inflect.NumberToWords(42))
@@ -74,6 +692,44 @@ func ExampleNumberToWords() {
 	// negative five
 }
 
+func ExampleWordsToNumber() {
+	fmt.Println(inflect.WordsToNumber("forty-two"))
+	fmt.Println(inflect.WordsToNumber("one hundred and five"))
+	fmt.Println(inflect.WordsToNumber("negative three thousand, two"))
+	// Output:
+	// 42
+	// 105
+	// -3002
+}
+
+func ExampleWordsToNumberE() {
+	_, err := inflect.WordsToNumberE("forty-lots")
+	fmt.Println(errors.Is(err, inflect.ErrUnknownWord))
+	_, err = inflect.WordsToNumberE("ten quintillion")
+	fmt.Println(errors.Is(err, inflect.ErrOverflow))
+	// Output:
+	// true
+	// true
+}
+
+func ExamplePluralE() {
+	fmt.Println(inflect.PluralE("child"))
+	_, err := inflect.PluralE("42")
+	fmt.Println(err)
+	// Output:
+	// children <nil>
+	// unknown word: "42"
+}
+
+func ExampleSingularE() {
+	fmt.Println(inflect.SingularE("children"))
+	_, err := inflect.SingularE("child")
+	fmt.Println(errors.Is(err, inflect.ErrNotPlural))
+	// Output:
+	// child <nil>
+	// true
+}
+
 func ExampleOrdinal() {
 	fmt.Println(inflect.Ordinal(1))
 	fmt.Println(inflect.Ordinal(2))
@@ -128,6 +784,83 @@ func ExamplePresentParticiple() {
 	// panicking
 }
 
+func ExamplePresentParticipleIn() {
+	fmt.Println(inflect.PresentParticipleIn("travel", inflect.DialectUS))
+	fmt.Println(inflect.PresentParticipleIn("travel", inflect.DialectUK))
+	// Output:
+	// traveling
+	// travelling
+}
+
+func ExamplePastTensePhrase() {
+	fmt.Println(inflect.PastTensePhrase("check out"))
+	fmt.Println(inflect.PresentParticiplePhrase("set up"))
+	fmt.Println(inflect.PluralVerbPhrase("logs in"))
+	// Output:
+	// checked out
+	// setting up
+	// log in
+}
+
+func ExampleBaseVerb() {
+	fmt.Println(inflect.BaseVerb("running"))
+	fmt.Println(inflect.BaseVerb("making"))
+	fmt.Println(inflect.BaseVerb("singing"))
+	fmt.Println(inflect.BaseVerb("bring"))
+	// Output:
+	// run
+	// make
+	// sing
+	// bring
+}
+
+func ExampleLemma() {
+	fmt.Println(inflect.Lemma("children", inflect.POSNoun))
+	fmt.Println(inflect.Lemma("ran", inflect.POSVerb))
+	fmt.Println(inflect.Lemma("watches", inflect.POSVerb))
+	fmt.Println(inflect.Lemma("happiest", inflect.POSAdjective))
+	// Output:
+	// child
+	// run
+	// watch
+	// happy
+}
+
+func ExampleGuessPOS() {
+	pos, confidence := inflect.GuessPOS("they")
+	fmt.Println(pos == inflect.POSPronoun, confidence)
+
+	pos, confidence = inflect.GuessPOS("happiness")
+	fmt.Println(pos == inflect.POSNoun, confidence)
+
+	pos, confidence = inflect.GuessPOS("famous")
+	fmt.Println(pos == inflect.POSAdjective, confidence)
+	// Output:
+	// true 1
+	// true 0.8
+	// true 0.8
+}
+
+func ExampleInflect() {
+	fmt.Println(inflect.Inflect("I saw plural('cat', 3) and a('owl')"))
+	fmt.Println(inflect.Inflect("num(1)There plural_verb('are') no('error')"))
+	fmt.Println(inflect.Inflect("num(2)auto('this') auto('child') auto('was') here"))
+	// Output:
+	// I saw cats and an owl
+	// There is 1 error
+	// these children were here
+}
+
+func ExampleInflectTokens() {
+	text := "I saw plural('cat', 3) and plurl('dog')"
+	for _, tok := range inflect.InflectTokens(text) {
+		fmt.Println(tok.Start, tok.End, tok.Func, tok.Args, tok.Err)
+	}
+	// Output:
+	// 6 22 plural [cat 3] <nil>
+	// 27 39 plurl [dog] unknown function
+}
+
 func ExamplePastParticiple() {
 	fmt.Println(inflect.PastParticiple("walk"))
 	fmt.Println(inflect.PastParticiple("stop"))
@@ -156,6 +889,46 @@ func ExampleCompare() {
 	//
 }
 
+func ExampleCompareDetailed() {
+	r := inflect.CompareDetailed("Mice", "mouse")
+	fmt.Println(r.Relation == inflect.RelPluralSingular, r.Base)
+	r = inflect.CompareDetailed("indexes", "indices")
+	fmt.Println(r, r.Base)
+	// Output:
+	// true mouse
+	// p:p index
+}
+
+func ExamplePluralNounPhrase() {
+	fmt.Println(inflect.PluralNounPhrase("cup of coffee"))
+	fmt.Println(inflect.PluralNounPhrase("piece of information"))
+	fmt.Println(inflect.PluralNounPhrase("mother-in-law"))
+	// Output:
+	// cups of coffee
+	// pieces of information
+	// mothers-in-law
+}
+
+func ExampleAllPlurals() {
+	for _, f := range inflect.AllPlurals("octopus") {
+		fmt.Println(f.Plural, f.Label)
+	}
+	// Output:
+	// octopuses modern
+	// octopi assimilated
+	// octopodes classical
+}
+
+func ExamplePluralPeople() {
+	fmt.Println(inflect.PluralPeople("person", inflect.PersonIndividuals))
+	fmt.Println(inflect.PluralPeople("person", inflect.PersonFormal))
+	fmt.Println(inflect.PluralPeople("people", inflect.PersonNations))
+	// Output:
+	// people
+	// persons
+	// peoples
+}
+
 // --- Adjective examples ---
 
 func ExampleComparative() {
@@ -218,6 +991,17 @@ func ExampleDefAn() {
 	// an hotel
 }
 
+func ExampleDefAnException() {
+	inflect.DefAReset() // Reset to defaults first
+	fmt.Println(inflect.An("herb"))
+	_ = inflect.DefAnException("herb", "a")
+	fmt.Println(inflect.An("herb"))
+	inflect.DefAReset() // Clean up
+	// Output:
+	// an herb
+	// a herb
+}
+
 func ExampleDefAPattern() {
 	inflect.DefAReset() // Reset to defaults first
 	_ = inflect.DefAPattern("herb.*")
@@ -521,6 +1305,14 @@ func ExampleCurrencyToWords() {
 	// one pound and one penny
 }
 
+func ExampleCheckAmount() {
+	fmt.Println(inflect.CheckAmount(1234.5))
+	fmt.Println(inflect.CheckAmount(100))
+	// Output:
+	// One thousand two hundred thirty-four and 50/100 dollars
+	// One hundred and no/100 dollars
+}
+
 // --- Custom noun/verb/adj examples ---
 
 func ExampleDefNoun() {
@@ -533,6 +1325,16 @@ func ExampleDefNoun() {
 	// foo
 }
 
+func ExampleDefNounRule() {
+	e := inflect.NewEngine()
+	_ = e.DefNounRule("us$", "i", 0)
+	fmt.Println(e.Plural("hippopotamus"))
+	fmt.Println(e.Plural("Octopus"))
+	// Output:
+	// hippopotami
+	// Octopi
+}
+
 func ExampleDefNounReset() {
 	inflect.DefNoun("child", "childs")
 	fmt.Println(inflect.Plural("child"))
@@ -630,6 +1432,26 @@ func ExampleFractionToWordsWithFourths() {
 	// three fourths
 }
 
+// --- Percent and ratio examples ---
+
+func ExamplePercentToWords() {
+	fmt.Println(inflect.PercentToWords(12.5))
+	fmt.Println(inflect.PercentToWords(100))
+	fmt.Println(inflect.PercentToWordsWithPrecision(33.3333, 1))
+	// Output:
+	// twelve point five percent
+	// one hundred percent
+	// thirty-three point three percent
+}
+
+func ExampleRatioToWords() {
+	fmt.Println(inflect.RatioToWords(3, 4))
+	fmt.Println(inflect.RatioToWordsWithSep(3, 4, "in"))
+	// Output:
+	// three out of four
+	// three in four
+}
+
 // --- Gender examples ---
 
 func ExampleGender() {
@@ -763,6 +1585,71 @@ func ExampleFormatNumber() {
 	// -1,234
 }
 
+func ExampleFormatNumberWithOptions() {
+	fmt.Println(inflect.FormatNumberWithOptions(1234567, inflect.FormatNumberOptions{Separator: '.'}))
+	fmt.Println(inflect.FormatNumberWithOptions(1234567, inflect.FormatNumberOptions{Separator: ' '}))
+	fmt.Println(inflect.FormatNumberWithOptions(12345678, inflect.FormatNumberOptions{GroupSize: 4}))
+	// Output:
+	// 1.234.567
+	// 1 234 567
+	// 1234,5678
+}
+
+func ExampleFormatFloat() {
+	fmt.Println(inflect.FormatFloat(1234.567, 2))
+	fmt.Println(inflect.FormatFloat(1000, 2))
+	fmt.Println(inflect.FormatFloat(-9876.5, 0))
+	// Output:
+	// 1,234.57
+	// 1,000.00
+	// -9,876
+}
+
+func ExampleFormatFloatWithOptions() {
+	opts := inflect.FormatNumberOptions{Separator: '.', DecimalSeparator: ',', Decimals: 2}
+	fmt.Println(inflect.FormatFloatWithOptions(1234.5, opts))
+	// Output:
+	// 1.234,50
+}
+
+func ExampleCompactNumber() {
+	fmt.Println(inflect.CompactNumber(999))
+	fmt.Println(inflect.CompactNumber(1234))
+	fmt.Println(inflect.CompactNumber(3400000))
+	fmt.Println(inflect.CompactNumberWithPrecision(1234567, 2))
+	// Output:
+	// 999
+	// 1.2K
+	// 3.4M
+	// 1.23M
+}
+
+func ExampleCompactNumberWords() {
+	fmt.Println(inflect.CompactNumberWords(1234567))
+	fmt.Println(inflect.CompactNumberWords(2000000000))
+	fmt.Println(inflect.CompactNumberWordsWithPrecision(1234567, 2))
+	// Output:
+	// 1.2 million
+	// 2 billion
+	// 1.23 million
+}
+
+func ExampleDigitsToWords() {
+	fmt.Println(inflect.DigitsToWords("415-555-0123"))
+	fmt.Println(inflect.DigitsToWords("007"))
+	// Output:
+	// four one five, five five five, zero one two three
+	// zero zero seven
+}
+
+func ExampleDigitsToWordsWithOptions() {
+	fmt.Println(inflect.DigitsToWordsWithOptions("415-555-0123", inflect.DigitsToWordsOptions{Zero: "oh"}))
+	fmt.Println(inflect.DigitsToWordsWithOptions("415-555-0123", inflect.DigitsToWordsOptions{Separator: " "}))
+	// Output:
+	// four one five, five five five, oh one two three
+	// four one five five five five zero one two three
+}
+
 func ExampleGetNum() {
 	inflect.Num(5)
 	fmt.Println(inflect.GetNum())
@@ -784,6 +1671,24 @@ func ExampleNo() {
 	// 5 errors
 }
 
+func ExampleNoWords() {
+	fmt.Println(inflect.NoWords("error", 0))
+	fmt.Println(inflect.NoWords("error", 1))
+	fmt.Println(inflect.NoWords("error", 3))
+	// Output:
+	// no errors
+	// one error
+	// three errors
+}
+
+func ExampleNoThreshold() {
+	fmt.Println(inflect.NoThreshold("warning", 2, 10))
+	fmt.Println(inflect.NoThreshold("warning", 12, 10))
+	// Output:
+	// two warnings
+	// 12 warnings
+}
+
 func ExampleNum() {
 	fmt.Println(inflect.Num(42))
 	fmt.Println(inflect.Num())
@@ -792,6 +1697,31 @@ func ExampleNum() {
 	// 0
 }
 
+func ExampleWithEngine() {
+	e := inflect.NewEngine()
+	e.Classical(true)
+	ctx := inflect.WithEngine(context.Background(), e)
+
+	fmt.Println(inflect.PluralCtx(ctx, "formula"))
+	fmt.Println(inflect.PluralCtx(context.Background(), "formula"))
+	// Output:
+	// formulae
+	// formulas
+}
+
+func ExampleNumPropagation() {
+	e := inflect.NewEngine()
+	e.Num(1)
+	fmt.Println(e.Plural("cat"))
+	fmt.Println(e.PluralVerb("is"))
+	e.NumPropagation(false)
+	fmt.Println(e.Plural("cat"))
+	// Output:
+	// cat
+	// is
+	// cats
+}
+
 func ExampleNumberToWordsFloat() {
 	fmt.Println(inflect.NumberToWordsFloat(3.14))
 	fmt.Println(inflect.NumberToWordsFloat(0.5))
@@ -818,6 +1748,25 @@ func ExampleNumberToWordsGrouped() {
 	// twelve thirty-four fifty-six
 }
 
+func ExampleNumberStringToWordsGrouped() {
+	words, err := inflect.NumberStringToWordsGrouped("0123", 2)
+	fmt.Println(words, err)
+
+	_, err = inflect.NumberStringToWordsGrouped("12-34", 2)
+	fmt.Println(errors.Is(err, inflect.ErrNotDigits))
+	// Output:
+	// zero one twenty-three <nil>
+	// true
+}
+
+func ExampleNumberToWordsBare() {
+	fmt.Println(inflect.NumberToWordsBare(120034))
+	fmt.Println(inflect.NumberToWordsBareWithOptions(120034, inflect.NumberToWordsBareOptions{OmitLeadingZeros: true}))
+	// Output:
+	// one twenty zero thirty-four
+	// one twenty thirty-four
+}
+
 func ExampleNumberToWordsThreshold() {
 	fmt.Println(inflect.NumberToWordsThreshold(5, 10))
 	fmt.Println(inflect.NumberToWordsThreshold(15, 10))
@@ -836,6 +1785,92 @@ func ExampleNumberToWordsWithAnd() {
 	// one hundred and twenty-one
 }
 
+func ExampleTensWord() {
+	fmt.Println(inflect.TensWord(2) + "-odd")
+	fmt.Println(inflect.CardinalWord(-42))
+	// Output:
+	// twenty-odd
+	// forty-two
+}
+
+func ExampleNumberToWordsWithOptions() {
+	style := inflect.NumberWordOptions{And: true}
+	fmt.Println(inflect.NumberToWordsWithOptions(121, style))
+	fmt.Println(inflect.OrdinalWordWithOptions(121, inflect.OrdinalWordOptions{NumberWordOptions: style}))
+	// Output:
+	// one hundred and twenty-one
+	// one hundred and twenty-first
+}
+
+// --- Time examples ---
+
+func ExampleDurationToWords() {
+	fmt.Println(inflect.DurationToWords(90 * time.Minute))
+	fmt.Println(inflect.DurationToWords(26*time.Hour + 3*time.Second))
+	fmt.Println(inflect.DurationToWords(250 * time.Millisecond))
+	// Output:
+	// one hour and thirty minutes
+	// one day, two hours, and three seconds
+	// two hundred fifty milliseconds
+}
+
+func ExampleClockToWords() {
+	fmt.Println(inflect.ClockToWords(14, 35))
+	fmt.Println(inflect.ClockToWords(14, 30))
+	fmt.Println(inflect.ClockToWords(12, 0))
+	// Output:
+	// twenty-five to three
+	// half past two
+	// noon
+}
+
+func ExampleClockToWordsWithStyle() {
+	fmt.Println(inflect.ClockToWordsWithStyle(14, 35, inflect.ClockFormal))
+	fmt.Println(inflect.ClockToWordsWithStyle(14, 5, inflect.ClockFormal))
+	fmt.Println(inflect.ClockToWordsWithStyle(9, 0, inflect.ClockTwentyFourHour))
+	// Output:
+	// two thirty-five
+	// two oh five
+	// oh nine hundred
+}
+
+func ExampleYearToWords() {
+	fmt.Println(inflect.YearToWords(1976))
+	fmt.Println(inflect.YearToWords(1905))
+	fmt.Println(inflect.YearToWords(2005))
+	fmt.Println(inflect.YearToWords(2024))
+	// Output:
+	// nineteen seventy-six
+	// nineteen oh five
+	// two thousand five
+	// twenty twenty-four
+}
+
+func ExampleDateToWords() {
+	fmt.Println(inflect.DateToWords(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC)))
+	// Output:
+	// the fourth of July, nineteen seventy-six
+}
+
+func ExampleDateToWordsWithStyle() {
+	date := time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC)
+	fmt.Println(inflect.DateToWordsWithStyle(date, inflect.DateSpelledOut))
+	fmt.Println(inflect.DateToWordsWithStyle(date, inflect.DateNumeric))
+	// Output:
+	// the fourth of July, nineteen seventy-six
+	// July 4th, 1976
+}
+
+func ExampleOrdinalDay() {
+	fmt.Println(inflect.OrdinalDay(time.July, 4, inflect.OrdinalDayMonthFirst))
+	fmt.Println(inflect.OrdinalDay(time.July, 4, inflect.OrdinalDayDayFirst))
+	fmt.Println(inflect.OrdinalDay(time.July, 4, inflect.OrdinalDaySpelledOut))
+	// Output:
+	// July 4th
+	// 4th July
+	// the fourth of July
+}
+
 // --- Ordinal examples ---
 
 func ExampleIsOrdinal() {
@@ -852,6 +1887,27 @@ func ExampleIsOrdinal() {
 	// false
 }
 
+func ExampleOrdinalFromEnd() {
+	fmt.Println(inflect.OrdinalFromEnd(10, 10))
+	fmt.Println(inflect.OrdinalFromEnd(9, 10))
+	fmt.Println(inflect.OrdinalFromEnd(8, 10))
+	// Output:
+	// last
+	// second to last
+	// third to last
+}
+
+func ExampleOrdinalWordWithOptions() {
+	fromEnd := inflect.OrdinalWordOptions{FromEnd: true}
+	fmt.Println(inflect.OrdinalWordWithOptions(-1, fromEnd))
+	fmt.Println(inflect.OrdinalWordWithOptions(-2, fromEnd))
+	fmt.Println(inflect.OrdinalWordWithOptions(0, inflect.OrdinalWordOptions{Zero: "none"}))
+	// Output:
+	// last
+	// second to last
+	// none
+}
+
 func ExampleOrdinalSuffix() {
 	fmt.Println(inflect.OrdinalSuffix(1))
 	fmt.Println(inflect.OrdinalSuffix(2))
@@ -1207,6 +2263,36 @@ func ExampleTableize() {
 	// children
 }
 
+func ExampleTableName() {
+	fmt.Println(inflect.TableName("Person"))
+	fmt.Println(inflect.ColumnName("CreatedAt"))
+	fmt.Println(inflect.JoinTableName("User", "Role"))
+	// Output:
+	// people
+	// created_at
+	// roles_users
+}
+
+func ExampleServiceMethodName() {
+	fmt.Println(inflect.ServiceMethodName(inflect.MethodList, "UserProfile"))
+	fmt.Println(inflect.ServiceMethodName(inflect.MethodGet, "UserProfile"))
+	fmt.Println(inflect.EnumValueName("OrderStatus", "InTransit"))
+	// Output:
+	// ListUserProfiles
+	// GetUserProfile
+	// ORDER_STATUS_IN_TRANSIT
+}
+
+func ExampleQueryFieldName() {
+	fmt.Println(inflect.QueryFieldName("Order"))
+	fmt.Println(inflect.ConnectionName("Order"))
+	fmt.Println(inflect.EdgeName("Order"))
+	// Output:
+	// orders
+	// OrderConnection
+	// OrderEdge
+}
+
 func ExampleParameterize() {
 	fmt.Println(inflect.Parameterize("Hello World!"))
 	fmt.Println(inflect.Parameterize("café au lait"))
@@ -1269,6 +2355,21 @@ func ExampleNewEngine() {
 	// modern: formulas
 }
 
+func ExampleNewEngine_options() {
+	e := inflect.NewEngine(
+		inflect.WithClassical(inflect.ClassicalModeAncient),
+		inflect.WithGender("f"),
+		inflect.WithDialect(inflect.DialectUK),
+	)
+	fmt.Println(e.Plural("formula"))
+	fmt.Println(e.SingularNoun("they"))
+	fmt.Println(e.An("herb"))
+	// Output:
+	// formulae
+	// she
+	// a herb
+}
+
 // ExampleEngine_Clone demonstrates creating a copy of an engine's configuration.
 func ExampleEngine_Clone() {
 	// Create and configure an engine
@@ -1318,6 +2419,19 @@ func ExampleAddAcronym() {
 	// GPU config
 }
 
+func ExampleDefAcronym() {
+	e := inflect.NewEngine()
+	e.DefAcronym("SQL", "sequel")
+	fmt.Println(e.An("SQL query"))
+	e.DefAcronym("SQL", "ess-cue-ell")
+	fmt.Println(e.An("SQL query"))
+	fmt.Println(e.Plural("SQL"))
+	// Output:
+	// a SQL query
+	// an SQL query
+	// SQLs
+}
+
 func ExampleIsAcronym() {
 	fmt.Println(inflect.IsAcronym("GPU"))
 	fmt.Println(inflect.IsAcronym("Cat"))
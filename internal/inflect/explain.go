@@ -0,0 +1,151 @@
+package inflect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explanation records the rules consulted while inflecting a word.
+//
+// A nil *explanation records nothing, so the inflection code can note each
+// step unconditionally.
+type explanation struct {
+	steps []string
+}
+
+// note records a step, formatted as "rule: detail".
+func (x *explanation) note(format string, args ...any) {
+	if x == nil {
+		return
+	}
+	x.steps = append(x.steps, fmt.Sprintf(format, args...))
+}
+
+// rule returns the rule of the last step, the part before ": ", or "" if
+// no step was recorded.
+func (x *explanation) rule() string {
+	if x == nil || len(x.steps) == 0 {
+		return ""
+	}
+	rule, _, _ := strings.Cut(x.steps[len(x.steps)-1], ": ")
+	return rule
+}
+
+// ExplainPlural returns the chain of rules Plural applies to a word, in the
+// order they were applied. The last step names the rule that produced the
+// plural.
+//
+// This is meant for debugging, for example to find out why a DefNoun() rule
+// or a classical mode flag is not taking effect. The wording of the steps
+// may change between releases.
+//
+// Examples:
+//   - ExplainPlural("ox") returns ["irregular table: ox -> oxen"]
+//   - ExplainPlural("cat") returns ["suffix rule (default + -s): cat -> cats"]
+//   - ExplainPlural("formula") returns ["classical table: skipped formula -> formulae, classical mode is off", "suffix rule (default + -s): formula -> formulas"]
+//   - ExplainPlural("ox's") returns ["possessive: inflecting ox", "irregular table: ox -> oxen"]
+func ExplainPlural(word string) []string {
+	return defaultEngine.ExplainPlural(word)
+}
+
+// ExplainPlural returns the chain of rules e.Plural applies to a word, in
+// the order they were applied. The last step names the rule that produced
+// the plural.
+//
+// This is meant for debugging, for example to find out why a DefNoun() rule
+// or a classical mode flag is not taking effect. The wording of the steps
+// may change between releases.
+//
+// Examples:
+//   - e.ExplainPlural("ox") returns ["irregular table: ox -> oxen"]
+//   - e.ExplainPlural("cat") returns ["suffix rule (default + -s): cat -> cats"]
+func (e *Engine) ExplainPlural(word string) []string {
+	x := &explanation{}
+	e.pluralCounted(e.normalize(word), x)
+	return x.steps
+}
+
+// ExplainSingular returns the chain of rules Singular applies to a word, in
+// the order they were applied. The last step names the rule that produced
+// the singular.
+//
+// This is meant for debugging, for example to find out why a noun ending
+// in s loses it. The wording of the steps may change between releases.
+//
+// Examples:
+//   - ExplainSingular("oxen") returns ["irregular table: oxen -> ox"]
+//   - ExplainSingular("cats") returns ["suffix rule (-s removed): cats -> cat"]
+//   - ExplainSingular("bus") returns ["known singular: bus is already singular"]
+func ExplainSingular(word string) []string {
+	return defaultEngine.ExplainSingular(word)
+}
+
+// ExplainSingular returns the chain of rules e.Singular applies to a word,
+// in the order they were applied. The last step names the rule that
+// produced the singular.
+//
+// This is meant for debugging, for example to find out why a noun ending
+// in s loses it. The wording of the steps may change between releases.
+//
+// Examples:
+//   - e.ExplainSingular("oxen") returns ["irregular table: oxen -> ox"]
+//   - e.ExplainSingular("cats") returns ["suffix rule (-s removed): cats -> cat"]
+func (e *Engine) ExplainSingular(word string) []string {
+	x := &explanation{}
+	e.singularExplained(e.normalize(word), x)
+	return x.steps
+}
+
+// ExplainSingularSafe returns the chain of rules SingularSafe applies to a
+// word, in the order they were applied. The last step names the rule that
+// produced the singular, or why the word was kept unchanged.
+//
+// Examples:
+//   - ExplainSingularSafe("cats") returns ["suffix rule (-s removed): cats -> cat"]
+//   - ExplainSingularSafe("octothorpus") returns ["suffix rule (-s removed after u or i): octothorpus -> octothorpu", "safe mode: octothorpus may be singular and is kept"]
+func ExplainSingularSafe(word string) []string {
+	return defaultEngine.ExplainSingularSafe(word)
+}
+
+// ExplainSingularSafe returns the chain of rules e.SingularSafe applies to
+// a word, in the order they were applied. See the package-level
+// ExplainSingularSafe for details.
+func (e *Engine) ExplainSingularSafe(word string) []string {
+	x := &explanation{}
+	e.singularSafe(e.normalize(word), x)
+	return x.steps
+}
+
+// ExplainAn returns the chain of rules An applies to choose the article for
+// a word, in the order they were applied. The last step names the rule that
+// chose the article.
+//
+// This is meant for debugging, for example to find out why a DefA() or
+// DefAn() rule is not taking effect. The wording of the steps may change
+// between releases.
+//
+// Examples:
+//   - ExplainAn("hourglass") returns ["silent-h list: hourglass -> an"]
+//   - ExplainAn("cat") returns ["first letter: cat -> a"]
+//   - ExplainAn("FBI agent") returns ["abbreviation: FBI -> an"]
+//   - ExplainAn("\"apple\"") returns ["wrappers: analyzing apple", "first letter: apple -> an"]
+func ExplainAn(word string) []string {
+	return defaultEngine.ExplainAn(word)
+}
+
+// ExplainAn returns the chain of rules e.An applies to choose the article
+// for a word, in the order they were applied. The last step names the rule
+// that chose the article.
+//
+// This is meant for debugging, for example to find out why a DefA() or
+// DefAn() rule is not taking effect. The wording of the steps may change
+// between releases.
+//
+// Examples:
+//   - e.ExplainAn("hourglass") returns ["silent-h list: hourglass -> an"]
+//   - e.ExplainAn("cat") returns ["first letter: cat -> a"]
+func (e *Engine) ExplainAn(word string) []string {
+	x := &explanation{}
+	e.articleFor(e.normalize(word), x)
+	return x.steps
+}
@@ -0,0 +1,228 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestExplainPlural(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "irregular", input: "ox", want: []string{"irregular table: ox -> oxen"}},
+		{name: "irregular keeps case", input: "Child", want: []string{"irregular table: Child -> Children"}},
+		{name: "unchanged", input: "sheep", want: []string{"unchanged plurals: sheep is unchanged"}},
+		{name: "default suffix", input: "cat", want: []string{"suffix rule (default + -s): cat -> cats"}},
+		{name: "sibilant", input: "box", want: []string{"suffix rule (sibilant + -es): box -> boxes"}},
+		{name: "consonant y", input: "city", want: []string{"suffix rule (consonant + -y -> -ies): city -> cities"}},
+		{name: "proper name y", input: "Mary", want: []string{"suffix rule (proper name + -s): Mary -> Marys"}},
+		{name: "f to ves", input: "knife", want: []string{"suffix rule (-fe -> -ves): knife -> knives"}},
+		{name: "o exception", input: "piano", want: []string{"suffix rule (-o exception + -s): piano -> pianos"}},
+		{name: "nationality", input: "Chinese", want: []string{"nationality suffix: Chinese is unchanged"}},
+		{
+			name:  "-ese noun",
+			input: "cheese",
+			want: []string{
+				"-ese nouns: cheese takes a regular plural",
+				"suffix rule (default + -s): cheese -> cheeses",
+			},
+		},
+		{
+			name:  "classical mode off",
+			input: "formula",
+			want: []string{
+				"classical table: skipped formula -> formulae, classical mode is off",
+				"suffix rule (default + -s): formula -> formulas",
+			},
+		},
+		{
+			name:  "herd animal",
+			input: "bison",
+			want: []string{
+				"herd animals: classical herd mode is off",
+				"suffix rule (default + -s): bison -> bisons",
+			},
+		},
+		{
+			name:  "possessive",
+			input: "ox's",
+			want:  []string{"possessive: inflecting ox", "irregular table: ox -> oxen"},
+		},
+		{
+			name:  "punctuation",
+			input: "(ox)",
+			want:  []string{"punctuation: inflecting ox inside (ox)", "irregular table: ox -> oxen"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ExplainPlural(tt.input))
+		})
+	}
+}
+
+func TestExplainPluralEngine(t *testing.T) {
+	t.Run("custom noun", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.DefNoun("regex", "regexen")
+		assert.Equal(t, []string{"custom noun (DefNoun): regex -> regexen"}, e.ExplainPlural("regex"))
+	})
+
+	t.Run("custom noun rule", func(t *testing.T) {
+		e := inflect.NewEngine()
+		require.NoError(t, e.DefNounRule("us$", "i", 0))
+		assert.Equal(t,
+			[]string{`custom noun rule "us$": hippopotamus -> hippopotami`},
+			e.ExplainPlural("hippopotamus"))
+	})
+
+	t.Run("classical mode on", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.Classical(true)
+		assert.Equal(t, []string{"classical table: formula -> formulae"}, e.ExplainPlural("formula"))
+	})
+
+	t.Run("classical herd", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.ClassicalHerd(true)
+		assert.Equal(t,
+			[]string{"herd animals: bison is unchanged in classical herd mode"},
+			e.ExplainPlural("bison"))
+	})
+
+	t.Run("acronym", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.AddAcronym("NPU")
+		assert.Equal(t, []string{"acronym registry: NPU -> NPUs"}, e.ExplainPlural("NPU"))
+	})
+
+	t.Run("default count of one", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.Num(1)
+		assert.Equal(t,
+			[]string{"default count: cat is unchanged for a count of 1"},
+			e.ExplainPlural("cat"))
+	})
+
+	t.Run("matches Plural", func(t *testing.T) {
+		e := inflect.NewEngine()
+		for _, word := range []string{"ox", "cat", "knife", "Mary", "sheep"} {
+			steps := e.ExplainPlural(word)
+			require.NotEmpty(t, steps)
+			assert.Contains(t, steps[len(steps)-1], e.Plural(word))
+		}
+	})
+}
+
+func TestExplainSingular(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "irregular", input: "oxen", want: []string{"irregular table: oxen -> ox"}},
+		{name: "unchanged", input: "sheep", want: []string{"unchanged plurals: sheep is unchanged"}},
+		{name: "known singular", input: "bus", want: []string{"known singular: bus is already singular"}},
+		{name: "irregular singular", input: "analysis", want: []string{"known singular: analysis is already singular"}},
+		{name: "default suffix", input: "cats", want: []string{"suffix rule (-s removed): cats -> cat"}},
+		{name: "sibilant", input: "boxes", want: []string{"suffix rule (-es removed): boxes -> box"}},
+		{name: "-us", input: "menus", want: []string{"suffix rule (-s removed after u or i): menus -> menu"}},
+		{
+			name:  "possessive",
+			input: "oxen's",
+			want:  []string{"possessive: inflecting oxen", "irregular table: oxen -> ox"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ExplainSingular(tt.input))
+		})
+	}
+
+	t.Run("matches Singular", func(t *testing.T) {
+		for _, word := range []string{"oxen", "cats", "knives", "bus", "sheep"} {
+			steps := inflect.ExplainSingular(word)
+			require.NotEmpty(t, steps)
+			assert.Contains(t, steps[len(steps)-1], inflect.Singular(word))
+		}
+	})
+}
+
+func TestExplainSingularSafe(t *testing.T) {
+	assert.Equal(t,
+		[]string{"suffix rule (-s removed): cats -> cat"},
+		inflect.ExplainSingularSafe("cats"))
+	assert.Equal(t,
+		[]string{
+			"suffix rule (-s removed after u or i): octothorpus -> octothorpu",
+			"safe mode: octothorpus may be singular and is kept",
+		},
+		inflect.ExplainSingularSafe("octothorpus"))
+}
+
+func TestExplainAn(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "whitespace", input: "   ", want: nil},
+		{name: "pronunciation", input: "hour", want: []string{"pronunciation dictionary: hour -> an"}},
+		{name: "silent h", input: "hourglass", want: []string{"silent-h list: hourglass -> an"}},
+		{name: "abbreviation", input: "FBI agent", want: []string{"abbreviation: FBI -> an"}},
+		{name: "consonant y sound", input: "usurper", want: []string{"consonant-sounding vowel: usurper -> a"}},
+		{name: "single letter", input: "x", want: []string{"single letter: x -> a"}},
+		{name: "vowel", input: "apple", want: []string{"first letter: apple -> an"}},
+		{name: "consonant", input: "cat", want: []string{"first letter: cat -> a"}},
+		{
+			name:  "wrapped",
+			input: `"apple"`,
+			want:  []string{"wrappers: analyzing apple", "first letter: apple -> an"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ExplainAn(tt.input))
+		})
+	}
+}
+
+func TestExplainAnEngine(t *testing.T) {
+	t.Run("custom a word", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.DefA("ape")
+		assert.Equal(t, []string{"custom a words (DefA): ape -> a"}, e.ExplainAn("ape"))
+	})
+
+	t.Run("custom an word", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.DefAn("hero")
+		assert.Equal(t, []string{"custom an words (DefAn): hero -> an"}, e.ExplainAn("hero"))
+	})
+
+	t.Run("acronym pronunciation", func(t *testing.T) {
+		e := inflect.NewEngine()
+		e.DefAcronym("SQL", "sequel")
+		assert.Equal(t,
+			[]string{`acronym pronunciation (DefAcronym): SQL read as "sequel" -> a`},
+			e.ExplainAn("SQL query"))
+	})
+
+	t.Run("custom pattern", func(t *testing.T) {
+		e := inflect.NewEngine()
+		require.NoError(t, e.DefAnPattern("hyp.*"))
+		assert.Equal(t, []string{`custom an pattern "^(?:hyp.*)$": hyper -> an`}, e.ExplainAn("hyper"))
+	})
+}
@@ -10,36 +10,86 @@ import "text/template"
 // Pluralization and Singularization:
 //   - plural(word string, count ...int) string - Plural form of a noun
 //   - pluralize(word string) string - Alias for plural
+//   - pluralSafe(word string, count ...int) string - Plural form, unchanged if already plural: "cats" -> "cats"
 //   - singular(word string) string - Singular form of a noun
 //   - singularize(word string) string - Alias for singular
+//   - singularSafe(word string) string - Singular form that never over-strips: "bus" -> "bus"
 //   - pluralNoun(word string, count ...int) string - Plural form with pronoun support
 //   - pluralVerb(word string, count ...int) string - Plural form of a verb
 //   - pluralAdj(word string, count ...int) string - Plural form of an adjective
 //   - singularNoun(word string, count ...int) string - Singular form with pronoun support
+//   - agreeVerb(count int, verb string) string - Verb agreeing with a count: 1, "have" -> "has"
+//   - agreeCompound(subjects []string, verb string) string - ["Alice" "Bob"], "is" -> "Alice and Bob are"
+//   - numberOfPhrase(n int, noun, verb string) string - 3, "error", "were" -> "the number of errors was three"
+//   - aNumberOf(noun, verb string) string - "error", "was found" -> "a number of errors were found"
+//   - pluralLastWord(phrase string) string - Plural of the last word: "blue bird" -> "blue birds"
+//   - singularLastWord(phrase string) string - Singular of the last word: "blue birds" -> "blue bird"
+//   - pluralNounPhrase(phrase string) string - Plural of the head noun: "cup of coffee" -> "cups of coffee"
+//   - singularNounPhrase(phrase string) string - Singular of the head noun: "cups of coffee" -> "cup of coffee"
+//   - singularizePhrase(text string) string - Singular with agreement: "these files are ready" -> "this file is ready"
+//   - pluralName(name string) string - Plural of a proper name: "Jones" -> "Joneses"
+//   - collectiveNoun(noun string) string - Collective noun: "lion" -> "pride"
+//   - collectivePhrase(noun string) string - Group phrase: "lion" -> "pride of lions"
+//   - diminutive(noun string) string - Diminutive form: "pig" -> "piglet"
+//   - fewerOrLess(noun string) string - "items" -> "fewer", "water" -> "less"
+//   - manyOrMuch(noun string) string - "items" -> "many", "water" -> "much"
 //
 // Articles:
 //   - an(word string) string - Prefixes word with "a" or "an"
 //   - a(word string) string - Alias for an()
+//   - articleFor(word string) string - Just the article: "hour" -> "an"
+//   - anCapitalized(word string) string - Capitalized article: "apple" -> "An apple"
+//   - the(phrase string) string - Definite article: "cat" -> "the cat"
+//   - theOrAn(phrase string) string - "1st item" -> "the 1st item", "2nd chance" -> "a 2nd chance"
 //
 // Numbers and Ordinals:
 //   - ordinal(n int) string - Ordinal with suffix: 1 -> "1st"
 //   - ordinalSuffix(n int) string - Just the suffix: 1 -> "st"
 //   - ordinalWord(n int) string - Ordinal in words: 1 -> "first"
+//   - ordinalFromEnd(n, total int) string - Position from end: 9, 10 -> "second to last"
 //   - ordinalToCardinal(s string) string - "first" -> "one"
 //   - wordToOrdinal(s string) string - "one" -> "first"
 //   - numberToWords(n int) string - Number in words: 42 -> "forty-two"
 //   - numberToWordsWithAnd(n int) string - With "and": 123 -> "one hundred and twenty-three"
 //   - formatNumber(n int) string - With commas: 1000 -> "1,000"
+//   - formatFloat(f float64, decimals int) string - With commas: 1234.567, 2 -> "1,234.57"
+//   - compactNumber(n int) string - Short form: 1234 -> "1.2K"
+//   - compactNumberWords(n int) string - Short form with words: 1234567 -> "1.2 million"
+//   - digitsToWords(s string) string - Digit by digit: "415-555" -> "four one five, five five five"
 //   - countingWord(n int) string - 1 -> "once", 2 -> "twice", 3 -> "3 times"
 //   - fractionToWords(num, denom int) string - 1,4 -> "one quarter"
+//   - percentToWords(p float64) string - 12.5 -> "twelve point five percent"
+//   - ratioToWords(num, denom int) string - 3,4 -> "three out of four"
 //   - currencyToWords(amount float64, currency string) string - 1.50, "USD" -> "one dollar and fifty cents"
+//   - checkAmount(amount float64) string - 1234.5 -> "One thousand two hundred thirty-four and 50/100 dollars"
 //   - no(word string, count int) string - 0 -> "no cats", 1 -> "1 cat"
+//   - phrase(count int, adjectives []string, noun string) string - 1, ["old"], "oak" -> "an old oak"
+//   - noWords(word string, count int) string - 0 -> "no cats", 3 -> "three cats"
+//   - noCapitalized(word string, count int) string - 0 -> "No cats", 3 -> "Three cats"
+//   - noThreshold(word string, count, threshold int) string - 3, 10 -> "three cats", 12, 10 -> "12 cats"
+//   - quantifyCount(n int, noun string) string - 7, "cat" -> "several cats", 40, "cat" -> "dozens of cats"
+//   - amountPhrase(n int, noun string) string - 5, "item" -> "fewer than five items"
+//
+// Time:
+//   - durationToWords(d time.Duration) string - 90*time.Minute -> "one hour and thirty minutes"
+//   - clockToWords(hour, minute int) string - 14, 35 -> "twenty-five to three"
+//   - yearToWords(year int) string - 1976 -> "nineteen seventy-six"
+//   - dateToWords(t time.Time) string - "the fourth of July, nineteen seventy-six"
 //
 // Verb Tenses:
 //   - pastTense(verb string) string - Past tense: "walk" -> "walked"
 //   - pastParticiple(verb string) string - Past participle: "take" -> "taken"
 //   - presentParticiple(verb string) string - Present participle: "run" -> "running"
+//   - pastTensePhrase(phrase string) string - Past tense of the head verb: "check out" -> "checked out"
+//   - pastParticiplePhrase(phrase string) string - Past participle of the head verb: "break down" -> "broken down"
+//   - presentParticiplePhrase(phrase string) string - Present participle of the head verb: "set up" -> "setting up"
+//   - pluralVerbPhrase(phrase string, count ...int) string - Plural of the head verb: "logs in" -> "log in"
 //   - futureTense(verb string) string - Future tense: "walk" -> "will walk"
+//   - baseVerb(verb string) string - Base form of a participle: "running" -> "run"
+//   - negate(verb string) string - Negative form: "is" -> "isn't", "runs" -> "doesn't run"
+//   - unnegate(verb string) string - Positive form: "isn't" -> "is"
+//   - interrogate(subject, verb, rest string) string - Question: "the user", "has", "access" -> "Does the user have access?"
+//   - passivize(subject, verb, object string) string - Passive voice: "the analyst", "wrote", "the report" -> "The report was written by the analyst"
 //
 // Adjectives and Adverbs:
 //   - comparative(adj string) string - Comparative form: "big" -> "bigger"
@@ -48,6 +98,8 @@ import "text/template"
 //
 // Possessives:
 //   - possessive(word string) string - Possessive form: "cat" -> "cat's"
+//   - joinPossessive(names []string, noun string) string - Each owns one: "Alice's and Bob's reports"
+//   - joinPossessiveShared(names []string, noun string) string - Owned together: "Alice and Bob's report"
 //
 // List Formatting:
 //   - join(words []string) string - Join with Oxford comma: ["a","b","c"] -> "a, b, and c"
@@ -68,8 +120,11 @@ import "text/template"
 //
 // Text Transformation:
 //   - capitalize(s string) string - Capitalize first letter: "hello" -> "Hello"
+//   - capitalizeSentence(s string) string - Capitalize each sentence: "no. yes." -> "No. Yes."
 //   - titleize(s string) string - Capitalize each word: "hello world" -> "Hello World"
 //   - humanize(s string) string - Human readable: "employee_salary" -> "Employee salary"
+//   - expand(s string) string - Expand contractions: "can't stop" -> "cannot stop"
+//   - contract(s string) string - Contract negatives and pronouns: "do not" -> "don't"
 //
 // Rails-style Helpers:
 //   - tableize(word string) string - Type to table: "Person" -> "people"
@@ -77,6 +132,19 @@ import "text/template"
 //   - typeify(word string) string - Table to type: "user_posts" -> "UserPost"
 //   - parameterize(word string) string - URL slug: "Hello World" -> "hello-world"
 //   - asciify(word string) string - Remove diacritics: "café" -> "cafe"
+//   - tableName(typeName string) string - SQL table: "UserProfile" -> "user_profiles"
+//   - columnName(fieldName string) string - SQL column: "CreatedAt" -> "created_at"
+//   - joinTableName(a, b string) string - Join table: "User", "Role" -> "roles_users"
+//   - messageNamePlural(name string) string - Proto message plural: "UserProfile" -> "UserProfiles"
+//   - messageNameSingular(name string) string - Proto message singular: "UserProfiles" -> "UserProfile"
+//   - enumValueName(enumName, value string) string - Proto enum value: "Color", "red" -> "COLOR_RED"
+//   - serviceMethodName(method, message string) string - gRPC method: "List", "User" -> "ListUsers"
+//   - queryFieldName(typeName string) string - GraphQL list field: "Order" -> "orders"
+//   - objectFieldName(typeName string) string - GraphQL object field: "Orders" -> "order"
+//   - connectionName(typeName string) string - Relay connection: "Order" -> "OrderConnection"
+//   - edgeName(typeName string) string - Relay edge: "Order" -> "OrderEdge"
+//   - connectionFieldName(typeName string) string - Connection field: "Order" -> "ordersConnection"
+//   - mutationFieldName(action, typeName string) string - Mutation: "create", "Order" -> "createOrder"
 //
 // Utility:
 //   - wordCount(text string) int - Count words in text
@@ -90,11 +158,33 @@ import "text/template"
 //	// With count parameter:
 //	tmpl.Parse(`There {{if eq .Count 1}}is{{else}}are{{end}} {{plural "item" .Count}}`)
 //
-// For custom engine configurations, use Engine.FuncMap() instead.
+// For custom engine configurations, use FuncMapFor or Engine.FuncMap()
+// instead.
 func FuncMap() template.FuncMap {
 	return defaultEngine.FuncMap()
 }
 
+// FuncMapFor returns a template.FuncMap whose functions use e's
+// configuration rather than the package-level settings, or the default
+// Engine's if e is nil. It is the same as e.FuncMap().
+//
+// Every function that depends on engine settings closes over e, so
+// templates rendered concurrently with engines configured differently, for
+// example one classical and one not, do not affect each other, and tests
+// need not change or reset global state.
+//
+// Example:
+//
+//	classical := inflect.NewEngine(inflect.WithClassical(inflect.ClassicalModeAll))
+//	tmpl := template.New("example").Funcs(inflect.FuncMapFor(classical))
+//	tmpl.Parse(`{{plural "formula"}}`) // renders "formulae"
+func FuncMapFor(e *Engine) template.FuncMap {
+	if e == nil {
+		e = defaultEngine
+	}
+	return e.FuncMap()
+}
+
 // FuncMap returns a template.FuncMap containing inflection functions that use
 // this Engine's configuration.
 //
@@ -105,45 +195,95 @@ func FuncMap() template.FuncMap {
 //
 //	e := inflect.NewEngine()
 //	e.DefNoun("foo", "fooz")
-//	e.Classical(inflect.ClassicalAll, true)
+//	e.Classical(true)
 //
 //	tmpl := template.New("example").Funcs(e.FuncMap())
 //	tmpl.Parse(`The plural of foo is {{plural "foo"}}`)
 func (e *Engine) FuncMap() template.FuncMap {
 	return template.FuncMap{
 		// Pluralization and Singularization
-		"plural":       e.templatePlural,
-		"pluralize":    e.Plural, // alias
-		"singular":     e.Singular,
-		"singularize":  e.Singular, // alias
-		"pluralNoun":   e.templatePluralNoun,
-		"pluralVerb":   e.templatePluralVerb,
-		"pluralAdj":    e.templatePluralAdj,
-		"singularNoun": e.templateSingularNoun,
+		"plural":             e.templatePlural,
+		"pluralize":          e.Plural, // alias
+		"pluralSafe":         e.PluralSafe,
+		"singular":           e.Singular,
+		"singularize":        e.Singular, // alias
+		"singularSafe":       e.SingularSafe,
+		"pluralNoun":         e.templatePluralNoun,
+		"pluralVerb":         e.templatePluralVerb,
+		"pluralAdj":          e.templatePluralAdj,
+		"singularNoun":       e.templateSingularNoun,
+		"agreeVerb":          e.AgreeVerb,
+		"agreeCompound":      e.AgreeCompound,
+		"numberOfPhrase":     e.NumberOfPhrase,
+		"aNumberOf":          e.ANumberOf,
+		"pluralLastWord":     e.PluralLastWord,
+		"singularLastWord":   e.SingularLastWord,
+		"pluralNounPhrase":   e.PluralNounPhrase,
+		"singularNounPhrase": e.SingularNounPhrase,
+		"singularizePhrase":  e.SingularizePhrase,
+		"pluralName":         e.PluralName,
+		"collectiveNoun":     e.CollectiveNoun,
+		"collectivePhrase":   e.CollectivePhrase,
+		"diminutive":         e.Diminutive,
+		"fewerOrLess":        FewerOrLess,
+		"manyOrMuch":         ManyOrMuch,
 
 		// Articles
-		"an": e.An,
-		"a":  e.An, // alias
+		"an":            e.An,
+		"a":             e.An, // alias
+		"articleFor":    e.ArticleFor,
+		"anCapitalized": e.AnCapitalized,
+		"the":           The,
+		"theOrAn":       e.TheOrAn,
 
 		// Numbers and Ordinals
 		"ordinal":              Ordinal,
 		"ordinalSuffix":        OrdinalSuffix,
 		"ordinalWord":          OrdinalWord,
+		"ordinalFromEnd":       OrdinalFromEnd,
 		"ordinalToCardinal":    OrdinalToCardinal,
 		"wordToOrdinal":        WordToOrdinal,
 		"numberToWords":        NumberToWords,
 		"numberToWordsWithAnd": NumberToWordsWithAnd,
 		"formatNumber":         FormatNumber,
+		"formatFloat":          FormatFloat,
+		"compactNumber":        CompactNumber,
+		"compactNumberWords":   CompactNumberWords,
+		"digitsToWords":        DigitsToWords,
 		"countingWord":         CountingWord,
 		"fractionToWords":      FractionToWords,
+		"percentToWords":       PercentToWords,
+		"ratioToWords":         RatioToWords,
 		"currencyToWords":      CurrencyToWords,
+		"checkAmount":          CheckAmount,
 		"no":                   e.templateNo,
+		"phrase":               e.Phrase,
+		"noWords":              e.NoWords,
+		"noCapitalized":        e.NoCapitalized,
+		"noThreshold":          e.NoThreshold,
+		"quantifyCount":        e.QuantifyCount,
+		"amountPhrase":         e.AmountPhrase,
+
+		// Time
+		"durationToWords": DurationToWords,
+		"clockToWords":    ClockToWords,
+		"yearToWords":     YearToWords,
+		"dateToWords":     DateToWords,
 
 		// Verb Tenses
-		"pastTense":         PastTense,
-		"pastParticiple":    PastParticiple,
-		"presentParticiple": PresentParticiple,
-		"futureTense":       FutureTense,
+		"pastTense":               e.PastTense,
+		"pastParticiple":          e.PastParticiple,
+		"presentParticiple":       e.PresentParticiple,
+		"pastTensePhrase":         e.PastTensePhrase,
+		"pastParticiplePhrase":    e.PastParticiplePhrase,
+		"presentParticiplePhrase": e.PresentParticiplePhrase,
+		"pluralVerbPhrase":        e.PluralVerbPhrase,
+		"futureTense":             FutureTense,
+		"baseVerb":                BaseVerb,
+		"negate":                  Negate,
+		"unnegate":                Unnegate,
+		"interrogate":             Interrogate,
+		"passivize":               e.Passivize,
 
 		// Adjectives and Adverbs
 		"comparative": Comparative,
@@ -151,7 +291,9 @@ func (e *Engine) FuncMap() template.FuncMap {
 		"adverb":      Adverb,
 
 		// Possessives
-		"possessive": e.Possessive,
+		"possessive":           e.Possessive,
+		"joinPossessive":       e.JoinPossessive,
+		"joinPossessiveShared": e.JoinPossessiveShared,
 
 		// List Formatting
 		"join":     Join,
@@ -171,16 +313,32 @@ func (e *Engine) FuncMap() template.FuncMap {
 		"goCamelCase":       e.GoCamelCase,
 
 		// Text Transformation
-		"capitalize": Capitalize,
-		"titleize":   Titleize,
-		"humanize":   Humanize,
+		"capitalize":         Capitalize,
+		"capitalizeSentence": CapitalizeSentence,
+		"titleize":           Titleize,
+		"humanize":           e.Humanize,
+		"expand":             Expand,
+		"contract":           Contract,
 
 		// Rails-style Helpers
-		"tableize":     Tableize,
-		"foreignKey":   ForeignKey,
-		"typeify":      Typeify,
-		"parameterize": Parameterize,
-		"asciify":      Asciify,
+		"tableize":            e.Tableize,
+		"foreignKey":          ForeignKey,
+		"typeify":             e.Typeify,
+		"parameterize":        Parameterize,
+		"asciify":             Asciify,
+		"tableName":           e.TableName,
+		"columnName":          ColumnName,
+		"joinTableName":       e.JoinTableName,
+		"messageNamePlural":   e.MessageNamePlural,
+		"messageNameSingular": e.MessageNameSingular,
+		"enumValueName":       e.EnumValueName,
+		"serviceMethodName":   e.ServiceMethodName,
+		"queryFieldName":      e.QueryFieldName,
+		"objectFieldName":     e.ObjectFieldName,
+		"connectionName":      e.ConnectionName,
+		"edgeName":            e.EdgeName,
+		"connectionFieldName": e.ConnectionFieldName,
+		"mutationFieldName":   e.MutationFieldName,
 
 		// Utility
 		"wordCount":      WordCount,
@@ -191,10 +349,10 @@ func (e *Engine) FuncMap() template.FuncMap {
 // templatePlural wraps Plural for template use, handling the variadic count parameter.
 // Templates can call it as {{plural "cat"}} or {{plural "cat" .Count}}.
 func (e *Engine) templatePlural(word string, count ...int) string {
-	if len(count) > 0 && (count[0] == 1 || count[0] == -1) {
+	if e.isSingularCount(count) {
 		return word
 	}
-	return e.Plural(word)
+	return e.plural(word)
 }
 
 // templatePluralNoun wraps PluralNoun for template use.
@@ -3,6 +3,7 @@ package inflect_test
 import (
 	"bytes"
 	htmltemplate "html/template"
+	"sync"
 	"testing"
 	texttemplate "text/template"
 
@@ -18,29 +19,40 @@ func TestFuncMap(t *testing.T) {
 	// Verify all expected functions are present
 	expectedFuncs := []string{
 		// Pluralization and Singularization
-		"plural", "pluralize", "singular", "singularize",
+		"plural", "pluralize", "pluralSafe", "singular", "singularize", "singularSafe",
 		"pluralNoun", "pluralVerb", "pluralAdj", "singularNoun",
+		"pluralLastWord", "singularLastWord", "pluralNounPhrase", "singularNounPhrase", "singularizePhrase", "pluralName", "agreeVerb", "agreeCompound", "numberOfPhrase", "aNumberOf", "collectiveNoun", "collectivePhrase", "diminutive",
+		"fewerOrLess", "manyOrMuch",
 		// Articles
-		"an", "a",
+		"an", "a", "articleFor", "anCapitalized", "the", "theOrAn",
 		// Numbers and Ordinals
-		"ordinal", "ordinalSuffix", "ordinalWord", "ordinalToCardinal", "wordToOrdinal",
-		"numberToWords", "numberToWordsWithAnd", "formatNumber",
-		"countingWord", "fractionToWords", "currencyToWords", "no",
+		"ordinal", "ordinalSuffix", "ordinalWord", "ordinalFromEnd", "ordinalToCardinal", "wordToOrdinal",
+		"numberToWords", "numberToWordsWithAnd", "formatNumber", "formatFloat",
+		"compactNumber", "compactNumberWords", "digitsToWords",
+		"countingWord", "fractionToWords", "percentToWords", "ratioToWords",
+		"currencyToWords", "checkAmount", "no", "noWords", "noCapitalized", "noThreshold", "phrase", "quantifyCount", "amountPhrase",
+		// Time
+		"durationToWords", "clockToWords", "yearToWords", "dateToWords",
 		// Verb Tenses
-		"pastTense", "pastParticiple", "presentParticiple", "futureTense",
+		"pastTense", "pastParticiple", "presentParticiple", "futureTense", "baseVerb",
+		"pastTensePhrase", "pastParticiplePhrase", "presentParticiplePhrase", "pluralVerbPhrase",
+		"negate", "unnegate", "interrogate", "passivize",
 		// Adjectives and Adverbs
 		"comparative", "superlative", "adverb",
 		// Possessives
-		"possessive",
+		"possessive", "joinPossessive", "joinPossessiveShared",
 		// List Formatting
 		"join", "joinWith",
 		// Case Conversion
 		"camelCase", "snakeCase", "underscore", "kebabCase", "dasherize",
 		"pascalCase", "titleCase", "camelize", "camelizeDownFirst",
 		// Text Transformation
-		"capitalize", "titleize", "humanize",
+		"capitalize", "capitalizeSentence", "titleize", "humanize",
+		"expand", "contract",
 		// Rails-style Helpers
-		"tableize", "foreignKey", "typeify", "parameterize", "asciify",
+		"tableize", "foreignKey", "typeify", "parameterize", "asciify", "tableName", "columnName", "joinTableName",
+		"messageNamePlural", "messageNameSingular", "enumValueName", "serviceMethodName",
+		"queryFieldName", "objectFieldName", "connectionName", "edgeName", "connectionFieldName", "mutationFieldName",
 		// Utility
 		"wordCount", "countSyllables",
 	}
@@ -97,6 +109,12 @@ func TestFuncMapPlural(t *testing.T) {
 			data:     nil,
 			want:     "children",
 		},
+		{
+			name:     "plural safe keeps plural",
+			template: `{{pluralSafe "children"}}`,
+			data:     nil,
+			want:     "children",
+		},
 	}
 
 	for _, tt := range tests {
@@ -121,6 +139,7 @@ func TestFuncMapSingular(t *testing.T) {
 		{name: "basic singular", template: `{{singular "cats"}}`, want: "cat"},
 		{name: "irregular singular", template: `{{singular "children"}}`, want: "child"},
 		{name: "already singular", template: `{{singular "cat"}}`, want: "cat"},
+		{name: "singular safe keeps -us", template: `{{singularSafe "octothorpus"}}`, want: "octothorpus"},
 	}
 
 	for _, tt := range tests {
@@ -148,6 +167,9 @@ func TestFuncMapArticles(t *testing.T) {
 		{name: "a vowel", template: `{{a "apple"}}`, want: "an apple"},
 		{name: "an hour", template: `{{an "hour"}}`, want: "an hour"},
 		{name: "an university", template: `{{an "university"}}`, want: "a university"},
+		{name: "the", template: `{{the "cat"}}`, want: "the cat"},
+		{name: "theOrAn first", template: `{{theOrAn (printf "%s item" (ordinal 1))}}`, want: "the 1st item"},
+		{name: "theOrAn second", template: `{{theOrAn (printf "%s chance" (ordinal 2))}}`, want: "a 2nd chance"},
 	}
 
 	for _, tt := range tests {
@@ -205,6 +227,11 @@ func TestFuncMapVerbTenses(t *testing.T) {
 		{name: "presentParticiple run", template: `{{presentParticiple "run"}}`, want: "running"},
 		{name: "presentParticiple make", template: `{{presentParticiple "make"}}`, want: "making"},
 		{name: "presentParticiple play", template: `{{presentParticiple "play"}}`, want: "playing"},
+		{name: "negate is", template: `{{negate "is"}}`, want: "isn't"},
+		{name: "negate runs", template: `{{negate "runs"}}`, want: "doesn't run"},
+		{name: "unnegate isn't", template: `{{unnegate "isn't"}}`, want: "is"},
+		{name: "interrogate", template: `{{interrogate "the user" "has" "access"}}`, want: "Does the user have access?"},
+		{name: "passivize", template: `{{passivize "the analyst" "wrote" "the report"}}`, want: "The report was written by the analyst"},
 	}
 
 	for _, tt := range tests {
@@ -308,6 +335,50 @@ func TestFuncMapJoin(t *testing.T) {
 			data:     map[string][]string{"Items": {"red", "blue", "green"}},
 			want:     "red, blue, or green",
 		},
+		{
+			name:     "joinPossessive",
+			template: `{{joinPossessive .Items "report"}}`,
+			data:     map[string][]string{"Items": {"Alice", "Bob"}},
+			want:     "Alice's and Bob's reports",
+		},
+		{
+			name:     "joinPossessiveShared",
+			template: `{{joinPossessiveShared .Items "report"}}`,
+			data:     map[string][]string{"Items": {"Alice", "Bob"}},
+			want:     "Alice and Bob's report",
+		},
+		{
+			name:     "agreeCompound",
+			template: `{{agreeCompound .Items "has"}} joined`,
+			data:     map[string][]string{"Items": {"Alice", "Bob"}},
+			want:     "Alice and Bob have joined",
+		},
+		{
+			name:     "numberOfPhrase",
+			template: `{{numberOfPhrase .N "error" "were"}}`,
+			data:     map[string]int{"N": 3},
+			want:     "the number of errors was three",
+		},
+		{
+			name:     "aNumberOf",
+			template: `{{aNumberOf "error" "was found"}}`,
+			want:     "a number of errors were found",
+		},
+		{
+			name:     "pluralNounPhrase",
+			template: `{{pluralNounPhrase "cup of coffee"}}`,
+			want:     "cups of coffee",
+		},
+		{
+			name:     "singularNounPhrase",
+			template: `{{singularNounPhrase "boards of directors"}}`,
+			want:     "board of directors",
+		},
+		{
+			name:     "singularizePhrase",
+			template: `{{singularizePhrase "these files are ready"}}`,
+			want:     "this file is ready",
+		},
 	}
 
 	for _, tt := range tests {
@@ -438,6 +509,67 @@ func TestEngineFuncMapWithClassicalMode(t *testing.T) {
 	assert.Equal(t, "formulae", buf.String())
 }
 
+func TestFuncMapFor(t *testing.T) {
+	classical := inflect.NewEngine(inflect.WithClassical(inflect.ClassicalModeAll))
+	modern := inflect.NewEngine()
+	engines := map[string]*inflect.Engine{"formulae": classical, "formulas": modern}
+
+	var wg sync.WaitGroup
+	for want, e := range engines {
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tmpl, err := texttemplate.New("test").Funcs(inflect.FuncMapFor(e)).Parse(`{{plural "formula"}}`)
+				if !assert.NoError(t, err) {
+					return
+				}
+				var buf bytes.Buffer
+				assert.NoError(t, tmpl.Execute(&buf, nil))
+				assert.Equal(t, want, buf.String())
+			}()
+		}
+	}
+	wg.Wait()
+	assert.False(t, inflect.IsClassical(), "the default engine is unchanged")
+}
+
+func TestFuncMapForNil(t *testing.T) {
+	tmpl, err := texttemplate.New("test").Funcs(inflect.FuncMapFor(nil)).Parse(`{{plural "cat"}}`)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, nil))
+	assert.Equal(t, "cats", buf.String())
+}
+
+func TestFuncMapForUsesEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("octopus", "octopodes")
+	e.AddAcronym("GPU")
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{template: `{{tableize "Octopus"}}`, want: "octopodes"},
+		{template: `{{typeify "octopodes"}}`, want: "Octopus"},
+		{template: `{{tableName "SeaOctopus"}}`, want: "sea_octopodes"},
+		{template: `{{humanize "GPUConfig"}}`, want: "GPU config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			tmpl, err := texttemplate.New("test").Funcs(inflect.FuncMapFor(e)).Parse(tt.template)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, tmpl.Execute(&buf, nil))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
 func TestFuncMapWithHTMLTemplate(t *testing.T) {
 	// Verify FuncMap works with html/template as well
 	// html/template.FuncMap is the same underlying type as text/template.FuncMap
@@ -525,19 +657,32 @@ func TestFuncMapNewFunctions(t *testing.T) {
 		{name: "countingWord", template: `{{countingWord 2}}`, want: "twice"},
 		{name: "fractionToWords", template: `{{fractionToWords 1 4}}`, want: "one quarter"},
 		{name: "currencyToWords", template: `{{currencyToWords 1.50 "USD"}}`, want: "one dollar and fifty cents"},
+		{name: "checkAmount", template: `{{checkAmount 1234.5}}`, want: "One thousand two hundred thirty-four and 50/100 dollars"},
 		{name: "no zero", template: `{{no "cat" 0}}`, want: "no cats"},
 		{name: "no one", template: `{{no "cat" 1}}`, want: "1 cat"},
 		{name: "no many", template: `{{no "cat" 5}}`, want: "5 cats"},
+		{name: "noCapitalized", template: `{{noCapitalized "cat" 0}}`, want: "No cats"},
+		{name: "amountPhrase", template: `must have {{amountPhrase 5 "item"}}`, want: "must have fewer than five items"},
+		{name: "fewerOrLess", template: `{{fewerOrLess "water"}}`, want: "less"},
+		{name: "manyOrMuch", template: `{{manyOrMuch "items"}}`, want: "many"},
 
 		// Verb Tenses
 		{name: "pastParticiple", template: `{{pastParticiple "take"}}`, want: "taken"},
 		{name: "futureTense", template: `{{futureTense "walk"}}`, want: "will walk"},
+		{name: "pastTensePhrase", template: `{{pastTensePhrase "check out"}}`, want: "checked out"},
+		{name: "pastParticiplePhrase", template: `{{pastParticiplePhrase "break down"}}`, want: "broken down"},
+		{name: "presentParticiplePhrase", template: `{{presentParticiplePhrase "set up"}}`, want: "setting up"},
+		{name: "pluralVerbPhrase", template: `{{pluralVerbPhrase "logs in"}}`, want: "log in"},
+		{name: "pluralVerbPhrase count", template: `{{pluralVerbPhrase "logs in" 1}}`, want: "logs in"},
 
 		// Adverbs
 		{name: "adverb", template: `{{adverb "quick"}}`, want: "quickly"},
 
 		// Text Transformation
 		{name: "capitalize", template: `{{capitalize "hello"}}`, want: "Hello"},
+		{name: "capitalizeSentence", template: `{{capitalizeSentence "no. yes."}}`, want: "No. Yes."},
+		{name: "expand", template: `{{expand "can't stop won't stop"}}`, want: "cannot stop will not stop"},
+		{name: "contract", template: `{{contract "do not"}}`, want: "don't"},
 		{name: "titleize", template: `{{titleize "hello world"}}`, want: "Hello World"},
 		{name: "humanize", template: `{{humanize "employee_salary"}}`, want: "Employee salary"},
 
@@ -547,6 +692,19 @@ func TestFuncMapNewFunctions(t *testing.T) {
 		{name: "typeify", template: `{{typeify "user_posts"}}`, want: "UserPost"},
 		{name: "parameterize", template: `{{parameterize "Hello World!"}}`, want: "hello-world"},
 		{name: "asciify", template: `{{asciify "café"}}`, want: "cafe"},
+		{name: "tableName", template: `{{tableName "UserProfile"}}`, want: "user_profiles"},
+		{name: "columnName", template: `{{columnName "CreatedAt"}}`, want: "created_at"},
+		{name: "joinTableName", template: `{{joinTableName "User" "Role"}}`, want: "roles_users"},
+		{name: "messageNamePlural", template: `{{messageNamePlural "UserProfile"}}`, want: "UserProfiles"},
+		{name: "messageNameSingular", template: `{{messageNameSingular "UserProfiles"}}`, want: "UserProfile"},
+		{name: "enumValueName", template: `{{enumValueName "Colors" "red"}}`, want: "COLOR_RED"},
+		{name: "serviceMethodName", template: `{{serviceMethodName "List" "UserProfile"}}`, want: "ListUserProfiles"},
+		{name: "queryFieldName", template: `{{queryFieldName "Order"}}`, want: "orders"},
+		{name: "objectFieldName", template: `{{objectFieldName "Orders"}}`, want: "order"},
+		{name: "connectionName", template: `{{connectionName "Order"}}`, want: "OrderConnection"},
+		{name: "edgeName", template: `{{edgeName "Order"}}`, want: "OrderEdge"},
+		{name: "connectionFieldName", template: `{{connectionFieldName "Order"}}`, want: "ordersConnection"},
+		{name: "mutationFieldName", template: `{{mutationFieldName "create" "Order"}}`, want: "createOrder"},
 
 		// Utility
 		{name: "wordCount", template: `{{wordCount "hello world"}}`, want: "2"},
@@ -55,12 +55,12 @@ func FuzzPlural(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
 		// Should not panic
-		_ = Plural(input)
+		checkInvariants(t, "Plural", input, Plural(input))
 	})
 }
 
@@ -102,11 +102,11 @@ func FuzzSingular(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = Singular(input)
+		checkInvariants(t, "Singular", input, Singular(input))
 	})
 }
 
@@ -140,15 +140,142 @@ func FuzzAn(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
+		if !utf8.ValidString(input) {
+			return
+		}
+		for name, fn := range map[string]func(string) string{"An": An, "A": A} {
+			output := fn(input)
+			checkInvariants(t, name, input, output)
+			if strings.TrimSpace(input) == "" {
+				continue
+			}
+			if !strings.HasPrefix(output, "a ") && !strings.HasPrefix(output, "an ") {
+				t.Errorf("%s(%q) = %q, want an \"a\" or \"an\" prefix", name, input, output)
+			}
+			if !strings.HasSuffix(output, input) {
+				t.Errorf("%s(%q) = %q, want the input unchanged after the article", name, input, output)
+			}
+		}
+	})
+}
+
+// Covers: Inflect.
+func FuzzInflect(f *testing.F) {
+	seeds := []string{
+		// Calls with quoted, backtick, bare, and numeric arguments
+		"plural('cat')", "plural(\"cat\", 2)", "plural(`honest man`)",
+		"plural(cat)", "plural(cat, 1)", "a(hour)", "no('error', 0)",
+		"ordinal(2)", "number_to_words(-42)", "present_participle('run')",
+		"auto('this', 2)", "singular_noun('we')", "plural_verb('is', 1)",
+		// num() calls
+		"num(1)plural('cat')", "num(3, True) plural('cat')", "num()", "num(2, False)",
+		// Prose, unknown, escaped, and invalid calls
+		"Enter a(n) value", "no(thing)", "file(s)", "foo('bar')",
+		"\\plural('cat')", "number_to_words('x')", "plural(", "plural('cat'",
+		// Markdown code
+		"`plural('cat')` plural('dog')", "```\nplural('cat')\n```", "a ` plural('cat')",
+		// Unicode and edge cases
+		"plural('café')", "plural('日本語')", "plural('')", "a('')",
+		"", " ", "plain text", "plural('cat's')", "plural(x-ray)",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		if !utf8.ValidString(input) {
+			return
+		}
+		output := Inflect(input)
+		if !utf8.ValidString(output) {
+			t.Errorf("Inflect(%q) = %q, which is not valid UTF-8", input, output)
+		}
+		// num() expands to nothing, so only text without it must keep
+		// something
+		if strings.TrimSpace(input) != "" && output == "" && !strings.Contains(input, "num(") {
+			t.Errorf("Inflect(%q) returned an empty string", input)
+		}
+	})
+}
+
+// Covers: Plural, Singular.
+func FuzzPluralSingularRoundTrip(f *testing.F) {
+	seeds := []string{
+		"cat", "bus", "city", "boy", "knife", "hero", "child", "mouse",
+		"analysis", "cactus", "sheep", "fireman", "Jones", "Chinese",
+		"Cat", "CAT", "cat's", "dogs'", "cat,", "\"cat\"",
+		"café", "naïve", "日本語", "a b c", "", " ",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = An(input)
-		_ = A(input)
+		plural := Plural(input)
+		singular := Singular(plural)
+		checkInvariants(t, "Plural", input, plural)
+		checkInvariants(t, "Singular", plural, singular)
+		checkCaseInvariants(t, "Plural", input, plural)
+		checkCaseInvariants(t, "Singular", plural, singular)
+
+		// Singularizing a plural may not recover the input ("bus" and
+		// "buss" both pluralize to "busses"), but for plain lowercase words
+		// it must recover a word with the same plural. A plural that is
+		// also a known singular is kept by Singular ("bu" -> "bus" -> "bus"),
+		// so it is not checked.
+		if isLowerASCIIWord(input) && singular != input && SingularSafe(plural) != plural {
+			if again := Plural(singular); again != plural {
+				t.Errorf("Plural(%q) = %q, Singular(%q) = %q, but Plural(%q) = %q",
+					input, plural, plural, singular, singular, again)
+			}
+		}
 	})
 }
 
+// checkInvariants reports violations of properties that every string
+// inflection must satisfy: it must not lose a non-empty input and must
+// produce valid UTF-8.
+func checkInvariants(t *testing.T, name, input, output string) {
+	t.Helper()
+	if strings.TrimSpace(input) != "" && output == "" {
+		t.Errorf("%s(%q) returned an empty string", name, input)
+	}
+	if !utf8.ValidString(output) {
+		t.Errorf("%s(%q) = %q, which is not valid UTF-8", name, input, output)
+	}
+}
+
+// checkCaseInvariants reports violations of the case class rules for word
+// inflections: lowercase input stays lowercase and capitalized input stays
+// capitalized.
+func checkCaseInvariants(t *testing.T, name, input, output string) {
+	t.Helper()
+	if isLowerASCIIWord(input) && strings.ToLower(output) != output {
+		t.Errorf("%s(%q) = %q, want lowercase output for lowercase input", name, input, output)
+	}
+	if len(input) > 1 && isLowerASCIIWord(strings.ToLower(input[:1])+input[1:]) &&
+		input[0] >= 'A' && input[0] <= 'Z' && (output == "" || output[0] < 'A' || output[0] > 'Z') {
+		t.Errorf("%s(%q) = %q, want capitalized output for capitalized input", name, input, output)
+	}
+}
+
+// isLowerASCIIWord reports whether s is a non-empty run of lowercase ASCII letters.
+func isLowerASCIIWord(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
 // Covers: NumberToWords, NumberToWordsWithAnd.
 func FuzzNumberToWords(f *testing.F) {
 	seeds := []int{
@@ -248,11 +375,11 @@ func FuzzPresentParticiple(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = PresentParticiple(input)
+		checkInvariants(t, "PresentParticiple", input, PresentParticiple(input))
 	})
 }
 
@@ -292,11 +419,11 @@ func FuzzPastTense(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = PastTense(input)
+		checkInvariants(t, "PastTense", input, PastTense(input))
 	})
 }
 
@@ -355,11 +482,11 @@ func FuzzPastParticiple(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = PastParticiple(input)
+		checkInvariants(t, "PastParticiple", input, PastParticiple(input))
 	})
 }
 
@@ -393,11 +520,11 @@ func FuzzComparative(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = Comparative(input)
+		checkInvariants(t, "Comparative", input, Comparative(input))
 		_ = Superlative(input)
 	})
 }
@@ -467,11 +594,11 @@ func FuzzPossessive(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = Possessive(input)
+		checkInvariants(t, "Possessive", input, Possessive(input))
 	})
 }
 
@@ -629,11 +756,11 @@ func FuzzPluralNoun(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = PluralNoun(input)
+		checkInvariants(t, "PluralNoun", input, PluralNoun(input))
 	})
 }
 
@@ -730,11 +857,11 @@ func FuzzSingularNoun(f *testing.F) {
 		f.Add(s)
 	}
 
-	f.Fuzz(func(_ *testing.T, input string) {
+	f.Fuzz(func(t *testing.T, input string) {
 		if !utf8.ValidString(input) {
 			return
 		}
-		_ = SingularNoun(input)
+		checkInvariants(t, "SingularNoun", input, SingularNoun(input))
 	})
 }
 
@@ -1399,3 +1526,31 @@ func FuzzRomanToInt(f *testing.F) {
 		}
 	})
 }
+
+func FuzzMatchCase(f *testing.F) {
+	seeds := [][2]string{
+		{"cat", "cats"}, {"Cat", "cats"}, {"CAT", "cats"}, {"cAT", "cats"},
+		{"A", "an"}, {"a", "an"}, {"1A", "as"}, {"123", "abc"}, {"", "x"},
+		{"Élan", "élans"}, {"ÉCOLE", "écoles"}, {"Child", "children"},
+		{"X", "ñu"}, {"O'Neil", "o'neils"}, {"GPU", "gpus"},
+	}
+	for _, s := range seeds {
+		f.Add(s[0], s[1])
+	}
+
+	f.Fuzz(func(t *testing.T, original, replacement string) {
+		if !utf8.ValidString(original) || !utf8.ValidString(replacement) {
+			return
+		}
+		if original == "" || replacement == "" {
+			return
+		}
+		// The ASCII fast paths must agree with the Unicode slow paths
+		if got, want := matchCase(original, replacement), matchCaseUnicode(original, replacement); got != want {
+			t.Errorf("matchCase(%q, %q) = %q, want %q", original, replacement, got, want)
+		}
+		if got, want := isAllUpper(original), isAllUpperUnicode(original); got != want {
+			t.Errorf("isAllUpper(%q) = %v, want %v", original, got, want)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package inflect_test
+
+import (
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+	"github.com/cv/go-inflect/v2/corpus"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite the expected outputs of the corpus in corpus/data with the current results")
+
+// goldenFuncs maps each function of the corpus to the function it tests.
+var goldenFuncs = map[string]func(string) string{
+	"an":                inflect.An,
+	"comparative":       inflect.Comparative,
+	"pastParticiple":    inflect.PastParticiple,
+	"pastTense":         inflect.PastTense,
+	"plural":            inflect.Plural,
+	"presentParticiple": inflect.PresentParticiple,
+	"singular":          inflect.Singular,
+	"superlative":       inflect.Superlative,
+}
+
+// TestGolden checks each function against its cases in the corpus, with the
+// default settings. Run it with -update-golden to accept the current results
+// as the expected ones.
+func TestGolden(t *testing.T) {
+	require.ElementsMatch(t, corpus.Functions(), slices.Collect(maps.Keys(goldenFuncs)), "every corpus file has a function")
+
+	for _, name := range corpus.Functions() {
+		t.Run(name, func(t *testing.T) {
+			cases, err := corpus.Cases(name)
+			require.NoError(t, err)
+			require.NotEmpty(t, cases)
+
+			fn := goldenFuncs[name]
+			for i, c := range cases {
+				got := fn(c.Input)
+				if *updateGolden {
+					cases[i].Want = got
+					continue
+				}
+				assert.Equal(t, c.Want, got, "%s: %s (%s)", name, c.Name, c.Group)
+			}
+
+			if *updateGolden {
+				data, err := corpus.Encode(cases)
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(filepath.Join("..", "..", "corpus", "data", name+".json"), data, 0o644))
+			}
+		})
+	}
+}
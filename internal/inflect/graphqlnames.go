@@ -0,0 +1,115 @@
+package inflect
+
+// QueryFieldName returns the name of the GraphQL query field listing
+// objects of a type: its plural in camelCase.
+//
+// The name is put in camelCase before its last word is made plural, so
+// that acronyms stay whole, and the default count set with Num does not
+// apply.
+//
+// Examples:
+//   - QueryFieldName("Order") returns "orders"
+//   - QueryFieldName("UserProfile") returns "userProfiles"
+//   - QueryFieldName("Person") returns "people"
+//   - QueryFieldName("HTTPRoute") returns "httpRoutes"
+//   - QueryFieldName("URL") returns "urls"
+//   - QueryFieldName("UserID") returns "userIDs"
+func QueryFieldName(typeName string) string {
+	return defaultEngine.QueryFieldName(typeName)
+}
+
+// QueryFieldName returns the name of the GraphQL query field listing
+// objects of a type, using e's plurals and acronyms. See the package-level
+// QueryFieldName for details.
+func (e *Engine) QueryFieldName(typeName string) string {
+	return inflectIdentifier(e.GoCamelCase(typeName), e.pluralSafe)
+}
+
+// ObjectFieldName returns the name of the GraphQL query field fetching one
+// object of a type: its singular in camelCase.
+//
+// Examples:
+//   - ObjectFieldName("Order") returns "order"
+//   - ObjectFieldName("UserProfiles") returns "userProfile"
+//   - ObjectFieldName("People") returns "person"
+func ObjectFieldName(typeName string) string {
+	return defaultEngine.ObjectFieldName(typeName)
+}
+
+// ObjectFieldName returns the name of the GraphQL query field fetching one
+// object of a type, using e's singulars and acronyms. See the package-level
+// ObjectFieldName for details.
+func (e *Engine) ObjectFieldName(typeName string) string {
+	return e.GoCamelCase(inflectIdentifier(typeName, e.SingularSafe))
+}
+
+// ConnectionName returns the name of the Relay connection type for a
+// type: its singular in PascalCase followed by "Connection".
+//
+// Examples:
+//   - ConnectionName("Order") returns "OrderConnection"
+//   - ConnectionName("orders") returns "OrderConnection"
+//   - ConnectionName("user_profile") returns "UserProfileConnection"
+func ConnectionName(typeName string) string {
+	return defaultEngine.ConnectionName(typeName)
+}
+
+// ConnectionName returns the name of the Relay connection type for a type,
+// using e's singulars and acronyms. See the package-level ConnectionName
+// for details.
+func (e *Engine) ConnectionName(typeName string) string {
+	return e.GoPascalCase(inflectIdentifier(typeName, e.SingularSafe)) + "Connection"
+}
+
+// EdgeName returns the name of the Relay edge type for a type: its
+// singular in PascalCase followed by "Edge".
+//
+// Examples:
+//   - EdgeName("Order") returns "OrderEdge"
+//   - EdgeName("people") returns "PersonEdge"
+func EdgeName(typeName string) string {
+	return defaultEngine.EdgeName(typeName)
+}
+
+// EdgeName returns the name of the Relay edge type for a type, using e's
+// singulars and acronyms. See the package-level EdgeName for details.
+func (e *Engine) EdgeName(typeName string) string {
+	return e.GoPascalCase(inflectIdentifier(typeName, e.SingularSafe)) + "Edge"
+}
+
+// ConnectionFieldName returns the name of the field returning the Relay
+// connection of a type, next to the plain list of QueryFieldName: its
+// plural in camelCase followed by "Connection".
+//
+// Examples:
+//   - ConnectionFieldName("Order") returns "ordersConnection"
+//   - ConnectionFieldName("Person") returns "peopleConnection"
+func ConnectionFieldName(typeName string) string {
+	return defaultEngine.ConnectionFieldName(typeName)
+}
+
+// ConnectionFieldName returns the name of the field returning the Relay
+// connection of a type, using e's plurals and acronyms. See the
+// package-level ConnectionFieldName for details.
+func (e *Engine) ConnectionFieldName(typeName string) string {
+	return e.QueryFieldName(typeName) + "Connection"
+}
+
+// MutationFieldName returns the name of the GraphQL mutation field acting
+// on one object of a type: the action followed by the type's singular, in
+// camelCase.
+//
+// Examples:
+//   - MutationFieldName("create", "Order") returns "createOrder"
+//   - MutationFieldName("delete", "UserProfiles") returns "deleteUserProfile"
+//   - MutationFieldName("Update", "Person") returns "updatePerson"
+func MutationFieldName(action, typeName string) string {
+	return defaultEngine.MutationFieldName(action, typeName)
+}
+
+// MutationFieldName returns the name of the GraphQL mutation field acting
+// on one object of a type, using e's singulars and acronyms. See the
+// package-level MutationFieldName for details.
+func (e *Engine) MutationFieldName(action, typeName string) string {
+	return e.GoCamelCase(action + "_" + inflectIdentifier(typeName, e.SingularSafe))
+}
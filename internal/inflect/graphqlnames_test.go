@@ -0,0 +1,84 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestGraphQLNames(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{name: "query field", fn: inflect.QueryFieldName, in: "Order", want: "orders"},
+		{name: "query field compound", fn: inflect.QueryFieldName, in: "UserProfile", want: "userProfiles"},
+		{name: "query field irregular", fn: inflect.QueryFieldName, in: "Person", want: "people"},
+		{name: "query field acronym", fn: inflect.QueryFieldName, in: "HTTPRoute", want: "httpRoutes"},
+		{name: "query field acronym only", fn: inflect.QueryFieldName, in: "URL", want: "urls"},
+		{name: "query field short acronym", fn: inflect.QueryFieldName, in: "ID", want: "ids"},
+		{name: "query field acronym api", fn: inflect.QueryFieldName, in: "API", want: "apis"},
+		{name: "query field trailing acronym", fn: inflect.QueryFieldName, in: "UserID", want: "userIDs"},
+		{name: "query field already plural", fn: inflect.QueryFieldName, in: "Orders", want: "orders"},
+		{name: "query field snake case", fn: inflect.QueryFieldName, in: "order_item", want: "orderItems"},
+		{name: "object field", fn: inflect.ObjectFieldName, in: "Order", want: "order"},
+		{name: "object field from plural", fn: inflect.ObjectFieldName, in: "UserProfiles", want: "userProfile"},
+		{name: "object field irregular", fn: inflect.ObjectFieldName, in: "People", want: "person"},
+		{name: "object field known singular", fn: inflect.ObjectFieldName, in: "OrderStatus", want: "orderStatus"},
+		{name: "connection", fn: inflect.ConnectionName, in: "Order", want: "OrderConnection"},
+		{name: "connection from plural", fn: inflect.ConnectionName, in: "orders", want: "OrderConnection"},
+		{name: "connection snake case", fn: inflect.ConnectionName, in: "user_profile", want: "UserProfileConnection"},
+		{name: "connection acronym", fn: inflect.ConnectionName, in: "HTTPRoute", want: "HTTPRouteConnection"},
+		{name: "edge", fn: inflect.EdgeName, in: "Order", want: "OrderEdge"},
+		{name: "edge irregular", fn: inflect.EdgeName, in: "people", want: "PersonEdge"},
+		{name: "connection field", fn: inflect.ConnectionFieldName, in: "Order", want: "ordersConnection"},
+		{name: "connection field irregular", fn: inflect.ConnectionFieldName, in: "Person", want: "peopleConnection"},
+		{name: "connection field acronym", fn: inflect.ConnectionFieldName, in: "API", want: "apisConnection"},
+		{name: "connection acronym only", fn: inflect.ConnectionName, in: "URL", want: "URLConnection"},
+		{name: "object field acronym only", fn: inflect.ObjectFieldName, in: "ID", want: "id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.fn(tt.in))
+		})
+	}
+}
+
+func TestMutationFieldName(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		typeName string
+		want     string
+	}{
+		{name: "create", action: "create", typeName: "Order", want: "createOrder"},
+		{name: "from plural", action: "delete", typeName: "UserProfiles", want: "deleteUserProfile"},
+		{name: "capitalized action", action: "Update", typeName: "Person", want: "updatePerson"},
+		{name: "compound action", action: "bulkArchive", typeName: "Order", want: "bulkArchiveOrder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.MutationFieldName(tt.action, tt.typeName))
+		})
+	}
+}
+
+func TestGraphQLNamesEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("octopus", "octopodes")
+	assert.Equal(t, "seaOctopodes", e.QueryFieldName("SeaOctopus"))
+	assert.Equal(t, "SeaOctopusConnection", e.ConnectionName("SeaOctopodes"))
+}
+
+func TestGraphQLNamesIgnoreNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "orders", e.QueryFieldName("Order"))
+	assert.Equal(t, "ordersConnection", e.ConnectionFieldName("Order"))
+}
@@ -0,0 +1,196 @@
+package inflect
+
+import "strings"
+
+// posSuffix associates a word ending with a likely part of speech.
+type posSuffix struct {
+	suffix     string
+	pos        PartOfSpeech
+	confidence float64
+}
+
+// posSuffixes lists word endings that suggest a part of speech, checked in
+// order so that longer endings take precedence ("-ical" before "-al").
+var posSuffixes = []posSuffix{
+	{"tion", POSNoun, 0.8},
+	{"sion", POSNoun, 0.8},
+	{"ment", POSNoun, 0.8},
+	{"ness", POSNoun, 0.8},
+	{"ship", POSNoun, 0.8},
+	{"hood", POSNoun, 0.8},
+	{"ance", POSNoun, 0.7},
+	{"ence", POSNoun, 0.7},
+	{"ity", POSNoun, 0.7},
+	{"ism", POSNoun, 0.7},
+	{"dom", POSNoun, 0.6},
+	{"ist", POSNoun, 0.5},
+	{"ical", POSAdjective, 0.8},
+	{"ous", POSAdjective, 0.8},
+	{"ful", POSAdjective, 0.7},
+	{"less", POSAdjective, 0.7},
+	{"able", POSAdjective, 0.7},
+	{"ible", POSAdjective, 0.7},
+	{"ive", POSAdjective, 0.6},
+	{"ish", POSAdjective, 0.6},
+	{"ic", POSAdjective, 0.6},
+	{"al", POSAdjective, 0.5},
+	{"ize", POSVerb, 0.7},
+	{"ise", POSVerb, 0.6},
+	{"ify", POSVerb, 0.7},
+	{"ate", POSVerb, 0.5},
+	{"er", POSNoun, 0.4},
+	{"or", POSNoun, 0.4},
+}
+
+// plainPronouns contains pronouns that have no entry in the pronoun tables.
+var plainPronouns = map[string]bool{
+	"you": true, "yours": true, "yourselves": true, "one": true,
+}
+
+// GuessPOS guesses the part of speech of an English word.
+//
+// It returns the guess and a confidence between 0 and 1. Words found in the
+// internal pronoun, verb, noun, and adjective tables are classified with
+// high confidence; other words are classified by their endings ("-tion",
+// "-ous", "-ize", "-ing"). Words with no clues are guessed to be nouns with
+// low confidence.
+//
+// Examples:
+//   - GuessPOS("they") returns POSPronoun, 1.0
+//   - GuessPOS("went") returns POSVerb, 0.9
+//   - GuessPOS("children") returns POSNoun, 0.9
+//   - GuessPOS("better") returns POSAdjective, 0.9
+//   - GuessPOS("happiness") returns POSNoun, 0.8
+//   - GuessPOS("famous") returns POSAdjective, 0.8
+//   - GuessPOS("organize") returns POSVerb, 0.7
+//   - GuessPOS("table") returns POSNoun, 0.3
+func GuessPOS(word string) (PartOfSpeech, float64) {
+	return defaultEngine.GuessPOS(word)
+}
+
+// GuessPOS guesses the part of speech of an English word.
+//
+// It returns the guess and a confidence between 0 and 1. Words found in the
+// internal pronoun, verb, noun, and adjective tables, including nouns
+// defined with e.DefNoun, are classified with high confidence; other words
+// are classified by their endings ("-tion", "-ous", "-ize", "-ing"). Words
+// with no clues are guessed to be nouns with low confidence.
+//
+// Examples:
+//   - e.GuessPOS("they") returns POSPronoun, 1.0
+//   - e.GuessPOS("went") returns POSVerb, 0.9
+//   - e.GuessPOS("children") returns POSNoun, 0.9
+//   - e.GuessPOS("better") returns POSAdjective, 0.9
+//   - e.GuessPOS("happiness") returns POSNoun, 0.8
+//   - e.GuessPOS("famous") returns POSAdjective, 0.8
+//   - e.GuessPOS("organize") returns POSVerb, 0.7
+//   - e.GuessPOS("table") returns POSNoun, 0.3
+func (e *Engine) GuessPOS(word string) (PartOfSpeech, float64) {
+	lower := strings.ToLower(strings.TrimSpace(word))
+	if lower == "" {
+		return POSNoun, 0
+	}
+
+	if pos, confidence, ok := e.guessPOSFromTables(lower); ok {
+		return pos, confidence
+	}
+
+	// Regular verb forms (walking, walked)
+	if _, ok := participleBase(lower); ok {
+		return POSVerb, 0.6
+	}
+	if _, ok := regularPastBase(lower); ok {
+		return POSVerb, 0.6
+	}
+
+	for _, s := range posSuffixes {
+		if strings.HasSuffix(lower, s.suffix) && len(lower) > len(s.suffix)+1 {
+			return s.pos, s.confidence
+		}
+	}
+
+	// Regular plural nouns (tables, boxes)
+	if strings.HasSuffix(lower, "s") && e.Singular(lower) != lower {
+		return POSNoun, 0.5
+	}
+
+	return POSNoun, 0.3
+}
+
+// guessPOSFromTables classifies a lowercase word found in the internal
+// dictionaries.
+func (e *Engine) guessPOSFromTables(lower string) (PartOfSpeech, float64, bool) {
+	if isPronoun(lower) {
+		return POSPronoun, 1.0, true
+	}
+
+	// Auxiliaries, modals, and irregular past forms are verbs
+	if _, ok := verbSingularToPlural[lower]; ok || verbUnchanged[lower] || lower == "be" || lower == "am" {
+		return POSVerb, 1.0, true
+	}
+	if _, ok := verbPluralToSingular[lower]; ok {
+		return POSVerb, 0.9, true
+	}
+	if _, ok := irregularVerbBases[lower]; ok && !isKnownVerb(lower) {
+		return POSVerb, 0.9, true
+	}
+
+	e.mu.RLock()
+	_, isSingular := e.irregularPlurals[lower]
+	_, isPlural := e.singularIrregulars[lower]
+	e.mu.RUnlock()
+	if isSingular || isPlural {
+		return POSNoun, 0.9, true
+	}
+
+	if isKnownAdjective(lower) {
+		return POSAdjective, 0.9, true
+	}
+	if e.isUnchanged(lower) {
+		return POSNoun, 0.8, true
+	}
+
+	// Base forms of irregular verbs are often nouns too (run, cut, drink)
+	if isKnownVerb(lower) {
+		return POSVerb, 0.6, true
+	}
+	return POSNoun, 0, false
+}
+
+// isPronoun reports whether a lowercase word is a personal pronoun or
+// possessive determiner in the pronoun tables.
+func isPronoun(lower string) bool {
+	if plainPronouns[lower] {
+		return true
+	}
+	if _, ok := allPronounsToPlural[lower]; ok {
+		return true
+	}
+	for _, table := range []map[string]map[string]string{
+		pronounNominativeSingularByGender, pronounAccusativeSingularByGender,
+		pronounPossessiveSingularByGender, pronounReflexiveSingularByGender,
+	} {
+		if _, ok := table[lower]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isKnownAdjective reports whether a lowercase word is an adjective, or an
+// irregular comparative or superlative, in the adjective tables.
+func isKnownAdjective(lower string) bool {
+	if _, ok := irregularComparatives[lower]; ok {
+		return true
+	}
+	if _, ok := irregularAdjectiveBases[lower]; ok {
+		return true
+	}
+	if _, ok := adjSingularToPlural[lower]; ok {
+		return true
+	}
+	if _, ok := adjPluralToSingular[lower]; ok {
+		return true
+	}
+	return twoSyllableWithSuffix[lower]
+}
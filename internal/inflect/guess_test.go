@@ -0,0 +1,67 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestGuessPOS(t *testing.T) {
+	tests := []struct {
+		name       string
+		word       string
+		want       inflect.PartOfSpeech
+		confidence float64
+	}{
+		// Dictionary lookups
+		{name: "pronoun", word: "they", want: inflect.POSPronoun, confidence: 1.0},
+		{name: "pronoun I", word: "I", want: inflect.POSPronoun, confidence: 1.0},
+		{name: "possessive", word: "their", want: inflect.POSPronoun, confidence: 1.0},
+		{name: "you", word: "you", want: inflect.POSPronoun, confidence: 1.0},
+		{name: "auxiliary", word: "has", want: inflect.POSVerb, confidence: 1.0},
+		{name: "modal", word: "can", want: inflect.POSVerb, confidence: 1.0},
+		{name: "irregular past", word: "went", want: inflect.POSVerb, confidence: 0.9},
+		{name: "irregular noun", word: "children", want: inflect.POSNoun, confidence: 0.9},
+		{name: "irregular adjective", word: "better", want: inflect.POSAdjective, confidence: 0.9},
+		{name: "determiner", word: "this", want: inflect.POSAdjective, confidence: 0.9},
+		{name: "unchanged plural", word: "sheep", want: inflect.POSNoun, confidence: 0.8},
+		{name: "irregular base verb", word: "bring", want: inflect.POSVerb, confidence: 0.6},
+
+		// Verb forms
+		{name: "participle", word: "walking", want: inflect.POSVerb, confidence: 0.6},
+		{name: "past tense", word: "walked", want: inflect.POSVerb, confidence: 0.6},
+
+		// Suffixes
+		{name: "-ness", word: "happiness", want: inflect.POSNoun, confidence: 0.8},
+		{name: "-tion", word: "creation", want: inflect.POSNoun, confidence: 0.8},
+		{name: "-ous", word: "famous", want: inflect.POSAdjective, confidence: 0.8},
+		{name: "-ical", word: "musical", want: inflect.POSAdjective, confidence: 0.8},
+		{name: "-ize", word: "organize", want: inflect.POSVerb, confidence: 0.7},
+		{name: "-er", word: "teacher", want: inflect.POSNoun, confidence: 0.4},
+		{name: "short word with suffix", word: "table", want: inflect.POSNoun, confidence: 0.3},
+
+		// Fallbacks
+		{name: "regular plural", word: "boxes", want: inflect.POSNoun, confidence: 0.5},
+		{name: "unknown", word: "zorp", want: inflect.POSNoun, confidence: 0.3},
+		{name: "empty", word: "", want: inflect.POSNoun, confidence: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, confidence := inflect.GuessPOS(tt.word)
+			assert.Equal(t, tt.want, pos)
+			assert.InDelta(t, tt.confidence, confidence, 1e-9)
+		})
+	}
+}
+
+func TestGuessPOSCustomNoun(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+
+	pos, confidence := e.GuessPOS("regexen")
+	assert.Equal(t, inflect.POSNoun, pos)
+	assert.InDelta(t, 0.9, confidence, 1e-9)
+}
@@ -0,0 +1,85 @@
+package inflect
+
+// Hook observes the lookups made by Plural, Singular, and An.
+//
+// Register one with SetHook to count usage, find words that no dictionary
+// covers in production, and feed them back into DefNoun() or DefAn().
+// OnLookup is called after every lookup, including ones answered from the
+// cache, so it must be safe for concurrent use and should return quickly.
+type Hook interface {
+	// OnLookup is called with the function name ("Plural", "Singular", or
+	// "An"), its input and output, and the rule that produced the output,
+	// such as "irregular table" or "suffix rule (default + -s)". The rule
+	// names are the ones used by ExplainPlural and ExplainAn, and may
+	// change between releases.
+	OnLookup(fn, input, output, ruleUsed string)
+}
+
+// HookFunc adapts an ordinary function to the Hook interface.
+type HookFunc func(fn, input, output, ruleUsed string)
+
+// OnLookup calls f(fn, input, output, ruleUsed).
+func (f HookFunc) OnLookup(fn, input, output, ruleUsed string) {
+	f(fn, input, output, ruleUsed)
+}
+
+// SetHook registers a hook that observes every Plural, Singular, and An
+// lookup, replacing any previous hook. Pass nil to remove it.
+//
+// Hooks are disabled by default, and cost nothing beyond an atomic load
+// while disabled. Setting a hook clears the cache enabled by EnableCache,
+// so that cached results carry the rule that produced them.
+//
+// Examples:
+//
+//	SetHook(HookFunc(func(fn, input, output, rule string) {
+//		if rule == "suffix rule (default + -s)" {
+//			log.Printf("%s(%q) used the fallback rule", fn, input)
+//		}
+//	}))
+//	Plural("blorg") // logs: Plural("blorg") used the fallback rule
+func SetHook(h Hook) {
+	defaultEngine.SetHook(h)
+}
+
+// SetHook registers a hook that observes every e.Plural, e.Singular, and
+// e.An lookup, replacing any previous hook. Pass nil to remove it.
+//
+// Hooks are disabled by default, and cost nothing beyond an atomic load
+// while disabled. Setting a hook clears the cache enabled by
+// e.EnableCache, so that cached results carry the rule that produced them.
+// Clone does not copy the hook.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	counts := make(map[string]int)
+//	var mu sync.Mutex
+//	e.SetHook(HookFunc(func(fn, input, output, rule string) {
+//		mu.Lock()
+//		counts[fn]++
+//		mu.Unlock()
+//	}))
+func (e *Engine) SetHook(h Hook) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if h == nil {
+		e.hook.Store(nil)
+		return
+	}
+	e.hook.Store(&h)
+}
+
+// lookup returns the result of op for word, through the cache if one is
+// enabled, and reports it to the hook if one is set.
+func (e *Engine) lookup(op lookupOp, word string) string {
+	h := e.hook.Load()
+	if h == nil {
+		value, _ := e.cached(op, e.normalize(word), nil)
+		return value
+	}
+	value, rule := e.cached(op, e.normalize(word), &explanation{})
+	(*h).OnLookup(lookupOpNames[op], word, value, rule)
+	return value
+}
@@ -0,0 +1,110 @@
+package inflect_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+// lookup is a call recorded by recordingHook.
+type lookup struct {
+	fn, input, output, rule string
+}
+
+// recordingHook records every lookup it observes.
+type recordingHook struct {
+	mu      sync.Mutex
+	lookups []lookup
+}
+
+func (h *recordingHook) OnLookup(fn, input, output, ruleUsed string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.lookups = append(h.lookups, lookup{fn, input, output, ruleUsed})
+}
+
+func TestSetHook(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(e *inflect.Engine) string
+		want lookup
+	}{
+		{
+			name: "plural irregular",
+			call: func(e *inflect.Engine) string { return e.Plural("child") },
+			want: lookup{"Plural", "child", "children", "irregular table"},
+		},
+		{
+			name: "plural fallback",
+			call: func(e *inflect.Engine) string { return e.Plural("blorg") },
+			want: lookup{"Plural", "blorg", "blorgs", "suffix rule (default + -s)"},
+		},
+		{
+			name: "plural in punctuation",
+			call: func(e *inflect.Engine) string { return e.Plural(`"box"`) },
+			want: lookup{"Plural", `"box"`, `"boxes"`, "suffix rule (sibilant + -es)"},
+		},
+		{
+			name: "singular irregular",
+			call: func(e *inflect.Engine) string { return e.Singular("mice") },
+			want: lookup{"Singular", "mice", "mouse", "irregular table"},
+		},
+		{
+			name: "singular suffix",
+			call: func(e *inflect.Engine) string { return e.Singular("cities") },
+			want: lookup{"Singular", "cities", "city", "suffix rule (consonant + -ies -> -y)"},
+		},
+		{
+			name: "singular not plural",
+			call: func(e *inflect.Engine) string { return e.Singular("blorg") },
+			want: lookup{"Singular", "blorg", "blorg", "suffix rule (not plural)"},
+		},
+		{
+			name: "an",
+			call: func(e *inflect.Engine) string { return e.An("hour") },
+			want: lookup{"An", "hour", "an hour", "pronunciation dictionary"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			h := &recordingHook{}
+			e.SetHook(h)
+			assert.Equal(t, tt.want.output, tt.call(e))
+			assert.Equal(t, []lookup{tt.want}, h.lookups)
+		})
+	}
+}
+
+func TestSetHookWithCache(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	e.Plural("child") // cached without a rule
+
+	h := &recordingHook{}
+	e.SetHook(h)
+	e.Plural("child")
+	e.Plural("child")
+
+	want := lookup{"Plural", "child", "children", "irregular table"}
+	assert.Equal(t, []lookup{want, want}, h.lookups)
+	assert.Equal(t, uint64(1), e.GetCacheStats().Hits)
+}
+
+func TestSetHookRemove(t *testing.T) {
+	e := inflect.NewEngine()
+	var calls int
+	e.SetHook(inflect.HookFunc(func(_, _, _, _ string) { calls++ }))
+	e.Plural("cat")
+
+	clone := e.Clone()
+	clone.Plural("cat")
+
+	e.SetHook(nil)
+	e.Plural("cat")
+	assert.Equal(t, 1, calls)
+}
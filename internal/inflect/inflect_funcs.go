@@ -0,0 +1,609 @@
+package inflect
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// inflectFunc implements a function of the Inflect mini-language. It
+// receives the word argument, which inflectCallErr has validated, and the
+// count, if any.
+type inflectFunc func(e *Engine, word string, count []int) string
+
+// inflectFuncPattern matches a mini-language call such as plural('cat', 2):
+// a function name, a word or integer, and an optional integer count, or
+// True or False for num(). The word may be in single quotes, double quotes,
+// or backticks, or bare. The arguments may be omitted, as in num().
+var inflectFuncPattern = regexp.MustCompile(`\b([a-z_]+)\(\s*(?:('[^']*'|"[^"]*"|` + "`[^`]*`" + `|-?\d+|[A-Za-z][A-Za-z'-]*)\s*(?:,\s*(-?\d+|True|False)\s*)?)?\)`)
+
+// inflectProseWords are unquoted words that turn a, an, or no into another
+// word, as in "no(thing)" or "an(other)", so that such prose is not taken
+// for a call.
+var inflectProseWords = map[string]bool{
+	"body": true, "how": true, "one": true, "other": true, "thing": true,
+	"things": true, "where": true,
+}
+
+// inflectFuncs maps mini-language function names to their implementations.
+var inflectFuncs = map[string]inflectFunc{
+	"plural": func(e *Engine, word string, count []int) string {
+		if e.isSingularCount(count) {
+			return word
+		}
+		return e.plural(word)
+	},
+	"plural_noun": func(e *Engine, word string, count []int) string {
+		return e.PluralNoun(word, count...)
+	},
+	"plural_verb": func(e *Engine, word string, count []int) string {
+		return e.PluralVerb(word, count...)
+	},
+	"plural_adj": func(e *Engine, word string, count []int) string {
+		return e.PluralAdj(word, count...)
+	},
+	"singular_noun": func(e *Engine, word string, count []int) string {
+		return e.SingularNoun(word, count...)
+	},
+	"a": func(e *Engine, word string, count []int) string {
+		if len(count) > 0 {
+			return e.AnCount(word, count[0])
+		}
+		return e.An(word)
+	},
+	"an": func(e *Engine, word string, count []int) string {
+		if len(count) > 0 {
+			return e.AnCount(word, count[0])
+		}
+		return e.An(word)
+	},
+	"no": func(e *Engine, word string, count []int) string {
+		n, _ := e.countOrNum(count)
+		return e.No(word, n)
+	},
+	"ordinal": func(_ *Engine, word string, _ []int) string {
+		if n, err := strconv.Atoi(word); err == nil {
+			return Ordinal(n)
+		}
+		return WordToOrdinal(word)
+	},
+	"number_to_words": func(_ *Engine, word string, _ []int) string {
+		n, _ := strconv.Atoi(word)
+		return NumberToWords(n)
+	},
+	"present_participle": func(_ *Engine, word string, _ []int) string {
+		return PresentParticiple(word)
+	},
+	"auto": func(e *Engine, word string, count []int) string {
+		switch pos, _ := e.GuessPOS(word); pos {
+		case POSVerb:
+			return e.PluralVerb(word, count...)
+		case POSAdjective:
+			return e.PluralAdj(word, count...)
+		case POSNoun, POSPronoun:
+			return e.PluralNoun(word, count...)
+		}
+		return e.PluralNoun(word, count...)
+	},
+}
+
+// Inflect expands inflection function calls embedded in text.
+//
+// Each call has the form name('word') or name('word', count), with the
+// word in single quotes, double quotes, or backticks, or unquoted if it is
+// a single word of three letters or more. Prose such as "a(n) value" or
+// "no(thing)" is not taken for a call. The supported functions are:
+//   - plural, plural_noun, plural_verb, plural_adj, singular_noun
+//   - a, an, no
+//   - ordinal, number_to_words (which also accept a bare integer)
+//   - present_participle
+//   - auto, which inflects with the part of speech from GuessPOS
+//   - num(n), which sets the count for later calls without one and
+//     expands to nothing; num(n, True) also expands to n, and num()
+//     clears the count again
+//
+// Calls without a count use the count from num(), or the default count
+// set by Num(). A count set by num() lasts until the end of the text.
+// As in Python inflect, a() and an() only use an explicit count, so
+// a('cat') is always "a cat" but a('cats', 2) is "2 cats". Unknown
+// functions and invalid calls are left unchanged.
+//
+// Calls inside Markdown code, either an inline `code span` or a fenced
+// code block, are left unchanged, so that documentation showing the
+// mini-language is not expanded. Elsewhere, a backslash before a call, as
+// in \plural('cat'), escapes it: the backslash is removed and the call is
+// left unchanged.
+//
+// Examples:
+//   - Inflect("The plural of cat is plural('cat')") returns "The plural of cat is cats"
+//   - Inflect("I saw plural('cat', 3)") returns "I saw cats"
+//   - Inflect("num(1)There plural_verb('are') no('error')") returns "There is 1 error"
+//   - Inflect("This is the ordinal(2) a('hour')") returns "This is the 2nd an hour"
+//   - Inflect("num(2)auto('this') auto('child') auto('was') here") returns "these children were here"
+//   - Inflect("num(3, True) plural('cat') and a('dogs', 2)") returns "3 cats and 2 dogs"
+//   - Inflect("plural(cat) and plural(`dog`)") returns "cats and dogs"
+//   - Inflect("Write `plural('cat')` to get plural('cat')") returns "Write `plural('cat')` to get cats"
+//   - Inflect("Write \\plural('cat') to get plural('cat')") returns "Write plural('cat') to get cats"
+func Inflect(text string) string {
+	return defaultEngine.Inflect(text)
+}
+
+// Inflect expands inflection function calls embedded in text.
+//
+// Each call has the form name('word') or name('word', count), with the
+// word in single quotes, double quotes, or backticks, or unquoted if it is
+// a single word of three letters or more. Prose such as "a(n) value" or
+// "no(thing)" is not taken for a call. The supported functions are:
+//   - plural, plural_noun, plural_verb, plural_adj, singular_noun
+//   - a, an, no
+//   - ordinal, number_to_words (which also accept a bare integer)
+//   - present_participle
+//   - auto, which inflects with the part of speech from e.GuessPOS
+//   - num(n), which sets the count for later calls without one and
+//     expands to nothing; num(n, True) also expands to n, and num()
+//     clears the count again
+//
+// Calls without a count use the count from num(), or the default count
+// set by e.Num(). A count set by num() lasts until the end of the text.
+// As in Python inflect, a() and an() only use an explicit count, so
+// a('cat') is always "a cat" but a('cats', 2) is "2 cats". Unknown
+// functions and invalid calls are left unchanged.
+//
+// Calls inside Markdown code, either an inline `code span` or a fenced
+// code block, are left unchanged, so that documentation showing the
+// mini-language is not expanded. Elsewhere, a backslash before a call, as
+// in \plural('cat'), escapes it: the backslash is removed and the call is
+// left unchanged.
+//
+// Examples:
+//   - e.Inflect("The plural of cat is plural('cat')") returns "The plural of cat is cats"
+//   - e.Inflect("I saw plural('cat', 3)") returns "I saw cats"
+//   - e.Inflect("num(1)There plural_verb('are') no('error')") returns "There is 1 error"
+//   - e.Inflect("This is the ordinal(2) a('hour')") returns "This is the 2nd an hour"
+//   - e.Inflect("num(2)auto('this') auto('child') auto('was') here") returns "these children were here"
+//   - e.Inflect("num(3, True) plural('cat') and a('dogs', 2)") returns "3 cats and 2 dogs"
+//   - e.Inflect("plural(cat) and plural(`dog`)") returns "cats and dogs"
+//   - e.Inflect("Write `plural('cat')` to get plural('cat')") returns "Write `plural('cat')` to get cats"
+//   - e.Inflect("Write \\plural('cat') to get plural('cat')") returns "Write plural('cat') to get cats"
+func (e *Engine) Inflect(text string) string {
+	out, _ := e.inflect(text, InflectOptions{})
+	return out
+}
+
+// AppendInflect appends text with its inflection function calls expanded,
+// as Inflect returns it, to dst and returns the extended buffer.
+//
+// Code that expands many texts, such as a rendering loop, can reuse one
+// buffer to avoid allocating a string for each.
+//
+// Examples:
+//   - AppendInflect([]byte("> "), "I saw plural('cat', 3)") returns []byte("> I saw cats")
+func AppendInflect(dst []byte, text string) []byte {
+	return defaultEngine.AppendInflect(dst, text)
+}
+
+// AppendInflect appends text with its inflection function calls expanded,
+// as e.Inflect returns it, to dst and returns the extended buffer.
+//
+// Examples:
+//
+//	buf := e.AppendInflect(buf[:0], "plural('child')") // buf is "children"
+func (e *Engine) AppendInflect(dst []byte, text string) []byte {
+	var num []int
+	dst, _ = e.appendCalls(dst, text, findInflectCalls(text, markdownCodeRegions), InflectOptions{}, &num, formatText)
+	return dst
+}
+
+// UnknownFuncPolicy controls what InflectWithOptions does with calls to
+// functions that are not part of the mini-language.
+type UnknownFuncPolicy int
+
+const (
+	// UnknownFuncKeep leaves calls to unknown functions unchanged, as
+	// Inflect does. This is the default.
+	// Example: "foo('bar')" -> "foo('bar')"
+	UnknownFuncKeep UnknownFuncPolicy = iota
+
+	// UnknownFuncError makes InflectWithOptions return ErrUnknownFunc for
+	// the first call to an unknown function, which catches typos such as
+	// plurl('cat') in templates.
+	// Example: "plurl('cat')" -> error
+	UnknownFuncError
+)
+
+// InflectOptions configures InflectWithOptions.
+type InflectOptions struct {
+	// UnknownFuncs controls what happens to calls to unknown functions.
+	// The default, UnknownFuncKeep, leaves them unchanged.
+	UnknownFuncs UnknownFuncPolicy
+}
+
+// ErrUnknownFunc is returned by InflectWithOptions with UnknownFuncError
+// when the text calls a function that is not part of the mini-language.
+// It is wrapped with the call, so compare it with errors.Is.
+var ErrUnknownFunc = errors.New("unknown function")
+
+// InflectWithOptions expands inflection function calls embedded in text,
+// like Inflect, with the given options.
+//
+// With UnknownFuncError, it returns ErrUnknownFunc and an empty string if
+// the text calls an unknown function outside Markdown code. Escaped calls,
+// such as \foo('bar'), are never an error.
+//
+// Examples:
+//   - InflectWithOptions("plural('cat')", InflectOptions{}) returns "cats", nil
+//   - InflectWithOptions("plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns an error
+//   - InflectWithOptions("\\plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns "plurl('cat')", nil
+func InflectWithOptions(text string, opts InflectOptions) (string, error) {
+	return defaultEngine.InflectWithOptions(text, opts)
+}
+
+// InflectWithOptions expands inflection function calls embedded in text,
+// like e.Inflect, with the given options.
+//
+// With UnknownFuncError, it returns ErrUnknownFunc and an empty string if
+// the text calls an unknown function outside Markdown code. Escaped calls,
+// such as \foo('bar'), are never an error.
+//
+// Examples:
+//   - e.InflectWithOptions("plural('cat')", InflectOptions{}) returns "cats", nil
+//   - e.InflectWithOptions("plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns an error
+//   - e.InflectWithOptions("\\plurl('cat')", InflectOptions{UnknownFuncs: UnknownFuncError}) returns "plurl('cat')", nil
+func (e *Engine) InflectWithOptions(text string, opts InflectOptions) (string, error) {
+	return e.inflect(text, opts)
+}
+
+// ErrInvalidCall is reported by InflectTokens for a call to a known
+// function with invalid arguments, such as number_to_words('x').
+var ErrInvalidCall = errors.New("invalid arguments")
+
+// InflectToken is a mini-language call found by InflectTokens.
+type InflectToken struct {
+	// Start and End are the byte offsets of the call, so text[Start:End]
+	// is the call itself.
+	Start, End int
+
+	// Func is the function name, such as "plural".
+	Func string
+
+	// Args holds the arguments with their quotes removed, so
+	// plural('cat', 2) has the arguments "cat" and "2".
+	Args []string
+
+	// Err is nil if Inflect would expand the call, or ErrUnknownFunc or
+	// ErrInvalidCall if it would leave the call unchanged.
+	Err error
+}
+
+// InflectTokens returns the mini-language calls in text that Inflect would
+// consider, in order, without expanding them.
+//
+// It is meant for editors and linters that highlight or validate
+// inflection directives. Calls in Markdown code and escaped calls are not
+// returned, since Inflect leaves them alone.
+//
+// Examples:
+//   - InflectTokens("I saw plural('cat', 3)") returns one token with Start 6, End 22, Func "plural", and Args ["cat" "3"]
+//   - InflectTokens("plurl('cat')") returns one token whose Err is ErrUnknownFunc
+//   - InflectTokens("`plural('cat')`") returns no tokens
+func InflectTokens(text string) []InflectToken {
+	var tokens []InflectToken
+	for _, c := range findInflectCalls(text, markdownCodeRegions) {
+		if c.escaped {
+			continue
+		}
+		token := InflectToken{Start: c.start, End: c.end, Func: c.name, Err: inflectCallErr(c.name, c.arg, c.count)}
+		if c.arg != "" {
+			token.Args = append(token.Args, unquoteInflectArg(c.arg))
+		}
+		if c.count != "" {
+			token.Args = append(token.Args, c.count)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// inflectMatch is a mini-language call found by findInflectCalls.
+type inflectMatch struct {
+	start, end       int
+	name, arg, count string
+	escaped          bool // preceded by a backslash
+}
+
+// findInflectCalls returns the mini-language calls in text outside the
+// regions returned by skip, such as markdownCodeRegions, in order.
+func findInflectCalls(text string, skip func(text string) [][2]int) []inflectMatch {
+	locs := inflectFuncPattern.FindAllStringSubmatchIndex(text, -1)
+	if locs == nil {
+		return nil
+	}
+	code := skip(text)
+
+	matches := make([]inflectMatch, 0, len(locs))
+	for _, loc := range locs {
+		for len(code) > 0 && code[0][1] <= loc[0] {
+			code = code[1:]
+		}
+		if len(code) > 0 && code[0][0] <= loc[0] {
+			continue
+		}
+		group := func(i int) string {
+			if loc[2*i] < 0 {
+				return ""
+			}
+			return text[loc[2*i]:loc[2*i+1]]
+		}
+		if isInflectProse(group(1), group(2)) {
+			continue
+		}
+		matches = append(matches, inflectMatch{
+			start:   loc[0],
+			end:     loc[1],
+			name:    group(1),
+			arg:     group(2),
+			count:   group(3),
+			escaped: loc[0] > 0 && text[loc[0]-1] == '\\',
+		})
+	}
+	return matches
+}
+
+// isInflectProse reports whether a call with the given function name and
+// word argument is more likely prose than a mini-language call: an
+// unquoted word of one or two letters, as in "a(n)" or "file(s)", or one of
+// inflectProseWords after a, an, or no.
+func isInflectProse(name, arg string) bool {
+	if arg == "" || !isASCIILetter(arg[0]) {
+		return false
+	}
+	if len(arg) < 3 {
+		return true
+	}
+	return (name == "a" || name == "an" || name == "no") && inflectProseWords[strings.ToLower(arg)]
+}
+
+// inflectFormat is the markup of the text passed to expandCalls.
+type inflectFormat int
+
+const (
+	// formatText is plain text, in which Markdown code is skipped.
+	formatText inflectFormat = iota
+
+	// formatHTML is the content of an HTML text node.
+	formatHTML
+
+	// formatMarkdown is Markdown, in which code and links are skipped.
+	formatMarkdown
+)
+
+// inflect implements Inflect and InflectWithOptions.
+func (e *Engine) inflect(text string, opts InflectOptions) (string, error) {
+	var num []int
+	return e.expandCalls(text, opts, &num, formatText)
+}
+
+// expandCalls expands the mini-language calls in text, updating the count
+// set by num() in num. In HTML, character references in the arguments are
+// decoded and the expansions are escaped.
+func (e *Engine) expandCalls(text string, opts InflectOptions, num *[]int, format inflectFormat) (string, error) {
+	skip := markdownCodeRegions
+	if format == formatMarkdown {
+		skip = markdownRegions
+	}
+	matches := findInflectCalls(text, skip)
+	if matches == nil {
+		return text, nil
+	}
+
+	var err error
+	out := buildString(func(dst []byte) []byte {
+		dst, err = e.appendCalls(dst, text, matches, opts, num, format)
+		return dst
+	})
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// appendCalls appends text to dst with the calls found in it by
+// findInflectCalls expanded, as expandCalls does.
+func (e *Engine) appendCalls(dst []byte, text string, matches []inflectMatch, opts InflectOptions, num *[]int, format inflectFormat) ([]byte, error) {
+	last := 0
+	for _, c := range matches {
+		call := text[c.start:c.end]
+		if c.escaped {
+			dst = append(dst, text[last:c.start-1]...)
+			dst = append(dst, call...)
+			last = c.end
+			continue
+		}
+		arg := c.arg
+		if format == formatHTML {
+			arg = html.UnescapeString(arg)
+		}
+		out, err := e.inflectCall(c.name, arg, c.count, num)
+		switch {
+		case err != nil && errors.Is(err, ErrUnknownFunc) && opts.UnknownFuncs == UnknownFuncError:
+			return dst, fmt.Errorf("%w: %s", ErrUnknownFunc, call)
+		case err != nil:
+			out = call
+		case format == formatHTML:
+			out = htmlTextEscaper.Replace(out)
+		}
+		dst = append(dst, text[last:c.start]...)
+		dst = append(dst, out...)
+		last = c.end
+	}
+	return append(dst, text[last:]...), nil
+}
+
+// inflectCallErr reports whether a call with the given function name, word
+// argument, and count argument is valid, returning ErrUnknownFunc or
+// ErrInvalidCall if not.
+func inflectCallErr(name, arg, countArg string) error {
+	if name == "num" {
+		if arg != "" {
+			if _, err := strconv.Atoi(unquoteInflectArg(arg)); err != nil {
+				return ErrInvalidCall
+			}
+		}
+		return nil
+	}
+	if _, ok := inflectFuncs[name]; !ok {
+		return ErrUnknownFunc
+	}
+	if strings.TrimSpace(unquoteInflectArg(arg)) == "" {
+		return ErrInvalidCall
+	}
+	if countArg != "" {
+		if _, err := strconv.Atoi(countArg); err != nil {
+			return ErrInvalidCall
+		}
+	}
+	if name == "number_to_words" {
+		if _, err := strconv.Atoi(unquoteInflectArg(arg)); err != nil {
+			return ErrInvalidCall
+		}
+	}
+	return nil
+}
+
+// inflectCall expands a single mini-language call with the given function
+// name, word argument, and count argument, updating the count set by num()
+// in num. It returns ErrUnknownFunc or ErrInvalidCall if the call cannot be
+// expanded.
+func (e *Engine) inflectCall(name, arg, countArg string, num *[]int) (string, error) {
+	if err := inflectCallErr(name, arg, countArg); err != nil {
+		return "", err
+	}
+	word := unquoteInflectArg(arg)
+	if name == "num" {
+		return inflectNum(word, countArg, num), nil
+	}
+
+	count := *num
+	if name == "a" || name == "an" {
+		count = nil
+	}
+	if countArg != "" {
+		n, _ := strconv.Atoi(countArg)
+		count = []int{n}
+	}
+	return inflectFuncs[name](e, word, count), nil
+}
+
+// markdownCodeRegions returns the byte ranges of the fenced code blocks and
+// inline code spans in text, in order. A fence is a line starting with at
+// least three backticks or tildes, and runs to a closing fence of the same
+// kind or to the end of the text. A code span starts with a run of
+// backticks and ends at the next run of the same length; a run without one
+// is literal text.
+func markdownCodeRegions(text string) [][2]int {
+	if !strings.ContainsAny(text, "`~") {
+		return nil
+	}
+	var regions [][2]int
+	fence, fenceStart, inlineStart := "", 0, 0
+	for pos := 0; pos < len(text); {
+		end := len(text)
+		if i := strings.IndexByte(text[pos:], '\n'); i >= 0 {
+			end = pos + i + 1
+		}
+		line := strings.TrimLeft(text[pos:end], " ")
+		if fence == "" {
+			if marker := codeFence(line); marker != "" {
+				regions = appendCodeSpans(regions, text, inlineStart, pos)
+				fence, fenceStart = marker, pos
+			}
+		} else if rest := strings.TrimLeft(line, fence[:1]); len(line)-len(rest) >= len(fence) && strings.TrimSpace(rest) == "" {
+			regions = append(regions, [2]int{fenceStart, end})
+			fence, inlineStart = "", end
+		}
+		pos = end
+	}
+	if fence != "" {
+		return append(regions, [2]int{fenceStart, len(text)})
+	}
+	return appendCodeSpans(regions, text, inlineStart, len(text))
+}
+
+// codeFence returns the run of backticks or tildes opening a fenced code
+// block on line, or "" if line does not open one.
+func codeFence(line string) string {
+	if line == "" || line[0] != '`' && line[0] != '~' {
+		return ""
+	}
+	n := len(line) - len(strings.TrimLeft(line, line[:1]))
+	// As in CommonMark, ```code``` on one line is a code span, not a fence
+	if n < 3 || line[0] == '`' && strings.ContainsRune(line[n:], '`') {
+		return ""
+	}
+	return line[:n]
+}
+
+// appendCodeSpans appends the ranges of the inline code spans in
+// text[start:end] to regions.
+func appendCodeSpans(regions [][2]int, text string, start, end int) [][2]int {
+	for i := start; i < end; {
+		if text[i] != '`' {
+			i++
+			continue
+		}
+		n := backtickRun(text[i:end])
+		closed := -1
+		for j := i + n; j < end; {
+			if text[j] != '`' {
+				j++
+				continue
+			}
+			m := backtickRun(text[j:end])
+			if m == n {
+				closed = j
+				break
+			}
+			j += m
+		}
+		if closed < 0 {
+			i += n
+			continue
+		}
+		regions = append(regions, [2]int{i, closed + n})
+		i = closed + n
+	}
+	return regions
+}
+
+// backtickRun returns the number of backticks at the start of s.
+func backtickRun(s string) int {
+	return len(s) - len(strings.TrimLeft(s, "`"))
+}
+
+// inflectNum implements num(), num(n), and num(n, show) by updating the
+// count in num, and returns the expansion, which is n when show is True or
+// a nonzero integer. The arguments must be valid.
+func inflectNum(arg, show string, num *[]int) string {
+	if arg == "" {
+		*num = nil
+		return ""
+	}
+	n, _ := strconv.Atoi(arg)
+	*num = []int{n}
+	if show == "True" || show != "" && show != "False" && show != "0" {
+		return arg
+	}
+	return ""
+}
+
+// unquoteInflectArg removes the quotes or backticks around a mini-language
+// word argument.
+func unquoteInflectArg(arg string) string {
+	if len(arg) >= 2 && (arg[0] == '\'' || arg[0] == '"' || arg[0] == '`') {
+		return arg[1 : len(arg)-1]
+	}
+	return arg
+}
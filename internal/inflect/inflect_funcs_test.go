@@ -0,0 +1,303 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestInflect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plural", text: "The plural of cat is plural('cat')", want: "The plural of cat is cats"},
+		{name: "plural with count", text: "I saw plural('cat', 3) and plural('dog', 1)", want: "I saw cats and dog"},
+		{name: "double quotes", text: `plural("child")`, want: "children"},
+		{name: "spaces", text: "plural( 'box' , 2 )", want: "boxes"},
+		{name: "plural_noun", text: "plural_noun('me')", want: "us"},
+		{name: "plural_verb", text: "plural_verb('is')", want: "are"},
+		{name: "plural_adj", text: "plural_adj('this')", want: "these"},
+		{name: "singular_noun", text: "singular_noun('cats')", want: "cat"},
+		{name: "a", text: "a('cat')", want: "a cat"},
+		{name: "an", text: "an('hour')", want: "an hour"},
+		{name: "no", text: "no('error', 0)", want: "no errors"},
+		{name: "no without count", text: "no('error')", want: "no errors"},
+		{name: "ordinal number", text: "the ordinal(2) time", want: "the 2nd time"},
+		{name: "ordinal word", text: "the ordinal('three') time", want: "the third time"},
+		{name: "number_to_words", text: "number_to_words(42)", want: "forty-two"},
+		{name: "present_participle", text: "present_participle('run')", want: "running"},
+
+		// num sets the count for later calls
+		{name: "num singular", text: "num(1)There plural_verb('are') no('error')", want: "There is 1 error"},
+		{name: "num plural", text: "num(3)There plural_verb('is') no('error')", want: "There are 3 errors"},
+		{name: "num then plural", text: "num(1)plural('cat') num(2)plural('cat')", want: "cat cats"},
+		{name: "explicit count wins", text: "num(1)plural('cat', 2)", want: "cats"},
+		{name: "num shown", text: "num(3, True) plural('cat')", want: "3 cats"},
+		{name: "num hidden", text: "num(3, False)plural('cat')", want: "cats"},
+		{name: "num reset", text: "num(1)plural('cat') num()plural('cat')", want: "cat cats"},
+
+		// a and an only use an explicit count
+		{name: "a with count", text: "a('cats', 2)", want: "2 cats"},
+		{name: "a with count 1", text: "a('apple', 1)", want: "an apple"},
+		{name: "a ignores num", text: "num(2)a('cat')", want: "a cat"},
+
+		// auto
+		{name: "auto noun", text: "auto('child', 2)", want: "children"},
+		{name: "auto verb", text: "auto('was', 2)", want: "were"},
+		{name: "auto adjective", text: "auto('this', 2)", want: "these"},
+		{name: "auto pronoun", text: "auto('myself', 2)", want: "ourselves"},
+		{name: "auto singular", text: "auto('children', 1)", want: "children"},
+		{name: "auto with num", text: "num(2)auto('this') auto('child') auto('was') here", want: "these children were here"},
+
+		// Unquoted and backtick arguments
+		{name: "unquoted word", text: "plural(cat)", want: "cats"},
+		{name: "unquoted word with count", text: "plural(cat, 1)", want: "cat"},
+		{name: "unquoted article", text: "a(hour)", want: "an hour"},
+		{name: "unquoted hyphenated", text: "plural(x-ray)", want: "x-rays"},
+		{name: "backtick word", text: "plural(`cat`)", want: "cats"},
+		{name: "backtick word with count", text: "no(`error`, 3)", want: "3 errors"},
+		{name: "backtick phrase", text: "a(`honest man`)", want: "an honest man"},
+		{name: "short unquoted word is prose", text: "Enter a(n) value", want: "Enter a(n) value"},
+		{name: "optional plural is prose", text: "Delete the file(s)", want: "Delete the file(s)"},
+		{name: "no(thing) is prose", text: "There is no(thing) here", want: "There is no(thing) here"},
+		{name: "an(other) is prose", text: "Try an(other) one", want: "Try an(other) one"},
+		{name: "short quoted word", text: "plural('ox')", want: "oxen"},
+		{name: "empty word", text: "plural('') and a(\"\")", want: "plural('') and a(\"\")"},
+
+		// Markdown code is left unchanged
+		{name: "inline code", text: "Use `plural('cat')` for plural('dog')", want: "Use `plural('cat')` for dogs"},
+		{name: "double backtick code", text: "``plural(`cat`)`` gives plural(`cat`)", want: "``plural(`cat`)`` gives cats"},
+		{name: "one-line triple backtick code", text: "```plural('cat')``` gives plural('cat')", want: "```plural('cat')``` gives cats"},
+		{name: "unclosed backtick", text: "a ` and plural('cat')", want: "a ` and cats"},
+		{name: "num inside code", text: "`num(1)` plural('cat')", want: "`num(1)` cats"},
+		{
+			name: "fenced block",
+			text: "plural('cat')\n```go\nInflect(\"plural('cat')\")\n```\nplural('dog')",
+			want: "cats\n```go\nInflect(\"plural('cat')\")\n```\ndogs",
+		},
+		{
+			name: "tilde fenced block",
+			text: "~~~\nplural('cat')\n~~~~\nplural('cat')",
+			want: "~~~\nplural('cat')\n~~~~\ncats",
+		},
+		{
+			name: "indented fence",
+			text: "  ```\nplural('cat')\n  ```\nplural('cat')",
+			want: "  ```\nplural('cat')\n  ```\ncats",
+		},
+		{
+			name: "backticks inside fenced block",
+			text: "```\n`x\n```\n`plural('cat')` plural('cat')",
+			want: "```\n`x\n```\n`plural('cat')` cats",
+		},
+		{
+			name: "unclosed fence",
+			text: "plural('cat')\n```\nplural('cat')",
+			want: "cats\n```\nplural('cat')",
+		},
+
+		// Escaped calls
+		{name: "escaped call", text: "\\plural('cat') is plural('cat')", want: "plural('cat') is cats"},
+		{name: "escaped num", text: "\\num(2) plural('cat')", want: "num(2) cats"},
+		{name: "escaped unknown function", text: "\\foo('bar')", want: "foo('bar')"},
+		{name: "escape in code", text: "`\\plural('cat')`", want: "`\\plural('cat')`"},
+		{name: "backslash not before call", text: "a\\b plural('cat')", want: "a\\b cats"},
+
+		// Left unchanged
+		{name: "no calls", text: "nothing to do here", want: "nothing to do here"},
+		{name: "unknown function", text: "foo('bar')", want: "foo('bar')"},
+		{name: "invalid number", text: "number_to_words('x')", want: "number_to_words('x')"},
+		{name: "multiple unquoted words", text: "plural(big cat)", want: "plural(big cat)"},
+		{name: "num with word", text: "num('x')", want: "num('x')"},
+		{name: "no arguments", text: "plural()", want: "plural()"},
+		{name: "boolean count", text: "plural('cat', True)", want: "plural('cat', True)"},
+		{name: "empty", text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Inflect(tt.text))
+		})
+	}
+}
+
+func TestInflectEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "two regexen", e.Inflect("two plural('regex')"))
+
+	e.Num(1)
+	assert.Equal(t, "one regex", e.Inflect("one plural('regex')"))
+	assert.Equal(t, "two regexen", e.Inflect("two plural('regex', 2)"))
+}
+
+func BenchmarkInflect(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		text string
+	}{
+		{"no_calls", "There are no function calls in this text"},
+		{"plural", "I saw plural('cat', 3)"},
+		{"num", "num(1)There plural_verb('are') no('error')"},
+		{"mixed", "num(2)a('cat') and plural_adj('this') plural_noun('child') were here"},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				inflect.Inflect(bm.text)
+			}
+		})
+	}
+}
+
+func TestAppendInflect(t *testing.T) {
+	texts := []string{
+		"",
+		"There are no function calls in this text",
+		"I saw plural('cat', 3)",
+		"num(1)There plural_verb('are') no('error')",
+		"Write `plural('cat')` to get plural('cat')",
+		"Write \\plural('cat') to get plural('cat')",
+		"foo('bar') and plural('dog')",
+	}
+
+	for _, text := range texts {
+		t.Run(text, func(t *testing.T) {
+			assert.Equal(t, "> "+inflect.Inflect(text), string(inflect.AppendInflect([]byte("> "), text)))
+		})
+	}
+
+	e := inflect.NewEngine()
+	e.DefNoun("cat", "kitties")
+	assert.Equal(t, "kitties", string(e.AppendInflect(nil, "plural('cat')")))
+}
+
+func BenchmarkAppendInflect(b *testing.B) {
+	text := "num(2)a('cat') and plural_adj('this') plural_noun('child') were here"
+	buf := make([]byte, 0, 128)
+	b.ReportAllocs()
+	for range b.N {
+		buf = inflect.AppendInflect(buf[:0], text)
+	}
+}
+
+func TestInflectWithOptions(t *testing.T) {
+	strict := inflect.InflectOptions{UnknownFuncs: inflect.UnknownFuncError}
+	tests := []struct {
+		name    string
+		text    string
+		opts    inflect.InflectOptions
+		want    string
+		wantErr error
+	}{
+		{name: "default keeps unknown", text: "foo('bar') plural('cat')", want: "foo('bar') cats"},
+		{name: "keep", text: "foo('bar')", opts: inflect.InflectOptions{UnknownFuncs: inflect.UnknownFuncKeep}, want: "foo('bar')"},
+		{name: "error", text: "plural('cat') plurl('dog')", opts: strict, wantErr: inflect.ErrUnknownFunc},
+		{name: "error on bareword call", text: "call(them)", opts: strict, wantErr: inflect.ErrUnknownFunc},
+		{name: "prose is not a call", text: "Delete the file(s)", opts: strict, want: "Delete the file(s)"},
+		{name: "known functions", text: "num(2)plural('cat') and no('dog')", opts: strict, want: "cats and 2 dogs"},
+		{name: "escaped unknown", text: "\\plurl('cat')", opts: strict, want: "plurl('cat')"},
+		{name: "unknown in code", text: "`plurl('cat')` plural('cat')", opts: strict, want: "`plurl('cat')` cats"},
+		{name: "invalid known call", text: "number_to_words('x')", opts: strict, want: "number_to_words('x')"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.InflectWithOptions(tt.text, tt.opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInflectWithOptionsErrorMessage(t *testing.T) {
+	_, err := inflect.NewEngine().InflectWithOptions("a plurl('cat')", inflect.InflectOptions{UnknownFuncs: inflect.UnknownFuncError})
+	require.ErrorIs(t, err, inflect.ErrUnknownFunc)
+	assert.Equal(t, "unknown function: plurl('cat')", err.Error())
+}
+
+func TestInflectTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []inflect.InflectToken
+	}{
+		{name: "no calls", text: "nothing here", want: nil},
+		{
+			name: "call with count",
+			text: "I saw plural('cat', 3)",
+			want: []inflect.InflectToken{{Start: 6, End: 22, Func: "plural", Args: []string{"cat", "3"}}},
+		},
+		{
+			name: "several calls",
+			text: "num(2)a(`hour`) and no(\"error\")",
+			want: []inflect.InflectToken{
+				{Start: 0, End: 6, Func: "num", Args: []string{"2"}},
+				{Start: 6, End: 15, Func: "a", Args: []string{"hour"}},
+				{Start: 20, End: 31, Func: "no", Args: []string{"error"}},
+			},
+		},
+		{
+			name: "no arguments",
+			text: "num() plural()",
+			want: []inflect.InflectToken{
+				{Start: 0, End: 5, Func: "num"},
+				{Start: 6, End: 14, Func: "plural", Err: inflect.ErrInvalidCall},
+			},
+		},
+		{
+			name: "unknown function",
+			text: "plurl(cat)",
+			want: []inflect.InflectToken{{Start: 0, End: 10, Func: "plurl", Args: []string{"cat"}, Err: inflect.ErrUnknownFunc}},
+		},
+		{
+			name: "invalid arguments",
+			text: "number_to_words('x') plural('cat', True) num('x')",
+			want: []inflect.InflectToken{
+				{Start: 0, End: 20, Func: "number_to_words", Args: []string{"x"}, Err: inflect.ErrInvalidCall},
+				{Start: 21, End: 40, Func: "plural", Args: []string{"cat", "True"}, Err: inflect.ErrInvalidCall},
+				{Start: 41, End: 49, Func: "num", Args: []string{"x"}, Err: inflect.ErrInvalidCall},
+			},
+		},
+		{
+			name: "num with show",
+			text: "num(3, True)",
+			want: []inflect.InflectToken{{Start: 0, End: 12, Func: "num", Args: []string{"3", "True"}}},
+		},
+		{
+			name: "code and escapes skipped",
+			text: "`plural('cat')` \\plural('cat') ordinal(2)",
+			want: []inflect.InflectToken{{Start: 31, End: 41, Func: "ordinal", Args: []string{"2"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.InflectTokens(tt.text))
+		})
+	}
+}
+
+func TestInflectTokensMatchInflect(t *testing.T) {
+	text := "num(2)There plural_verb('was') no(`error`) in plurl('x') and plural()"
+	for _, tok := range inflect.InflectTokens(text) {
+		call := text[tok.Start:tok.End]
+		if tok.Err != nil {
+			assert.Equal(t, call, inflect.Inflect(call), "invalid call %s should be left unchanged", call)
+		} else if tok.Func != "num" {
+			assert.NotEqual(t, call, inflect.Inflect(call), "valid call %s should be expanded", call)
+		}
+	}
+}
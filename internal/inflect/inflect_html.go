@@ -0,0 +1,127 @@
+package inflect
+
+import "strings"
+
+// htmlSkipElements contains the elements whose content InflectHTML leaves
+// unchanged: raw text elements, which are not text nodes, and code, which
+// is left alone like Markdown code in Inflect.
+var htmlSkipElements = map[string]bool{
+	"script": true, "style": true, "textarea": true, "pre": true, "code": true,
+}
+
+// htmlTextEscaper escapes text for an HTML text node, as browsers do when
+// they serialize one.
+var htmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// InflectHTML expands inflection function calls in the text nodes of an
+// HTML fragment, like Inflect, leaving the markup unchanged.
+//
+// Tags, including their attributes, and comments are copied as they are,
+// as is the content of script, style, textarea, pre, and code elements.
+// Character references in the arguments of a call are decoded, and each
+// expansion is escaped, so a('R&amp;D team') becomes "an R&amp;D team".
+// Quotes around arguments must be written as characters rather than
+// references, which is how browsers and most editors store text. A count
+// set by num() lasts until the end of the fragment, across elements. The
+// fragment is not validated, and a "<" that does not start a tag is
+// treated as text.
+//
+// Examples:
+//   - InflectHTML("<p>I saw plural('cat', 3)</p>") returns "<p>I saw cats</p>"
+//   - InflectHTML(`<a title="plural('cat')">a('hour')</a>`) returns `<a title="plural('cat')">an hour</a>`
+//   - InflectHTML("<code>plural('cat')</code> is plural('cat')") returns "<code>plural('cat')</code> is cats"
+//   - InflectHTML("<b>num(2)</b> plural_verb('is')") returns "<b></b> are"
+func InflectHTML(text string) string {
+	return defaultEngine.InflectHTML(text)
+}
+
+// InflectHTML expands inflection function calls in the text nodes of an
+// HTML fragment, like e.Inflect, leaving the markup unchanged. See the
+// package-level InflectHTML for details.
+//
+// Examples:
+//   - e.InflectHTML("<p>I saw plural('cat', 3)</p>") returns "<p>I saw cats</p>"
+//   - e.InflectHTML(`<a title="plural('cat')">a('hour')</a>`) returns `<a title="plural('cat')">an hour</a>`
+func (e *Engine) InflectHTML(text string) string {
+	var num []int
+	var b strings.Builder
+	for pos := 0; pos < len(text); {
+		start, end := nextHTMLMarkup(text, pos)
+		out, _ := e.expandCalls(text[pos:start], InflectOptions{}, &num, formatHTML)
+		b.WriteString(out)
+		b.WriteString(text[start:end])
+		pos = end
+	}
+	return b.String()
+}
+
+// nextHTMLMarkup returns the byte range of the first tag or comment in
+// text at or after pos, extended over the content and end tag of an
+// element in htmlSkipElements. If there is none, it returns an empty range
+// at the end of text.
+func nextHTMLMarkup(text string, pos int) (start, end int) {
+	for start = pos; start < len(text); start++ {
+		if text[start] == '<' && start+1 < len(text) && startsHTMLTag(text[start+1]) {
+			break
+		}
+	}
+	if start == len(text) {
+		return start, start
+	}
+
+	if strings.HasPrefix(text[start:], "<!--") {
+		if i := strings.Index(text[start+4:], "-->"); i >= 0 {
+			return start, start + 4 + i + 3
+		}
+		return start, len(text)
+	}
+
+	end = htmlTagEnd(text, start)
+	name := htmlTagName(text[start+1 : end])
+	if !htmlSkipElements[name] || strings.HasSuffix(text[start:end], "/>") {
+		return start, end
+	}
+	for i := end; i < len(text); i++ {
+		rest := text[i:]
+		if strings.HasPrefix(rest, "</") && len(rest) >= 2+len(name) && strings.EqualFold(rest[2:2+len(name)], name) {
+			return start, htmlTagEnd(text, i)
+		}
+	}
+	return start, len(text)
+}
+
+// startsHTMLTag reports whether c, following a "<", starts a tag, an end
+// tag, a comment, or a declaration.
+func startsHTMLTag(c byte) bool {
+	return isASCIILetter(c) || c == '/' || c == '!' || c == '?'
+}
+
+// htmlTagEnd returns the offset just after the ">" closing the tag that
+// starts at text[start], skipping any ">" inside a quoted attribute value,
+// or len(text) if the tag is not closed.
+func htmlTagEnd(text string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i + 1
+		}
+	}
+	return len(text)
+}
+
+// htmlTagName returns the lowercase element name of a start tag, given the
+// text after its "<", or "" for end tags, comments, and declarations.
+func htmlTagName(tag string) string {
+	end := 0
+	for end < len(tag) && (isASCIILetter(tag[end]) || end > 0 && '0' <= tag[end] && tag[end] <= '9') {
+		end++
+	}
+	return strings.ToLower(tag[:end])
+}
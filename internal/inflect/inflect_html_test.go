@@ -0,0 +1,64 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestInflectHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "text node", text: "<p>I saw plural('cat', 3)</p>", want: "<p>I saw cats</p>"},
+		{name: "no markup", text: "plural('ox')", want: "oxen"},
+		{name: "empty", text: "", want: ""},
+		{name: "nested elements", text: "<ul><li><b>plural('child')</b></li></ul>", want: "<ul><li><b>children</b></li></ul>"},
+		{name: "void element", text: "<br/>plural('ox')<br>", want: "<br/>oxen<br>"},
+
+		// Markup is left alone
+		{name: "attribute", text: `<a title="plural('cat')">a('hour')</a>`, want: `<a title="plural('cat')">an hour</a>`},
+		{name: "single quoted attribute", text: `<img alt='plural("cat")'>`, want: `<img alt='plural("cat")'>`},
+		{name: "bracket in attribute", text: `<img alt="x > plural('dog')"> plural('dog')`, want: `<img alt="x > plural('dog')"> dogs`},
+		{name: "comment", text: "<!-- plural('cat') --> plural('cat')", want: "<!-- plural('cat') --> cats"},
+		{name: "unclosed comment", text: "plural('cat') <!-- plural('cat')", want: "cats <!-- plural('cat')"},
+		{name: "unclosed tag", text: "plural('cat') <a title=\"plural('cat')", want: "cats <a title=\"plural('cat')"},
+		{name: "doctype", text: "<!DOCTYPE html>plural('cat')", want: "<!DOCTYPE html>cats"},
+
+		// Raw text and code elements are left alone
+		{name: "script", text: "<script>plural('cat')</script>plural('cat')", want: "<script>plural('cat')</script>cats"},
+		{name: "style", text: "<style>plural('cat')</style>", want: "<style>plural('cat')</style>"},
+		{name: "code", text: "<code>plural('cat')</code> is plural('cat')", want: "<code>plural('cat')</code> is cats"},
+		{name: "pre uppercase", text: "<PRE class=x>plural('ox')</pre >plural('ox')", want: "<PRE class=x>plural('ox')</pre >oxen"},
+		{name: "unclosed code", text: "<code>plural('ox')", want: "<code>plural('ox')"},
+
+		// Text that is not markup
+		{name: "less than", text: "a < plural('cat') > b", want: "a < cats > b"},
+		{name: "trailing less than", text: "plural('cat') <", want: "cats <"},
+
+		// Character references
+		{name: "reference in argument", text: "a('R&amp;D team')", want: "an R&amp;D team"},
+		{name: "reference in text", text: "Tom &amp; plural('cat')", want: "Tom &amp; cats"},
+
+		// Counts and escapes carry across elements
+		{name: "num across elements", text: "<b>num(2)</b> plural_verb('is')", want: "<b></b> are"},
+		{name: "escaped call", text: "<p>\\plural('cat')</p>", want: "<p>plural('cat')</p>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.InflectHTML(tt.text))
+		})
+	}
+}
+
+func TestEngineInflectHTML(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "<p>regexen</p>", e.InflectHTML("<p>plural('regex')</p>"))
+	assert.Equal(t, "<p>regexes</p>", inflect.InflectHTML("<p>plural('regex')</p>"))
+}
@@ -0,0 +1,175 @@
+package inflect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Inflectf formats according to a format specifier, like fmt.Sprintf, and
+// additionally inflects words to agree with the count arguments.
+//
+// Besides the usual fmt verbs, the format may contain two inflection
+// markers, which do not consume arguments:
+//   - %p{noun} is replaced by the noun in the form that agrees with the
+//     most recent integer argument: singular for 1 and plural otherwise
+//   - %v{verb} is replaced by the present-tense verb that agrees with the
+//     most recent integer argument, as with AgreeVerb
+//
+// Markers that appear before any integer argument use the default count
+// set by Num(), or the plural form if there is none. A plain %p or %v
+// without braces is an ordinary fmt verb.
+//
+// Examples:
+//   - Inflectf("Deleted %d %p{file} that %v{was} outdated", 3) returns "Deleted 3 files that were outdated"
+//   - Inflectf("Deleted %d %p{file} that %v{was} outdated", 1) returns "Deleted 1 file that was outdated"
+//   - Inflectf("%s: %d %p{child} %v{is} waiting", "Room 4", 2) returns "Room 4: 2 children are waiting"
+func Inflectf(format string, args ...any) string {
+	return defaultEngine.Inflectf(format, args...)
+}
+
+// Inflectf formats according to a format specifier, like fmt.Sprintf, and
+// additionally inflects words to agree with the count arguments.
+//
+// Besides the usual fmt verbs, the format may contain two inflection
+// markers, which do not consume arguments:
+//   - %p{noun} is replaced by the noun in the form that agrees with the
+//     most recent integer argument: singular for 1 and plural otherwise
+//   - %v{verb} is replaced by the present-tense verb that agrees with the
+//     most recent integer argument, as with e.AgreeVerb
+//
+// Markers that appear before any integer argument use the default count
+// set by e.Num(), or the plural form if there is none. A plain %p or %v
+// without braces is an ordinary fmt verb.
+//
+// Examples:
+//   - e.Inflectf("Deleted %d %p{file} that %v{was} outdated", 3) returns "Deleted 3 files that were outdated"
+//   - e.Inflectf("Deleted %d %p{file} that %v{was} outdated", 1) returns "Deleted 1 file that was outdated"
+//   - e.Inflectf("%s: %d %p{child} %v{is} waiting", "Room 4", 2) returns "Room 4: 2 children are waiting"
+func (e *Engine) Inflectf(format string, args ...any) string {
+	var b strings.Builder
+	var counts []int
+	argNum := 0
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			next := strings.IndexByte(format[i:], '%')
+			if next < 0 {
+				next = len(format) - i
+			}
+			b.WriteString(format[i : i+next])
+			i += next
+			continue
+		}
+
+		// Inflection markers are replaced by literal text
+		if kind, word, end, ok := parseInflectMarker(format, i); ok {
+			inflected := e.inflectMarker(kind, word, counts)
+			b.WriteString(strings.ReplaceAll(inflected, "%", "%%"))
+			i = end
+			continue
+		}
+
+		// Ordinary verbs are copied, tracking the argument each consumes
+		end, arg := scanFormatVerb(format, i, argNum)
+		if arg >= 0 {
+			if arg < len(args) {
+				if n, ok := countArg(args[arg]); ok {
+					counts = []int{n}
+				}
+			}
+			argNum = arg + 1
+		}
+		b.WriteString(format[i:end])
+		i = end
+	}
+
+	return fmt.Sprintf(b.String(), args...)
+}
+
+// inflectMarker returns the inflected word for a %p{...} or %v{...} marker.
+func (e *Engine) inflectMarker(kind byte, word string, counts []int) string {
+	n, ok := e.countOrNum(counts)
+	if !ok {
+		n = 2
+	}
+	if kind == 'v' {
+		return e.AgreeVerb(n, word)
+	}
+	return e.PluralNoun(word, n)
+}
+
+// parseInflectMarker parses a %p{...} or %v{...} marker starting at
+// format[i]. It returns the marker kind, the word between the braces, and
+// the index just past the closing brace.
+func parseInflectMarker(format string, i int) (kind byte, word string, end int, ok bool) {
+	if i+2 >= len(format) || format[i+2] != '{' {
+		return 0, "", 0, false
+	}
+	kind = format[i+1]
+	if kind != 'p' && kind != 'v' {
+		return 0, "", 0, false
+	}
+	closing := strings.IndexByte(format[i+3:], '}')
+	if closing < 0 {
+		return 0, "", 0, false
+	}
+	return kind, format[i+3 : i+3+closing], i + 4 + closing, true
+}
+
+// scanFormatVerb scans the fmt verb starting at format[i], where argNum is
+// the index of the next argument. It returns the index just past the verb
+// and the index of the argument the verb formats, or -1 for "%%".
+// Arguments consumed by '*' widths and precisions are skipped, and
+// explicit argument indexes ("%[2]d") are honored.
+func scanFormatVerb(format string, i, argNum int) (end, arg int) {
+	for j := i + 1; j < len(format); j++ {
+		switch c := format[j]; {
+		case c == '%' && j == i+1:
+			return j + 1, -1
+		case strings.IndexByte("+-# 0123456789.", c) >= 0:
+			// Flags, width, and precision
+		case c == '*':
+			argNum++
+		case c == '[':
+			closing := strings.IndexByte(format[j:], ']')
+			if closing < 0 {
+				return len(format), argNum
+			}
+			if n, err := strconv.Atoi(format[j+1 : j+closing]); err == nil && n > 0 {
+				argNum = n - 1
+			}
+			j += closing
+		default:
+			return j + 1, argNum
+		}
+	}
+	return len(format), argNum
+}
+
+// countArg returns the value of an integer argument.
+func countArg(arg any) (int, bool) {
+	switch v := arg.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true //nolint:gosec // counts beyond MaxInt are treated as plural either way
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true //nolint:gosec // counts beyond MaxInt are treated as plural either way
+	}
+	return 0, false
+}
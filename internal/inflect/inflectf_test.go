@@ -0,0 +1,61 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestInflectf(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   string
+	}{
+		{name: "plural count", format: "Deleted %d %p{file} that %v{was} outdated", args: []any{3}, want: "Deleted 3 files that were outdated"},
+		{name: "singular count", format: "Deleted %d %p{file} that %v{was} outdated", args: []any{1}, want: "Deleted 1 file that was outdated"},
+		{name: "zero count", format: "%d %p{child} %v{is} waiting", args: []any{0}, want: "0 children are waiting"},
+		{name: "irregular noun", format: "%d %p{person} %v{has} left", args: []any{1}, want: "1 person has left"},
+		{name: "non-integer args skipped", format: "%s: %d %p{error}", args: []any{"build", 2}, want: "build: 2 errors"},
+		{name: "most recent count", format: "%d %p{dog} and %d %p{cat}", args: []any{2, 1}, want: "2 dogs and 1 cat"},
+		{name: "other integer types", format: "%d %p{byte}", args: []any{uint8(1)}, want: "1 byte"},
+		{name: "int64", format: "%d %p{row}", args: []any{int64(7)}, want: "7 rows"},
+		{name: "count as word", format: "%s %p{box}", args: []any{"three"}, want: "three boxes"},
+		{name: "no count", format: "all %p{box}", args: nil, want: "all boxes"},
+		{name: "marker before count", format: "%p{item}: %d", args: []any{1}, want: "items: 1"},
+		{name: "percent literal", format: "%d%% of %p{test} %v{pass}", args: []any{1}, want: "1% of test passes"},
+		{name: "width and flags", format: "%-3d|%p{line}", args: []any{1}, want: "1  |line"},
+		{name: "star width", format: "%*d %p{cell}", args: []any{4, 1}, want: "   1 cell"},
+		{name: "explicit index", format: "%[2]d %p{node}", args: []any{5, 1}, want: "1 node"},
+		{name: "plain v verb", format: "%v %p{item}", args: []any{1}, want: "1 item"},
+		{name: "unterminated marker", format: "%d %p{file", args: []any{2}, want: "2 %!p(MISSING){file"},
+		{name: "case preserved", format: "%d %p{File} %v{Was}", args: []any{2}, want: "2 Files Were"},
+		{name: "empty format", format: "", args: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Inflectf(tt.format, tt.args...))
+		})
+	}
+}
+
+func TestInflectfNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "the file was saved", e.Inflectf("the %p{file} %v{was} saved"))
+	assert.Equal(t, "3 files were saved", e.Inflectf("%d %p{file} %v{was} saved", 3))
+
+	e.NumPropagation(false)
+	assert.Equal(t, "the files were saved", e.Inflectf("the %p{file} %v{was} saved"))
+}
+
+func TestInflectfEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "2 regexen", e.Inflectf("%d %p{regex}", 2))
+	assert.Equal(t, "2 regexes", inflect.Inflectf("%d %p{regex}", 2))
+}
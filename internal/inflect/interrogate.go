@@ -0,0 +1,87 @@
+package inflect
+
+import "strings"
+
+// questionDeterminers contains words that start a subject and are written
+// in lowercase once the subject no longer starts the sentence.
+var questionDeterminers = map[string]bool{
+	"the": true, "a": true, "an": true, "some": true, "any": true,
+	"all": true, "no": true, "my": true, "your": true, "his": true,
+	"her": true, "its": true, "our": true, "their": true,
+}
+
+// Interrogate returns a yes/no question formed from a subject, a verb or
+// verb phrase, and the rest of the sentence.
+//
+// Forms of "be", modal verbs, and auxiliaries followed by another verb are
+// moved before the subject ("is" -> "Is the build green?"). Other verbs
+// take a form of "do" that carries their tense, and revert to their base
+// form ("has" -> "Does the user have access?"). The question starts with a
+// capital letter and ends with a question mark, replacing any final
+// punctuation of rest. Determiners and pronouns starting the subject are
+// lowercased, but names are left alone.
+//
+// Examples:
+//   - Interrogate("the user", "has", "access") returns "Does the user have access?"
+//   - Interrogate("the build", "is", "green") returns "Is the build green?"
+//   - Interrogate("the job", "ran", "overnight") returns "Did the job run overnight?"
+//   - Interrogate("you", "can", "see it") returns "Can you see it?"
+//   - Interrogate("the file", "has been", "deleted") returns "Has the file been deleted?"
+//   - Interrogate("Alice", "isn't", "here.") returns "Isn't Alice here?"
+func Interrogate(subject, verb, rest string) string {
+	subject = lowerSubjectStart(strings.TrimSpace(subject))
+	verb = strings.TrimSpace(verb)
+	rest = strings.TrimRight(strings.TrimSpace(rest), ".!?")
+
+	first, after, _ := strings.Cut(verb, " ")
+	lower := strings.ToLower(first)
+	aux, main := "", verb
+	if isInvertible(lower, after) {
+		aux, main = lower, after
+	} else if do, base, ok := doSupport(lower); ok && lower != "" {
+		aux, main = do, strings.TrimSpace(base+" "+after)
+	}
+
+	var parts []string
+	for _, part := range []string{aux, subject, main, rest} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return Capitalize(strings.Join(parts, " ")) + "?"
+}
+
+// isInvertible reports whether a lowercase verb can move before the subject
+// of a question. Forms of "be", modals, and negative contractions always
+// can; "do" and "have" can when they are followed by another verb.
+func isInvertible(lower, after string) bool {
+	switch lower {
+	case "am", "is", "are", "was", "were", "may":
+		return true
+	case "do", "does", "did", "have", "has", "had":
+		return after != ""
+	}
+	_, negative := verbPositives[lower]
+	return negative || verbUnchanged[lower]
+}
+
+// lowerSubjectStart lowercases the first word of a subject if it is a
+// capitalized determiner or pronoun, so "The user" becomes "the user" in
+// the middle of a question. "I" and names are unchanged.
+func lowerSubjectStart(subject string) string {
+	first, rest, found := strings.Cut(subject, " ")
+	lower := strings.ToLower(first)
+	if isAllUpper(first) && len(first) > 1 {
+		return subject
+	}
+	if !questionDeterminers[lower] && !singularSubjects[lower] && !pluralSubjects[lower] {
+		return subject
+	}
+	if found {
+		return lower + " " + rest
+	}
+	return lower
+}
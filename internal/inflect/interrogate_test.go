@@ -0,0 +1,60 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestInterrogate(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		verb    string
+		rest    string
+		want    string
+	}{
+		// Auxiliary inversion
+		{name: "is", subject: "the build", verb: "is", rest: "green", want: "Is the build green?"},
+		{name: "are", subject: "they", verb: "are", rest: "ready", want: "Are they ready?"},
+		{name: "am", subject: "I", verb: "am", rest: "late", want: "Am I late?"},
+		{name: "was", subject: "the email", verb: "was", rest: "sent", want: "Was the email sent?"},
+		{name: "modal", subject: "you", verb: "can", rest: "see it", want: "Can you see it?"},
+		{name: "will", subject: "the job", verb: "will", rest: "retry", want: "Will the job retry?"},
+		{name: "may", subject: "I", verb: "may", rest: "come in", want: "May I come in?"},
+		{name: "has been", subject: "the file", verb: "has been", rest: "deleted", want: "Has the file been deleted?"},
+		{name: "will have", subject: "she", verb: "will have", rest: "left", want: "Will she have left?"},
+		{name: "negative", subject: "Alice", verb: "isn't", rest: "here", want: "Isn't Alice here?"},
+		{name: "negative do", subject: "it", verb: "doesn't work", rest: "", want: "Doesn't it work?"},
+
+		// Do-support
+		{name: "has", subject: "the user", verb: "has", rest: "access", want: "Does the user have access?"},
+		{name: "have", subject: "we", verb: "have", rest: "time", want: "Do we have time?"},
+		{name: "had", subject: "the team", verb: "had", rest: "a plan", want: "Did the team have a plan?"},
+		{name: "runs", subject: "the job", verb: "runs", rest: "nightly", want: "Does the job run nightly?"},
+		{name: "watches", subject: "she", verb: "watches", rest: "the logs", want: "Does she watch the logs?"},
+		{name: "run", subject: "the tests", verb: "run", rest: "in CI", want: "Do the tests run in CI?"},
+		{name: "ran", subject: "the job", verb: "ran", rest: "overnight", want: "Did the job run overnight?"},
+		{name: "walked", subject: "you", verb: "walked", rest: "home", want: "Did you walk home?"},
+		{name: "does", subject: "the script", verb: "does", rest: "cleanup", want: "Does the script do cleanup?"},
+		{name: "phrasal verb", subject: "the server", verb: "shuts down", rest: "cleanly", want: "Does the server shut down cleanly?"},
+
+		// Subjects and punctuation
+		{name: "capitalized determiner", subject: "The user", verb: "is", rest: "active", want: "Is the user active?"},
+		{name: "capitalized pronoun", subject: "They", verb: "left", rest: "", want: "Did they leave?"},
+		{name: "name", subject: "Bob", verb: "likes", rest: "tea", want: "Does Bob like tea?"},
+		{name: "acronym", subject: "NASA", verb: "is", rest: "hiring", want: "Is NASA hiring?"},
+		{name: "final period", subject: "the build", verb: "is", rest: "green.", want: "Is the build green?"},
+		{name: "final question mark", subject: "the build", verb: "is", rest: "green?", want: "Is the build green?"},
+		{name: "no rest", subject: "it", verb: "works", rest: "", want: "Does it work?"},
+		{name: "empty", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Interrogate(tt.subject, tt.verb, tt.rest))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package inflect
+
+import "strings"
+
+// DefUnchanged registers a noun whose plural is the same as its singular,
+// like the built-in "sheep" and "series".
+//
+// Plural and Singular return the noun unchanged, whatever the classical
+// settings. Words are matched in lowercase, and DefNoun definitions take
+// precedence.
+//
+// Examples:
+//
+//	Plural("kudos")  // returns "kudoses"
+//	DefUnchanged("kudos")
+//	Plural("kudos")  // returns "kudos"
+//	Singular("Kudos") // returns "Kudos"
+func DefUnchanged(word string) {
+	defaultEngine.DefUnchanged(word)
+}
+
+// DefUnchanged registers a noun whose plural is the same as its singular.
+// See the package-level DefUnchanged for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefUnchanged("kudos")
+//	e.Plural("kudos") // returns "kudos"
+func (e *Engine) DefUnchanged(word string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if e.customUnchanged == nil {
+		e.customUnchanged = make(map[string]bool)
+	}
+	e.customUnchanged[strings.ToLower(word)] = true
+}
+
+// UndefUnchanged makes a noun registered with DefUnchanged, or a built-in
+// unchanged noun such as "shrimp", follow the regular rules again.
+//
+// Returns true if the noun was unchanged in the plural, false otherwise.
+//
+// Examples:
+//
+//	UndefUnchanged("shrimp") // returns true
+//	Plural("shrimp")         // returns "shrimps"
+//	UndefUnchanged("cat")    // returns false
+func UndefUnchanged(word string) bool {
+	return defaultEngine.UndefUnchanged(word)
+}
+
+// UndefUnchanged makes a noun registered with e.DefUnchanged, or a
+// built-in unchanged noun, follow the regular rules again. See the
+// package-level UndefUnchanged for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.UndefUnchanged("shrimp") // returns true
+//	e.Plural("shrimp")         // returns "shrimps"
+func (e *Engine) UndefUnchanged(word string) bool {
+	lower := strings.ToLower(word)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isUnchangedLocked(lower) {
+		return false
+	}
+	e.cache.clear()
+	if e.customUnchanged == nil {
+		e.customUnchanged = make(map[string]bool)
+	}
+	e.customUnchanged[lower] = false
+	return true
+}
+
+// DefHerd registers a noun that is unchanged in the plural only in
+// classical herd mode, like the built-in "bison" and "elk".
+//
+// With ClassicalHerd(true), Plural returns the noun unchanged; otherwise the
+// regular rules apply. Words are matched in lowercase, and DefNoun
+// definitions take precedence.
+//
+// Examples:
+//
+//	DefHerd("yak")
+//	Plural("yak") // returns "yaks"
+//	ClassicalHerd(true)
+//	Plural("yak") // returns "yak"
+func DefHerd(word string) {
+	defaultEngine.DefHerd(word)
+}
+
+// DefHerd registers a noun that is unchanged in the plural only in
+// classical herd mode. See the package-level DefHerd for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefHerd("yak")
+//	e.ClassicalHerd(true)
+//	e.Plural("yak") // returns "yak"
+func (e *Engine) DefHerd(word string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if e.customHerd == nil {
+		e.customHerd = make(map[string]bool)
+	}
+	e.customHerd[strings.ToLower(word)] = true
+}
+
+// UndefHerd makes a noun registered with DefHerd, or a built-in herd
+// animal such as "elk", follow the regular rules in classical herd mode.
+//
+// Returns true if the noun was a herd animal, false otherwise.
+//
+// Examples:
+//
+//	ClassicalHerd(true)
+//	UndefHerd("elk") // returns true
+//	Plural("elk")    // returns "elks"
+func UndefHerd(word string) bool {
+	return defaultEngine.UndefHerd(word)
+}
+
+// UndefHerd makes a noun registered with e.DefHerd, or a built-in herd
+// animal, follow the regular rules in classical herd mode. See the
+// package-level UndefHerd for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.ClassicalHerd(true)
+//	e.UndefHerd("elk") // returns true
+//	e.Plural("elk")    // returns "elks"
+func (e *Engine) UndefHerd(word string) bool {
+	lower := strings.ToLower(word)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isHerdLocked(lower) {
+		return false
+	}
+	e.cache.clear()
+	if e.customHerd == nil {
+		e.customHerd = make(map[string]bool)
+	}
+	e.customHerd[lower] = false
+	return true
+}
+
+// isUnchanged reports whether a lowercase noun is unchanged in the plural,
+// from DefUnchanged or unchangedPlurals.
+func (e *Engine) isUnchanged(lower string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.isUnchangedLocked(lower)
+}
+
+// isUnchangedLocked is isUnchanged for callers that hold e.mu.
+func (e *Engine) isUnchangedLocked(lower string) bool {
+	if unchanged, ok := e.customUnchanged[lower]; ok {
+		return unchanged
+	}
+	return unchangedPlurals[lower]
+}
+
+// isHerd reports whether a lowercase noun is a herd animal, from DefHerd or
+// herdAnimals.
+func (e *Engine) isHerd(lower string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.isHerdLocked(lower)
+}
+
+// isHerdLocked is isHerd for callers that hold e.mu.
+func (e *Engine) isHerdLocked(lower string) bool {
+	if herd, ok := e.customHerd[lower]; ok {
+		return herd
+	}
+	return herdAnimals[lower]
+}
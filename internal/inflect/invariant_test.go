@@ -0,0 +1,83 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestDefUnchanged(t *testing.T) {
+	tests := []struct {
+		word   string
+		before string
+	}{
+		{word: "kudos", before: "kudoses"},
+		{word: "Kudos", before: "Kudoses"},
+		{word: "bison", before: "bisons"},
+		{word: "pokemon", before: "pokemons"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.EnableCache(10)
+			assert.Equal(t, tt.before, e.Plural(tt.word))
+
+			e.DefUnchanged(tt.word)
+			assert.Equal(t, tt.word, e.Plural(tt.word), "cached result is cleared")
+			assert.Equal(t, tt.word, e.Singular(tt.word))
+			assert.Equal(t, tt.word, e.Clone().Plural(tt.word))
+			assert.Equal(t, tt.before, inflect.Plural(tt.word), "default engine is unaffected")
+
+			assert.True(t, e.UndefUnchanged(tt.word))
+			assert.Equal(t, tt.before, e.Plural(tt.word))
+			assert.False(t, e.UndefUnchanged(tt.word))
+		})
+	}
+}
+
+func TestUndefUnchangedBuiltin(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, "shrimp", e.Plural("shrimp"))
+	assert.False(t, e.UndefUnchanged("cat"))
+
+	assert.True(t, e.UndefUnchanged("Shrimp"))
+	assert.Equal(t, "shrimps", e.Plural("shrimp"))
+	assert.Equal(t, "shrimp", e.Singular("shrimps"))
+
+	e.DefUnchanged("shrimp")
+	assert.Equal(t, "shrimp", e.Plural("shrimp"))
+
+	e.UndefUnchanged("shrimp")
+	e.Reset()
+	assert.Equal(t, "shrimp", e.Plural("shrimp"))
+}
+
+func TestDefHerd(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefHerd("Yak")
+	assert.Equal(t, "yaks", e.Plural("yak"))
+
+	e.ClassicalHerd(true)
+	assert.Equal(t, "yak", e.Plural("yak"))
+	assert.Equal(t, "Yak", e.Plural("Yak"))
+
+	assert.True(t, e.UndefHerd("yak"))
+	assert.Equal(t, "yaks", e.Plural("yak"))
+	assert.False(t, e.UndefHerd("yak"))
+
+	assert.True(t, e.UndefHerd("elk"), "built-in herd animal")
+	assert.Equal(t, "elks", e.Plural("elk"))
+	assert.Equal(t, "bison", e.Plural("bison"))
+
+	e.DefNoun("yak", "yakkim")
+	e.DefHerd("yak")
+	assert.Equal(t, "yakkim", e.Plural("yak"), "DefNoun takes precedence")
+
+	e.Reset()
+	e.ClassicalHerd(true)
+	assert.Equal(t, "elk", e.Plural("elk"))
+	assert.Equal(t, "yaks", e.Plural("yak"))
+}
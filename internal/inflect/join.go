@@ -1,6 +1,10 @@
 package inflect
 
-import "strings"
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
 
 // Join combines a slice of strings into a grammatically correct English list.
 //
@@ -89,9 +93,71 @@ func JoinWithFinalSep(words []string, conj, sep, finalSep string) string {
 		return words[0]
 	case 2:
 		return words[0] + " " + conj + " " + words[1]
-	default:
-		return strings.Join(words[:len(words)-1], sep) + finalSep + conj + " " + words[len(words)-1]
 	}
+	return buildString(func(dst []byte) []byte {
+		return AppendJoinWithFinalSep(dst, words, conj, sep, finalSep)
+	})
+}
+
+// AppendJoin appends the list Join returns for words to dst and returns
+// the extended buffer.
+//
+// It does not allocate when dst has room for the list, so code that writes
+// many lists, such as a template rendering loop, can reuse one buffer.
+//
+// Examples:
+//   - AppendJoin([]byte("Pick "), []string{"a", "b", "c"}) returns []byte("Pick a, b, and c")
+//   - AppendJoin(nil, []string{"a"}) returns []byte("a")
+func AppendJoin(dst []byte, words []string) []byte {
+	return AppendJoinWithConj(dst, words, "and")
+}
+
+// AppendJoinWithConj appends the list JoinWithConj returns for words and
+// conj to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithConj(nil, []string{"a", "b", "c"}, "or") returns []byte("a, b, or c")
+func AppendJoinWithConj(dst []byte, words []string, conj string) []byte {
+	return AppendJoinWithFinalSep(dst, words, conj, ", ", ", ")
+}
+
+// AppendJoinWithSep appends the list JoinWithSep returns for words, conj,
+// and sep to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithSep(nil, []string{"a", "b", "c"}, "and", "; ") returns []byte("a; b; and c")
+func AppendJoinWithSep(dst []byte, words []string, conj, sep string) []byte {
+	return AppendJoinWithFinalSep(dst, words, conj, sep, sep)
+}
+
+// AppendJoinWithFinalSep appends the list JoinWithFinalSep returns for
+// words, conj, sep, and finalSep to dst and returns the extended buffer.
+//
+// Examples:
+//   - AppendJoinWithFinalSep(nil, []string{"a", "b", "c"}, "and", ", ", " ") returns []byte("a, b and c")
+func AppendJoinWithFinalSep(dst []byte, words []string, conj, sep, finalSep string) []byte {
+	switch len(words) {
+	case 0:
+		return dst
+	case 1:
+		return append(dst, words[0]...)
+	}
+
+	last := len(words) - 1
+	for i, w := range words[:last] {
+		if i > 0 {
+			dst = append(dst, sep...)
+		}
+		dst = append(dst, w...)
+	}
+	if last > 1 {
+		dst = append(dst, finalSep...)
+	} else {
+		dst = append(dst, ' ')
+	}
+	dst = append(dst, conj...)
+	dst = append(dst, ' ')
+	return append(dst, words[last]...)
 }
 
 // JoinNoOxford combines a slice of strings without the Oxford comma.
@@ -115,3 +181,79 @@ func JoinNoOxford(words []string) string {
 func JoinNoOxfordWithConj(words []string, conj string) string {
 	return JoinWithFinalSep(words, conj, ", ", " ")
 }
+
+// JoinAuthorsOptions configures JoinAuthorsWithOptions.
+//
+// The zero value lists every name, joined with "and" and an Oxford comma.
+type JoinAuthorsOptions struct {
+	// Max is the most names listed in full. Longer lists are cut short and
+	// end in EtAl. Zero or less means no limit.
+	Max int
+
+	// Keep is how many names are listed before EtAl when a list is cut
+	// short. Zero means Max, so MLA style, which lists one name when there
+	// are three or more, is Max: 2, Keep: 1.
+	Keep int
+
+	// Conj joins the last two names of a full list. Empty means "and";
+	// APA style uses "&".
+	Conj string
+
+	// EtAl ends a list that was cut short. Empty means "et al.".
+	EtAl string
+
+	// Sep separates names. Empty means ", ", or "; " if any name contains
+	// a comma, as in "Smith, J.".
+	Sep string
+}
+
+// JoinAuthors formats a list of author names for a citation, cutting lists
+// longer than maxNames short with "et al.".
+//
+// When a list is cut short, the first maxNames names are kept; a single
+// kept name is followed directly by "et al.". A maxNames of zero or less
+// lists every name. Use JoinAuthorsWithOptions for other citation styles.
+//
+// Examples:
+//   - JoinAuthors([]string{"Smith", "Jones"}, 2) returns "Smith and Jones"
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown"}, 3) returns "Smith, Jones, and Brown"
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown", "Lee"}, 2) returns "Smith, Jones, et al."
+//   - JoinAuthors([]string{"Smith", "Jones", "Brown"}, 1) returns "Smith et al."
+//   - JoinAuthors([]string{"Smith, J.", "Jones, K."}, 0) returns "Smith, J.; and Jones, K."
+func JoinAuthors(names []string, maxNames int) string {
+	return JoinAuthorsWithOptions(names, JoinAuthorsOptions{Max: maxNames})
+}
+
+// JoinAuthorsWithOptions formats a list of author names for a citation,
+// following the truncation rules in opts.
+//
+// Examples:
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones"}, JoinAuthorsOptions{Conj: "&"}) returns "Smith & Jones"
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones", "Brown"}, JoinAuthorsOptions{Max: 2, Keep: 1}) returns "Smith et al."
+//   - JoinAuthorsWithOptions([]string{"Smith", "Jones", "Brown"}, JoinAuthorsOptions{Max: 2, EtAl: "and others"}) returns "Smith, Jones, and others"
+func JoinAuthorsWithOptions(names []string, opts JoinAuthorsOptions) string {
+	conj := cmp.Or(opts.Conj, "and")
+	etAl := cmp.Or(opts.EtAl, "et al.")
+	hasComma := slices.ContainsFunc(names, func(name string) bool { return strings.Contains(name, ",") })
+	sep := opts.Sep
+	if sep == "" {
+		sep = ", "
+		if hasComma {
+			sep = "; "
+		}
+	}
+
+	if opts.Max <= 0 || len(names) <= opts.Max {
+		if len(names) == 2 && hasComma {
+			// Names with commas need the separator even between two
+			return names[0] + sep + conj + " " + names[1]
+		}
+		return JoinWithSep(names, conj, sep)
+	}
+
+	keep := min(cmp.Or(opts.Keep, opts.Max), len(names)-1)
+	if keep <= 1 {
+		return names[0] + " " + etAl
+	}
+	return strings.Join(names[:keep], sep) + sep + etAl
+}
@@ -206,6 +206,7 @@ func BenchmarkJoin(b *testing.B) {
 
 	for _, bm := range benchmarks {
 		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
 			for range b.N {
 				inflect.Join(bm.input)
 			}
@@ -382,3 +383,89 @@ func BenchmarkJoinNoOxford(b *testing.B) {
 		inflect.JoinNoOxford(input)
 	}
 }
+
+func TestJoinAuthors(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		max   int
+		want  string
+	}{
+		{name: "empty", names: nil, max: 3, want: ""},
+		{name: "one", names: []string{"Smith"}, max: 3, want: "Smith"},
+		{name: "two", names: []string{"Smith", "Jones"}, max: 2, want: "Smith and Jones"},
+		{name: "three", names: []string{"Smith", "Jones", "Brown"}, max: 3, want: "Smith, Jones, and Brown"},
+		{name: "truncated to two", names: []string{"Smith", "Jones", "Brown", "Lee"}, max: 2, want: "Smith, Jones, et al."},
+		{name: "truncated to one", names: []string{"Smith", "Jones", "Brown"}, max: 1, want: "Smith et al."},
+		{name: "no limit", names: []string{"Smith", "Jones", "Brown", "Lee"}, max: 0, want: "Smith, Jones, Brown, and Lee"},
+		{name: "names with commas", names: []string{"Smith, J.", "Jones, K."}, max: 0, want: "Smith, J.; and Jones, K."},
+		{name: "names with commas truncated", names: []string{"Smith, J.", "Jones, K.", "Lee, M."}, max: 2, want: "Smith, J.; Jones, K.; et al."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.JoinAuthors(tt.names, tt.max))
+		})
+	}
+}
+
+func TestJoinAuthorsWithOptions(t *testing.T) {
+	authors := []string{"Smith", "Jones", "Brown", "Lee"}
+	tests := []struct {
+		name  string
+		names []string
+		opts  inflect.JoinAuthorsOptions
+		want  string
+	}{
+		{name: "zero value", names: authors, want: "Smith, Jones, Brown, and Lee"},
+		{name: "APA ampersand", names: authors[:2], opts: inflect.JoinAuthorsOptions{Conj: "&"}, want: "Smith & Jones"},
+		{name: "MLA two", names: authors[:2], opts: inflect.JoinAuthorsOptions{Max: 2, Keep: 1}, want: "Smith and Jones"},
+		{name: "MLA three", names: authors[:3], opts: inflect.JoinAuthorsOptions{Max: 2, Keep: 1}, want: "Smith et al."},
+		{name: "keep fewer than max", names: authors, opts: inflect.JoinAuthorsOptions{Max: 3, Keep: 2}, want: "Smith, Jones, et al."},
+		{name: "keep more than names", names: authors, opts: inflect.JoinAuthorsOptions{Max: 3, Keep: 9}, want: "Smith, Jones, Brown, et al."},
+		{name: "custom et al", names: authors[:3], opts: inflect.JoinAuthorsOptions{Max: 2, EtAl: "and others"}, want: "Smith, Jones, and others"},
+		{name: "custom separator", names: authors[:3], opts: inflect.JoinAuthorsOptions{Sep: "; "}, want: "Smith; Jones; and Brown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.JoinAuthorsWithOptions(tt.names, tt.opts))
+		})
+	}
+}
+
+func TestAppendJoin(t *testing.T) {
+	lists := [][]string{
+		nil,
+		{"a"},
+		{"a", "b"},
+		{"a", "b", "c"},
+		{"one", "two", "three", "four"},
+	}
+
+	for _, words := range lists {
+		assert.Equal(t, "Pick "+inflect.Join(words), string(inflect.AppendJoin([]byte("Pick "), words)))
+		assert.Equal(t, inflect.JoinWithConj(words, "or"), string(inflect.AppendJoinWithConj(nil, words, "or")))
+		assert.Equal(t, inflect.JoinWithSep(words, "and", "; "), string(inflect.AppendJoinWithSep(nil, words, "and", "; ")))
+		assert.Equal(t, inflect.JoinNoOxford(words), string(inflect.AppendJoinWithFinalSep(nil, words, "and", ", ", " ")))
+	}
+}
+
+func TestAppendJoinReusesBuffer(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	words := []string{"apple", "banana", "cherry"}
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = inflect.AppendJoin(buf[:0], words)
+	})
+	assert.Zero(t, allocs)
+	assert.Equal(t, "apple, banana, and cherry", string(buf))
+}
+
+func BenchmarkAppendJoin(b *testing.B) {
+	words := []string{"one", "two", "three", "four", "five"}
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for range b.N {
+		buf = inflect.AppendJoin(buf[:0], words)
+	}
+}
@@ -0,0 +1,141 @@
+package inflect
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// PluralizeKeys returns a copy of m with each key made plural, for
+// reshaping JSON and API payloads: "user" -> "users".
+//
+// Keys are read as identifiers in any case style, and only their last word
+// is inflected, keeping the rest of the key and its separators:
+// "userProfile" -> "userProfiles", "line_item" -> "line_items". Keys that
+// are already plural are kept, so PluralizeKeys can be applied to a
+// payload more than once. If recursive is true, maps nested in values and
+// in []any values are converted too. The input is not modified, and the
+// default count set with Num does not apply.
+//
+// When two keys have the same plural, the key that was already plural
+// wins, and otherwise the key that sorts first.
+//
+// Examples:
+//   - PluralizeKeys(map[string]any{"user": 1, "postTag": 2}, false)
+//     returns map[string]any{"users": 1, "postTags": 2}
+//   - PluralizeKeys(map[string]any{"person": map[string]any{"child": 1}}, true)
+//     returns map[string]any{"people": map[string]any{"children": 1}}
+func PluralizeKeys(m map[string]any, recursive bool) map[string]any {
+	return defaultEngine.PluralizeKeys(m, recursive)
+}
+
+// PluralizeKeys returns a copy of m with each key made plural, using e's
+// plurals. See the package-level PluralizeKeys for details.
+func (e *Engine) PluralizeKeys(m map[string]any, recursive bool) map[string]any {
+	return inflectKeys(m, recursive, e.pluralSafe)
+}
+
+// SingularizeKeys returns a copy of m with each key made singular, for
+// reshaping JSON and API payloads: "users" -> "user".
+//
+// Keys are read as identifiers in any case style, and only their last word
+// is inflected, keeping the rest of the key and its separators:
+// "userProfiles" -> "userProfile", "line_items" -> "line_item". Keys that
+// are already singular are kept, including nouns ending in s such as
+// "status". If recursive is true, maps nested in values and in []any values
+// are converted too. The input is not modified.
+//
+// When two keys have the same singular, the key that was already singular
+// wins, and otherwise the key that sorts first.
+//
+// Examples:
+//   - SingularizeKeys(map[string]any{"users": 1, "postTags": 2}, false)
+//     returns map[string]any{"user": 1, "postTag": 2}
+//   - SingularizeKeys(map[string]any{"status": 1}, false)
+//     returns map[string]any{"status": 1}
+func SingularizeKeys(m map[string]any, recursive bool) map[string]any {
+	return defaultEngine.SingularizeKeys(m, recursive)
+}
+
+// SingularizeKeys returns a copy of m with each key made singular, using
+// e's singulars. See the package-level SingularizeKeys for details.
+func (e *Engine) SingularizeKeys(m map[string]any, recursive bool) map[string]any {
+	return inflectKeys(m, recursive, e.SingularSafe)
+}
+
+// inflectKeys returns a copy of m with inflect applied to the last word of
+// each key, and to the keys of nested maps if recursive is true.
+func inflectKeys(m map[string]any, recursive bool, inflect func(string) string) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	out := make(map[string]any, len(m))
+	type renamedKey struct {
+		key   string
+		value any
+	}
+	var renamed []renamedKey
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		value := m[key]
+		if recursive {
+			value = inflectValueKeys(value, inflect)
+		}
+		if newKey := inflectIdentifier(key, inflect); newKey != key {
+			renamed = append(renamed, renamedKey{newKey, value})
+			continue
+		}
+		out[key] = value
+	}
+
+	// Keys already in the inflected form take precedence over renamed ones
+	for _, r := range renamed {
+		if _, taken := out[r.key]; !taken {
+			out[r.key] = r.value
+		}
+	}
+	return out
+}
+
+// inflectValueKeys applies inflectKeys to a map value, or to each element
+// of a []any value, recursively.
+func inflectValueKeys(value any, inflect func(string) string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return inflectKeys(v, true, inflect)
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			out[i] = inflectValueKeys(elem, inflect)
+		}
+		return out
+	}
+	return value
+}
+
+// inflectIdentifier applies inflect to the last word of an identifier in
+// any case style, found the way SnakeCase splits it, and keeps the rest:
+// "userProfile" -> "userProfiles", "line_item" -> "line_items". A word that
+// is not all uppercase is inflected in lowercase and then given its
+// capitalization back, so that it is not taken for a proper name:
+// "Category" -> "Categories", not "Categorys".
+func inflectIdentifier(id string, inflect func(string) string) string {
+	words := splitIntoWords(id)
+	for i := len(words) - 1; i >= 0; i-- {
+		word := words[i]
+		if strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		at := strings.LastIndex(id, word)
+		if at < 0 {
+			return id
+		}
+		inflected := inflect(word)
+		if !isAllUpper(word) {
+			inflected = restyle(word, inflect(strings.ToLower(word)))
+		}
+		return id[:at] + inflected + id[at+len(word):]
+	}
+	return id
+}
@@ -0,0 +1,103 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralizeKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     map[string]any
+		recursive bool
+		want      map[string]any
+	}{
+		{name: "nil", input: nil, want: nil},
+		{name: "empty", input: map[string]any{}, want: map[string]any{}},
+		{name: "simple", input: map[string]any{"user": 1}, want: map[string]any{"users": 1}},
+		{name: "irregular", input: map[string]any{"person": 1}, want: map[string]any{"people": 1}},
+		{name: "camel case", input: map[string]any{"userProfile": 1}, want: map[string]any{"userProfiles": 1}},
+		{name: "snake case", input: map[string]any{"line_item": 1}, want: map[string]any{"line_items": 1}},
+		{name: "kebab case", input: map[string]any{"line-item": 1}, want: map[string]any{"line-items": 1}},
+		{name: "acronym", input: map[string]any{"userID": 1}, want: map[string]any{"userIDs": 1}},
+		{name: "pascal case", input: map[string]any{"UserCategory": 1}, want: map[string]any{"UserCategories": 1}},
+		{name: "upper case", input: map[string]any{"USER_PERSON": 1}, want: map[string]any{"USER_PEOPLE": 1}},
+		{name: "trailing digits", input: map[string]any{"address2": 1}, want: map[string]any{"addresses2": 1}},
+		{name: "already plural", input: map[string]any{"users": 1}, want: map[string]any{"users": 1}},
+		{name: "no letters", input: map[string]any{"42": 1}, want: map[string]any{"42": 1}},
+		{name: "plural wins", input: map[string]any{"user": 1, "users": 2}, want: map[string]any{"users": 2}},
+		{
+			name:  "not recursive",
+			input: map[string]any{"order": map[string]any{"item": 1}},
+			want:  map[string]any{"orders": map[string]any{"item": 1}},
+		},
+		{
+			name:      "recursive map",
+			input:     map[string]any{"order": map[string]any{"item": 1}},
+			recursive: true,
+			want:      map[string]any{"orders": map[string]any{"items": 1}},
+		},
+		{
+			name:      "recursive slice",
+			input:     map[string]any{"order": []any{map[string]any{"item": 1}, "x"}},
+			recursive: true,
+			want:      map[string]any{"orders": []any{map[string]any{"items": 1}, "x"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralizeKeys(tt.input, tt.recursive))
+		})
+	}
+}
+
+func TestSingularizeKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     map[string]any
+		recursive bool
+		want      map[string]any
+	}{
+		{name: "nil", input: nil, want: nil},
+		{name: "simple", input: map[string]any{"users": 1}, want: map[string]any{"user": 1}},
+		{name: "irregular", input: map[string]any{"people": 1}, want: map[string]any{"person": 1}},
+		{name: "camel case", input: map[string]any{"userProfiles": 1}, want: map[string]any{"userProfile": 1}},
+		{name: "snake case", input: map[string]any{"line_items": 1}, want: map[string]any{"line_item": 1}},
+		{name: "known singular", input: map[string]any{"status": 1}, want: map[string]any{"status": 1}},
+		{name: "singular wins", input: map[string]any{"user": 1, "users": 2}, want: map[string]any{"user": 1}},
+		{
+			name:      "recursive",
+			input:     map[string]any{"orders": []any{map[string]any{"items": 1}}},
+			recursive: true,
+			want:      map[string]any{"order": []any{map[string]any{"item": 1}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.SingularizeKeys(tt.input, tt.recursive))
+		})
+	}
+}
+
+func TestPluralizeKeysDoesNotModifyInput(t *testing.T) {
+	input := map[string]any{"order": map[string]any{"item": 1}}
+	inflect.PluralizeKeys(input, true)
+	assert.Equal(t, map[string]any{"order": map[string]any{"item": 1}}, input)
+}
+
+func TestPluralizeKeysEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, map[string]any{"userRegexen": 1}, e.PluralizeKeys(map[string]any{"userRegex": 1}, false))
+}
+
+func TestPluralizeKeysIgnoresNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, map[string]any{"users": 1}, e.PluralizeKeys(map[string]any{"user": 1}, false))
+}
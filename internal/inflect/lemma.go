@@ -0,0 +1,245 @@
+package inflect
+
+import "strings"
+
+// PartOfSpeech identifies the word class used by Lemma.
+type PartOfSpeech int
+
+const (
+	// POSNoun treats the word as a noun.
+	// Example: "children" -> "child"
+	POSNoun PartOfSpeech = iota
+
+	// POSVerb treats the word as a verb.
+	// Example: "ran" -> "run", "running" -> "run"
+	POSVerb
+
+	// POSAdjective treats the word as an adjective.
+	// Example: "happier" -> "happy", "best" -> "good"
+	POSAdjective
+
+	// POSPronoun treats the word as a pronoun.
+	// Example: "they", "myself"
+	POSPronoun
+
+	// POSOther marks a word that is none of the above, such as a
+	// preposition, conjunction, or adverb. GuessPOS never returns it.
+	// Example: "with", "and", "quickly"
+	POSOther
+)
+
+// irregularVerbBases maps irregular past tense and past participle forms to
+// their base verbs.
+var irregularVerbBases = buildIrregularVerbBases()
+
+// irregularAdjectiveBases maps irregular comparatives and superlatives to
+// their base adjectives.
+var irregularAdjectiveBases = map[string]string{
+	"better":   "good",
+	"best":     "good",
+	"worse":    "bad",
+	"worst":    "bad",
+	"farther":  "far",
+	"farthest": "far",
+	"further":  "far",
+	"furthest": "far",
+	"less":     "little",
+	"least":    "little",
+}
+
+// lemmaUnchanged contains words whose inflection-like endings are part of
+// the dictionary form ("clever" is not "clev" + "-er").
+var lemmaUnchanged = map[string]bool{
+	"bitter": true, "clever": true, "eager": true, "former": true,
+	"inner": true, "latter": true, "other": true, "outer": true,
+	"proper": true, "slender": true, "sober": true, "tender": true,
+	"upper": true, "utter": true,
+	"earnest": true, "honest": true, "modest": true,
+}
+
+// buildIrregularVerbBases reverses the irregular verb tables. Forms of "be"
+// and third-person forms such as "has" are skipped so that each past form
+// maps to a single base ("had" -> "have").
+func buildIrregularVerbBases() map[string]string {
+	bases := make(map[string]string)
+	for _, table := range []map[string]string{irregularVerbsSame, irregularPastTenseOnly, irregularPastParticipleOnly} {
+		for base, form := range table {
+			if _, ok := verbSingularToPlural[base]; ok || base == "am" {
+				continue
+			}
+			bases[form] = base
+		}
+	}
+	return bases
+}
+
+// Lemma returns the dictionary form of an inflected word.
+//
+// The part of speech selects the analysis:
+//   - POSNoun: plural nouns are singularized, as with Singular
+//   - POSVerb: past tenses, participles, and third-person forms are reduced
+//     to the base verb, using the irregular verb tables and BaseVerb
+//   - POSAdjective: comparatives and superlatives are reduced to the base
+//     adjective, including "more"/"most" forms
+//   - POSPronoun and POSOther: the word is returned unchanged
+//
+// Words already in dictionary form are returned unchanged, and the case of
+// the input is preserved.
+//
+// Examples:
+//   - Lemma("children", POSNoun) returns "child"
+//   - Lemma("ran", POSVerb) returns "run"
+//   - Lemma("running", POSVerb) returns "run"
+//   - Lemma("tried", POSVerb) returns "try"
+//   - Lemma("watches", POSVerb) returns "watch"
+//   - Lemma("was", POSVerb) returns "be"
+//   - Lemma("happier", POSAdjective) returns "happy"
+//   - Lemma("biggest", POSAdjective) returns "big"
+//   - Lemma("best", POSAdjective) returns "good"
+//   - Lemma("more beautiful", POSAdjective) returns "beautiful"
+func Lemma(word string, pos PartOfSpeech) string {
+	return defaultEngine.Lemma(word, pos)
+}
+
+// Lemma returns the dictionary form of an inflected word.
+//
+// The part of speech selects the analysis:
+//   - POSNoun: plural nouns are singularized, as with e.Singular
+//   - POSVerb: past tenses, participles, and third-person forms are reduced
+//     to the base verb, using the irregular verb tables and BaseVerb
+//   - POSAdjective: comparatives and superlatives are reduced to the base
+//     adjective, including "more"/"most" forms
+//   - POSPronoun and POSOther: the word is returned unchanged
+//
+// Words already in dictionary form are returned unchanged, and the case of
+// the input is preserved.
+//
+// Examples:
+//   - e.Lemma("children", POSNoun) returns "child"
+//   - e.Lemma("ran", POSVerb) returns "run"
+//   - e.Lemma("running", POSVerb) returns "run"
+//   - e.Lemma("tried", POSVerb) returns "try"
+//   - e.Lemma("watches", POSVerb) returns "watch"
+//   - e.Lemma("was", POSVerb) returns "be"
+//   - e.Lemma("happier", POSAdjective) returns "happy"
+//   - e.Lemma("biggest", POSAdjective) returns "big"
+//   - e.Lemma("best", POSAdjective) returns "good"
+//   - e.Lemma("more beautiful", POSAdjective) returns "beautiful"
+func (e *Engine) Lemma(word string, pos PartOfSpeech) string {
+	prefix, trimmed, suffix := extractWhitespace(word)
+	if trimmed == "" {
+		return word
+	}
+
+	var lemma string
+	switch pos {
+	case POSNoun:
+		return e.Singular(word)
+	case POSVerb:
+		lemma = verbLemma(strings.ToLower(trimmed))
+	case POSAdjective:
+		lemma = adjectiveLemma(strings.ToLower(trimmed))
+	case POSPronoun, POSOther:
+		return word
+	}
+	if lemma == "" {
+		return word
+	}
+	return prefix + matchCase(trimmed, lemma) + suffix
+}
+
+// verbLemma returns the base form of a lowercase verb, or "" if the verb is
+// already a base form or cannot be analyzed.
+func verbLemma(lower string) string {
+	switch lower {
+	case "am", "is", "are", "was", "were", "been", "being":
+		return "be"
+	}
+	if base, ok := verbSingularToPlural[lower]; ok {
+		return base
+	}
+	if base, ok := irregularVerbBases[lower]; ok {
+		return base
+	}
+	if isKnownVerb(lower) || lemmaUnchanged[lower] {
+		return ""
+	}
+	if base, ok := participleBase(lower); ok {
+		return base
+	}
+
+	// Regular past tense and past participle (walked, tried, stopped)
+	if !strings.HasSuffix(lower, "eed") || (len(lower) > 5 && !strings.HasSuffix(lower, "ceed")) {
+		if base, ok := regularPastBase(lower); ok {
+			return base
+		}
+	}
+
+	// Third-person singular (runs, watches, tries)
+	if strings.HasSuffix(lower, "ss") || strings.HasSuffix(lower, "us") || strings.HasSuffix(lower, "is") {
+		return ""
+	}
+	if base, ok := suffixLemma(lower, "es", thirdPersonSingular); ok {
+		return base
+	}
+	base, _ := suffixLemma(lower, "s", thirdPersonSingular)
+	return base
+}
+
+// adjectiveLemma returns the base form of a lowercase adjective, or "" if
+// the adjective is already a base form or cannot be analyzed.
+func adjectiveLemma(lower string) string {
+	if base, ok := irregularAdjectiveBases[lower]; ok {
+		return base
+	}
+	if base, ok := strings.CutPrefix(lower, "more "); ok {
+		return base
+	}
+	if base, ok := strings.CutPrefix(lower, "most "); ok {
+		return base
+	}
+	if lemmaUnchanged[lower] {
+		return ""
+	}
+	if base, ok := suffixLemma(lower, "est", Superlative); ok {
+		return base
+	}
+	base, _ := suffixLemma(lower, "er", Comparative)
+	return base
+}
+
+// regularPastBase returns the base of a lowercase regular past tense verb,
+// spelled as in any dialect: "walked" -> "walk", "travelled" -> "travel".
+func regularPastBase(lower string) (string, bool) {
+	return suffixLemma(lower, "ed", func(v string) string {
+		for _, d := range dialects {
+			if past := PastTenseIn(v, d); past == lower {
+				return past
+			}
+		}
+		return ""
+	})
+}
+
+// suffixLemma removes suffix from a lowercase word and returns the first
+// candidate base that inflect maps back to the word.
+func suffixLemma(lower, suffix string, inflect func(string) string) (string, bool) {
+	stem, ok := strings.CutSuffix(lower, suffix)
+	if !ok || !hasVowelSound(stem) {
+		return "", false
+	}
+
+	candidates := stemCandidates(stem)
+
+	// tried -> try, happier -> happy
+	if s, ok := strings.CutSuffix(stem, "i"); ok {
+		candidates = append([]string{s + "y"}, candidates...)
+	}
+
+	for _, c := range candidates {
+		if hasVowelSound(c) && inflect(c) == lower {
+			return c, true
+		}
+	}
+	return "", false
+}
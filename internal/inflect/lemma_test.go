@@ -0,0 +1,105 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestLemma(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		pos  inflect.PartOfSpeech
+		want string
+	}{
+		// Nouns
+		{name: "regular noun", word: "cats", pos: inflect.POSNoun, want: "cat"},
+		{name: "irregular noun", word: "children", pos: inflect.POSNoun, want: "child"},
+		{name: "singular noun", word: "mouse", pos: inflect.POSNoun, want: "mouse"},
+
+		// Irregular verbs
+		{name: "ran", word: "ran", pos: inflect.POSVerb, want: "run"},
+		{name: "taken", word: "taken", pos: inflect.POSVerb, want: "take"},
+		{name: "thought", word: "thought", pos: inflect.POSVerb, want: "think"},
+		{name: "had", word: "had", pos: inflect.POSVerb, want: "have"},
+		{name: "did", word: "did", pos: inflect.POSVerb, want: "do"},
+		{name: "has", word: "has", pos: inflect.POSVerb, want: "have"},
+		{name: "goes", word: "goes", pos: inflect.POSVerb, want: "go"},
+		{name: "was", word: "was", pos: inflect.POSVerb, want: "be"},
+		{name: "is", word: "is", pos: inflect.POSVerb, want: "be"},
+		{name: "been", word: "been", pos: inflect.POSVerb, want: "be"},
+		{name: "unchanged past", word: "cut", pos: inflect.POSVerb, want: "cut"},
+
+		// Regular past tense
+		{name: "walked", word: "walked", pos: inflect.POSVerb, want: "walk"},
+		{name: "tried", word: "tried", pos: inflect.POSVerb, want: "try"},
+		{name: "stopped", word: "stopped", pos: inflect.POSVerb, want: "stop"},
+		{name: "hoped", word: "hoped", pos: inflect.POSVerb, want: "hope"},
+		{name: "British travelled", word: "travelled", pos: inflect.POSVerb, want: "travel"},
+		{name: "British dialled", word: "dialled", pos: inflect.POSVerb, want: "dial"},
+		{name: "British travelling", word: "travelling", pos: inflect.POSVerb, want: "travel"},
+		{name: "recalled", word: "recalled", pos: inflect.POSVerb, want: "recall"},
+		{name: "called", word: "called", pos: inflect.POSVerb, want: "call"},
+		{name: "danced", word: "danced", pos: inflect.POSVerb, want: "dance"},
+		{name: "agreed", word: "agreed", pos: inflect.POSVerb, want: "agree"},
+		{name: "need", word: "need", pos: inflect.POSVerb, want: "need"},
+		{name: "succeed", word: "succeed", pos: inflect.POSVerb, want: "succeed"},
+
+		// Participles
+		{name: "running", word: "running", pos: inflect.POSVerb, want: "run"},
+		{name: "making", word: "making", pos: inflect.POSVerb, want: "make"},
+		{name: "dancing", word: "dancing", pos: inflect.POSVerb, want: "dance"},
+
+		// Third-person singular
+		{name: "runs", word: "runs", pos: inflect.POSVerb, want: "run"},
+		{name: "watches", word: "watches", pos: inflect.POSVerb, want: "watch"},
+		{name: "studies", word: "studies", pos: inflect.POSVerb, want: "study"},
+		{name: "makes", word: "makes", pos: inflect.POSVerb, want: "make"},
+		{name: "plays", word: "plays", pos: inflect.POSVerb, want: "play"},
+		{name: "focus", word: "focus", pos: inflect.POSVerb, want: "focus"},
+		{name: "bless", word: "bless", pos: inflect.POSVerb, want: "bless"},
+		{name: "base verb", word: "walk", pos: inflect.POSVerb, want: "walk"},
+
+		// Adjectives
+		{name: "happier", word: "happier", pos: inflect.POSAdjective, want: "happy"},
+		{name: "happiest", word: "happiest", pos: inflect.POSAdjective, want: "happy"},
+		{name: "bigger", word: "bigger", pos: inflect.POSAdjective, want: "big"},
+		{name: "biggest", word: "biggest", pos: inflect.POSAdjective, want: "big"},
+		{name: "nicer", word: "nicer", pos: inflect.POSAdjective, want: "nice"},
+		{name: "larger", word: "larger", pos: inflect.POSAdjective, want: "large"},
+		{name: "slower", word: "slower", pos: inflect.POSAdjective, want: "slow"},
+		{name: "best", word: "best", pos: inflect.POSAdjective, want: "good"},
+		{name: "worse", word: "worse", pos: inflect.POSAdjective, want: "bad"},
+		{name: "more", word: "more beautiful", pos: inflect.POSAdjective, want: "beautiful"},
+		{name: "most", word: "most beautiful", pos: inflect.POSAdjective, want: "beautiful"},
+		{name: "clever", word: "clever", pos: inflect.POSAdjective, want: "clever"},
+		{name: "cleverer", word: "cleverer", pos: inflect.POSAdjective, want: "clever"},
+		{name: "honest", word: "honest", pos: inflect.POSAdjective, want: "honest"},
+		{name: "base adjective", word: "tall", pos: inflect.POSAdjective, want: "tall"},
+
+		// Other words
+		{name: "preposition", word: "with", pos: inflect.POSOther, want: "with"},
+
+		// Case and whitespace
+		{name: "titlecase verb", word: "Ran", pos: inflect.POSVerb, want: "Run"},
+		{name: "uppercase adjective", word: "HAPPIER", pos: inflect.POSAdjective, want: "HAPPY"},
+		{name: "whitespace", word: " walked ", pos: inflect.POSVerb, want: " walk "},
+		{name: "empty", word: "", pos: inflect.POSVerb, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Lemma(tt.word, tt.pos))
+		})
+	}
+}
+
+func TestLemmaEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "regex", e.Lemma("regexen", inflect.POSNoun))
+	assert.Equal(t, "regexen", inflect.Lemma("regexen", inflect.POSNoun))
+}
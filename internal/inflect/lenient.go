@@ -0,0 +1,267 @@
+package inflect
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// regularizableNouns contains the irregular nouns whose regular plural,
+// such as "childs" or "mouses", is a common mistake rather than an
+// accepted alternative like "persons" or "cactuses".
+var regularizableNouns = []string{
+	"child", "foot", "goose", "louse", "man", "mouse", "ox", "tooth", "woman",
+}
+
+// regularizedPlurals maps the mistaken plurals of regularizableNouns to
+// their singulars: regular plurals ("childs", "oxes") and plurals with an
+// extra -s ("childrens", "feets").
+var regularizedPlurals = func() map[string]string {
+	mistakes := make(map[string]string, 3*len(regularizableNouns))
+	for _, singular := range regularizableNouns {
+		plural := defaultIrregularPlurals[singular]
+		mistakes[singular+"s"] = singular
+		mistakes[plural+"s"] = singular
+		if strings.HasSuffix(singular, "x") {
+			mistakes[singular+"es"] = singular
+		}
+	}
+	return mistakes
+}()
+
+// correctLookalikes contains words that are close to an irregular noun but
+// are not misspellings of it.
+var correctLookalikes = map[string]bool{
+	"peoples": true,
+}
+
+// NounCorrection describes a misspelled or wrongly inflected irregular noun
+// found by CorrectNoun, and the noun it was taken for.
+type NounCorrection struct {
+	// Word is the noun as written.
+	Word string
+
+	// Singular and Plural are the forms of the noun Word was taken for,
+	// in the case of Word.
+	Singular, Plural string
+
+	// Note explains the correction, such as
+	// `"childs" is a regularized plural of "child"`.
+	Note string
+}
+
+// CorrectNoun returns the irregular noun that word is a near-miss of, and
+// whether one was found.
+//
+// Three kinds of near-miss are recognized: regular plurals of nouns whose
+// only plural is irregular ("childs", "mouses"), plurals with an extra -s
+// ("childrens", "feets"), and misspellings of irregular nouns, found by
+// Levenshtein distance, counting a swap of two adjacent letters as one
+// edit. Words of 7 letters or more may be one edit away from the noun and
+// words of 10 letters or more two edits away, as long as they start with
+// the same letter and only one noun is that close. Shorter words are too
+// close to other words ("mice" and "rice") to be corrected. Nouns defined
+// with DefNoun are included, and words that are already correct, such as
+// "persons", are not corrected.
+//
+// CorrectNoun works whatever the lenient setting; SetLenient makes Plural
+// and Singular apply the corrections it finds.
+//
+// Examples:
+//
+//	c, ok := CorrectNoun("childs")
+//	// ok is true, c.Singular is "child", c.Plural is "children"
+//	c, _ = CorrectNoun("Phenomenas")
+//	// c.Singular is "Phenomenon", c.Plural is "Phenomena",
+//	// c.Note is `"Phenomenas" adds -s to the plural "Phenomena"`
+//	c, _ = CorrectNoun("chidren")
+//	// c.Note is `"chidren" is a misspelling of "children"`
+//	_, ok = CorrectNoun("cats") // ok is false
+func CorrectNoun(word string) (NounCorrection, bool) {
+	return defaultEngine.CorrectNoun(word)
+}
+
+// CorrectNoun returns the irregular noun that word is a near-miss of, and
+// whether one was found, using e's nouns. See the package-level
+// CorrectNoun for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	c, ok := e.CorrectNoun("mouses")
+//	// ok is true, c.Singular is "mouse", c.Plural is "mice"
+func (e *Engine) CorrectNoun(word string) (NounCorrection, bool) {
+	word = strings.TrimSpace(word)
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.correctNounLocked(word)
+}
+
+// SetLenient enables or disables lenient mode, in which Plural and Singular
+// correct near-misses of irregular nouns, as found by CorrectNoun, before
+// inflecting them.
+//
+// Lenient mode is meant for normalizing user-generated text, where
+// "childs" and "mouses" mean "children" and "mice". When disabled (false,
+// the default), such words are inflected as written. ExplainPlural and
+// ExplainSingular note each correction.
+//
+// Examples:
+//
+//	Plural("childs")   // returns "childses"
+//	SetLenient(true)
+//	Plural("childs")   // returns "children"
+//	Singular("mouses") // returns "mouse"
+//	Plural("Chidren")  // returns "Children"
+func SetLenient(enabled bool) {
+	defaultEngine.SetLenient(enabled)
+}
+
+// SetLenient enables or disables lenient mode, in which e.Plural and
+// e.Singular correct near-misses of irregular nouns. See the package-level
+// SetLenient for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetLenient(true)
+//	e.Singular("childrens") // returns "child"
+func (e *Engine) SetLenient(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lenient = enabled
+}
+
+// IsLenient returns whether lenient mode is enabled.
+//
+// Examples:
+//
+//	IsLenient() // returns false (default)
+//	SetLenient(true)
+//	IsLenient() // returns true
+func IsLenient() bool {
+	return defaultEngine.IsLenient()
+}
+
+// IsLenient returns whether lenient mode is enabled.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.IsLenient() // returns false (default)
+func (e *Engine) IsLenient() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lenient
+}
+
+// lenientCorrection returns the correction of a word in lenient mode, or
+// false if lenient mode is off or the word is not a near-miss.
+func (e *Engine) lenientCorrection(word string) (NounCorrection, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if !e.lenient {
+		return NounCorrection{}, false
+	}
+	return e.correctNounLocked(word)
+}
+
+// correctNounLocked implements CorrectNoun for callers that hold e.mu.
+func (e *Engine) correctNounLocked(word string) (NounCorrection, bool) {
+	lower := strings.ToLower(word)
+	if _, ok := e.irregularPlurals[lower]; ok {
+		return NounCorrection{}, false
+	}
+	if _, ok := e.singularIrregulars[lower]; ok {
+		return NounCorrection{}, false
+	}
+
+	c := NounCorrection{Word: word}
+	if singular, ok := regularizedPlurals[lower]; ok && e.irregularPlurals[singular] != "" {
+		plural := e.irregularPlurals[singular]
+		if strings.HasPrefix(lower, plural) {
+			c.Note = fmt.Sprintf("%q adds -s to the plural %q", word, matchCase(word, plural))
+		} else {
+			c.Note = fmt.Sprintf("%q is a regularized plural of %q", word, matchCase(word, singular))
+		}
+		c.Singular, c.Plural = matchCase(word, singular), matchCase(word, plural)
+		return c, true
+	}
+
+	singular, intended, ok := e.closestIrregular(lower)
+	if !ok {
+		return NounCorrection{}, false
+	}
+	if plural := e.irregularPlurals[singular]; lower == plural+"s" {
+		c.Note = fmt.Sprintf("%q adds -s to the plural %q", word, matchCase(word, plural))
+	} else {
+		c.Note = fmt.Sprintf("%q is a misspelling of %q", word, matchCase(word, intended))
+	}
+	c.Singular, c.Plural = matchCase(word, singular), matchCase(word, e.irregularPlurals[singular])
+	return c, true
+}
+
+// closestIrregular returns the singular of the irregular noun whose
+// singular or plural is a misspelling of a lowercase word, and that form,
+// or false if no single noun is close enough. The caller must hold e.mu.
+func (e *Engine) closestIrregular(lower string) (singular, intended string, ok bool) {
+	n := utf8.RuneCountInString(lower)
+	limit := 0
+	switch {
+	case n >= 10:
+		limit = 2
+	case n >= 7:
+		limit = 1
+	}
+	if limit == 0 || correctLookalikes[lower] {
+		return "", "", false
+	}
+
+	best := limit + 1
+	for s, p := range e.irregularPlurals {
+		for _, form := range []string{s, p} {
+			// Words that extend the singular are other words or plurals in
+			// their own right: "persona", "persons"
+			if form == "" || form[0] != lower[0] || form == s && strings.HasPrefix(lower, s) || strings.HasPrefix(form, lower) {
+				continue
+			}
+			d := typoDistance(lower, form)
+			switch {
+			case d < best:
+				best, singular, intended, ok = d, s, form, true
+			case d == best && s != singular:
+				// Two nouns are equally close
+				ok = false
+			}
+		}
+	}
+	return singular, intended, ok
+}
+
+// typoDistance returns the Levenshtein distance between a and b, counting
+// a swap of two adjacent letters as a single edit.
+func typoDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	// d[i][j] is the distance between x[:i] and y[:j]
+	d := make([][]int, len(x)+1)
+	for i := range d {
+		d[i] = make([]int, len(y)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(x)][len(y)]
+}
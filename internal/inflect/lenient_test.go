@@ -0,0 +1,87 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestCorrectNoun(t *testing.T) {
+	tests := []struct {
+		word     string
+		singular string
+		plural   string
+		note     string
+	}{
+		{word: "childs", singular: "child", plural: "children", note: `"childs" is a regularized plural of "child"`},
+		{word: "mouses", singular: "mouse", plural: "mice", note: `"mouses" is a regularized plural of "mouse"`},
+		{word: "oxes", singular: "ox", plural: "oxen", note: `"oxes" is a regularized plural of "ox"`},
+		{word: "feets", singular: "foot", plural: "feet", note: `"feets" adds -s to the plural "feet"`},
+		{word: "Childrens", singular: "Child", plural: "Children", note: `"Childrens" adds -s to the plural "Children"`},
+		{word: "criterias", singular: "criterion", plural: "criteria", note: `"criterias" adds -s to the plural "criteria"`},
+		{word: "chidren", singular: "child", plural: "children", note: `"chidren" is a misspelling of "children"`},
+		{word: "chlidren", singular: "child", plural: "children", note: `"chlidren" is a misspelling of "children"`},
+		{word: "phenomenom", singular: "phenomenon", plural: "phenomena", note: `"phenomenom" is a misspelling of "phenomenon"`},
+		{word: "appendicies", singular: "appendix", plural: "appendices", note: `"appendicies" is a misspelling of "appendices"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			c, ok := inflect.CorrectNoun(tt.word)
+			assert.True(t, ok)
+			assert.Equal(t, inflect.NounCorrection{Word: tt.word, Singular: tt.singular, Plural: tt.plural, Note: tt.note}, c)
+		})
+	}
+}
+
+func TestCorrectNounNoMatch(t *testing.T) {
+	// Correct words, words too short to correct, and other words close to
+	// an irregular noun
+	for _, word := range []string{
+		"", "cats", "child", "children", "people", "persons", "peoples",
+		"persona", "cactuses", "formulas", "rice", "loose",
+	} {
+		t.Run(word, func(t *testing.T) {
+			_, ok := inflect.CorrectNoun(word)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestSetLenient(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.False(t, e.IsLenient())
+	assert.Equal(t, "childses", e.Plural("childs"))
+	assert.Equal(t, "mouse", e.Singular("mouses"))
+
+	e.SetLenient(true)
+	assert.True(t, e.IsLenient())
+	assert.Equal(t, "children", e.Plural("childs"), "cached result is not used")
+	assert.Equal(t, "Children", e.Plural("Chidren"))
+	assert.Equal(t, "child", e.Singular("childrens"))
+	assert.Equal(t, "feet", e.Plural("foots"))
+	assert.Equal(t, "cats", e.Plural("cat"))
+	assert.Equal(t, "people", e.Plural("person"))
+	assert.Equal(t, "children", e.Clone().Plural("childs"))
+	assert.Contains(t, e.ExplainPlural("childs"), `lenient mode: "childs" is a regularized plural of "child"`)
+	assert.Equal(t, "childses", inflect.Plural("childs"), "default engine is unaffected")
+
+	e.Reset()
+	assert.False(t, e.IsLenient())
+}
+
+func TestLenientDefNoun(t *testing.T) {
+	e := inflect.NewEngine()
+	e.SetLenient(true)
+	e.DefNoun("mouse", "mouses")
+	assert.Equal(t, "mouses", e.Plural("mouse"))
+	assert.Equal(t, "mouse", e.Singular("mouses"))
+
+	e.DefNoun("gizmo", "gizmotron")
+	c, ok := e.CorrectNoun("gizmotrom")
+	assert.True(t, ok)
+	assert.Equal(t, "gizmo", c.Singular)
+}
@@ -0,0 +1,67 @@
+package inflect
+
+import (
+	"regexp"
+	"slices"
+)
+
+// markdownLinkPattern matches the parts of Markdown that hold URLs rather
+// than prose: the destination and title of an inline link or image, a
+// link reference definition, an autolink or inline HTML tag, and a bare
+// URL.
+var markdownLinkPattern = regexp.MustCompile(
+	`\]\(\s*(?:<[^>\n]*>|(?:[^\s()]|\([^\s()]*\))*)(?:\s+(?:"[^"]*"|'[^']*'|\([^)]*\)))?\s*\)` +
+		`|(?m:^ {0,3}\[[^\]\n]+\]:.*$)` +
+		`|<[A-Za-z/!][^<>\n]*>` +
+		`|\b(?:https?://|www\.)[^\s<>]*`)
+
+// InflectMarkdown expands inflection function calls embedded in Markdown,
+// like Inflect, without touching code or URLs.
+//
+// As with Inflect, calls in fenced code blocks and inline code spans are
+// left unchanged. In addition, calls in the destination or title of a
+// link or image, in a link reference definition, in an autolink or inline
+// HTML tag, and in a bare URL are left unchanged, so that a URL such as
+// https://example.com/plural(cat) is never rewritten. Calls in link text
+// are expanded.
+//
+// Examples:
+//   - InflectMarkdown("See [plural('cat')](https://example.com/plural(cat))") returns "See [cats](https://example.com/plural(cat))"
+//   - InflectMarkdown("Use `plural('cat')` to get plural('cat')") returns "Use `plural('cat')` to get cats"
+//   - InflectMarkdown("[docs]: https://example.com/ordinal(1)\nThe ordinal(1) item") returns "[docs]: https://example.com/ordinal(1)\nThe 1st item"
+func InflectMarkdown(text string) string {
+	return defaultEngine.InflectMarkdown(text)
+}
+
+// InflectMarkdown expands inflection function calls embedded in Markdown,
+// like e.Inflect, without touching code or URLs. See the package-level
+// InflectMarkdown for details.
+//
+// Examples:
+//   - e.InflectMarkdown("See [plural('cat')](https://example.com/plural(cat))") returns "See [cats](https://example.com/plural(cat))"
+//   - e.InflectMarkdown("Use `plural('cat')` to get plural('cat')") returns "Use `plural('cat')` to get cats"
+func (e *Engine) InflectMarkdown(text string) string {
+	var num []int
+	out, _ := e.expandCalls(text, InflectOptions{}, &num, formatMarkdown)
+	return out
+}
+
+// markdownRegions returns the byte ranges of the code and URLs in
+// Markdown text, in order, with overlapping ranges merged.
+func markdownRegions(text string) [][2]int {
+	regions := markdownCodeRegions(text)
+	for _, loc := range markdownLinkPattern.FindAllStringIndex(text, -1) {
+		regions = append(regions, [2]int{loc[0], loc[1]})
+	}
+	slices.SortFunc(regions, func(a, b [2]int) int { return a[0] - b[0] })
+
+	merged := regions[:0]
+	for _, r := range regions {
+		if n := len(merged); n > 0 && r[0] < merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
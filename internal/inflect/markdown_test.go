@@ -0,0 +1,61 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestInflectMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "prose", text: "I saw plural('cat', 3)", want: "I saw cats"},
+		{name: "empty", text: "", want: ""},
+
+		// Code, as with Inflect
+		{name: "code span", text: "Use `plural('cat')` to get plural('cat')", want: "Use `plural('cat')` to get cats"},
+		{name: "fenced code", text: "```\nplural('cat')\n```\nplural('cat')", want: "```\nplural('cat')\n```\ncats"},
+
+		// Links
+		{name: "link destination", text: "See [plural('cat')](https://example.com/plural(cat))", want: "See [cats](https://example.com/plural(cat))"},
+		{name: "link title", text: `[a('hour')](/x "plural('ox')")`, want: `[an hour](/x "plural('ox')")`},
+		{name: "image", text: "![plural('ox')](img/plural(ox).png) plural('ox')", want: "![oxen](img/plural(ox).png) oxen"},
+		{name: "angle destination", text: "[x](<a plural(x) b>) plural('ox')", want: "[x](<a plural(x) b>) oxen"},
+		{name: "reference definition", text: "[docs]: https://example.com/ordinal(1)\nThe ordinal(1) item", want: "[docs]: https://example.com/ordinal(1)\nThe 1st item"},
+		{name: "reference link", text: "[plural('cat')][docs]", want: "[cats][docs]"},
+		{name: "autolink", text: "<https://x.com/plural(cat)> plural('cat')", want: "<https://x.com/plural(cat)> cats"},
+		{name: "bare URL", text: "Visit https://x.com/plural(cat) for plural('cat')", want: "Visit https://x.com/plural(cat) for cats"},
+		{name: "www URL", text: "www.x.com/plural(cat)", want: "www.x.com/plural(cat)"},
+		{name: "inline HTML", text: `<span title="plural('cat')">plural('cat')</span>`, want: `<span title="plural('cat')">cats</span>`},
+
+		// Links inside code
+		{name: "link in code span", text: "`[x](plural(cat))` plural('cat')", want: "`[x](plural(cat))` cats"},
+
+		// Escapes and counts
+		{name: "escaped call", text: "\\plural('cat') [x](/y)", want: "plural('cat') [x](/y)"},
+		{name: "num across links", text: "num(2)[x](/y) plural_verb('is')", want: "[x](/y) are"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.InflectMarkdown(tt.text))
+		})
+	}
+}
+
+func TestInflectMarkdownURLsInInflect(t *testing.T) {
+	// Inflect itself only skips code
+	assert.Equal(t, "[x](https://x.com/cats)", inflect.Inflect("[x](https://x.com/plural(cat))"))
+	assert.Equal(t, "[x](https://x.com/plural(cat))", inflect.InflectMarkdown("[x](https://x.com/plural(cat))"))
+}
+
+func TestEngineInflectMarkdown(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "[regexen](/regex)", e.InflectMarkdown("[plural('regex')](/regex)"))
+}
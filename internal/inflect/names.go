@@ -0,0 +1,218 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ProperNameDetection controls how Plural, Singular, and Possessive decide
+// whether a word is a proper name.
+type ProperNameDetection int
+
+const (
+	// ProperNameHeuristic treats any capitalized word that is not all
+	// uppercase as a proper name. This is the default.
+	// Example: "Mary" -> "Marys", but also "Berry" -> "Berrys" at the start of a sentence
+	ProperNameHeuristic ProperNameDetection = iota
+
+	// ProperNameOff never treats a word as a proper name, so capitalized
+	// words are inflected like any other noun.
+	// Example: "Mary" -> "Maries", "Berry" -> "Berries"
+	ProperNameOff
+
+	// ProperNameDictionary treats a capitalized word as a proper name only
+	// if it is a known name, from the built-in list or DefProperName().
+	// Example: "Mary" -> "Marys", "Berry" -> "Berries"
+	ProperNameDictionary
+)
+
+// properNames contains common given names and surnames, in lowercase, used
+// by ProperNameDictionary.
+var properNames = map[string]bool{
+	// Given names
+	"alice": true, "amy": true, "andrew": true, "anthony": true, "barbara": true,
+	"betty": true, "billy": true, "bobby": true, "charles": true, "chris": true,
+	"daniel": true, "david": true, "dennis": true, "dorothy": true, "elizabeth": true,
+	"emily": true, "francis": true, "gary": true, "harry": true, "henry": true,
+	"james": true, "jenny": true, "jerry": true, "jesus": true, "john": true,
+	"joseph": true, "judy": true, "kelly": true, "larry": true, "lucy": true,
+	"mary": true, "michael": true, "moses": true, "nancy": true, "paul": true,
+	"peggy": true, "richard": true, "robert": true, "sally": true, "sarah": true,
+	"terry": true, "thomas": true, "timothy": true, "tommy": true, "william": true,
+	// Surnames
+	"adams": true, "brown": true, "davis": true, "evans": true, "harris": true,
+	"hastings": true, "hughes": true, "jackson": true, "johnson": true, "jones": true,
+	"kennedy": true, "lewis": true, "miller": true, "morris": true, "murphy": true,
+	"perry": true, "roberts": true, "smith": true, "taylor": true, "thompson": true,
+	"walker": true, "williams": true, "wilson": true, "wright": true, "young": true,
+}
+
+// SetProperNameDetection sets how proper names are recognized.
+//
+// Proper names are pluralized without spelling changes ("Mary" -> "Marys"),
+// are left unchanged by ClassicalNames(true) when they end in "s", and take
+// "'s" in possessives. The default, ProperNameHeuristic, treats every
+// capitalized word as a name, which can misfire at the start of a sentence.
+//
+// Examples:
+//
+//	SetProperNameDetection(ProperNameOff)
+//	Plural("Mary")  // returns "Maries"
+//	SetProperNameDetection(ProperNameDictionary)
+//	Plural("Mary")  // returns "Marys"
+//	Plural("Berry") // returns "Berries"
+func SetProperNameDetection(mode ProperNameDetection) {
+	defaultEngine.SetProperNameDetection(mode)
+}
+
+// SetProperNameDetection sets how proper names are recognized.
+//
+// Proper names are pluralized without spelling changes ("Mary" -> "Marys"),
+// are left unchanged by ClassicalNames(true) when they end in "s", and take
+// "'s" in possessives. The default, ProperNameHeuristic, treats every
+// capitalized word as a name, which can misfire at the start of a sentence.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetProperNameDetection(ProperNameOff)
+//	e.Plural("Mary")  // returns "Maries"
+//	e.SetProperNameDetection(ProperNameDictionary)
+//	e.Plural("Mary")  // returns "Marys"
+//	e.Plural("Berry") // returns "Berries"
+func (e *Engine) SetProperNameDetection(mode ProperNameDetection) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.properNameDetection = mode
+}
+
+// GetProperNameDetection returns how proper names are recognized.
+func GetProperNameDetection() ProperNameDetection {
+	return defaultEngine.GetProperNameDetection()
+}
+
+// GetProperNameDetection returns how proper names are recognized.
+func (e *Engine) GetProperNameDetection() ProperNameDetection {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.properNameDetection
+}
+
+// DefProperName adds a name to the dictionary used by ProperNameDictionary.
+//
+// Names are matched case-insensitively. They have no effect in the other
+// detection modes.
+//
+// Examples:
+//
+//	SetProperNameDetection(ProperNameDictionary)
+//	Plural("Jolly") // returns "Jollies"
+//	DefProperName("Jolly")
+//	Plural("Jolly") // returns "Jollys"
+func DefProperName(name string) {
+	defaultEngine.DefProperName(name)
+}
+
+// DefProperName adds a name to the dictionary used by ProperNameDictionary.
+//
+// Names are matched case-insensitively. They have no effect in the other
+// detection modes.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetProperNameDetection(ProperNameDictionary)
+//	e.Plural("Jolly") // returns "Jollies"
+//	e.DefProperName("Jolly")
+//	e.Plural("Jolly") // returns "Jollys"
+func (e *Engine) DefProperName(name string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if e.customProperNames == nil {
+		e.customProperNames = make(map[string]bool)
+	}
+	e.customProperNames[strings.ToLower(name)] = true
+}
+
+// PluralName returns the plural of a proper name, such as a family name,
+// regardless of the proper name detection mode.
+//
+// Names are never respelled: "-s" is added, or "-es" after s, x, z, ch, or
+// sh. Only the last word of a multi-word name is pluralized. With
+// ClassicalNames(true), names ending in "s" are returned unchanged.
+//
+// Examples:
+//   - PluralName("Mary") returns "Marys"
+//   - PluralName("Kennedy") returns "Kennedys"
+//   - PluralName("Jones") returns "Joneses"
+//   - PluralName("Wolf") returns "Wolfs"
+//   - PluralName("Church") returns "Churches"
+//   - PluralName("John Smith") returns "John Smiths"
+func PluralName(name string) string {
+	return defaultEngine.PluralName(name)
+}
+
+// PluralName returns the plural of a proper name, such as a family name,
+// regardless of the proper name detection mode.
+//
+// Names are never respelled: "-s" is added, or "-es" after s, x, z, ch, or
+// sh. Only the last word of a multi-word name is pluralized. With
+// e.ClassicalNames(true), names ending in "s" are returned unchanged.
+//
+// Examples:
+//   - e.PluralName("Mary") returns "Marys"
+//   - e.PluralName("Jones") returns "Joneses"
+//   - e.PluralName("John Smith") returns "John Smiths"
+func (e *Engine) PluralName(name string) string {
+	head, last, tail := splitLastWord(name)
+	if last == "" {
+		return name
+	}
+
+	lower := strings.ToLower(last)
+	switch {
+	case strings.HasSuffix(lower, "s") && e.IsClassicalNames():
+		return name
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"),
+		strings.HasSuffix(lower, "z"), strings.HasSuffix(lower, "ch"),
+		strings.HasSuffix(lower, "sh"):
+		return head + last + matchSuffix(last, "es") + tail
+	default:
+		return head + last + matchSuffix(last, "s") + tail
+	}
+}
+
+// isProperName reports whether word is a proper name under the engine's
+// proper name detection mode.
+func (e *Engine) isProperName(word string) bool {
+	if !isProperName(word) {
+		return false
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	switch e.properNameDetection {
+	case ProperNameOff:
+		return false
+	case ProperNameDictionary:
+		lower := strings.ToLower(word)
+		return properNames[lower] || e.customProperNames[lower]
+	case ProperNameHeuristic:
+		return true
+	}
+	return true
+}
+
+// isProperNameEndingInS checks if a word is a proper name ending in 's',
+// such as "Jones", "Williams", or "Hastings".
+func (e *Engine) isProperNameEndingInS(word string) bool {
+	if !e.isProperName(word) {
+		return false
+	}
+
+	// Check if the word ends in 's' or 'S'
+	lastRune, _ := utf8.DecodeLastRuneInString(word)
+	return unicode.ToLower(lastRune) == 's'
+}
@@ -0,0 +1,118 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestProperNameDetection(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      inflect.ProperNameDetection
+		classical bool
+		input     string
+		want      string
+	}{
+		{name: "heuristic name", mode: inflect.ProperNameHeuristic, input: "Mary", want: "Marys"},
+		{name: "heuristic common noun", mode: inflect.ProperNameHeuristic, input: "Berry", want: "Berrys"},
+		{name: "heuristic lowercase", mode: inflect.ProperNameHeuristic, input: "berry", want: "berries"},
+		{name: "off name", mode: inflect.ProperNameOff, input: "Mary", want: "Maries"},
+		{name: "off common noun", mode: inflect.ProperNameOff, input: "Berry", want: "Berries"},
+		{name: "dictionary name", mode: inflect.ProperNameDictionary, input: "Mary", want: "Marys"},
+		{name: "dictionary common noun", mode: inflect.ProperNameDictionary, input: "Berry", want: "Berries"},
+		{name: "dictionary acronym", mode: inflect.ProperNameDictionary, input: "CITY", want: "CITIES"},
+		{name: "classical heuristic", mode: inflect.ProperNameHeuristic, classical: true, input: "Bus", want: "Bus"},
+		{name: "classical off", mode: inflect.ProperNameOff, classical: true, input: "Jones", want: "Joneses"},
+		{name: "classical dictionary name", mode: inflect.ProperNameDictionary, classical: true, input: "Jones", want: "Jones"},
+		{name: "classical dictionary noun", mode: inflect.ProperNameDictionary, classical: true, input: "Bus", want: "Buses"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.SetProperNameDetection(tt.mode)
+			e.ClassicalNames(tt.classical)
+			assert.Equal(t, tt.want, e.Plural(tt.input))
+		})
+	}
+}
+
+func TestProperNameDetectionPossessive(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, "Jones's", e.Possessive("Jones"))
+
+	e.SetProperNameDetection(inflect.ProperNameOff)
+	assert.Equal(t, "Jones'", e.Possessive("Jones"))
+
+	e.SetProperNameDetection(inflect.ProperNameDictionary)
+	assert.Equal(t, "Jones's", e.Possessive("Jones"))
+}
+
+func TestGetProperNameDetection(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, inflect.ProperNameHeuristic, e.GetProperNameDetection())
+
+	e.SetProperNameDetection(inflect.ProperNameDictionary)
+	assert.Equal(t, inflect.ProperNameDictionary, e.GetProperNameDetection())
+	assert.Equal(t, inflect.ProperNameDictionary, e.Clone().GetProperNameDetection())
+
+	e.Reset()
+	assert.Equal(t, inflect.ProperNameHeuristic, e.GetProperNameDetection())
+}
+
+func TestDefProperName(t *testing.T) {
+	e := inflect.NewEngine()
+	e.SetProperNameDetection(inflect.ProperNameDictionary)
+	assert.Equal(t, "Jollies", e.Plural("Jolly"))
+
+	e.DefProperName("jolly")
+	assert.Equal(t, "Jollys", e.Plural("Jolly"))
+	assert.Equal(t, "jollies", e.Plural("jolly"))
+
+	clone := e.Clone()
+	e.Reset()
+	e.SetProperNameDetection(inflect.ProperNameDictionary)
+	assert.Equal(t, "Jollies", e.Plural("Jolly"))
+	assert.Equal(t, "Jollys", clone.Plural("Jolly"))
+}
+
+func TestPluralName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "consonant y", input: "Mary", want: "Marys"},
+		{name: "surname y", input: "Kennedy", want: "Kennedys"},
+		{name: "ends in s", input: "Jones", want: "Joneses"},
+		{name: "ends in x", input: "Fox", want: "Foxes"},
+		{name: "ends in ch", input: "Church", want: "Churches"},
+		{name: "ends in sh", input: "Bush", want: "Bushes"},
+		{name: "ends in f", input: "Wolf", want: "Wolfs"},
+		{name: "irregular noun", input: "Child", want: "Childs"},
+		{name: "lowercase", input: "mary", want: "marys"},
+		{name: "uppercase", input: "JONES", want: "JONESES"},
+		{name: "full name", input: "John Smith", want: "John Smiths"},
+		{name: "trailing space", input: "Mary ", want: "Marys "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralName(tt.input))
+		})
+	}
+}
+
+func TestPluralNameClassicalNames(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalNames(true)
+	assert.Equal(t, "Jones", e.PluralName("Jones"))
+	assert.Equal(t, "Marys", e.PluralName("Mary"))
+
+	e.SetProperNameDetection(inflect.ProperNameOff)
+	assert.Equal(t, "Jones", e.PluralName("Jones"))
+}
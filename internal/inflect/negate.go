@@ -0,0 +1,149 @@
+package inflect
+
+import "strings"
+
+// Negate returns the negative of a verb, or of a verb phrase starting with
+// one, using a contraction where English has one.
+//
+// Auxiliary and modal verbs take "not" directly ("is" -> "isn't"); other
+// verbs take a form of "do" followed by the base verb ("runs" -> "doesn't
+// run"). Forms of "have" are treated as auxiliaries. A phrase that is
+// already negative is returned unchanged, and the case of the first word
+// is preserved.
+//
+// Examples:
+//   - Negate("is") returns "isn't"
+//   - Negate("can") returns "can't"
+//   - Negate("will") returns "won't"
+//   - Negate("am") returns "am not"
+//   - Negate("has been") returns "hasn't been"
+//   - Negate("runs") returns "doesn't run"
+//   - Negate("went home") returns "didn't go home"
+func Negate(verb string) string {
+	return NegateWithOptions(verb, true)
+}
+
+// NegateWithOptions returns the negative of a verb, or of a verb phrase
+// starting with one, as Negate does. If contract is false, "not" is
+// written out ("is not", "does not run"), and "can" becomes "cannot".
+//
+// Examples:
+//   - NegateWithOptions("is", false) returns "is not"
+//   - NegateWithOptions("can", false) returns "cannot"
+//   - NegateWithOptions("runs", false) returns "does not run"
+//   - NegateWithOptions("is", true) returns "isn't"
+func NegateWithOptions(verb string, contract bool) string {
+	prefix, trimmed, suffix := extractWhitespace(verb)
+	if trimmed == "" || isNegated(trimmed) {
+		return verb
+	}
+	first, rest, _ := strings.Cut(trimmed, " ")
+	lower := strings.ToLower(first)
+
+	var negated string
+	switch neg, ok := verbNegatives[lower]; {
+	case ok && contract:
+		negated = neg
+	case lower == "can":
+		negated = "cannot"
+	case ok || lower == "am" || lower == "may":
+		negated = lower + " not"
+	default:
+		negated = negateLexicalVerb(lower, contract)
+	}
+	if rest != "" {
+		negated += " " + rest
+	}
+	return prefix + matchCase(first, negated) + suffix
+}
+
+// Unnegate returns the positive form of a negated verb or verb phrase,
+// reversing Negate. A phrase that is not negative is returned unchanged.
+//
+// Examples:
+//   - Unnegate("isn't") returns "is"
+//   - Unnegate("is not") returns "is"
+//   - Unnegate("cannot") returns "can"
+//   - Unnegate("won't") returns "will"
+//   - Unnegate("doesn't run") returns "runs"
+//   - Unnegate("didn't go home") returns "went home"
+func Unnegate(verb string) string {
+	prefix, trimmed, suffix := extractWhitespace(verb)
+	if trimmed == "" {
+		return verb
+	}
+	first, rest, _ := strings.Cut(trimmed, " ")
+	lower := strings.ToLower(first)
+
+	var positive string
+	switch pos, ok := verbPositives[lower]; {
+	case ok:
+		positive = pos
+	case lower == "cannot":
+		positive = "can"
+	case lower == "not":
+		return prefix + matchCase(first, rest) + suffix
+	default:
+		next, after, _ := strings.Cut(rest, " ")
+		if !strings.EqualFold(next, "not") {
+			return verb
+		}
+		positive, rest = lower, after
+	}
+
+	// Drop do-support: "doesn't run" -> "runs"
+	if base, after, _ := strings.Cut(rest, " "); base != "" {
+		switch positive {
+		case "do":
+			positive, rest = base, after
+		case "does":
+			positive, rest = thirdPersonSingular(base), after
+		case "did":
+			positive, rest = PastTense(base), after
+		}
+	}
+	if rest != "" {
+		positive += " " + rest
+	}
+	return prefix + matchCase(first, positive) + suffix
+}
+
+// negateLexicalVerb returns the negative of a lowercase verb that is not an
+// auxiliary, using a form of "do" chosen by the tense of the verb.
+func negateLexicalVerb(lower string, contract bool) string {
+	aux, base, ok := doSupport(lower)
+	if !ok {
+		// Participles cannot take "do": "running" -> "not running"
+		return "not " + lower
+	}
+	if contract {
+		return verbNegatives[aux] + " " + base
+	}
+	return aux + " not " + base
+}
+
+// doSupport returns the form of "do" that carries the tense of a lowercase
+// verb, and the base form of the verb: "runs" -> "does", "run". It reports
+// false for participles, which cannot take "do".
+func doSupport(lower string) (aux, base string, ok bool) {
+	base = verbLemma(lower)
+	if base == "" {
+		base = lower
+	}
+	switch {
+	case lower == base:
+		return "do", base, true
+	case verbSingularToPlural[lower] == base || thirdPersonSingular(base) == lower:
+		return "does", base, true
+	case PastTense(base) == lower:
+		return "did", base, true
+	}
+	return "", "", false
+}
+
+// isNegated reports whether a verb phrase is already negative.
+func isNegated(phrase string) bool {
+	first, rest, _ := strings.Cut(strings.ToLower(phrase), " ")
+	next, _, _ := strings.Cut(rest, " ")
+	return strings.HasSuffix(first, "n't") || first == "cannot" || first == "not" || next == "not"
+}
@@ -0,0 +1,130 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestNegate(t *testing.T) {
+	tests := []struct {
+		name string
+		verb string
+		want string
+	}{
+		// Auxiliaries and modals
+		{name: "is", verb: "is", want: "isn't"},
+		{name: "are", verb: "are", want: "aren't"},
+		{name: "was", verb: "was", want: "wasn't"},
+		{name: "has", verb: "has", want: "hasn't"},
+		{name: "did", verb: "did", want: "didn't"},
+		{name: "can", verb: "can", want: "can't"},
+		{name: "will", verb: "will", want: "won't"},
+		{name: "shall", verb: "shall", want: "shan't"},
+		{name: "would", verb: "would", want: "wouldn't"},
+		{name: "am has no contraction", verb: "am", want: "am not"},
+		{name: "may has no contraction", verb: "may", want: "may not"},
+
+		// Lexical verbs take do-support
+		{name: "base form", verb: "run", want: "don't run"},
+		{name: "third person", verb: "runs", want: "doesn't run"},
+		{name: "third person -es", verb: "watches", want: "doesn't watch"},
+		{name: "regular past", verb: "walked", want: "didn't walk"},
+		{name: "irregular past", verb: "went", want: "didn't go"},
+		{name: "participle", verb: "running", want: "not running"},
+
+		// Phrases
+		{name: "auxiliary phrase", verb: "has been", want: "hasn't been"},
+		{name: "lexical phrase", verb: "went home", want: "didn't go home"},
+		{name: "modal phrase", verb: "will work", want: "won't work"},
+
+		// Already negative
+		{name: "contracted", verb: "isn't", want: "isn't"},
+		{name: "not", verb: "is not", want: "is not"},
+		{name: "cannot", verb: "cannot", want: "cannot"},
+
+		// Case and whitespace
+		{name: "capitalized", verb: "Is", want: "Isn't"},
+		{name: "uppercase", verb: "IS", want: "ISN'T"},
+		{name: "capitalized lexical", verb: "Runs", want: "Doesn't run"},
+		{name: "whitespace", verb: "  is ", want: "  isn't "},
+		{name: "empty", verb: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Negate(tt.verb))
+		})
+	}
+}
+
+func TestNegateWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		verb     string
+		contract bool
+		want     string
+	}{
+		{name: "is", verb: "is", want: "is not"},
+		{name: "can", verb: "can", want: "cannot"},
+		{name: "will", verb: "will", want: "will not"},
+		{name: "am", verb: "am", want: "am not"},
+		{name: "runs", verb: "runs", want: "does not run"},
+		{name: "ran", verb: "ran", want: "did not run"},
+		{name: "phrase", verb: "has been", want: "has not been"},
+		{name: "capitalized", verb: "Can", want: "Cannot"},
+		{name: "contracted", verb: "is", contract: true, want: "isn't"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NegateWithOptions(tt.verb, tt.contract))
+		})
+	}
+}
+
+func TestUnnegate(t *testing.T) {
+	tests := []struct {
+		name string
+		verb string
+		want string
+	}{
+		{name: "isn't", verb: "isn't", want: "is"},
+		{name: "is not", verb: "is not", want: "is"},
+		{name: "aren't", verb: "aren't", want: "are"},
+		{name: "won't", verb: "won't", want: "will"},
+		{name: "shan't", verb: "shan't", want: "shall"},
+		{name: "cannot", verb: "cannot", want: "can"},
+		{name: "can not", verb: "can not", want: "can"},
+		{name: "am not", verb: "am not", want: "am"},
+		{name: "don't run", verb: "don't run", want: "run"},
+		{name: "doesn't run", verb: "doesn't run", want: "runs"},
+		{name: "does not watch", verb: "does not watch", want: "watches"},
+		{name: "didn't go home", verb: "didn't go home", want: "went home"},
+		{name: "bare doesn't", verb: "doesn't", want: "does"},
+		{name: "hasn't been", verb: "hasn't been", want: "has been"},
+		{name: "not running", verb: "not running", want: "running"},
+		{name: "positive", verb: "is", want: "is"},
+		{name: "positive phrase", verb: "runs fast", want: "runs fast"},
+		{name: "capitalized", verb: "Doesn't run", want: "Runs"},
+		{name: "uppercase", verb: "WON'T", want: "WILL"},
+		{name: "empty", verb: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Unnegate(tt.verb))
+		})
+	}
+}
+
+func TestNegateRoundTrip(t *testing.T) {
+	for _, verb := range []string{"is", "are", "was", "has", "can", "will", "runs", "run", "went", "has been"} {
+		t.Run(verb, func(t *testing.T) {
+			assert.Equal(t, verb, inflect.Unnegate(inflect.Negate(verb)))
+			assert.Equal(t, verb, inflect.Unnegate(inflect.NegateWithOptions(verb, false)))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeInput enables or disables Unicode normalization of the words
+// passed to Plural, Singular, and An.
+//
+// Text from different sources can spell the same word with different code
+// points: "café" may end in the single character "é" (NFC, the usual form)
+// or in "e" followed by a combining accent (NFD, as produced by macOS file
+// names and some input methods). Both forms are inflected correctly, and
+// each result keeps the form of its input. When enabled, input is first
+// converted to NFC, so that equal words give identical results, which
+// matters when comparing or caching them. Normalization is disabled by
+// default so that input is never changed beyond its inflection.
+//
+// Examples:
+//
+//	Plural("cafe\u0301")   // returns "cafe\u0301s"
+//	NormalizeInput(true)
+//	Plural("cafe\u0301")   // returns "cafés"
+func NormalizeInput(enabled bool) {
+	defaultEngine.NormalizeInput(enabled)
+}
+
+// NormalizeInput enables or disables Unicode normalization (NFC) of the
+// words passed to e.Plural, e.Singular, and e.An. See the package-level
+// NormalizeInput for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.NormalizeInput(true)
+//	e.Plural("cafe\u0301")   // returns "cafés"
+func (e *Engine) NormalizeInput(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.normalizeInput = enabled
+}
+
+// IsNormalizeInput returns whether input to Plural, Singular, and An is
+// normalized to NFC.
+//
+// Examples:
+//
+//	IsNormalizeInput() // returns false (default)
+//	NormalizeInput(true)
+//	IsNormalizeInput() // returns true
+func IsNormalizeInput() bool {
+	return defaultEngine.IsNormalizeInput()
+}
+
+// IsNormalizeInput returns whether input to e.Plural, e.Singular, and e.An
+// is normalized to NFC.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.IsNormalizeInput() // returns false (default)
+func (e *Engine) IsNormalizeInput() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.normalizeInput
+}
+
+// normalize returns word in NFC if input normalization is enabled.
+func (e *Engine) normalize(word string) string {
+	if !e.IsNormalizeInput() {
+		return word
+	}
+	return norm.NFC.String(word)
+}
+
+// splitInvisible splits the invisible formatting characters at either end
+// of word, such as zero-width joiners and spaces, soft hyphens, and byte
+// order marks, from the word between them.
+func splitInvisible(word string) (prefix, trimmed, suffix string) {
+	trimmed = strings.TrimLeftFunc(word, isInvisible)
+	prefix = word[:len(word)-len(trimmed)]
+	trimmed = strings.TrimRightFunc(trimmed, isInvisible)
+	suffix = word[len(prefix)+len(trimmed):]
+	return prefix, trimmed, suffix
+}
+
+// isInvisible reports whether r is an invisible formatting character, such
+// as U+200D ZERO WIDTH JOINER or U+00AD SOFT HYPHEN.
+func isInvisible(r rune) bool {
+	return unicode.Is(unicode.Cf, r)
+}
+
+// lastBaseRune returns the last rune of word that is not a combining mark,
+// so that "cafe\u0301" (NFD) ends in "e" like "café" does.
+func lastBaseRune(word string) rune {
+	for word != "" {
+		r, size := utf8.DecodeLastRuneInString(word)
+		if !unicode.Is(unicode.M, r) {
+			return r
+		}
+		word = word[:len(word)-size]
+	}
+	return utf8.RuneError
+}
+
+// firstBaseRune returns the first rune of word without any accent, so that
+// "éclair" starts with "e" whether it is written in NFC or NFD.
+func firstBaseRune(word string) rune {
+	r, _ := utf8.DecodeRuneInString(word)
+	if r < utf8.RuneSelf {
+		return r
+	}
+	r, _ = utf8.DecodeRuneInString(norm.NFD.String(string(r)))
+	return r
+}
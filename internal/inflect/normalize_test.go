@@ -0,0 +1,79 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralInvisibleCharacters(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want string
+	}{
+		{name: "NFC accent", word: "café", want: "cafés"},
+		{name: "NFD accent", word: "cafe\u0301", want: "cafe\u0301s"},
+		{name: "NFD accent inside", word: "fiance\u0301e", want: "fiance\u0301es"},
+		{name: "trailing ZWJ", word: "man\u200d", want: "men\u200d"},
+		{name: "leading ZWSP", word: "\u200bchild", want: "\u200bchildren"},
+		{name: "soft hyphen", word: "box\u00ad", want: "boxes\u00ad"},
+		{name: "only invisible", word: "\u200b\u200d", want: "\u200b\u200d"},
+		{name: "emoji ZWJ sequence", word: "👩\u200d💻", want: "👩\u200d💻"},
+		{name: "emoji", word: "👍", want: "👍"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Plural(tt.word))
+		})
+	}
+}
+
+func TestSingularInvisibleCharacters(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want string
+	}{
+		{name: "NFD accent", word: "cafe\u0301s", want: "cafe\u0301"},
+		{name: "trailing ZWJ", word: "men\u200d", want: "man\u200d"},
+		{name: "leading ZWSP", word: "\u200bchildren", want: "\u200bchild"},
+		{name: "emoji ZWJ sequence", word: "👩\u200d💻", want: "👩\u200d💻"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.word))
+		})
+	}
+}
+
+func TestAnInvisibleCharacters(t *testing.T) {
+	assert.Equal(t, "an \u200bapple", inflect.An("\u200bapple"))
+	assert.Equal(t, "an e\u0301clair", inflect.An("e\u0301clair"))
+	assert.Equal(t, "an éclair", inflect.An("éclair"))
+}
+
+func TestNormalizeInput(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.False(t, e.IsNormalizeInput())
+	assert.Equal(t, "cafe\u0301s", e.Plural("cafe\u0301"))
+
+	e.NormalizeInput(true)
+	assert.True(t, e.IsNormalizeInput())
+	assert.Equal(t, "cafés", e.Plural("cafe\u0301"))
+	assert.Equal(t, "café", e.Singular("cafe\u0301s"))
+	assert.Equal(t, "an éclair", e.An("e\u0301clair"))
+	assert.Equal(t, []string{"suffix rule (default + -s): café -> cafés"}, e.ExplainPlural("cafe\u0301"))
+
+	clone := e.Clone()
+	assert.True(t, clone.IsNormalizeInput())
+
+	e.Reset()
+	assert.False(t, e.IsNormalizeInput())
+	assert.Equal(t, "cafe\u0301s", e.Plural("cafe\u0301"))
+	assert.True(t, clone.IsNormalizeInput())
+}
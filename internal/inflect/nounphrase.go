@@ -0,0 +1,119 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// phrasePrepositions contains the prepositions that end the head of a noun
+// phrase: in "cup of coffee", "cup" is the head and "of coffee" its
+// complement.
+var phrasePrepositions = map[string]bool{
+	"about": true, "after": true, "against": true, "among": true, "around": true,
+	"at": true, "before": true, "behind": true, "between": true, "beyond": true,
+	"by": true, "during": true, "for": true, "from": true, "in": true,
+	"into": true, "of": true, "on": true, "over": true, "per": true,
+	"through": true, "to": true, "under": true, "with": true, "within": true,
+	"without": true,
+}
+
+// PluralNounPhrase returns the plural form of a noun phrase by inflecting
+// its head noun, the word before the first preposition, and keeping the
+// complement that follows it. Phrases without a preposition have their last
+// word made plural, as with PluralLastWord.
+//
+// The same applies within hyphenated compounds, and to a compound as the
+// head of a longer phrase.
+//
+// Examples:
+//   - PluralNounPhrase("cup of coffee") returns "cups of coffee"
+//   - PluralNounPhrase("board of directors") returns "boards of directors"
+//   - PluralNounPhrase("piece of information") returns "pieces of information"
+//   - PluralNounPhrase("mother-in-law") returns "mothers-in-law"
+//   - PluralNounPhrase("blue bird") returns "blue birds"
+func PluralNounPhrase(phrase string) string {
+	return defaultEngine.PluralNounPhrase(phrase)
+}
+
+// PluralNounPhrase returns the plural form of a noun phrase by inflecting
+// its head noun. See the package-level PluralNounPhrase for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.PluralNounPhrase("man of war") // returns "men of war"
+func (e *Engine) PluralNounPhrase(phrase string) string {
+	return inflectNounPhrase(phrase, e.Plural)
+}
+
+// SingularNounPhrase returns the singular form of a noun phrase by
+// inflecting its head noun, the word before the first preposition, and
+// keeping the complement that follows it. Phrases without a preposition
+// have their last word made singular, as with SingularLastWord.
+//
+// Examples:
+//   - SingularNounPhrase("cups of coffee") returns "cup of coffee"
+//   - SingularNounPhrase("boards of directors") returns "board of directors"
+//   - SingularNounPhrase("mothers-in-law") returns "mother-in-law"
+func SingularNounPhrase(phrase string) string {
+	return defaultEngine.SingularNounPhrase(phrase)
+}
+
+// SingularNounPhrase returns the singular form of a noun phrase by
+// inflecting its head noun. See the package-level SingularNounPhrase for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SingularNounPhrase("men of war") // returns "man of war"
+func (e *Engine) SingularNounPhrase(phrase string) string {
+	return inflectNounPhrase(phrase, e.Singular)
+}
+
+// inflectNounPhrase applies inflect to the head noun of a phrase and keeps
+// the other words and the whitespace between them.
+func inflectNounPhrase(phrase string, inflect func(string) string) string {
+	start, end := phraseHead(phrase, unicode.IsSpace)
+	if start < 0 {
+		return phrase
+	}
+
+	// A hyphenated compound has a head of its own: mother-in-law
+	word := phrase[start:end]
+	if i, j := phraseHead(word, func(r rune) bool { return r == '-' }); i >= 0 && j < len(word) {
+		return phrase[:start] + word[:i] + inflect(word[i:j]) + word[j:] + phrase[end:]
+	}
+	return phrase[:start] + inflect(word) + phrase[end:]
+}
+
+// phraseHead returns the byte offsets of the head of a phrase whose words
+// are separated by runes for which isSep is true: the word before the first
+// preposition that is followed by a complement, or else the last word. It
+// returns -1, -1 if the phrase has no words.
+func phraseHead(phrase string, isSep func(rune) bool) (start, end int) {
+	type span struct{ start, end int }
+	var words []span
+	inWord := false
+	for i, r := range phrase {
+		switch {
+		case isSep(r) && inWord:
+			words[len(words)-1].end = i
+			inWord = false
+		case !isSep(r) && !inWord:
+			words = append(words, span{start: i, end: len(phrase)})
+			inWord = true
+		}
+	}
+	if len(words) == 0 {
+		return -1, -1
+	}
+
+	for k := 1; k < len(words)-1; k++ {
+		if phrasePrepositions[strings.ToLower(phrase[words[k].start:words[k].end])] {
+			return words[k-1].start, words[k-1].end
+		}
+	}
+	last := words[len(words)-1]
+	return last.start, last.end
+}
@@ -0,0 +1,53 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralNounPhrase(t *testing.T) {
+	tests := []struct {
+		name     string
+		singular string
+		plural   string
+	}{
+		{name: "head before of", singular: "cup of coffee", plural: "cups of coffee"},
+		{name: "plural complement", singular: "board of directors", plural: "boards of directors"},
+		{name: "mass noun complement", singular: "piece of information", plural: "pieces of information"},
+		{name: "irregular head", singular: "man of war", plural: "men of war"},
+		{name: "other preposition", singular: "cat in the hat", plural: "cats in the hat"},
+		{name: "first preposition", singular: "bottle of wine from France", plural: "bottles of wine from France"},
+		{name: "modifiers before head", singular: "large cup of tea", plural: "large cups of tea"},
+		{name: "hyphenated compound", singular: "mother-in-law", plural: "mothers-in-law"},
+		{name: "compound head", singular: "mother-in-law of the bride", plural: "mothers-in-law of the bride"},
+		{name: "hyphenated modifier", singular: "state-of-the-art design", plural: "state-of-the-art designs"},
+		{name: "trailing particle", singular: "break-in", plural: "break-ins"},
+		{name: "no preposition", singular: "blue bird", plural: "blue birds"},
+		{name: "single word", singular: "child", plural: "children"},
+		{name: "case", singular: "Cup Of Coffee", plural: "Cups Of Coffee"},
+		{name: "whitespace", singular: " cup  of tea ", plural: " cups  of tea "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.plural, inflect.PluralNounPhrase(tt.singular))
+			assert.Equal(t, tt.singular, inflect.SingularNounPhrase(tt.plural))
+		})
+	}
+}
+
+func TestNounPhraseEmpty(t *testing.T) {
+	assert.Equal(t, "", inflect.PluralNounPhrase(""))
+	assert.Equal(t, "  ", inflect.SingularNounPhrase("  "))
+}
+
+func TestNounPhraseEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("bag", "baggen")
+	assert.Equal(t, "baggen of chips", e.PluralNounPhrase("bag of chips"))
+	assert.Equal(t, "bag of chips", e.SingularNounPhrase("baggen of chips"))
+	assert.Equal(t, "bags of chips", inflect.PluralNounPhrase("bag of chips"))
+}
@@ -3,8 +3,11 @@ package inflect
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // onesCardinal maps 1-19 to their cardinal word forms.
@@ -33,6 +36,9 @@ var tensOrdinal = []string{
 	"seventieth", "eightieth", "ninetieth",
 }
 
+// digitWords maps 0-9 to the words used when reading digits individually.
+var digitWords = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
 // wordZero is the word representation of zero.
 const wordZero = "zero"
 
@@ -52,6 +58,63 @@ func NumberToWords(n int) string {
 	return cardinalWord(n)
 }
 
+// CardinalWord converts the magnitude of an integer to its English word
+// representation: NumberToWords without the "negative" prefix.
+//
+// It is meant for composing phrases from number words, where the sign is
+// worded separately or does not apply.
+//
+// Examples:
+//   - CardinalWord(0) returns "zero"
+//   - CardinalWord(42) returns "forty-two"
+//   - CardinalWord(1001) returns "one thousand one"
+//   - CardinalWord(-5) returns "five"
+func CardinalWord(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	return cardinalWord(n)
+}
+
+// TensWord returns the word for a tens digit: "ten" for 1 through "ninety"
+// for 9, or "" for any other digit.
+//
+// Together with OnesWord, it gives the words NumberToWords is built from,
+// for phrases such as "twenty-odd" or "the forties".
+//
+// Examples:
+//   - TensWord(2) returns "twenty"
+//   - TensWord(9) returns "ninety"
+//   - TensWord(1) returns "ten"
+//   - TensWord(0) returns ""
+func TensWord(digit int) string {
+	switch {
+	case digit == 1:
+		return onesCardinal[10]
+	case digit >= 2 && digit <= 9:
+		return tensCardinal[digit]
+	}
+	return ""
+}
+
+// OnesWord returns the word for a number from 0 to 19, the numbers that
+// have a word of their own, or "" for any other number.
+//
+// Examples:
+//   - OnesWord(0) returns "zero"
+//   - OnesWord(7) returns "seven"
+//   - OnesWord(13) returns "thirteen"
+//   - OnesWord(20) returns ""
+func OnesWord(n int) string {
+	switch {
+	case n == 0:
+		return wordZero
+	case n >= 1 && n <= 19:
+		return onesCardinal[n]
+	}
+	return ""
+}
+
 // NumberToWordsWithAnd converts an integer to its English word representation
 // using British English style with "and" before the final part.
 //
@@ -74,70 +137,240 @@ func NumberToWordsWithAnd(n int) string {
 // cardinalWordWithAnd converts a positive integer to its cardinal word form
 // using British English style with "and".
 func cardinalWordWithAnd(n int) string {
-	if n == 0 {
-		return wordZero
+	return spellNumber(n, NumberWordOptions{And: true}, false)
+}
+
+// NumberWordOptions controls the style of the words written by
+// NumberToWordsWithOptions and OrdinalWordWithOptions, so that cardinal and
+// ordinal numbers in a text can share one style.
+//
+// The zero value writes the American style of NumberToWords and
+// OrdinalWord: "one hundred twenty-one", "one hundred twenty-first".
+type NumberWordOptions struct {
+	// And inserts "and" after "hundred", and after "thousand", "million",
+	// and "billion" when followed by a number below 100, as British
+	// English and the Chicago Manual of Style do: "one hundred and
+	// twenty-first", "one thousand and one".
+	And bool
+
+	// OmitHyphens separates the tens from the ones with a space instead of
+	// a hyphen: "twenty one", "twenty first".
+	OmitHyphens bool
+}
+
+// NumberToWordsWithOptions converts an integer to its English word
+// representation in the style of opts.
+//
+// Examples:
+//   - NumberToWordsWithOptions(121, NumberWordOptions{}) returns "one hundred twenty-one"
+//   - NumberToWordsWithOptions(121, NumberWordOptions{And: true}) returns "one hundred and twenty-one"
+//   - NumberToWordsWithOptions(1001, NumberWordOptions{And: true}) returns "one thousand and one"
+//   - NumberToWordsWithOptions(42, NumberWordOptions{OmitHyphens: true}) returns "forty two"
+//   - NumberToWordsWithOptions(-21, NumberWordOptions{And: true}) returns "negative twenty-one"
+func NumberToWordsWithOptions(n int, opts NumberWordOptions) string {
+	if n < 0 {
+		return "negative " + spellNumber(-n, opts, false)
 	}
+	return spellNumber(n, opts, false)
+}
+
+// numberScales lists the scale words used by spellNumber, largest first.
+var numberScales = []struct {
+	value int
+	word  string
+}{
+	{1000000000, "billion"},
+	{1000000, "million"},
+	{1000, "thousand"},
+	{100, "hundred"},
+}
 
+// spellNumber converts a non-negative integer to its cardinal word form, or
+// a positive integer to its ordinal word form, in the style of opts.
+func spellNumber(n int, opts NumberWordOptions, ordinal bool) string {
 	if n <= 19 {
+		if ordinal {
+			return onesOrdinal[n]
+		}
+		if n == 0 {
+			return wordZero
+		}
 		return onesCardinal[n]
 	}
 
-	if n < 100 && n%10 == 0 {
-		return tensCardinal[n/10]
+	if n < 100 {
+		if n%10 == 0 {
+			if ordinal {
+				return tensOrdinal[n/10]
+			}
+			return tensCardinal[n/10]
+		}
+		sep := "-"
+		if opts.OmitHyphens {
+			sep = " "
+		}
+		return tensCardinal[n/10] + sep + spellNumber(n%10, opts, ordinal)
 	}
 
-	if n < 100 {
-		return tensCardinal[n/10] + "-" + onesCardinal[n%10]
+	for _, scale := range numberScales {
+		if n < scale.value {
+			continue
+		}
+		head := spellNumber(n/scale.value, opts, false) + " " + scale.word
+		rest := n % scale.value
+		if rest == 0 {
+			if ordinal {
+				return head + "th"
+			}
+			return head
+		}
+		if opts.And && (scale.value == 100 || rest < 100) {
+			return head + " and " + spellNumber(rest, opts, ordinal)
+		}
+		return head + " " + spellNumber(rest, opts, ordinal)
 	}
+	return ""
+}
 
-	if n < 1000 && n%100 == 0 {
-		return onesCardinal[n/100] + " hundred"
+// scaleValues maps the scale words read by WordsToNumber to their values.
+var scaleValues = map[string]int{
+	"thousand":    1e3,
+	"million":     1e6,
+	"billion":     1e9,
+	"trillion":    1e12,
+	"quadrillion": 1e15,
+	"quintillion": 1e18,
+}
+
+// unitValues maps the cardinal words for 1-19 and the tens to their values.
+var unitValues = buildUnitValues()
+
+// buildUnitValues builds unitValues from onesCardinal and tensCardinal.
+func buildUnitValues() map[string]int {
+	values := make(map[string]int, len(onesCardinal)+len(tensCardinal))
+	for i, w := range onesCardinal[1:] {
+		values[w] = i + 1
+	}
+	for i, w := range tensCardinal[2:] {
+		values[w] = (i + 2) * 10
 	}
+	return values
+}
 
-	if n < 1000 {
-		// Insert "and" after hundreds
-		return onesCardinal[n/100] + " hundred and " + cardinalWordWithAnd(n%100)
+// WordsToNumber converts English number words to an integer. It is the
+// inverse of NumberToWords and NumberToWordsWithAnd.
+//
+// Words may be separated by spaces, hyphens, or commas, and "and" is
+// ignored. It returns 0 if the words cannot be parsed; use WordsToNumberE
+// to tell a parse failure from zero.
+//
+// Examples:
+//   - WordsToNumber("forty-two") returns 42
+//   - WordsToNumber("one hundred and five") returns 105
+//   - WordsToNumber("fifteen hundred") returns 1500
+//   - WordsToNumber("negative three thousand, two") returns -3002
+//   - WordsToNumber("zero") returns 0
+//   - WordsToNumber("lots") returns 0
+func WordsToNumber(words string) int {
+	n, _ := WordsToNumberE(words)
+	return n
+}
+
+// WordsToNumberE converts English number words to an integer, like
+// WordsToNumber, but reports input it cannot parse instead of returning 0.
+//
+// It returns ErrUnknownWord if a word is not a number word or is out of
+// place (as in "one two" or "hundred"), and ErrOverflow if the number does
+// not fit in an int.
+//
+// Examples:
+//   - WordsToNumberE("forty-two") returns (42, nil)
+//   - WordsToNumberE("two million three hundred thousand") returns (2300000, nil)
+//   - WordsToNumberE("forty-lots") returns (0, ErrUnknownWord)
+//   - WordsToNumberE("one two") returns (0, ErrUnknownWord)
+//   - WordsToNumberE("ten quintillion") returns (0, ErrOverflow)
+func WordsToNumberE(words string) (int, error) {
+	fields := strings.FieldsFunc(strings.ToLower(words), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == ','
+	})
+
+	sign := 1
+	if len(fields) > 0 && (fields[0] == "negative" || fields[0] == "minus") {
+		sign = -1
+		fields = fields[1:]
+	}
+	if len(fields) == 1 && fields[0] == wordZero {
+		return 0, nil
 	}
+	fields = slices.DeleteFunc(fields, func(f string) bool { return f == "and" })
 
-	if n < 1000000 && n%1000 == 0 {
-		return cardinalWordWithAnd(n/1000) + " thousand"
+	n, err := parseNumberWords(fields)
+	if err != nil {
+		return 0, fmt.Errorf("%w in %q", err, words)
 	}
+	return sign * n, nil
+}
 
-	if n < 1000000 {
-		remainder := n % 1000
-		prefix := cardinalWordWithAnd(n/1000) + " thousand"
-		// If remainder is less than 100, add "and"
-		if remainder < 100 {
-			return prefix + " and " + cardinalWordWithAnd(remainder)
+// parseNumberWords converts lowercase number words to an integer. The last
+// occurrence of the largest scale word multiplies everything before it, so
+// nested scales such as "one thousand billion" are read the way
+// NumberToWords writes them.
+func parseNumberWords(fields []string) (int, error) {
+	split, scale := -1, 0
+	for i, f := range fields {
+		if s, ok := scaleValues[f]; ok && s >= scale {
+			split, scale = i, s
 		}
-		return prefix + " " + cardinalWordWithAnd(remainder)
 	}
-
-	if n < 1000000000 && n%1000000 == 0 {
-		return cardinalWordWithAnd(n/1000000) + " million"
+	if split < 0 {
+		return parseHundreds(fields)
 	}
 
-	if n < 1000000000 {
-		remainder := n % 1000000
-		prefix := cardinalWordWithAnd(n/1000000) + " million"
-		// If remainder is less than 100, add "and"
-		if remainder < 100 {
-			return prefix + " and " + cardinalWordWithAnd(remainder)
+	left, err := parseNumberWords(fields[:split])
+	if err != nil {
+		return 0, err
+	}
+	right := 0
+	if split+1 < len(fields) {
+		if right, err = parseNumberWords(fields[split+1:]); err != nil {
+			return 0, err
 		}
-		return prefix + " " + cardinalWordWithAnd(remainder)
 	}
+	if right >= scale {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownWord, fields[split])
+	}
+	if left > (math.MaxInt-right)/scale {
+		return 0, ErrOverflow
+	}
+	return left*scale + right, nil
+}
 
-	if n%1000000000 == 0 {
-		return cardinalWordWithAnd(n/1000000000) + " billion"
+// parseHundreds converts lowercase number words below a thousand, or a
+// count of hundreds such as "fifteen hundred", to an integer, checking that
+// each word is valid where it appears.
+func parseHundreds(fields []string) (int, error) {
+	if len(fields) == 0 {
+		return 0, ErrUnknownWord
 	}
 
-	remainder := n % 1000000000
-	prefix := cardinalWordWithAnd(n/1000000000) + " billion"
-	// If remainder is less than 100, add "and"
-	if remainder < 100 {
-		return prefix + " and " + cardinalWordWithAnd(remainder)
+	n := 0
+	for _, f := range fields {
+		v, ok := unitValues[f]
+		switch {
+		case f == "hundred" && n > 0 && n < 100:
+			n *= 100
+		case !ok:
+			return 0, fmt.Errorf("%w: %q", ErrUnknownWord, f)
+		default:
+			// Units need an empty ones place; teens and tens an empty tens place
+			rem := n % 100
+			if v >= 10 && rem != 0 || v < 10 && (rem%10 != 0 || rem == 10) {
+				return 0, fmt.Errorf("%w: %q", ErrUnknownWord, f)
+			}
+			n += v
+		}
 	}
-	return prefix + " " + cardinalWordWithAnd(remainder)
+	return n, nil
 }
 
 // NumberToWordsFloat converts a floating-point number to its English word representation.
@@ -190,7 +423,6 @@ func NumberToWordsFloatWithDecimal(f float64, decimal string) string {
 	parts = append(parts, prefix+cardinalWord(intPart), decimal)
 
 	// Convert each decimal digit individually
-	digitWords := []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
 	for _, ch := range decimalDigits {
 		digit := int(ch - '0')
 		parts = append(parts, digitWords[digit])
@@ -272,53 +504,177 @@ func NumberToWordsGrouped(n, groupSize int) string {
 	return prefix + strings.Join(words, " ")
 }
 
-// cardinalWord converts a positive integer to its cardinal word form.
-func cardinalWord(n int) string {
-	if n == 0 {
-		return wordZero
+// NumberStringToWordsGrouped converts a string of digits to English words
+// by splitting it into groups of the specified size and converting each
+// group independently, like NumberToWordsGrouped.
+//
+// Unlike NumberToWordsGrouped, which takes an int, this keeps leading
+// zeros, so it suits account numbers, PINs, and codes that are read back
+// to a user. Zeros at the start of a group are read one by one before the
+// rest of the group: "0123" in groups of 2 is "zero one twenty-three". The
+// string is split from right to left. A group too long to fit in an int is
+// read digit by digit. Surrounding spaces are ignored.
+//
+// It returns ErrNotDigits if the string is empty or contains anything
+// other than the digits 0-9, including signs and separators, and
+// ErrInvalidGroupSize if groupSize is less than 1.
+//
+// Examples:
+//   - NumberStringToWordsGrouped("0123", 2) returns ("zero one twenty-three", nil)
+//   - NumberStringToWordsGrouped("007", 3) returns ("zero zero seven", nil)
+//   - NumberStringToWordsGrouped("4152", 2) returns ("forty-one fifty-two", nil)
+//   - NumberStringToWordsGrouped("12345", 2) returns ("one twenty-three forty-five", nil)
+//   - NumberStringToWordsGrouped("12-34", 2) returns ("", ErrNotDigits)
+//   - NumberStringToWordsGrouped("0123", 0) returns ("", ErrInvalidGroupSize)
+func NumberStringToWordsGrouped(s string, groupSize int) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsFunc(s, func(r rune) bool { return r < '0' || r > '9' }) {
+		return "", fmt.Errorf("%w: %q", ErrNotDigits, s)
 	}
-
-	if n <= 19 {
-		return onesCardinal[n]
+	if groupSize < 1 {
+		return "", fmt.Errorf("%w: %d", ErrInvalidGroupSize, groupSize)
 	}
 
-	if n < 100 && n%10 == 0 {
-		return tensCardinal[n/10]
+	// The leftmost group may have fewer digits than the others
+	first := len(s) % groupSize
+	if first == 0 {
+		first = groupSize
 	}
-
-	if n < 100 {
-		return tensCardinal[n/10] + "-" + onesCardinal[n%10]
+	words := []string{digitGroupWords(s[:first])}
+	for i := first; i < len(s); i += groupSize {
+		words = append(words, digitGroupWords(s[i:i+groupSize]))
 	}
+	return strings.Join(words, " "), nil
+}
 
-	if n < 1000 && n%100 == 0 {
-		return onesCardinal[n/100] + " hundred"
+// digitGroupWords reads a group of digits as a number, after reading any
+// zeros at its start one by one: "012" -> "zero twelve".
+func digitGroupWords(group string) string {
+	rest := strings.TrimLeft(group, "0")
+	zeros := len(group) - len(rest)
+	if rest == "" {
+		zeros = len(group)
 	}
-
-	if n < 1000 {
-		return onesCardinal[n/100] + " hundred " + cardinalWord(n%100)
+	words := slices.Repeat([]string{wordZero}, zeros)
+	if n, err := strconv.Atoi(rest); err == nil {
+		words = append(words, cardinalWord(n))
+	} else if rest != "" {
+		// Too long for an int
+		words = append(words, DigitsToWordsWithOptions(rest, DigitsToWordsOptions{Separator: " "}))
 	}
+	return strings.Join(words, " ")
+}
+
+// NumberToWordsBareOptions controls how NumberToWordsBareWithOptions reads
+// the groups of a number.
+//
+// The zero value reads zero as "zero" and speaks the zeros at the start of
+// each group.
+type NumberToWordsBareOptions struct {
+	// Zero is the word used for a zero digit, such as "oh". Empty means
+	// "zero".
+	Zero string
 
-	if n < 1000000 && n%1000 == 0 {
-		return cardinalWord(n/1000) + " thousand"
+	// OmitLeadingZeros drops the zeros at the start of each group, so that
+	// 120034 is read "one twenty thirty-four". The result is shorter, but
+	// no longer tells how many digits each group has.
+	OmitLeadingZeros bool
+}
+
+// NumberToWordsBare reads an integer aloud one thousands group at a time,
+// without the scale words "thousand", "million", and so on.
+//
+// Each group of three digits is read as its first digit followed by the
+// number formed by the last two, the way numbers such as room numbers and
+// flight numbers are said, and zeros within a group are spoken digit by
+// digit. Unlike NumberToWordsGrouped, which reads each group as a number,
+// this keeps every digit: 100 is "one zero zero", not "one hundred".
+//
+// Examples:
+//   - NumberToWordsBare(120034) returns "one twenty zero thirty-four"
+//   - NumberToWordsBare(2024) returns "two zero twenty-four"
+//   - NumberToWordsBare(555) returns "five fifty-five"
+//   - NumberToWordsBare(105) returns "one zero five"
+//   - NumberToWordsBare(-42) returns "negative forty-two"
+func NumberToWordsBare(n int) string {
+	return NumberToWordsBareWithOptions(n, NumberToWordsBareOptions{})
+}
+
+// NumberToWordsBareWithOptions reads an integer aloud one thousands group
+// at a time, like NumberToWordsBare, using the word for zero and the
+// handling of leading zeros in opts.
+//
+// Examples:
+//   - NumberToWordsBareWithOptions(120034, NumberToWordsBareOptions{Zero: "oh"})
+//     returns "one twenty oh thirty-four"
+//   - NumberToWordsBareWithOptions(120034, NumberToWordsBareOptions{OmitLeadingZeros: true})
+//     returns "one twenty thirty-four"
+//   - NumberToWordsBareWithOptions(1000, NumberToWordsBareOptions{OmitLeadingZeros: true})
+//     returns "one zero"
+func NumberToWordsBareWithOptions(n int, opts NumberToWordsBareOptions) string {
+	zero := opts.Zero
+	if zero == "" {
+		zero = wordZero
 	}
 
-	if n < 1000000 {
-		return cardinalWord(n/1000) + " thousand " + cardinalWord(n%1000)
+	// Use the digits of the string, so that math.MinInt can be negated
+	s := strconv.Itoa(n)
+	prefix := ""
+	if n < 0 {
+		prefix = "negative "
+		s = s[1:]
 	}
 
-	if n < 1000000000 && n%1000000 == 0 {
-		return cardinalWord(n/1000000) + " million"
+	// The leftmost group may have fewer than three digits
+	first := len(s) % 3
+	if first == 0 {
+		first = 3
+	}
+	words := []string{bareGroup(s[:first], zero)}
+	for i := first; i < len(s); i += 3 {
+		group := s[i : i+3]
+		if opts.OmitLeadingZeros {
+			group = strings.TrimLeft(group, "0")
+			if group == "" {
+				group = "0"
+			}
+		}
+		words = append(words, bareGroup(group, zero))
 	}
+	return prefix + strings.Join(words, " ")
+}
 
-	if n < 1000000000 {
-		return cardinalWord(n/1000000) + " million " + cardinalWord(n%1000000)
+// bareGroup reads a group of one to three digits for NumberToWordsBare: a
+// three-digit group as its first digit and the number formed by the last
+// two, with zero read as zero.
+func bareGroup(group, zero string) string {
+	digit := func(d byte) string {
+		if d == '0' {
+			return zero
+		}
+		return onesCardinal[d-'0']
 	}
 
-	if n%1000000000 == 0 {
-		return cardinalWord(n/1000000000) + " billion"
+	var words []string
+	if len(group) == 3 {
+		words = append(words, digit(group[0]))
+		group = group[1:]
 	}
+	if len(group) == 2 && group[0] == '0' {
+		// "05" -> "zero five"
+		words = append(words, zero)
+		group = group[1:]
+	}
+	if len(group) == 1 {
+		return strings.Join(append(words, digit(group[0])), " ")
+	}
+	pair, _ := strconv.Atoi(group)
+	return strings.Join(append(words, cardinalWord(pair)), " ")
+}
 
-	return cardinalWord(n/1000000000) + " billion " + cardinalWord(n%1000000000)
+// cardinalWord converts a positive integer to its cardinal word form.
+func cardinalWord(n int) string {
+	return spellNumber(n, NumberWordOptions{}, false)
 }
 
 // FormatNumber formats an integer with commas as thousand separators.
@@ -365,6 +721,142 @@ func FormatNumber(n int) string {
 	return result.String()
 }
 
+// FormatNumberOptions controls digit grouping for FormatNumberWithOptions and
+// FormatFloatWithOptions.
+//
+// The zero value produces the same output as FormatNumber: commas between
+// groups of three digits, a period as the decimal separator, and no decimal
+// places.
+type FormatNumberOptions struct {
+	// Separator is placed between digit groups. Zero means ','.
+	Separator rune
+
+	// GroupSize is the number of digits per group. Zero or negative means 3.
+	GroupSize int
+
+	// DecimalSeparator separates the integer and fractional parts of a
+	// float. Zero means '.'.
+	DecimalSeparator rune
+
+	// Decimals is the number of digits after the decimal separator when
+	// formatting a float. A negative value uses the fewest digits needed
+	// to represent the value exactly.
+	Decimals int
+}
+
+// FormatNumberWithOptions formats an integer with a configurable thousands
+// separator and group size.
+//
+// Examples:
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{}) returns "1,234,567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{Separator: '.'}) returns "1.234.567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{Separator: ' '}) returns "1 234 567"
+//   - FormatNumberWithOptions(1234567, FormatNumberOptions{GroupSize: 4}) returns "123,4567"
+//   - FormatNumberWithOptions(-1234, FormatNumberOptions{Separator: '_'}) returns "-1_234"
+func FormatNumberWithOptions(n int, opts FormatNumberOptions) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign = "-"
+		s = s[1:]
+	}
+	return sign + groupDigits(s, opts.separator(), opts.groupSize())
+}
+
+// FormatFloat formats a floating-point number with commas as thousand
+// separators, rounded to the given number of decimal places.
+//
+// A negative decimals value uses the fewest digits needed to represent
+// the value exactly. NaN and infinities are returned as "NaN", "+Inf",
+// and "-Inf".
+//
+// Examples:
+//   - FormatFloat(1234.567, 2) returns "1,234.57"
+//   - FormatFloat(1234567.5, 0) returns "1,234,568"
+//   - FormatFloat(-9876.5, 1) returns "-9,876.5"
+//   - FormatFloat(0.125, -1) returns "0.125"
+func FormatFloat(f float64, decimals int) string {
+	return FormatFloatWithOptions(f, FormatNumberOptions{Decimals: decimals})
+}
+
+// FormatFloatWithOptions formats a floating-point number using the grouping,
+// separator, and precision settings in opts.
+//
+// Examples:
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Decimals: 2}) returns "1,234.50"
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Separator: '.', DecimalSeparator: ',', Decimals: 2}) returns "1.234,50"
+//   - FormatFloatWithOptions(1234.5, FormatNumberOptions{Separator: ' ', DecimalSeparator: ',', Decimals: -1}) returns "1 234,5"
+func FormatFloatWithOptions(f float64, opts FormatNumberOptions) string {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	s := strconv.FormatFloat(f, 'f', opts.Decimals, 64)
+	sign := ""
+	if s[0] == '-' {
+		s = s[1:]
+		// Don't render "-0" or "-0.00" for values that round to zero
+		if strings.Trim(s, "0.") != "" {
+			sign = "-"
+		}
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	result := sign + groupDigits(intPart, opts.separator(), opts.groupSize())
+	if hasFrac {
+		result += string(opts.decimalSeparator()) + fracPart
+	}
+	return result
+}
+
+// separator returns the group separator, defaulting to ','.
+func (o FormatNumberOptions) separator() rune {
+	if o.Separator == 0 {
+		return ','
+	}
+	return o.Separator
+}
+
+// groupSize returns the group size, defaulting to 3.
+func (o FormatNumberOptions) groupSize() int {
+	if o.GroupSize <= 0 {
+		return 3
+	}
+	return o.GroupSize
+}
+
+// decimalSeparator returns the decimal separator, defaulting to '.'.
+func (o FormatNumberOptions) decimalSeparator() rune {
+	if o.DecimalSeparator == 0 {
+		return '.'
+	}
+	return o.DecimalSeparator
+}
+
+// groupDigits inserts sep between groups of size digits in s, counting
+// from the right. s must contain only digits.
+func groupDigits(s string, sep rune, size int) string {
+	if len(s) <= size {
+		return s
+	}
+
+	var result strings.Builder
+	result.Grow(len(s) + (len(s)-1)/size*utf8.RuneLen(sep))
+
+	firstGroup := len(s) % size
+	if firstGroup == 0 {
+		firstGroup = size
+	}
+	result.WriteString(s[:firstGroup])
+
+	for i := firstGroup; i < len(s); i += size {
+		result.WriteRune(sep)
+		result.WriteString(s[i : i+size])
+	}
+
+	return result.String()
+}
+
 // No returns a count and noun phrase in English, using "no" for zero counts.
 //
 // The function handles pluralization automatically:
@@ -407,16 +899,118 @@ func No(word string, count int) string {
 //   - e.No("error", 0) returns "no error"
 //   - e.No("child", 0) returns "no child"
 func (e *Engine) No(word string, count int) string {
+	return e.noWithFormat(word, count, strconv.Itoa)
+}
+
+// NoWords returns a count and noun phrase in English with the count spelled
+// out in words, using "no" for zero counts.
+//
+// Pluralization follows the same rules as No().
+//
+// Examples:
+//   - NoWords("error", 0) returns "no errors"
+//   - NoWords("error", 1) returns "one error"
+//   - NoWords("error", 3) returns "three errors"
+//   - NoWords("child", 21) returns "twenty-one children"
+func NoWords(word string, count int) string {
+	return defaultEngine.NoWords(word, count)
+}
+
+// NoWords returns a count and noun phrase in English with the count spelled
+// out in words, using "no" for zero counts.
+//
+// Pluralization follows the same rules as No().
+//
+// Examples:
+//   - e.NoWords("error", 0) returns "no errors"
+//   - e.NoWords("error", 1) returns "one error"
+//   - e.NoWords("error", 3) returns "three errors"
+//   - e.NoWords("child", 21) returns "twenty-one children"
+func (e *Engine) NoWords(word string, count int) string {
+	return e.noWithFormat(word, count, NumberToWords)
+}
+
+// NoCapitalized returns a count and noun phrase for the start of a
+// sentence, with the first word capitalized.
+//
+// Since a sentence should not start with a numeral, the count is spelled
+// out as in NoWords(). Only the first letter of the phrase is changed, so
+// acronyms and proper names keep their case.
+//
+// Examples:
+//   - NoCapitalized("error", 0) returns "No errors"
+//   - NoCapitalized("error", 1) returns "One error"
+//   - NoCapitalized("child", 3) returns "Three children"
+//   - NoCapitalized("URL", 0) returns "No URLs"
+//   - NoCapitalized("iPhone", 2) returns "Two iPhones"
+func NoCapitalized(word string, count int) string {
+	return defaultEngine.NoCapitalized(word, count)
+}
+
+// NoCapitalized returns a count and noun phrase for the start of a
+// sentence, with the first word capitalized.
+//
+// Since a sentence should not start with a numeral, the count is spelled
+// out as in e.NoWords(). Only the first letter of the phrase is changed, so
+// acronyms and proper names keep their case.
+//
+// Examples:
+//   - e.NoCapitalized("error", 0) returns "No errors"
+//   - e.NoCapitalized("error", 1) returns "One error"
+//   - e.NoCapitalized("child", 3) returns "Three children"
+//   - e.NoCapitalized("URL", 0) returns "No URLs"
+func (e *Engine) NoCapitalized(word string, count int) string {
+	return Capitalize(e.NoWords(word, count))
+}
+
+// NoThreshold returns a count and noun phrase in English, spelling out counts
+// below the threshold in words and leaving larger counts as digits, using
+// "no" for zero counts.
+//
+// This follows the common style rule of spelling out small numbers, with
+// the count formatted by NumberToWordsThreshold(). Pluralization follows
+// the same rules as No().
+//
+// Examples:
+//   - NoThreshold("error", 0, 10) returns "no errors"
+//   - NoThreshold("error", 1, 10) returns "one error"
+//   - NoThreshold("error", 9, 10) returns "nine errors"
+//   - NoThreshold("error", 12, 10) returns "12 errors"
+func NoThreshold(word string, count, threshold int) string {
+	return defaultEngine.NoThreshold(word, count, threshold)
+}
+
+// NoThreshold returns a count and noun phrase in English, spelling out counts
+// below the threshold in words and leaving larger counts as digits, using
+// "no" for zero counts.
+//
+// This follows the common style rule of spelling out small numbers, with
+// the count formatted by NumberToWordsThreshold(). Pluralization follows
+// the same rules as No().
+//
+// Examples:
+//   - e.NoThreshold("error", 0, 10) returns "no errors"
+//   - e.NoThreshold("error", 1, 10) returns "one error"
+//   - e.NoThreshold("error", 9, 10) returns "nine errors"
+//   - e.NoThreshold("error", 12, 10) returns "12 errors"
+func (e *Engine) NoThreshold(word string, count, threshold int) string {
+	return e.noWithFormat(word, count, func(n int) string {
+		return NumberToWordsThreshold(n, threshold)
+	})
+}
+
+// noWithFormat implements the No family, formatting non-zero counts with format.
+func (e *Engine) noWithFormat(word string, count int, format func(int) string) string {
 	if count == 0 {
 		if e.IsClassicalZero() {
 			return "no " + word
 		}
-		return "no " + e.Plural(word)
+		return "no " + e.plural(word)
 	}
 	if count == 1 || count == -1 {
-		return fmt.Sprintf("%d %s", count, word)
+		return format(count) + " " + word
 	}
-	return fmt.Sprintf("%d %s", count, e.Plural(word))
+	return format(count) + " " + e.plural(word)
 }
 
 // Num stores and retrieves a default count for number-related operations.
@@ -479,3 +1073,97 @@ func (e *Engine) GetNum() int {
 	defer e.mu.RUnlock()
 	return e.defaultNum
 }
+
+// NumPropagation enables or disables use of the default count set by Num().
+//
+// When enabled (true, the default), functions that take an optional count
+// use the default count when none is given, matching Python inflect:
+//   - Plural() returns the word unchanged when the default count is 1
+//   - PluralNoun(), PluralVerb(), PluralAdj(), and SingularNoun() use it
+//     in place of a missing count argument
+//
+// When disabled (false), the default count is stored but ignored, which
+// restores the behavior of earlier versions.
+//
+// Examples:
+//
+//	Num(1)
+//	Plural("cat") // returns "cat"
+//	NumPropagation(false)
+//	Plural("cat") // returns "cats"
+func NumPropagation(enabled bool) {
+	defaultEngine.NumPropagation(enabled)
+}
+
+// NumPropagation enables or disables use of the default count set by Num().
+//
+// When enabled (true, the default), functions that take an optional count
+// use the default count when none is given, matching Python inflect:
+//   - e.Plural() returns the word unchanged when the default count is 1
+//   - e.PluralNoun(), e.PluralVerb(), e.PluralAdj(), and e.SingularNoun()
+//     use it in place of a missing count argument
+//
+// When disabled (false), the default count is stored but ignored, which
+// restores the behavior of earlier versions.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.Num(1)
+//	e.Plural("cat") // returns "cat"
+//	e.NumPropagation(false)
+//	e.Plural("cat") // returns "cats"
+func (e *Engine) NumPropagation(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.numPropagation = enabled
+}
+
+// IsNumPropagation returns whether the default count set by Num() is used
+// by functions that take an optional count.
+//
+// Examples:
+//
+//	IsNumPropagation() // returns true (default)
+//	NumPropagation(false)
+//	IsNumPropagation() // returns false
+func IsNumPropagation() bool {
+	return defaultEngine.IsNumPropagation()
+}
+
+// IsNumPropagation returns whether the default count set by e.Num() is used
+// by methods that take an optional count.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.IsNumPropagation() // returns true (default)
+//	e.NumPropagation(false)
+//	e.IsNumPropagation() // returns false
+func (e *Engine) IsNumPropagation() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.numPropagation
+}
+
+// countOrNum returns the explicit count if one was given, or else the default
+// count set by Num() when count propagation is enabled. The second result
+// reports whether either was available.
+func (e *Engine) countOrNum(count []int) (int, bool) {
+	if len(count) > 0 {
+		return count[0], true
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.numPropagation && e.defaultNum != 0 {
+		return e.defaultNum, true
+	}
+	return 0, false
+}
+
+// isSingularCount reports whether a count given or defaulted through
+// countOrNum calls for the singular form.
+func (e *Engine) isSingularCount(count []int) bool {
+	n, ok := e.countOrNum(count)
+	return ok && (n == 1 || n == -1)
+}
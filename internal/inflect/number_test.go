@@ -2,9 +2,11 @@ package inflect_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	inflect "github.com/cv/go-inflect/v2"
 )
@@ -592,6 +594,111 @@ func TestNumberToWordsGrouped(t *testing.T) {
 	}
 }
 
+func TestNumberStringToWordsGrouped(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		groupSize int
+		want      string
+	}{
+		{name: "leading zero", s: "0123", groupSize: 2, want: "zero one twenty-three"},
+		{name: "leading zeros in group", s: "007", groupSize: 3, want: "zero zero seven"},
+		{name: "pin", s: "4152", groupSize: 2, want: "forty-one fifty-two"},
+		{name: "short first group", s: "12345", groupSize: 2, want: "one twenty-three forty-five"},
+		{name: "zero inside", s: "1001", groupSize: 2, want: "ten zero one"},
+		{name: "all zeros", s: "000", groupSize: 2, want: "zero zero zero"},
+		{name: "one digit groups", s: "0412", groupSize: 1, want: "zero four one two"},
+		{name: "account number", s: "00123456", groupSize: 4, want: "zero zero twelve three thousand four hundred fifty-six"},
+		{name: "surrounding spaces", s: " 0042 ", groupSize: 4, want: "zero zero forty-two"},
+		{name: "one group", s: "0042", groupSize: 4, want: "zero zero forty-two"},
+		{name: "group larger than string", s: "0042", groupSize: 10, want: "zero zero forty-two"},
+		{
+			name:      "too long for an int",
+			s:         "12345678901234567890",
+			groupSize: 20,
+			want:      "one two three four five six seven eight nine zero one two three four five six seven eight nine zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.NumberStringToWordsGrouped(tt.s, tt.groupSize)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNumberStringToWordsGroupedInvalid(t *testing.T) {
+	for _, s := range []string{"", "  ", "12-34", "-123", "12a", "1.5", "١٢٣"} {
+		t.Run(s, func(t *testing.T) {
+			got, err := inflect.NumberStringToWordsGrouped(s, 2)
+			assert.ErrorIs(t, err, inflect.ErrNotDigits)
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestNumberStringToWordsGroupedInvalidGroupSize(t *testing.T) {
+	for _, size := range []int{0, -1, -4} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			got, err := inflect.NumberStringToWordsGrouped("0123", size)
+			assert.ErrorIs(t, err, inflect.ErrInvalidGroupSize)
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestNumberToWordsBare(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{name: "zero inside groups", n: 120034, want: "one twenty zero thirty-four"},
+		{name: "year", n: 2024, want: "two zero twenty-four"},
+		{name: "three digits", n: 555, want: "five fifty-five"},
+		{name: "zero tens", n: 105, want: "one zero five"},
+		{name: "round hundred", n: 100, want: "one zero zero"},
+		{name: "round thousand", n: 1000, want: "one zero zero zero"},
+		{name: "million", n: 1234567, want: "one two thirty-four five sixty-seven"},
+		{name: "two digits", n: 10, want: "ten"},
+		{name: "one digit", n: 7, want: "seven"},
+		{name: "zero", n: 0, want: "zero"},
+		{name: "negative", n: -42, want: "negative forty-two"},
+		{name: "min int", n: math.MinInt64, want: "negative nine two twenty-three three seventy-two zero thirty-six eight fifty-four seven seventy-five eight zero eight"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NumberToWordsBare(tt.n))
+		})
+	}
+}
+
+func TestNumberToWordsBareWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		opts inflect.NumberToWordsBareOptions
+		want string
+	}{
+		{name: "zero word", n: 120034, opts: inflect.NumberToWordsBareOptions{Zero: "oh"}, want: "one twenty oh thirty-four"},
+		{name: "zero word in pair", n: 105, opts: inflect.NumberToWordsBareOptions{Zero: "oh"}, want: "one oh five"},
+		{name: "omit leading zeros", n: 120034, opts: inflect.NumberToWordsBareOptions{OmitLeadingZeros: true}, want: "one twenty thirty-four"},
+		{name: "omit leading zeros of zero group", n: 1000, opts: inflect.NumberToWordsBareOptions{OmitLeadingZeros: true}, want: "one zero"},
+		{name: "omit leading zeros of one digit", n: 1005, opts: inflect.NumberToWordsBareOptions{OmitLeadingZeros: true}, want: "one five"},
+		{name: "omit leading zeros keeps first group", n: 105, opts: inflect.NumberToWordsBareOptions{OmitLeadingZeros: true}, want: "one zero five"},
+		{name: "zero", n: 0, opts: inflect.NumberToWordsBareOptions{Zero: "nought"}, want: "nought"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NumberToWordsBareWithOptions(tt.n, tt.opts))
+		})
+	}
+}
+
 func TestFormatNumber(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -653,6 +760,93 @@ func TestFormatNumber(t *testing.T) {
 	}
 }
 
+func TestFormatNumberWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		opts  inflect.FormatNumberOptions
+		want  string
+	}{
+		{name: "zero options match FormatNumber", input: 1234567, opts: inflect.FormatNumberOptions{}, want: "1,234,567"},
+		{name: "period separator", input: 1234567, opts: inflect.FormatNumberOptions{Separator: '.'}, want: "1.234.567"},
+		{name: "space separator", input: 1234567, opts: inflect.FormatNumberOptions{Separator: ' '}, want: "1 234 567"},
+		{name: "underscore separator", input: -1234, opts: inflect.FormatNumberOptions{Separator: '_'}, want: "-1_234"},
+		{name: "multibyte separator", input: 1234567, opts: inflect.FormatNumberOptions{Separator: '\u202f'}, want: "1\u202f234\u202f567"},
+		{name: "group size 4", input: 12345678, opts: inflect.FormatNumberOptions{GroupSize: 4}, want: "1234,5678"},
+		{name: "group size 2", input: 123456, opts: inflect.FormatNumberOptions{GroupSize: 2}, want: "12,34,56"},
+		{name: "negative group size uses default", input: 1234, opts: inflect.FormatNumberOptions{GroupSize: -1}, want: "1,234"},
+		{name: "short number", input: 999, opts: inflect.FormatNumberOptions{Separator: '.'}, want: "999"},
+		{name: "zero", input: 0, opts: inflect.FormatNumberOptions{}, want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.FormatNumberWithOptions(tt.input, tt.opts)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		decimals int
+		want     string
+	}{
+		{name: "two decimals rounds", input: 1234.567, decimals: 2, want: "1,234.57"},
+		{name: "zero decimals rounds", input: 1234567.5, decimals: 0, want: "1,234,568"},
+		{name: "pads decimals", input: 1000, decimals: 2, want: "1,000.00"},
+		{name: "negative", input: -9876.5, decimals: 1, want: "-9,876.5"},
+		{name: "small number", input: 3.14159, decimals: 3, want: "3.142"},
+		{name: "shortest representation", input: 0.125, decimals: -1, want: "0.125"},
+		{name: "shortest large", input: 1234567.25, decimals: -1, want: "1,234,567.25"},
+		{name: "negative rounds to zero", input: -0.001, decimals: 2, want: "0.00"},
+		{name: "NaN", input: math.NaN(), decimals: 2, want: "NaN"},
+		{name: "positive infinity", input: math.Inf(1), decimals: 2, want: "+Inf"},
+		{name: "negative infinity", input: math.Inf(-1), decimals: 2, want: "-Inf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.FormatFloat(tt.input, tt.decimals)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatFloatWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input float64
+		opts  inflect.FormatNumberOptions
+		want  string
+	}{
+		{name: "defaults drop decimals", input: 1234.6, opts: inflect.FormatNumberOptions{}, want: "1,235"},
+		{name: "two decimals", input: 1234.5, opts: inflect.FormatNumberOptions{Decimals: 2}, want: "1,234.50"},
+		{
+			name:  "european style",
+			input: 1234.5,
+			opts:  inflect.FormatNumberOptions{Separator: '.', DecimalSeparator: ',', Decimals: 2},
+			want:  "1.234,50",
+		},
+		{
+			name:  "french style shortest",
+			input: 1234.5,
+			opts:  inflect.FormatNumberOptions{Separator: ' ', DecimalSeparator: ',', Decimals: -1},
+			want:  "1 234,5",
+		},
+		{name: "group size 4", input: 12345678.9, opts: inflect.FormatNumberOptions{GroupSize: 4, Decimals: 1}, want: "1234,5678.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.FormatFloatWithOptions(tt.input, tt.opts)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestNo(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -711,6 +905,85 @@ func TestNo(t *testing.T) {
 	}
 }
 
+func TestNoWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		word  string
+		count int
+		want  string
+	}{
+		{name: "zero", word: "error", count: 0, want: "no errors"},
+		{name: "one", word: "error", count: 1, want: "one error"},
+		{name: "three", word: "error", count: 3, want: "three errors"},
+		{name: "irregular plural", word: "child", count: 21, want: "twenty-one children"},
+		{name: "unchanged plural", word: "sheep", count: 2, want: "two sheep"},
+		{name: "large", word: "item", count: 1000, want: "one thousand items"},
+		{name: "negative one", word: "error", count: -1, want: "negative one error"},
+		{name: "negative", word: "error", count: -2, want: "negative two errors"},
+		{name: "titlecase", word: "Error", count: 2, want: "two Errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NoWords(tt.word, tt.count))
+		})
+	}
+}
+
+func TestNoCapitalized(t *testing.T) {
+	tests := []struct {
+		name  string
+		word  string
+		count int
+		want  string
+	}{
+		{name: "zero", word: "error", count: 0, want: "No errors"},
+		{name: "one", word: "error", count: 1, want: "One error"},
+		{name: "three", word: "child", count: 3, want: "Three children"},
+		{name: "negative", word: "error", count: -2, want: "Negative two errors"},
+		{name: "acronym", word: "URL", count: 0, want: "No URLs"},
+		{name: "brand name", word: "iPhone", count: 2, want: "Two iPhones"},
+		{name: "proper name", word: "Mary", count: 2, want: "Two Marys"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NoCapitalized(tt.word, tt.count))
+		})
+	}
+}
+
+func TestNoThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		word      string
+		count     int
+		threshold int
+		want      string
+	}{
+		{name: "zero", word: "error", count: 0, threshold: 10, want: "no errors"},
+		{name: "one", word: "error", count: 1, threshold: 10, want: "one error"},
+		{name: "below threshold", word: "error", count: 9, threshold: 10, want: "nine errors"},
+		{name: "at threshold", word: "error", count: 10, threshold: 10, want: "10 errors"},
+		{name: "above threshold", word: "child", count: 12, threshold: 10, want: "12 children"},
+		{name: "one at threshold", word: "error", count: 1, threshold: 1, want: "1 error"},
+		{name: "negative", word: "error", count: -3, threshold: 10, want: "negative three errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NoThreshold(tt.word, tt.count, tt.threshold))
+		})
+	}
+}
+
+func TestNoWordsClassicalZero(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalZero(true)
+	assert.Equal(t, "no error", e.NoWords("error", 0))
+	assert.Equal(t, "no error", e.NoThreshold("error", 0, 10))
+}
+
 func TestNum(t *testing.T) {
 	defer inflect.Num()
 
@@ -758,6 +1031,65 @@ func TestNum(t *testing.T) {
 	}
 }
 
+func TestNumPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		num  int
+		call func(e *inflect.Engine) string
+		want string
+	}{
+		{name: "plural without num", num: 0, call: func(e *inflect.Engine) string { return e.Plural("cat") }, want: "cats"},
+		{name: "plural with num 1", num: 1, call: func(e *inflect.Engine) string { return e.Plural("cat") }, want: "cat"},
+		{name: "plural with num 2", num: 2, call: func(e *inflect.Engine) string { return e.Plural("cat") }, want: "cats"},
+		{name: "plural noun with num 1", num: 1, call: func(e *inflect.Engine) string { return e.PluralNoun("I") }, want: "I"},
+		{name: "plural noun explicit count wins", num: 1, call: func(e *inflect.Engine) string { return e.PluralNoun("I", 2) }, want: "We"},
+		{name: "plural verb with num 1", num: 1, call: func(e *inflect.Engine) string { return e.PluralVerb("is") }, want: "is"},
+		{name: "plural verb with num 3", num: 3, call: func(e *inflect.Engine) string { return e.PluralVerb("is") }, want: "are"},
+		{name: "plural adj with num 1", num: 1, call: func(e *inflect.Engine) string { return e.PluralAdj("this") }, want: "this"},
+		{name: "singular noun with num 2", num: 2, call: func(e *inflect.Engine) string { return e.SingularNoun("cats") }, want: "cats"},
+		{name: "singular noun with num 1", num: 1, call: func(e *inflect.Engine) string { return e.SingularNoun("cats") }, want: "cat"},
+		{name: "no ignores num", num: 1, call: func(e *inflect.Engine) string { return e.No("cat", 2) }, want: "2 cats"},
+		{name: "pluralize alias with num 1", num: 1, call: func(e *inflect.Engine) string { return e.Pluralize("cat") }, want: "cat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.Num(tt.num)
+			assert.Equal(t, tt.want, tt.call(e))
+		})
+	}
+}
+
+func TestNumPropagationDisabled(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.True(t, e.IsNumPropagation())
+
+	e.Num(1)
+	e.NumPropagation(false)
+	assert.False(t, e.IsNumPropagation())
+	assert.Equal(t, "cats", e.Plural("cat"))
+	assert.Equal(t, "We", e.PluralNoun("I"))
+	assert.Equal(t, "cat", e.SingularNoun("cats"))
+	assert.Equal(t, 1, e.GetNum())
+
+	e.Reset()
+	assert.True(t, e.IsNumPropagation())
+	assert.Equal(t, "cats", e.Plural("cat"))
+}
+
+func TestNumPropagationPackageLevel(t *testing.T) {
+	defer inflect.Num()
+
+	inflect.Num(1)
+	assert.Equal(t, "cat", inflect.Plural("cat"))
+	assert.Equal(t, "people", inflect.Tableize("Person"))
+
+	inflect.NumPropagation(false)
+	defer inflect.NumPropagation(true)
+	assert.Equal(t, "cats", inflect.Plural("cat"))
+}
+
 func TestGetNum(t *testing.T) {
 	defer inflect.Num()
 
@@ -928,6 +1260,179 @@ func TestNumberToWordsWithAnd(t *testing.T) {
 	}
 }
 
+func TestCardinalWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "zero", input: 0, want: "zero"},
+		{name: "unit", input: 7, want: "seven"},
+		{name: "compound", input: 42, want: "forty-two"},
+		{name: "thousands", input: 1001, want: "one thousand one"},
+		{name: "negative drops sign", input: -42, want: "forty-two"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CardinalWord(tt.input))
+		})
+	}
+}
+
+func TestTensWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "negative", input: -1, want: ""},
+		{name: "zero", input: 0, want: ""},
+		{name: "digit 1", input: 1, want: "ten"},
+		{name: "digit 2", input: 2, want: "twenty"},
+		{name: "digit 4", input: 4, want: "forty"},
+		{name: "digit 9", input: 9, want: "ninety"},
+		{name: "too large", input: 10, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.TensWord(tt.input))
+		})
+	}
+}
+
+func TestOnesWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "negative", input: -1, want: ""},
+		{name: "zero", input: 0, want: "zero"},
+		{name: "number 1", input: 1, want: "one"},
+		{name: "number 12", input: 12, want: "twelve"},
+		{name: "number 19", input: 19, want: "nineteen"},
+		{name: "too large", input: 20, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OnesWord(tt.input))
+		})
+	}
+}
+
+func TestNumberToWordsWithOptions(t *testing.T) {
+	and := inflect.NumberWordOptions{And: true}
+	spaced := inflect.NumberWordOptions{OmitHyphens: true}
+	tests := []struct {
+		name  string
+		input int
+		opts  inflect.NumberWordOptions
+		want  string
+	}{
+		{name: "zero value", input: 121, opts: inflect.NumberWordOptions{}, want: "one hundred twenty-one"},
+		{name: "zero", input: 0, opts: and, want: "zero"},
+		{name: "and hundreds", input: 121, opts: and, want: "one hundred and twenty-one"},
+		{name: "and thousands", input: 1001, opts: and, want: "one thousand and one"},
+		{name: "and large remainder", input: 1101, opts: and, want: "one thousand one hundred and one"},
+		{name: "omit hyphens", input: 42, opts: spaced, want: "forty two"},
+		{name: "omit hyphens round tens", input: 40, opts: spaced, want: "forty"},
+		{name: "both", input: 2042, opts: inflect.NumberWordOptions{And: true, OmitHyphens: true}, want: "two thousand and forty two"},
+		{name: "negative", input: -121, opts: and, want: "negative one hundred and twenty-one"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NumberToWordsWithOptions(tt.input, tt.opts))
+		})
+	}
+}
+
+func TestNumberToWordsWithOptionsMatchesDefaults(t *testing.T) {
+	for _, n := range []int{0, 7, 19, 20, 42, 100, 101, 999, 1000, 1001, 12345, 1000001, 1234567890, 5000000000} {
+		assert.Equal(t, inflect.NumberToWords(n), inflect.NumberToWordsWithOptions(n, inflect.NumberWordOptions{}), n)
+		assert.Equal(t, inflect.NumberToWordsWithAnd(n), inflect.NumberToWordsWithOptions(n, inflect.NumberWordOptions{And: true}), n)
+	}
+}
+
+func TestWordsToNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "zero", input: "zero", want: 0},
+		{name: "unit", input: "seven", want: 7},
+		{name: "teen", input: "thirteen", want: 13},
+		{name: "hyphenated tens", input: "forty-two", want: 42},
+		{name: "spaced tens", input: "forty two", want: 42},
+		{name: "hundred", input: "one hundred", want: 100},
+		{name: "hundred with and", input: "one hundred and five", want: 105},
+		{name: "hundreds of teens", input: "fifteen hundred", want: 1500},
+		{name: "thousands", input: "twelve thousand three hundred forty-five", want: 12345},
+		{name: "commas", input: "three thousand, two hundred", want: 3200},
+		{name: "nested scales", input: "one thousand billion", want: 1000000000000},
+		{name: "larger scales", input: "two trillion", want: 2000000000000},
+		{name: "case insensitive", input: "Forty-Two", want: 42},
+		{name: "negative", input: "negative three thousand and two", want: -3002},
+		{name: "minus", input: "minus five", want: -5},
+		{name: "unparseable", input: "lots", want: 0},
+		{name: "empty", input: "", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.WordsToNumber(tt.input))
+		})
+	}
+}
+
+func TestWordsToNumberRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 19, 20, 99, 101, 999, 1001, 12345, 1000001, 1234567890, math.MaxInt, -42} {
+		assert.Equal(t, n, inflect.WordsToNumber(inflect.NumberToWords(n)), "NumberToWords(%d)", n)
+		assert.Equal(t, n, inflect.WordsToNumber(inflect.NumberToWordsWithAnd(n)), "NumberToWordsWithAnd(%d)", n)
+	}
+}
+
+func TestWordsToNumberE(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int
+		wantErr error
+	}{
+		{name: "valid", input: "two million three hundred thousand", want: 2300000},
+		{name: "empty", input: "", wantErr: inflect.ErrUnknownWord},
+		{name: "only and", input: "and", wantErr: inflect.ErrUnknownWord},
+		{name: "unknown word", input: "forty-lots", wantErr: inflect.ErrUnknownWord},
+		{name: "digits", input: "42", wantErr: inflect.ErrUnknownWord},
+		{name: "zero in a number", input: "one zero", wantErr: inflect.ErrUnknownWord},
+		{name: "two units", input: "one two", wantErr: inflect.ErrUnknownWord},
+		{name: "unit after teen", input: "twelve three", wantErr: inflect.ErrUnknownWord},
+		{name: "two tens", input: "twenty thirty", wantErr: inflect.ErrUnknownWord},
+		{name: "bare hundred", input: "hundred", wantErr: inflect.ErrUnknownWord},
+		{name: "hundred hundred", input: "one hundred hundred", wantErr: inflect.ErrUnknownWord},
+		{name: "bare scale", input: "thousand", wantErr: inflect.ErrUnknownWord},
+		{name: "scale too small", input: "one million two thousand thousand", wantErr: inflect.ErrUnknownWord},
+		{name: "overflow", input: "ten quintillion", wantErr: inflect.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.WordsToNumberE(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Zero(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestWordToOrdinal(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -1119,6 +1624,7 @@ func BenchmarkFormatNumber(b *testing.B) {
 
 	for _, bm := range benchmarks {
 		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
 			for range b.N {
 				inflect.FormatNumber(bm.input)
 			}
@@ -0,0 +1,124 @@
+package inflect
+
+// Option configures an Engine created with NewEngine.
+//
+// Options make an engine's configuration a value that can be declared once,
+// stored, and shared, instead of a series of setter calls:
+//
+//	opts := []Option{WithClassical(ClassicalModeAncient), WithDialect(DialectUK)}
+//	e := NewEngine(opts...)
+//
+// Each option does the same as the corresponding setter, so an engine can
+// still be changed after it is created.
+type Option func(*Engine)
+
+// ClassicalMode is a set of classical pluralization options for
+// WithClassical. Modes are combined with |.
+type ClassicalMode uint
+
+const (
+	// ClassicalModeZero uses the singular for a count of zero, as
+	// ClassicalZero does.
+	// Example: "0 cat"
+	ClassicalModeZero ClassicalMode = 1 << iota
+
+	// ClassicalModeHerd uses the unchanged plural of herd animals, as
+	// ClassicalHerd does.
+	// Example: "wildebeest" -> "wildebeest"
+	ClassicalModeHerd
+
+	// ClassicalModeNames pluralizes proper names by the classical rules, as
+	// ClassicalNames does.
+	// Example: "Jones" -> "Joneses"
+	ClassicalModeNames
+
+	// ClassicalModeAncient uses Latin and Greek plurals, as ClassicalAncient
+	// does.
+	// Example: "formula" -> "formulae"
+	ClassicalModeAncient
+
+	// ClassicalModePersons uses "persons" as the plural of "person", as
+	// ClassicalPersons does.
+	// Example: "person" -> "persons"
+	ClassicalModePersons
+
+	// ClassicalModeAll enables every classical option, as ClassicalAll
+	// does.
+	// Example: "formula" -> "formulae", "person" -> "persons"
+	ClassicalModeAll = ClassicalModeZero | ClassicalModeHerd | ClassicalModeNames |
+		ClassicalModeAncient | ClassicalModePersons
+)
+
+// WithClassical returns an Option that enables the given classical
+// pluralization options. Options not in modes keep their defaults.
+//
+// Examples:
+//
+//	e := NewEngine(WithClassical(ClassicalModeAncient | ClassicalModeNames))
+//	e.Plural("formula")   // returns "formulae"
+//	e.IsClassicalHerd()   // returns false
+//	e = NewEngine(WithClassical(ClassicalModeAll))
+//	e.IsClassicalAll()    // returns true
+func WithClassical(modes ClassicalMode) Option {
+	return func(e *Engine) {
+		if modes&ClassicalModeAll == ClassicalModeAll {
+			e.ClassicalAll(true)
+			return
+		}
+		if modes&ClassicalModeZero != 0 {
+			e.ClassicalZero(true)
+		}
+		if modes&ClassicalModeHerd != 0 {
+			e.ClassicalHerd(true)
+		}
+		if modes&ClassicalModeNames != 0 {
+			e.ClassicalNames(true)
+		}
+		if modes&ClassicalModeAncient != 0 {
+			e.ClassicalAncient(true)
+		}
+		if modes&ClassicalModePersons != 0 {
+			e.ClassicalPersons(true)
+		}
+	}
+}
+
+// WithGender returns an Option that sets the gender of singular
+// third-person pronouns, as SetGender does. Invalid genders are ignored.
+//
+// Examples:
+//
+//	e := NewEngine(WithGender("f"))
+//	e.SingularNoun("they") // returns "she"
+func WithGender(g string) Option {
+	return func(e *Engine) {
+		e.SetGender(g)
+	}
+}
+
+// WithRules returns an Option that replaces the suffix rules Plural falls
+// back to, as SetRules does.
+//
+// Examples:
+//
+//	rule := SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+//	e := NewEngine(WithRules(slices.Insert(Rules(), 1, rule)))
+//	e.Plural("hippopotamus") // returns "hippopotami"
+func WithRules(rules []SuffixRule) Option {
+	return func(e *Engine) {
+		e.SetRules(rules)
+	}
+}
+
+// WithDialect returns an Option that sets the variety of English whose
+// pronunciation An follows, as SetDialect does.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.An("herb") // returns "a herb"
+func WithDialect(d Dialect) Option {
+	return func(e *Engine) {
+		e.SetDialect(d)
+	}
+}
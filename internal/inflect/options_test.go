@@ -0,0 +1,84 @@
+package inflect_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestNewEngineOptions(t *testing.T) {
+	rule := inflect.SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+	e := inflect.NewEngine(
+		inflect.WithClassical(inflect.ClassicalModeAncient|inflect.ClassicalModeNames),
+		inflect.WithGender("f"),
+		inflect.WithRules(slices.Insert(inflect.Rules(), 1, rule)),
+		inflect.WithDialect(inflect.DialectUK),
+	)
+
+	assert.Equal(t, "formulae", e.Plural("formula"))
+	assert.True(t, e.IsClassicalAncient())
+	assert.True(t, e.IsClassicalNames())
+	assert.False(t, e.IsClassicalHerd())
+	assert.False(t, e.IsClassicalAll())
+	assert.Equal(t, "f", e.GetGender())
+	assert.Equal(t, "she", e.SingularNoun("they"))
+	assert.Equal(t, "hippopotami", e.Plural("hippopotamus"))
+	assert.Equal(t, inflect.DialectUK, e.GetDialect())
+	assert.Equal(t, "a herb", e.An("herb"))
+
+	// The default engine is unchanged
+	assert.Equal(t, "formulas", inflect.Plural("formula"))
+}
+
+func TestWithClassical(t *testing.T) {
+	tests := []struct {
+		name    string
+		modes   inflect.ClassicalMode
+		zero    bool
+		herd    bool
+		names   bool
+		ancient bool
+		persons bool
+		all     bool
+	}{
+		{name: "none", modes: 0},
+		{name: "zero", modes: inflect.ClassicalModeZero, zero: true},
+		{name: "herd", modes: inflect.ClassicalModeHerd, herd: true},
+		{name: "names", modes: inflect.ClassicalModeNames, names: true},
+		{name: "ancient", modes: inflect.ClassicalModeAncient, ancient: true},
+		{name: "persons", modes: inflect.ClassicalModePersons, persons: true},
+		{name: "zero and herd", modes: inflect.ClassicalModeZero | inflect.ClassicalModeHerd, zero: true, herd: true},
+		{name: "all", modes: inflect.ClassicalModeAll, zero: true, herd: true, names: true, ancient: true, persons: true, all: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine(inflect.WithClassical(tt.modes))
+			assert.Equal(t, tt.zero, e.IsClassicalZero(), "zero")
+			assert.Equal(t, tt.herd, e.IsClassicalHerd(), "herd")
+			assert.Equal(t, tt.names, e.IsClassicalNames(), "names")
+			assert.Equal(t, tt.ancient, e.IsClassicalAncient(), "ancient")
+			assert.Equal(t, tt.persons, e.IsClassicalPersons(), "persons")
+			assert.Equal(t, tt.all, e.IsClassicalAll(), "all")
+		})
+	}
+}
+
+func TestNewEngineOptionsInOrder(t *testing.T) {
+	e := inflect.NewEngine(inflect.WithGender("m"), nil, inflect.WithGender("invalid"), inflect.WithGender("n"))
+	assert.Equal(t, "n", e.GetGender())
+}
+
+func TestNewEngineOptionsClone(t *testing.T) {
+	e := inflect.NewEngine(inflect.WithClassical(inflect.ClassicalModeAll), inflect.WithDialect(inflect.DialectUK))
+	c := e.Clone()
+	c.ClassicalAll(false)
+	c.SetDialect(inflect.DialectUS)
+
+	assert.True(t, e.IsClassicalAll())
+	assert.Equal(t, inflect.DialectUK, e.GetDialect())
+	assert.Equal(t, "an herb", c.An("herb"))
+}
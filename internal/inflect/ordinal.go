@@ -2,9 +2,12 @@ package inflect
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // Ordinal converts an integer to its ordinal string representation.
@@ -86,60 +89,79 @@ func OrdinalWord(n int) string {
 	return convertToOrdinalWord(n)
 }
 
-// convertToOrdinalWord converts a positive integer to its ordinal word form.
-func convertToOrdinalWord(n int) string {
-	// Handle numbers 1-19 with direct lookup
-	if n <= 19 {
-		return onesOrdinal[n]
-	}
-
-	// Handle exact tens (20, 30, 40, ...)
-	if n < 100 && n%10 == 0 {
-		return tensOrdinal[n/10]
-	}
-
-	// Handle 20-99 with compound form (twenty-first, etc.)
-	if n < 100 {
-		return tensCardinal[n/10] + "-" + onesOrdinal[n%10]
-	}
-
-	// Handle exact hundreds (100, 200, ...)
-	if n < 1000 && n%100 == 0 {
-		return onesCardinal[n/100] + " hundredth"
-	}
-
-	// Handle 100-999
-	if n < 1000 {
-		return onesCardinal[n/100] + " hundred " + convertToOrdinalWord(n%100)
-	}
-
-	// Handle exact thousands (1000, 2000, ...)
-	if n < 1000000 && n%1000 == 0 {
-		return cardinalWord(n/1000) + " thousandth"
-	}
-
-	// Handle 1000-999999
-	if n < 1000000 {
-		return cardinalWord(n/1000) + " thousand " + convertToOrdinalWord(n%1000)
-	}
+// OrdinalWordOptions controls how OrdinalWordWithOptions handles zero and
+// negative numbers, and the style of its words.
+//
+// The zero value behaves like OrdinalWord.
+type OrdinalWordOptions struct {
+	// NumberWordOptions sets the style of the words, as it does for
+	// NumberToWordsWithOptions: "one hundred and twenty-first".
+	NumberWordOptions
+
+	// FromEnd treats negative numbers as positions counted from the end of
+	// a list: -1 is "last", -2 is "second to last", and so on.
+	FromEnd bool
+
+	// Zero is the word returned for 0. Empty means "zeroth".
+	Zero string
+}
 
-	// Handle exact millions (1000000, 2000000, ...)
-	if n < 1000000000 && n%1000000 == 0 {
-		return cardinalWord(n/1000000) + " millionth"
+// OrdinalWordWithOptions converts an integer to its ordinal word representation,
+// with control over zero and negative numbers and the style of the words.
+//
+// Examples:
+//   - OrdinalWordWithOptions(3, OrdinalWordOptions{}) returns "third"
+//   - OrdinalWordWithOptions(121, OrdinalWordOptions{NumberWordOptions: NumberWordOptions{And: true}})
+//     returns "one hundred and twenty-first"
+//   - OrdinalWordWithOptions(-1, OrdinalWordOptions{}) returns "negative first"
+//   - OrdinalWordWithOptions(-1, OrdinalWordOptions{FromEnd: true}) returns "last"
+//   - OrdinalWordWithOptions(-2, OrdinalWordOptions{FromEnd: true}) returns "second to last"
+//   - OrdinalWordWithOptions(0, OrdinalWordOptions{Zero: "none"}) returns "none"
+func OrdinalWordWithOptions(n int, opts OrdinalWordOptions) string {
+	switch {
+	case n == 0 && opts.Zero != "":
+		return opts.Zero
+	case n < 0 && opts.FromEnd:
+		return ordinalFromEnd(-n, opts.NumberWordOptions)
+	case n == 0:
+		return "zeroth"
+	case n < 0:
+		return "negative " + spellNumber(-n, opts.NumberWordOptions, true)
+	default:
+		return spellNumber(n, opts.NumberWordOptions, true)
 	}
+}
 
-	// Handle 1000000-999999999
-	if n < 1000000000 {
-		return cardinalWord(n/1000000) + " million " + convertToOrdinalWord(n%1000000)
-	}
+// OrdinalFromEnd describes a 1-based position in a list of total items
+// relative to the end of the list.
+//
+// Returns an empty string if n is outside the range 1 to total.
+//
+// Examples:
+//   - OrdinalFromEnd(10, 10) returns "last"
+//   - OrdinalFromEnd(9, 10) returns "second to last"
+//   - OrdinalFromEnd(8, 10) returns "third to last"
+//   - OrdinalFromEnd(1, 10) returns "tenth to last"
+//   - OrdinalFromEnd(11, 10) returns ""
+func OrdinalFromEnd(n, total int) string {
+	if n < 1 || n > total {
+		return ""
+	}
+	return ordinalFromEnd(total-n+1, NumberWordOptions{})
+}
 
-	// Handle exact billions
-	if n%1000000000 == 0 {
-		return cardinalWord(n/1000000000) + " billionth"
+// ordinalFromEnd returns the word for the kth item from the end, where
+// k >= 1, in the style of opts.
+func ordinalFromEnd(k int, opts NumberWordOptions) string {
+	if k == 1 {
+		return "last"
 	}
+	return spellNumber(k, opts, true) + " to last"
+}
 
-	// Handle billions and above
-	return cardinalWord(n/1000000000) + " billion " + convertToOrdinalWord(n%1000000000)
+// convertToOrdinalWord converts a positive integer to its ordinal word form.
+func convertToOrdinalWord(n int) string {
+	return spellNumber(n, NumberWordOptions{}, true)
 }
 
 // cardinalToOrdinal maps cardinal word forms to ordinal word forms.
@@ -451,3 +473,168 @@ func OrdinalToCardinal(s string) string {
 	// Not an ordinal, return unchanged
 	return s
 }
+
+// OrdinalRange returns a range of positions as ordinals joined by an en
+// dash. If from is greater than to, they are swapped.
+//
+// Examples:
+//   - OrdinalRange(1, 3) returns "1st–3rd"
+//   - OrdinalRange(21, 23) returns "21st–23rd"
+//   - OrdinalRange(5, 5) returns "5th"
+//   - OrdinalRange(3, 1) returns "1st–3rd"
+func OrdinalRange(from, to int) string {
+	return ordinalRange(from, to, Ordinal, "–")
+}
+
+// OrdinalRangeWords returns a range of positions as ordinal words joined
+// by "through". If from is greater than to, they are swapped.
+//
+// Examples:
+//   - OrdinalRangeWords(1, 3) returns "first through third"
+//   - OrdinalRangeWords(20, 21) returns "twentieth through twenty-first"
+//   - OrdinalRangeWords(5, 5) returns "fifth"
+func OrdinalRangeWords(from, to int) string {
+	return ordinalRange(from, to, OrdinalWord, " through ")
+}
+
+// OrdinalRanges lists positions as ordinals, collapsing runs of three or
+// more consecutive positions into ranges like OrdinalRange.
+//
+// The positions are sorted and duplicates are dropped. The result is
+// joined with "and", using an Oxford comma for three or more parts.
+//
+// Examples:
+//   - OrdinalRanges([]int{1, 2, 3, 7}) returns "1st–3rd and 7th"
+//   - OrdinalRanges([]int{1, 2, 5, 6, 7, 8}) returns "1st, 2nd, and 5th–8th"
+//   - OrdinalRanges([]int{7, 3, 2, 1, 3}) returns "1st–3rd and 7th"
+//   - OrdinalRanges(nil) returns ""
+func OrdinalRanges(positions []int) string {
+	return ordinalRanges(positions, OrdinalRange, Ordinal)
+}
+
+// OrdinalRangesWords lists positions as ordinal words, collapsing runs of
+// three or more consecutive positions into ranges like OrdinalRangeWords.
+//
+// The positions are sorted and duplicates are dropped. The result is
+// joined with "and", using an Oxford comma for three or more parts.
+//
+// Examples:
+//   - OrdinalRangesWords([]int{1, 2, 3, 7}) returns "first through third and seventh"
+//   - OrdinalRangesWords([]int{1, 3}) returns "first and third"
+func OrdinalRangesWords(positions []int) string {
+	return ordinalRanges(positions, OrdinalRangeWords, OrdinalWord)
+}
+
+// ordinalRange formats the range from..to with format, joining the ends
+// with sep.
+func ordinalRange(from, to int, format func(int) string, sep string) string {
+	if from > to {
+		from, to = to, from
+	}
+	if from == to {
+		return format(from)
+	}
+	return format(from) + sep + format(to)
+}
+
+// ordinalRanges sorts and dedupes positions and joins them, formatting runs
+// of three or more with formatRange and other positions with format.
+func ordinalRanges(positions []int, formatRange func(int, int) string, format func(int) string) string {
+	sorted := slices.Compact(slices.Sorted(slices.Values(positions)))
+
+	var parts []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] == sorted[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			parts = append(parts, formatRange(sorted[i], sorted[j]))
+		} else {
+			for _, n := range sorted[i : j+1] {
+				parts = append(parts, format(n))
+			}
+		}
+		i = j + 1
+	}
+	return Join(parts)
+}
+
+// ordinalInStringPattern matches a number followed by an ordinal suffix,
+// such as "2nd" in "report_2nd_draft".
+var ordinalInStringPattern = regexp.MustCompile(`(?i)\d+(?:st|nd|rd|th)`)
+
+// OrdinalInString returns the value of the last numeric ordinal in s, and
+// reports whether one was found.
+//
+// An ordinal counts only if its suffix is right for its number and it is
+// not followed by a letter, so "2th" and "4thly" are not ordinals.
+//
+// Examples:
+//   - OrdinalInString("report_2nd_draft") returns (2, true)
+//   - OrdinalInString("1st-pass-22nd.txt") returns (22, true)
+//   - OrdinalInString("THE_3RD_MAN") returns (3, true)
+//   - OrdinalInString("report_2th_draft") returns (0, false)
+//   - OrdinalInString("report.txt") returns (0, false)
+func OrdinalInString(s string) (int, bool) {
+	_, _, n, ok := lastOrdinalInString(s)
+	return n, ok
+}
+
+// IncrementOrdinalInString adds delta to the last numeric ordinal in s, and
+// updates its suffix to match.
+//
+// Zero padding and the case of the suffix are kept. If s has no ordinal,
+// or the result would be less than 1 ("0th" is not a position), s is
+// returned unchanged.
+//
+// Examples:
+//   - IncrementOrdinalInString("report_2nd_draft", 1) returns "report_3rd_draft"
+//   - IncrementOrdinalInString("report_3rd_draft", -1) returns "report_2nd_draft"
+//   - IncrementOrdinalInString("take_10th.wav", 2) returns "take_12th.wav"
+//   - IncrementOrdinalInString("day_01ST", 1) returns "day_02ND"
+//   - IncrementOrdinalInString("1st", -1) returns "1st"
+//   - IncrementOrdinalInString("report.txt", 1) returns "report.txt"
+func IncrementOrdinalInString(s string, delta int) string {
+	start, end, n, ok := lastOrdinalInString(s)
+	if !ok || n+delta < 1 {
+		return s
+	}
+	n += delta
+	suffix := s[end-2 : end]
+	width := end - 2 - start
+	return fmt.Sprintf("%s%0*d%s%s", s[:start], width, n, matchCase(suffix, OrdinalSuffix(n)), s[end:])
+}
+
+// NextInName returns a name, such as a file name, with its last numeric
+// ordinal incremented by one.
+//
+// This is IncrementOrdinalInString(name, 1).
+//
+// Examples:
+//   - NextInName("report_2nd_draft") returns "report_3rd_draft"
+//   - NextInName("report_3rd_draft.docx") returns "report_4th_draft.docx"
+//   - NextInName("11th-hour") returns "12th-hour"
+//   - NextInName("20th_century") returns "21st_century"
+//   - NextInName("report.txt") returns "report.txt"
+func NextInName(name string) string {
+	return IncrementOrdinalInString(name, 1)
+}
+
+// lastOrdinalInString returns the byte offsets and value of the last
+// numeric ordinal in s.
+func lastOrdinalInString(s string) (start, end, n int, ok bool) {
+	matches := ordinalInStringPattern.FindAllStringIndex(s, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		start, end = matches[i][0], matches[i][1]
+		if next, _ := utf8.DecodeRuneInString(s[end:]); unicode.IsLetter(next) {
+			continue
+		}
+		n, err := strconv.Atoi(s[start : end-2])
+		if err != nil || !strings.EqualFold(s[end-2:end], OrdinalSuffix(n)) {
+			continue
+		}
+		return start, end, n, true
+	}
+	return 0, 0, 0, false
+}
@@ -99,6 +99,87 @@ func TestOrdinalWord(t *testing.T) {
 	}
 }
 
+func TestOrdinalWordWithOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		opts  inflect.OrdinalWordOptions
+		want  string
+	}{
+		{name: "positive default", input: 3, opts: inflect.OrdinalWordOptions{}, want: "third"},
+		{name: "zero default", input: 0, opts: inflect.OrdinalWordOptions{}, want: "zeroth"},
+		{name: "negative default", input: -1, opts: inflect.OrdinalWordOptions{}, want: "negative first"},
+		{name: "last", input: -1, opts: inflect.OrdinalWordOptions{FromEnd: true}, want: "last"},
+		{name: "second to last", input: -2, opts: inflect.OrdinalWordOptions{FromEnd: true}, want: "second to last"},
+		{name: "twenty-first to last", input: -21, opts: inflect.OrdinalWordOptions{FromEnd: true}, want: "twenty-first to last"},
+		{name: "positive unaffected by from end", input: 2, opts: inflect.OrdinalWordOptions{FromEnd: true}, want: "second"},
+		{name: "custom zero", input: 0, opts: inflect.OrdinalWordOptions{Zero: "none"}, want: "none"},
+		{name: "zero from end", input: 0, opts: inflect.OrdinalWordOptions{FromEnd: true}, want: "zeroth"},
+		{name: "default compound hundreds", input: 121, opts: inflect.OrdinalWordOptions{}, want: "one hundred twenty-first"},
+		{
+			name:  "and",
+			input: 121,
+			opts:  inflect.OrdinalWordOptions{NumberWordOptions: inflect.NumberWordOptions{And: true}},
+			want:  "one hundred and twenty-first",
+		},
+		{
+			name:  "and thousands",
+			input: 1001,
+			opts:  inflect.OrdinalWordOptions{NumberWordOptions: inflect.NumberWordOptions{And: true}},
+			want:  "one thousand and first",
+		},
+		{
+			name:  "omit hyphens",
+			input: 21,
+			opts:  inflect.OrdinalWordOptions{NumberWordOptions: inflect.NumberWordOptions{OmitHyphens: true}},
+			want:  "twenty first",
+		},
+		{
+			name:  "and negative",
+			input: -101,
+			opts:  inflect.OrdinalWordOptions{NumberWordOptions: inflect.NumberWordOptions{And: true}},
+			want:  "negative one hundred and first",
+		},
+		{
+			name:  "and from end",
+			input: -101,
+			opts:  inflect.OrdinalWordOptions{NumberWordOptions: inflect.NumberWordOptions{And: true}, FromEnd: true},
+			want:  "one hundred and first to last",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OrdinalWordWithOptions(tt.input, tt.opts))
+		})
+	}
+}
+
+func TestOrdinalFromEnd(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		total int
+		want  string
+	}{
+		{name: "last", n: 10, total: 10, want: "last"},
+		{name: "second to last", n: 9, total: 10, want: "second to last"},
+		{name: "third to last", n: 8, total: 10, want: "third to last"},
+		{name: "first of ten", n: 1, total: 10, want: "tenth to last"},
+		{name: "only item", n: 1, total: 1, want: "last"},
+		{name: "past end", n: 11, total: 10, want: ""},
+		{name: "zero position", n: 0, total: 10, want: ""},
+		{name: "negative position", n: -1, total: 10, want: ""},
+		{name: "empty list", n: 1, total: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OrdinalFromEnd(tt.n, tt.total))
+		})
+	}
+}
+
 func TestOrdinal(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -392,3 +473,131 @@ func BenchmarkOrdinalToCardinal(b *testing.B) {
 		inflect.OrdinalToCardinal(inputs[i%len(inputs)])
 	}
 }
+
+func TestOrdinalInString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   int
+		wantOK bool
+	}{
+		{name: "underscores", input: "report_2nd_draft", want: 2, wantOK: true},
+		{name: "last of several", input: "1st-pass-22nd.txt", want: 22, wantOK: true},
+		{name: "uppercase", input: "THE_3RD_MAN", want: 3, wantOK: true},
+		{name: "after letters", input: "draft4th", want: 4, wantOK: true},
+		{name: "teens", input: "the 11th hour", want: 11, wantOK: true},
+		{name: "whole string", input: "101st", want: 101, wantOK: true},
+		{name: "wrong suffix", input: "report_2th_draft", wantOK: false},
+		{name: "followed by letters", input: "4thly", wantOK: false},
+		{name: "wrong suffix skipped", input: "1st_2th", want: 1, wantOK: true},
+		{name: "no ordinal", input: "report.txt", wantOK: false},
+		{name: "bare number", input: "report_2", wantOK: false},
+		{name: "empty", input: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := inflect.OrdinalInString(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIncrementOrdinalInString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		delta int
+		want  string
+	}{
+		{name: "next", input: "report_2nd_draft", delta: 1, want: "report_3rd_draft"},
+		{name: "previous", input: "report_3rd_draft", delta: -1, want: "report_2nd_draft"},
+		{name: "into teens", input: "take_10th.wav", delta: 2, want: "take_12th.wav"},
+		{name: "out of teens", input: "take_13th.wav", delta: 8, want: "take_21st.wav"},
+		{name: "more digits", input: "99th", delta: 1, want: "100th"},
+		{name: "zero padding", input: "day_01ST", delta: 1, want: "day_02ND"},
+		{name: "title case suffix", input: "2Nd", delta: 1, want: "3Rd"},
+		{name: "last only", input: "1st_take_2nd", delta: 1, want: "1st_take_3rd"},
+		{name: "down to first", input: "2nd", delta: -1, want: "1st"},
+		{name: "zero result", input: "1st", delta: -1, want: "1st"},
+		{name: "negative result", input: "1st", delta: -2, want: "1st"},
+		{name: "far negative result", input: "2nd", delta: -5, want: "2nd"},
+		{name: "no ordinal", input: "report.txt", delta: 1, want: "report.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.IncrementOrdinalInString(tt.input, tt.delta))
+		})
+	}
+}
+
+func TestNextInName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "report_2nd_draft", want: "report_3rd_draft"},
+		{input: "report_3rd_draft.docx", want: "report_4th_draft.docx"},
+		{input: "11th-hour", want: "12th-hour"},
+		{input: "20th_century", want: "21st_century"},
+		{input: "report.txt", want: "report.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.NextInName(tt.input))
+		})
+	}
+}
+
+func TestOrdinalRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  int
+		want      string
+		wantWords string
+	}{
+		{name: "basic", from: 1, to: 3, want: "1st–3rd", wantWords: "first through third"},
+		{name: "twenties", from: 21, to: 23, want: "21st–23rd", wantWords: "twenty-first through twenty-third"},
+		{name: "teens", from: 11, to: 13, want: "11th–13th", wantWords: "eleventh through thirteenth"},
+		{name: "single", from: 5, to: 5, want: "5th", wantWords: "fifth"},
+		{name: "reversed", from: 3, to: 1, want: "1st–3rd", wantWords: "first through third"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OrdinalRange(tt.from, tt.to))
+			assert.Equal(t, tt.wantWords, inflect.OrdinalRangeWords(tt.from, tt.to))
+		})
+	}
+}
+
+func TestOrdinalRanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		want      string
+		wantWords string
+	}{
+		{name: "run and single", input: []int{1, 2, 3, 7}, want: "1st–3rd and 7th", wantWords: "first through third and seventh"},
+		{name: "pair not collapsed", input: []int{1, 2, 5, 6, 7, 8}, want: "1st, 2nd, and 5th–8th", wantWords: "first, second, and fifth through eighth"},
+		{name: "unsorted with duplicates", input: []int{7, 3, 2, 1, 3}, want: "1st–3rd and 7th", wantWords: "first through third and seventh"},
+		{name: "two runs", input: []int{1, 2, 3, 10, 11, 12}, want: "1st–3rd and 10th–12th", wantWords: "first through third and tenth through twelfth"},
+		{name: "no runs", input: []int{1, 3, 5}, want: "1st, 3rd, and 5th", wantWords: "first, third, and fifth"},
+		{name: "single", input: []int{2}, want: "2nd", wantWords: "second"},
+		{name: "empty", input: nil, want: "", wantWords: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OrdinalRanges(tt.input))
+			assert.Equal(t, tt.wantWords, inflect.OrdinalRangesWords(tt.input))
+		})
+	}
+
+	input := []int{3, 2, 1}
+	inflect.OrdinalRanges(input)
+	assert.Equal(t, []int{3, 2, 1}, input, "input is not modified")
+}
@@ -0,0 +1,78 @@
+package inflect_test
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+var parityReport = flag.String("parity-report", "", "write the Python inflect parity report to this file")
+
+// parityCase is a line of testdata/python_parity.txt.
+type parityCase struct {
+	line int
+	text string
+	want string
+	gap  string
+}
+
+// readParityCases parses the Python parity corpus.
+func readParityCases(t *testing.T) []parityCase {
+	t.Helper()
+	f, err := os.Open("testdata/python_parity.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	var cases []parityCase
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		text, want, ok := strings.Cut(line, " => ")
+		require.True(t, ok, "line %d: missing \" => \"", n)
+		want, gap, _ := strings.Cut(want, " # gap: ")
+		cases = append(cases, parityCase{line: n, text: text, want: want, gap: gap})
+	}
+	require.NoError(t, scanner.Err())
+	return cases
+}
+
+// TestPythonParity checks go-inflect against outputs of the Python inflect
+// library. Run it with -parity-report=FILE to write a Markdown report.
+func TestPythonParity(t *testing.T) {
+	cases := readParityCases(t)
+
+	var report strings.Builder
+	var gaps []parityCase
+	for _, c := range cases {
+		got := inflect.NewEngine().Inflect(c.text)
+		if c.gap != "" {
+			assert.NotEqual(t, c.want, got, "line %d: %s now matches Python; remove the gap marker", c.line, c.text)
+			gaps = append(gaps, c)
+			fmt.Fprintf(&report, "| `%s` | %s | %s | %s |\n", c.text, c.want, got, c.gap)
+			continue
+		}
+		assert.Equal(t, c.want, got, "line %d: %s", c.line, c.text)
+	}
+
+	matching := len(cases) - len(gaps)
+	summary := fmt.Sprintf("%d of %d cases (%.1f%%) match Python inflect; %d known gaps.",
+		matching, len(cases), 100*float64(matching)/float64(len(cases)), len(gaps))
+	t.Log(summary)
+
+	if *parityReport != "" {
+		out := "# Python inflect parity\n\n" + summary + "\n\n" +
+			"| Input | Python | go-inflect | Notes |\n|---|---|---|---|\n" + report.String()
+		require.NoError(t, os.WriteFile(*parityReport, []byte(out), 0o644))
+	}
+}
@@ -66,19 +66,57 @@ var knownParticiples = map[string]bool{
 //   - PresentParticiple("die") returns "dying" (ie -> ying)
 //   - PresentParticiple("see") returns "seeing" (ee -> eeing)
 //   - PresentParticiple("panic") returns "panicking" (c -> ck)
+//
+// The spelling follows the dialect set with SetDialect: in British English
+// a final l is doubled after a single vowel (travel -> travelling), and
+// verbs are spelled -ise rather than -ize (realize -> realising). Until a
+// dialect is set, verbs keep the -ise or -ize spelling they are given.
 func PresentParticiple(verb string) string {
+	return defaultEngine.PresentParticiple(verb)
+}
+
+// PresentParticiple converts a verb to its present participle (-ing) form,
+// in the dialect of this engine. See the package-level PresentParticiple
+// for details.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.PresentParticiple("travel") // returns "travelling"
+func (e *Engine) PresentParticiple(verb string) string {
+	return PresentParticipleIn(verb, e.GetDialect())
+}
+
+// PresentParticipleIn converts a verb to its present participle (-ing)
+// form in the given dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PresentParticipleIn("travel", DialectUS) returns "traveling"
+//   - PresentParticipleIn("travel", DialectUK) returns "travelling"
+//   - PresentParticipleIn("worship", DialectUK) returns "worshipping"
+//   - PresentParticipleIn("realise", DialectUS) returns "realizing"
+func PresentParticipleIn(verb string, d Dialect) string {
 	if verb == "" {
 		return ""
 	}
 
+	verb = verbInDialect(verb, d)
 	lower := strings.ToLower(verb)
-	n := len(lower)
 
-	// Already a present participle (ends in doubled consonant + ing, like "running")
+	// Already a present participle ("running", "singing"), but not a base
+	// verb that happens to end in -ing ("sing", "bring")
 	if isAlreadyParticiple(lower) {
 		return verb
 	}
 
+	return applyParticipleRules(verb, lower, d)
+}
+
+// applyParticipleRules applies the regular present participle formation
+// rules of a dialect.
+func applyParticipleRules(verb, lower string, d Dialect) string {
+	n := len(lower)
+
 	// Single letter verbs - just add -ing
 	if n == 1 {
 		return verb + matchSuffix(verb, "ing")
@@ -127,7 +165,7 @@ func PresentParticiple(verb string) string {
 	}
 
 	// Check for CVC pattern that requires doubling the final consonant
-	if shouldDoubleConsonant(lower) {
+	if shouldDoubleConsonant(lower) || doublesInDialect(lower, d) {
 		lastChar := verb[len(verb)-1:]
 		return verb + matchSuffix(verb, strings.ToLower(lastChar)+"ing")
 	}
@@ -137,30 +175,164 @@ func PresentParticiple(verb string) string {
 }
 
 // isAlreadyParticiple checks if a word is already a present participle.
-// This catches words like "running", "sitting" but not base verbs like "sing".
+// This catches words like "running" and "singing" but not base verbs like
+// "sing" and "bring", where removing -ing leaves no valid base.
 func isAlreadyParticiple(lower string) bool {
-	if !strings.HasSuffix(lower, "ing") {
-		return false
+	_, ok := participleBase(lower)
+	return ok
+}
+
+// BaseVerb returns the base form of a present participle.
+//
+// The -ing ending is removed only when a valid base remains: either a verb
+// in the irregular verb tables, or a base whose regular participle is the
+// given word. Doubled consonants, dropped silent e's, and -ie verbs are
+// restored. Words that are not present participles, including base verbs
+// that end in -ing, are returned unchanged.
+//
+// Examples:
+//   - BaseVerb("running") returns "run" (undouble consonant)
+//   - BaseVerb("making") returns "make" (restore silent e)
+//   - BaseVerb("dying") returns "die" (ying -> ie)
+//   - BaseVerb("singing") returns "sing"
+//   - BaseVerb("panicking") returns "panic"
+//   - BaseVerb("bring") returns "bring" (already a base verb)
+func BaseVerb(word string) string {
+	if word == "" {
+		return ""
 	}
-	n := len(lower)
-	if n < 5 {
-		return false
+	base, ok := participleBase(strings.ToLower(word))
+	if !ok {
+		return word
 	}
+	return matchCase(word, base)
+}
 
-	// Check for doubled consonant before -ing (running, sitting, hitting)
-	beforeIng := lower[n-4]
-	beforeThat := lower[n-5]
-	if beforeIng == beforeThat && !isVowel(rune(beforeIng)) {
-		return true
+// participleBase returns the base verb of a lowercase present participle,
+// and false if the word is not one.
+func participleBase(lower string) (string, bool) {
+	stem, ok := strings.CutSuffix(lower, "ing")
+	if !ok || !hasVowelSound(stem) {
+		return "", false
+	}
+
+	candidates := participleCandidates(stem)
+
+	// Prefer a base from the verb dictionary
+	for _, c := range candidates {
+		if isKnownVerb(c) {
+			return c, true
+		}
+	}
+
+	// Otherwise accept a base whose regular participle is the word, in
+	// either dialect
+	for _, c := range candidates {
+		if !hasVowelSound(c) {
+			continue
+		}
+		for _, d := range dialects {
+			if applyParticipleRules(c, c, d) == lower {
+				return c, true
+			}
+		}
+	}
+	return "", false
+}
+
+// participleCandidates lists the possible base verbs for a participle stem,
+// most likely first.
+func participleCandidates(stem string) []string {
+	n := len(stem)
+	candidates := make([]string, 0, 5)
+
+	// dying -> die, lying -> lie
+	if n == 2 && stem[1] == 'y' {
+		candidates = append(candidates, stem[:1]+"ie")
+	}
+
+	// panicking -> panic (but kicking -> kick)
+	if strings.HasSuffix(stem, "ick") && countVowels(stem) > 1 {
+		candidates = append(candidates, stem[:n-1])
+	}
+
+	return append(candidates, stemCandidates(stem)...)
+}
+
+// stemCandidates lists the possible bases of a stem left after removing a
+// suffix such as -ing or -ed, most likely first.
+func stemCandidates(stem string) []string {
+	n := len(stem)
+	candidates := make([]string, 0, 3)
+
+	// running -> run, but calling -> call and kissing -> kiss, since base
+	// words often end in a double l, s, f, or z; travelling -> travel, as
+	// British English doubles the l, but recalling -> recall, as verbs in
+	// consonant + all are more common than those in consonant + al
+	if n >= 2 && stem[n-1] == stem[n-2] && !isVowel(rune(stem[n-1])) {
+		undoubled := stem[:n-1]
+		ukDoubled := doublesInDialect(undoubled, DialectUK) &&
+			!(strings.HasSuffix(stem, "all") && n >= 4 && !isVowel(rune(stem[n-4])))
+		if strings.IndexByte("lsfz", stem[n-1]) >= 0 && !doubleConsonantWords[undoubled] && !ukDoubled {
+			candidates = append(candidates, stem, undoubled)
+		} else {
+			candidates = append(candidates, undoubled, stem)
+		}
+	} else if needsSilentE(stem) {
+		// dancing -> dance, arguing -> argue, larger -> large
+		return append(candidates, stem+"e", stem)
+	} else {
+		candidates = append(candidates, stem)
 	}
 
-	// Check for common participle patterns
-	// Words ending in -ting, -ning, -ping, etc. after a consonant
-	// But not "sing", "ring", "bring" which are base verbs
+	// making -> make
+	return append(candidates, stem+"e")
+}
 
+// needsSilentE reports whether a stem ends in letters that English words
+// rarely end in without a silent e: c, v, u, and g after a consonant other
+// than n ("danc", "lov", "argu", "larg").
+func needsSilentE(stem string) bool {
+	n := len(stem)
+	if n < 2 {
+		return false
+	}
+	switch stem[n-1] {
+	case 'c', 'v', 'u':
+		return true
+	case 'g':
+		return !isVowel(rune(stem[n-2])) && stem[n-2] != 'n'
+	}
+	return false
+}
+
+// hasVowelSound reports whether s contains a vowel, counting a 'y' that
+// follows another letter ("try").
+func hasVowelSound(s string) bool {
+	for i, r := range s {
+		if isVowel(r) || (r == 'y' && i > 0) {
+			return true
+		}
+	}
 	return false
 }
 
+// isKnownVerb reports whether a lowercase word is a base verb in the
+// irregular verb tables.
+func isKnownVerb(lower string) bool {
+	if _, ok := irregularVerbsSame[lower]; ok {
+		return true
+	}
+	if _, ok := irregularPastTenseOnly[lower]; ok {
+		return true
+	}
+	if _, ok := irregularPastParticipleOnly[lower]; ok {
+		return true
+	}
+	_, ok := verbPluralToSingular[lower]
+	return ok
+}
+
 // shouldDoubleConsonant checks if the final consonant should be doubled.
 // This applies to CVC (consonant-vowel-consonant) patterns in stressed syllables.
 func shouldDoubleConsonant(lower string) bool {
@@ -237,11 +409,37 @@ func countVowels(s string) int {
 //   - PastParticiple("go") returns "gone" (irregular)
 //   - PastParticiple("take") returns "taken" (irregular)
 //   - PastParticiple("run") returns "run" (unchanged irregular)
+//
+// The spelling follows the dialect set with SetDialect, as for
+// PresentParticiple: travel -> traveled (US) or travelled (UK).
 func PastParticiple(verb string) string {
+	return defaultEngine.PastParticiple(verb)
+}
+
+// PastParticiple converts a verb to its past participle form, in the dialect
+// of this engine. See the package-level PastParticiple for details.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.PastParticiple("cancel") // returns "cancelled"
+func (e *Engine) PastParticiple(verb string) string {
+	return PastParticipleIn(verb, e.GetDialect())
+}
+
+// PastParticipleIn converts a verb to its past participle form in the given
+// dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PastParticipleIn("cancel", DialectUS) returns "canceled"
+//   - PastParticipleIn("cancel", DialectUK) returns "cancelled"
+//   - PastParticipleIn("organize", DialectUK) returns "organised"
+func PastParticipleIn(verb string, d Dialect) string {
 	if verb == "" {
 		return ""
 	}
 
+	verb = verbInDialect(verb, d)
 	lower := strings.ToLower(verb)
 
 	// Check verbs with same past/participle first (most common)
@@ -277,7 +475,7 @@ func PastParticiple(verb string) string {
 	}
 
 	// Check for CVC pattern that requires doubling the final consonant
-	if shouldDoubleConsonant(lower) {
+	if shouldDoubleConsonant(lower) || doublesInDialect(lower, d) {
 		lastChar := verb[len(verb)-1:]
 		return verb + matchSuffix(verb, strings.ToLower(lastChar)+"ed")
 	}
@@ -8,111 +8,78 @@ import (
 	inflect "github.com/cv/go-inflect/v2"
 )
 
-func TestPresentParticiple(t *testing.T) {
+func TestBaseVerb(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
 		want  string
 	}{
-		// Empty string
 		{name: "empty", input: "", want: ""},
 
-		// Single letter verbs
-		{name: "single letter", input: "a", want: "aing"},
-
-		// Already ending in -ing
-		{name: "already -ing", input: "running", want: "running"},
-		{name: "already -ing sing", input: "sing", want: "singing"},
-
-		// Double consonant (CVC pattern)
-		{name: "run", input: "run", want: "running"},
-		{name: "sit", input: "sit", want: "sitting"},
-		{name: "hit", input: "hit", want: "hitting"},
-		{name: "cut", input: "cut", want: "cutting"},
-		{name: "stop", input: "stop", want: "stopping"},
-		{name: "drop", input: "drop", want: "dropping"},
-		{name: "plan", input: "plan", want: "planning"},
-		{name: "skip", input: "skip", want: "skipping"},
-		{name: "begin", input: "begin", want: "beginning"},
-		{name: "occur", input: "occur", want: "occurring"},
-		{name: "prefer", input: "prefer", want: "preferring"},
-		{name: "admit", input: "admit", want: "admitting"},
-		{name: "commit", input: "commit", want: "committing"},
-		{name: "regret", input: "regret", want: "regretting"},
-
-		// Drop silent e
-		{name: "make", input: "make", want: "making"},
-		{name: "take", input: "take", want: "taking"},
-		{name: "come", input: "come", want: "coming"},
-		{name: "give", input: "give", want: "giving"},
-		{name: "have", input: "have", want: "having"},
-		{name: "write", input: "write", want: "writing"},
-		{name: "live", input: "live", want: "living"},
-		{name: "move", input: "move", want: "moving"},
-		{name: "hope", input: "hope", want: "hoping"},
-		{name: "dance", input: "dance", want: "dancing"},
-
-		// Just add -ing (no changes needed)
-		{name: "play", input: "play", want: "playing"},
-		{name: "stay", input: "stay", want: "staying"},
-		{name: "enjoy", input: "enjoy", want: "enjoying"},
-		{name: "show", input: "show", want: "showing"},
-		{name: "follow", input: "follow", want: "following"},
-		{name: "fix", input: "fix", want: "fixing"},
-		{name: "mix", input: "mix", want: "mixing"},
-		{name: "go", input: "go", want: "going"},
-		{name: "do", input: "do", want: "doing"},
-		{name: "eat", input: "eat", want: "eating"},
-		{name: "read", input: "read", want: "reading"},
-		{name: "think", input: "think", want: "thinking"},
-		{name: "walk", input: "walk", want: "walking"},
-		{name: "talk", input: "talk", want: "talking"},
-		{name: "open", input: "open", want: "opening"},
-		{name: "listen", input: "listen", want: "listening"},
-		{name: "visit", input: "visit", want: "visiting"},
-
-		// ie -> ying
-		{name: "die", input: "die", want: "dying"},
-		{name: "lie", input: "lie", want: "lying"},
-		{name: "tie", input: "tie", want: "tying"},
-
-		// ee -> eeing
-		{name: "see", input: "see", want: "seeing"},
-		{name: "flee", input: "flee", want: "fleeing"},
-		{name: "agree", input: "agree", want: "agreeing"},
-		{name: "free", input: "free", want: "freeing"},
-
-		// be -> being (special vowel + e case)
-		{name: "be", input: "be", want: "being"},
-
-		// Words ending in -c (add k)
-		{name: "panic", input: "panic", want: "panicking"},
-		{name: "picnic", input: "picnic", want: "picnicking"},
-		{name: "traffic", input: "traffic", want: "trafficking"},
-		{name: "mimic", input: "mimic", want: "mimicking"},
-		{name: "frolic", input: "frolic", want: "frolicking"},
-
-		// Words ending in -ye, -oe (keep e)
-		{name: "dye", input: "dye", want: "dyeing"},
-		{name: "hoe", input: "hoe", want: "hoeing"},
-		{name: "toe", input: "toe", want: "toeing"},
-
-		// Words ending in -nge/-inge (keep e)
-		{name: "singe", input: "singe", want: "singeing"},
+		// Regular participles
+		{name: "walking", input: "walking", want: "walk"},
+		{name: "playing", input: "playing", want: "play"},
+		{name: "trying", input: "trying", want: "try"},
+		{name: "visiting", input: "visiting", want: "visit"},
+		{name: "opening", input: "opening", want: "open"},
+
+		// Doubled consonants
+		{name: "running", input: "running", want: "run"},
+		{name: "stopping", input: "stopping", want: "stop"},
+		{name: "committing", input: "committing", want: "commit"},
+		{name: "controlling", input: "controlling", want: "control"},
+		{name: "calling keeps ll", input: "calling", want: "call"},
+		{name: "kissing keeps ss", input: "kissing", want: "kiss"},
+		{name: "adding keeps dd", input: "adding", want: "add"},
+
+		// Silent e
+		{name: "making", input: "making", want: "make"},
+		{name: "hoping", input: "hoping", want: "hope"},
+		{name: "hopping", input: "hopping", want: "hop"},
+		{name: "writing", input: "writing", want: "write"},
+		{name: "being", input: "being", want: "be"},
+		{name: "seeing", input: "seeing", want: "see"},
+		{name: "dyeing", input: "dyeing", want: "dye"},
+		{name: "singeing", input: "singeing", want: "singe"},
+
+		// -ie and -c verbs
+		{name: "dying", input: "dying", want: "die"},
+		{name: "lying", input: "lying", want: "lie"},
+		{name: "panicking", input: "panicking", want: "panic"},
+		{name: "kicking", input: "kicking", want: "kick"},
+
+		// Irregular verbs ending in -ing
+		{name: "singing", input: "singing", want: "sing"},
+		{name: "bringing", input: "bringing", want: "bring"},
+		{name: "stinging", input: "stinging", want: "sting"},
+
+		// Not participles
+		{name: "bring", input: "bring", want: "bring"},
+		{name: "sting", input: "sting", want: "sting"},
+		{name: "string", input: "string", want: "string"},
+		{name: "walk", input: "walk", want: "walk"},
+		{name: "ing", input: "ing", want: "ing"},
 
 		// Case preservation
-		{name: "RUN uppercase", input: "RUN", want: "RUNNING"},
-		{name: "Run titlecase", input: "Run", want: "Running"},
-		{name: "MAKE uppercase", input: "MAKE", want: "MAKING"},
-		{name: "Make titlecase", input: "Make", want: "Making"},
-		{name: "DIE uppercase", input: "DIE", want: "DYING"},
-		{name: "PANIC uppercase", input: "PANIC", want: "PANICKING"},
+		{name: "titlecase", input: "Running", want: "Run"},
+		{name: "uppercase", input: "MAKING", want: "MAKE"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := inflect.PresentParticiple(tt.input)
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, inflect.BaseVerb(tt.input))
+		})
+	}
+}
+
+func TestBaseVerbRoundTrip(t *testing.T) {
+	verbs := []string{
+		"run", "make", "play", "die", "see", "panic", "sing", "bring",
+		"stop", "begin", "visit", "write", "hope", "hop", "try", "go",
+	}
+	for _, verb := range verbs {
+		t.Run(verb, func(t *testing.T) {
+			assert.Equal(t, verb, inflect.BaseVerb(inflect.PresentParticiple(verb)))
 		})
 	}
 }
@@ -141,176 +108,6 @@ func BenchmarkPresentParticiple(b *testing.B) {
 	}
 }
 
-func TestPastParticiple(t *testing.T) {
-	tests := []struct {
-		name  string
-		input string
-		want  string
-	}{
-		// Empty string
-		{name: "empty", input: "", want: ""},
-
-		// Regular verbs (-ed)
-		{name: "walk", input: "walk", want: "walked"},
-		{name: "talk", input: "talk", want: "talked"},
-		{name: "play", input: "play", want: "played"},
-		{name: "stay", input: "stay", want: "stayed"},
-		{name: "work", input: "work", want: "worked"},
-		{name: "help", input: "help", want: "helped"},
-		{name: "ask", input: "ask", want: "asked"},
-		{name: "call", input: "call", want: "called"},
-		{name: "open", input: "open", want: "opened"},
-		{name: "listen", input: "listen", want: "listened"},
-
-		// Verbs ending in -e (just add -d)
-		{name: "like", input: "like", want: "liked"},
-		{name: "love", input: "love", want: "loved"},
-		{name: "dance", input: "dance", want: "danced"},
-		{name: "hope", input: "hope", want: "hoped"},
-		{name: "use", input: "use", want: "used"},
-		{name: "close", input: "close", want: "closed"},
-
-		// Verbs ending in consonant + y (y -> ied)
-		{name: "try", input: "try", want: "tried"},
-		{name: "cry", input: "cry", want: "cried"},
-		{name: "study", input: "study", want: "studied"},
-		{name: "carry", input: "carry", want: "carried"},
-		{name: "worry", input: "worry", want: "worried"},
-
-		// Verbs ending in vowel + y (just add -ed)
-		{name: "play already", input: "play", want: "played"},
-		{name: "enjoy", input: "enjoy", want: "enjoyed"},
-		{name: "stay already", input: "stay", want: "stayed"},
-		{name: "delay", input: "delay", want: "delayed"},
-
-		// CVC pattern - double consonant
-		{name: "stop", input: "stop", want: "stopped"},
-		{name: "drop", input: "drop", want: "dropped"},
-		{name: "plan", input: "plan", want: "planned"},
-		{name: "skip", input: "skip", want: "skipped"},
-		{name: "admit", input: "admit", want: "admitted"},
-		{name: "occur", input: "occur", want: "occurred"},
-		{name: "prefer", input: "prefer", want: "preferred"},
-		{name: "regret", input: "regret", want: "regretted"},
-
-		// Don't double w, x, y
-		{name: "fix", input: "fix", want: "fixed"},
-		{name: "mix", input: "mix", want: "mixed"},
-		{name: "show", input: "show", want: "shown"},
-
-		// Verbs ending in -c (add k)
-		{name: "panic", input: "panic", want: "panicked"},
-		{name: "picnic", input: "picnic", want: "picnicked"},
-		{name: "traffic", input: "traffic", want: "trafficked"},
-
-		// Irregular verbs (common ones)
-		{name: "go", input: "go", want: "gone"},
-		{name: "be", input: "be", want: "been"},
-		{name: "have", input: "have", want: "had"},
-		{name: "do", input: "do", want: "done"},
-		{name: "say", input: "say", want: "said"},
-		{name: "get", input: "get", want: "got"},
-		{name: "make", input: "make", want: "made"},
-		{name: "know", input: "know", want: "known"},
-		{name: "think", input: "think", want: "thought"},
-		{name: "take", input: "take", want: "taken"},
-		{name: "see", input: "see", want: "seen"},
-		{name: "come", input: "come", want: "come"},
-		{name: "give", input: "give", want: "given"},
-		{name: "find", input: "find", want: "found"},
-		{name: "tell", input: "tell", want: "told"},
-		{name: "write", input: "write", want: "written"},
-		{name: "run", input: "run", want: "run"},
-		{name: "eat", input: "eat", want: "eaten"},
-		{name: "drink", input: "drink", want: "drunk"},
-		{name: "sing", input: "sing", want: "sung"},
-		{name: "swim", input: "swim", want: "swum"},
-		{name: "begin", input: "begin", want: "begun"},
-		{name: "break", input: "break", want: "broken"},
-		{name: "choose", input: "choose", want: "chosen"},
-		{name: "speak", input: "speak", want: "spoken"},
-		{name: "steal", input: "steal", want: "stolen"},
-		{name: "forget", input: "forget", want: "forgotten"},
-		{name: "drive", input: "drive", want: "driven"},
-		{name: "ride", input: "ride", want: "ridden"},
-		{name: "hide", input: "hide", want: "hidden"},
-		{name: "bite", input: "bite", want: "bitten"},
-		{name: "fly", input: "fly", want: "flown"},
-		{name: "grow", input: "grow", want: "grown"},
-		{name: "throw", input: "throw", want: "thrown"},
-		{name: "draw", input: "draw", want: "drawn"},
-		{name: "fall", input: "fall", want: "fallen"},
-		{name: "buy", input: "buy", want: "bought"},
-		{name: "bring", input: "bring", want: "brought"},
-		{name: "catch", input: "catch", want: "caught"},
-		{name: "teach", input: "teach", want: "taught"},
-		{name: "fight", input: "fight", want: "fought"},
-		{name: "seek", input: "seek", want: "sought"},
-		{name: "feel", input: "feel", want: "felt"},
-		{name: "keep", input: "keep", want: "kept"},
-		{name: "sleep", input: "sleep", want: "slept"},
-		{name: "leave", input: "leave", want: "left"},
-		{name: "meet", input: "meet", want: "met"},
-		{name: "read", input: "read", want: "read"},
-		{name: "lead", input: "lead", want: "led"},
-		{name: "sit", input: "sit", want: "sat"},
-		{name: "stand", input: "stand", want: "stood"},
-		{name: "lose", input: "lose", want: "lost"},
-		{name: "win", input: "win", want: "won"},
-		{name: "put", input: "put", want: "put"},
-		{name: "cut", input: "cut", want: "cut"},
-		{name: "hit", input: "hit", want: "hit"},
-		{name: "let", input: "let", want: "let"},
-		{name: "set", input: "set", want: "set"},
-		{name: "shut", input: "shut", want: "shut"},
-		{name: "hurt", input: "hurt", want: "hurt"},
-		{name: "cost", input: "cost", want: "cost"},
-		{name: "build", input: "build", want: "built"},
-		{name: "send", input: "send", want: "sent"},
-		{name: "spend", input: "spend", want: "spent"},
-		{name: "lend", input: "lend", want: "lent"},
-		{name: "bend", input: "bend", want: "bent"},
-
-		// New irregular past participles
-		{name: "bear", input: "bear", want: "borne"},
-		{name: "beseech", input: "beseech", want: "besought"},
-		{name: "beget", input: "beget", want: "begotten"},
-		{name: "dwell", input: "dwell", want: "dwelt"},
-		{name: "forsake", input: "forsake", want: "forsaken"},
-		{name: "inlay", input: "inlay", want: "inlaid"},
-		{name: "slay", input: "slay", want: "slain"},
-		{name: "awake", input: "awake", want: "awoken"},
-		{name: "arise", input: "arise", want: "arisen"},
-
-		// Compound verbs
-		{name: "foresee", input: "foresee", want: "foreseen"},
-		{name: "outdo", input: "outdo", want: "outdone"},
-		{name: "overdo", input: "overdo", want: "overdone"},
-		{name: "redo", input: "redo", want: "redone"},
-		{name: "undo", input: "undo", want: "undone"},
-		{name: "rebuild", input: "rebuild", want: "rebuilt"},
-		{name: "uphold", input: "uphold", want: "upheld"},
-		{name: "overcome", input: "overcome", want: "overcome"},
-		{name: "undergo", input: "undergo", want: "undergone"},
-		{name: "undertake", input: "undertake", want: "undertaken"},
-		{name: "mistake", input: "mistake", want: "mistaken"},
-		{name: "overtake", input: "overtake", want: "overtaken"},
-
-		// Case preservation
-		{name: "WALK uppercase", input: "WALK", want: "WALKED"},
-		{name: "Walk titlecase", input: "Walk", want: "Walked"},
-		{name: "GO uppercase", input: "GO", want: "GONE"},
-		{name: "Go titlecase", input: "Go", want: "Gone"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := inflect.PastParticiple(tt.input)
-			assert.Equal(t, tt.want, got)
-		})
-	}
-}
-
 func TestIsParticiple(t *testing.T) {
 	tests := []struct {
 		name  string
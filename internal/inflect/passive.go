@@ -0,0 +1,153 @@
+package inflect
+
+import "strings"
+
+// Tense selects the tense of a verb phrase.
+type Tense int
+
+const (
+	// TensePresent is the simple present tense.
+	// Example: "is written"
+	TensePresent Tense = iota
+
+	// TensePast is the simple past tense.
+	// Example: "was written"
+	TensePast
+
+	// TensePerfect is the present perfect tense.
+	// Example: "has been written"
+	TensePerfect
+
+	// TenseFuture is the simple future tense.
+	// Example: "will be written"
+	TenseFuture
+)
+
+// passiveBe holds the form of "be" taken by a singular subject in each
+// tense of a passive verb phrase.
+var passiveBe = [...]string{
+	TensePresent: "is",
+	TensePast:    "was",
+	TensePerfect: "has been",
+	TenseFuture:  "will be",
+}
+
+// nominativeToAccusative maps subject pronouns to the object pronouns that
+// follow "by" in a passive sentence.
+var nominativeToAccusative = map[string]string{
+	"i": "me", "he": "him", "she": "her", "we": "us", "they": "them",
+	"who": "whom",
+}
+
+// accusativeToNominative maps object pronouns to the subject pronouns that
+// replace them when the object becomes the subject.
+var accusativeToNominative = map[string]string{
+	"me": "I", "him": "he", "her": "she", "us": "we", "them": "they",
+	"whom": "who",
+}
+
+// Passivize turns an active clause into the passive voice, keeping the
+// tense of the verb.
+//
+// The object becomes the subject, the verb becomes a form of "be" that
+// agrees with it followed by the past participle, and the original subject
+// follows "by". The tense is read from the verb: a past form gives the
+// past tense, "has" or "have" with a participle the perfect, and "will"
+// the future. Pronouns change case ("she" -> "by her"). If subject is
+// empty, the "by" phrase is left out.
+//
+// Examples:
+//   - Passivize("the analyst", "wrote", "the report") returns "The report was written by the analyst"
+//   - Passivize("the team", "reviews", "all changes") returns "All changes are reviewed by the team"
+//   - Passivize("someone", "has taken", "the seat") returns "The seat has been taken by someone"
+//   - Passivize("she", "will send", "them") returns "They will be sent by her"
+//   - Passivize("", "deleted", "the file") returns "The file was deleted"
+func Passivize(subject, verb, object string) string {
+	return defaultEngine.Passivize(subject, verb, object)
+}
+
+// Passivize turns an active clause into the passive voice, keeping the
+// tense of the verb. See the package-level Passivize for details.
+//
+// Examples:
+//   - e.Passivize("the analyst", "wrote", "the report") returns "The report was written by the analyst"
+//   - e.Passivize("", "deleted", "the file") returns "The file was deleted"
+func (e *Engine) Passivize(subject, verb, object string) string {
+	base, tense := verbTense(verb)
+	return e.passive(subject, base, object, tense)
+}
+
+// PassivizeWithTense turns an active clause into the passive voice in the
+// given tense, whatever the tense of verb.
+//
+// Examples:
+//   - PassivizeWithTense("the analyst", "write", "the report", TensePresent) returns "The report is written by the analyst"
+//   - PassivizeWithTense("the analyst", "write", "the reports", TensePast) returns "The reports were written by the analyst"
+//   - PassivizeWithTense("the analyst", "wrote", "the report", TensePerfect) returns "The report has been written by the analyst"
+//   - PassivizeWithTense("I", "write", "the report", TenseFuture) returns "The report will be written by me"
+func PassivizeWithTense(subject, verb, object string, tense Tense) string {
+	return defaultEngine.PassivizeWithTense(subject, verb, object, tense)
+}
+
+// PassivizeWithTense turns an active clause into the passive voice in the
+// given tense, whatever the tense of verb.
+//
+// Examples:
+//   - e.PassivizeWithTense("the analyst", "write", "the report", TensePerfect) returns "The report has been written by the analyst"
+//   - e.PassivizeWithTense("the analyst", "write", "the reports", TensePast) returns "The reports were written by the analyst"
+func (e *Engine) PassivizeWithTense(subject, verb, object string, tense Tense) string {
+	base, _ := verbTense(verb)
+	return e.passive(subject, base, object, tense)
+}
+
+// passive builds a passive clause from the base form of a verb.
+func (e *Engine) passive(subject, base, object string, tense Tense) string {
+	if tense < TensePresent || tense > TenseFuture {
+		tense = TensePresent
+	}
+	object = strings.TrimSpace(object)
+	if nominative, ok := accusativeToNominative[strings.ToLower(object)]; ok {
+		object = nominative
+	}
+	clause := e.AgreeCompound([]string{lowerSubjectStart(object)}, passiveBe[tense])
+	if base != "" {
+		clause += " " + PastParticiple(base)
+	}
+	if subject = strings.TrimSpace(subject); subject != "" {
+		if accusative, ok := nominativeToAccusative[strings.ToLower(subject)]; ok {
+			subject = accusative
+		}
+		clause += " by " + lowerSubjectStart(subject)
+	}
+	return Capitalize(strings.TrimSpace(clause))
+}
+
+// verbTense returns the base form of a verb or verb phrase and the tense it
+// is in: "wrote" -> "write", TensePast; "has written" -> "write",
+// TensePerfect.
+func verbTense(verb string) (base string, tense Tense) {
+	first, rest, _ := strings.Cut(strings.ToLower(strings.TrimSpace(verb)), " ")
+	main, _, _ := strings.Cut(rest, " ")
+	switch {
+	case first == "":
+		return "", TensePresent
+	case first == "will" && main != "":
+		return main, TenseFuture
+	case (first == "has" || first == "have") && main != "":
+		if base = verbLemma(main); base == "" {
+			base = main
+		}
+		return base, TensePerfect
+	}
+	aux, base, ok := doSupport(first)
+	if !ok {
+		if base = verbLemma(first); base == "" {
+			base = first
+		}
+		return base, TensePresent
+	}
+	if aux == "did" {
+		return base, TensePast
+	}
+	return base, TensePresent
+}
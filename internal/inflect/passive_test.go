@@ -0,0 +1,78 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPassivize(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		verb    string
+		object  string
+		want    string
+	}{
+		// Tense read from the verb
+		{name: "irregular past", subject: "the analyst", verb: "wrote", object: "the report", want: "The report was written by the analyst"},
+		{name: "regular past", subject: "the admin", verb: "deleted", object: "the files", want: "The files were deleted by the admin"},
+		{name: "present", subject: "the team", verb: "reviews", object: "all changes", want: "All changes are reviewed by the team"},
+		{name: "present base form", subject: "robots", verb: "build", object: "the car", want: "The car is built by robots"},
+		{name: "perfect", subject: "someone", verb: "has taken", object: "the seat", want: "The seat has been taken by someone"},
+		{name: "perfect plural", subject: "they", verb: "have fixed", object: "the bugs", want: "The bugs have been fixed by them"},
+		{name: "future", subject: "the scheduler", verb: "will run", object: "the job", want: "The job will be run by the scheduler"},
+
+		// Pronouns
+		{name: "pronoun subject", subject: "she", verb: "will send", object: "them", want: "They will be sent by her"},
+		{name: "pronoun I", subject: "I", verb: "signed", object: "the form", want: "The form was signed by me"},
+		{name: "object me", subject: "the manager", verb: "promoted", object: "me", want: "I was promoted by the manager"},
+		{name: "object us", subject: "the storm", verb: "surprised", object: "us", want: "We were surprised by the storm"},
+
+		// Subjects and objects
+		{name: "no agent", verb: "deleted", object: "the file", want: "The file was deleted"},
+		{name: "capitalized subject", subject: "The analyst", verb: "wrote", object: "the report", want: "The report was written by the analyst"},
+		{name: "name", subject: "Alice", verb: "approved", object: "the request", want: "The request was approved by Alice"},
+		{name: "latin plural", subject: "the sensor", verb: "collects", object: "the data", want: "The data are collected by the sensor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Passivize(tt.subject, tt.verb, tt.object))
+		})
+	}
+}
+
+func TestPassivizeWithTense(t *testing.T) {
+	tests := []struct {
+		name   string
+		verb   string
+		object string
+		tense  inflect.Tense
+		want   string
+	}{
+		{name: "present", verb: "write", object: "the report", tense: inflect.TensePresent, want: "The report is written by the analyst"},
+		{name: "past", verb: "write", object: "the report", tense: inflect.TensePast, want: "The report was written by the analyst"},
+		{name: "perfect", verb: "write", object: "the report", tense: inflect.TensePerfect, want: "The report has been written by the analyst"},
+		{name: "future", verb: "write", object: "the report", tense: inflect.TenseFuture, want: "The report will be written by the analyst"},
+		{name: "plural past", verb: "write", object: "the reports", tense: inflect.TensePast, want: "The reports were written by the analyst"},
+		{name: "plural perfect", verb: "write", object: "the reports", tense: inflect.TensePerfect, want: "The reports have been written by the analyst"},
+		{name: "tense overrides verb", verb: "wrote", object: "the report", tense: inflect.TenseFuture, want: "The report will be written by the analyst"},
+		{name: "perfect overrides verb", verb: "has written", object: "the report", tense: inflect.TensePresent, want: "The report is written by the analyst"},
+		{name: "unknown tense", verb: "write", object: "the report", tense: inflect.Tense(99), want: "The report is written by the analyst"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PassivizeWithTense("the analyst", tt.verb, tt.object, tt.tense))
+		})
+	}
+}
+
+func TestEnginePassivize(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "The regexen were checked by the linter", e.Passivize("the linter", "checked", "the regexen"))
+}
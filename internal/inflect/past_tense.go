@@ -9,11 +9,38 @@ import "strings"
 //   - PastTense("go") returns "went"
 //   - PastTense("try") returns "tried"
 //   - PastTense("stop") returns "stopped"
+//
+// The spelling follows the dialect set with SetDialect, as for
+// PresentParticiple: travel -> traveled (US) or travelled (UK).
 func PastTense(verb string) string {
+	return defaultEngine.PastTense(verb)
+}
+
+// PastTense returns the simple past tense form of a verb, in the dialect of
+// this engine. See the package-level PastTense for details.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.PastTense("travel") // returns "travelled"
+func (e *Engine) PastTense(verb string) string {
+	return PastTenseIn(verb, e.GetDialect())
+}
+
+// PastTenseIn returns the simple past tense form of a verb in the given
+// dialect, whatever the dialect set with SetDialect.
+//
+// Examples:
+//   - PastTenseIn("travel", DialectUS) returns "traveled"
+//   - PastTenseIn("travel", DialectUK) returns "travelled"
+//   - PastTenseIn("realise", DialectUS) returns "realized"
+//   - PastTenseIn("realize", DialectUK) returns "realised"
+func PastTenseIn(verb string, d Dialect) string {
 	if verb == "" {
 		return ""
 	}
 
+	verb = verbInDialect(verb, d)
 	lower := strings.ToLower(verb)
 
 	// Check verbs with same past/participle first (most common)
@@ -27,11 +54,12 @@ func PastTense(verb string) string {
 	}
 
 	// Apply regular rules
-	return applyPastTenseRules(verb, lower)
+	return applyPastTenseRules(verb, lower, d)
 }
 
-// applyPastTenseRules applies regular past tense formation rules.
-func applyPastTenseRules(verb, lower string) string {
+// applyPastTenseRules applies the regular past tense formation rules of a
+// dialect.
+func applyPastTenseRules(verb, lower string, d Dialect) string {
 	// Verbs ending in -e: add -d
 	if strings.HasSuffix(lower, "e") {
 		return verb + matchSuffix(verb, "d")
@@ -46,7 +74,7 @@ func applyPastTenseRules(verb, lower string) string {
 	}
 
 	// CVC pattern: double the final consonant and add -ed
-	if shouldDoubleFinalConsonantForPast(lower) {
+	if shouldDoubleFinalConsonantForPast(lower) || doubleConsonantWords[lower] || doublesInDialect(lower, d) {
 		lastChar := string(lower[len(lower)-1])
 		return verb + matchSuffix(verb, lastChar+"ed")
 	}
@@ -0,0 +1,111 @@
+package inflect
+
+import "strings"
+
+// PersonSense is a meaning of "person" and "people", which decides the
+// plural and singular PluralPeople and SingularPeople return.
+type PersonSense int
+
+const (
+	// PersonIndividuals counts human beings, the default.
+	// Example: "person" -> "people", "people" -> "person"
+	PersonIndividuals PersonSense = iota
+
+	// PersonFormal counts human beings in legal and formal writing.
+	// Example: "person" -> "persons", "persons" -> "person"
+	PersonFormal
+
+	// PersonNations counts nations or ethnic groups, each of which is a
+	// people.
+	// Example: "people" -> "peoples", "peoples" -> "people"
+	PersonNations
+)
+
+// personForms maps each sense of "person" to its lowercase singular and
+// plural.
+var personForms = map[PersonSense][2]string{
+	PersonIndividuals: {"person", "people"},
+	PersonFormal:      {"person", "persons"},
+	PersonNations:     {"people", "peoples"},
+}
+
+// personWords contains the lowercase forms of "person" and "people" in
+// every sense.
+var personWords = map[string]bool{
+	"person": true, "persons": true, "people": true, "peoples": true,
+}
+
+// PluralPeople returns the plural of "person" or "people" in a sense:
+// "people" for individuals, "persons" in formal writing, or "peoples" for
+// nations.
+//
+// Plural always uses one plural of "person", chosen by ClassicalPersons,
+// and treats "people" as a singular only when asked for its plural.
+// PluralPeople accepts any form of either word and returns the plural for
+// the sense, whatever the classical settings. Other words are made plural
+// by Plural.
+//
+// Examples:
+//   - PluralPeople("person", PersonIndividuals) returns "people"
+//   - PluralPeople("person", PersonFormal) returns "persons"
+//   - PluralPeople("people", PersonNations) returns "peoples"
+//   - PluralPeople("people", PersonIndividuals) returns "people"
+//   - PluralPeople("cat", PersonNations) returns "cats"
+func PluralPeople(word string, sense PersonSense) string {
+	return defaultEngine.PluralPeople(word, sense)
+}
+
+// PluralPeople returns the plural of "person" or "people" in a sense. See
+// the package-level PluralPeople for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.ClassicalPersons(true)
+//	e.PluralPeople("person", PersonIndividuals) // returns "people"
+//	e.Plural("person")                          // returns "persons"
+func (e *Engine) PluralPeople(word string, sense PersonSense) string {
+	prefix, trimmed, suffix := extractPunctuation(word)
+	if !personWords[strings.ToLower(trimmed)] {
+		return e.Plural(word)
+	}
+	return prefix + matchCase(trimmed, personForm(sense)[1]) + suffix
+}
+
+// SingularPeople returns the singular of "person" or "people" in a sense:
+// "person" for individuals and in formal writing, or "people" for
+// nations.
+//
+// Singular always reads "people" as the plural of "person". SingularPeople
+// accepts any form of either word and returns the singular for the sense,
+// so "people" stays unchanged when it names a nation. Other words are made
+// singular by Singular.
+//
+// Examples:
+//   - SingularPeople("people", PersonIndividuals) returns "person"
+//   - SingularPeople("persons", PersonFormal) returns "person"
+//   - SingularPeople("people", PersonNations) returns "people"
+//   - SingularPeople("peoples", PersonNations) returns "people"
+//   - SingularPeople("cats", PersonNations) returns "cat"
+func SingularPeople(word string, sense PersonSense) string {
+	return defaultEngine.SingularPeople(word, sense)
+}
+
+// SingularPeople returns the singular of "person" or "people" in a sense.
+// See the package-level SingularPeople for details.
+func (e *Engine) SingularPeople(word string, sense PersonSense) string {
+	prefix, trimmed, suffix := extractPunctuation(word)
+	if !personWords[strings.ToLower(trimmed)] {
+		return e.Singular(word)
+	}
+	return prefix + matchCase(trimmed, personForm(sense)[0]) + suffix
+}
+
+// personForm returns the lowercase singular and plural of "person" in a
+// sense, or in PersonIndividuals if the sense is unknown.
+func personForm(sense PersonSense) [2]string {
+	if forms, ok := personForms[sense]; ok {
+		return forms
+	}
+	return personForms[PersonIndividuals]
+}
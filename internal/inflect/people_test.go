@@ -0,0 +1,66 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralPeople(t *testing.T) {
+	tests := []struct {
+		name  string
+		word  string
+		sense inflect.PersonSense
+		want  string
+	}{
+		{name: "individuals", word: "person", sense: inflect.PersonIndividuals, want: "people"},
+		{name: "individuals already plural", word: "people", sense: inflect.PersonIndividuals, want: "people"},
+		{name: "formal", word: "person", sense: inflect.PersonFormal, want: "persons"},
+		{name: "formal from people", word: "people", sense: inflect.PersonFormal, want: "persons"},
+		{name: "nations", word: "people", sense: inflect.PersonNations, want: "peoples"},
+		{name: "nations already plural", word: "peoples", sense: inflect.PersonNations, want: "peoples"},
+		{name: "title case", word: "People", sense: inflect.PersonNations, want: "Peoples"},
+		{name: "upper case", word: "PERSON", sense: inflect.PersonFormal, want: "PERSONS"},
+		{name: "punctuation", word: "(people)", sense: inflect.PersonNations, want: "(peoples)"},
+		{name: "unknown sense", word: "person", sense: inflect.PersonSense(99), want: "people"},
+		{name: "other word", word: "cat", sense: inflect.PersonNations, want: "cats"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralPeople(tt.word, tt.sense))
+		})
+	}
+}
+
+func TestSingularPeople(t *testing.T) {
+	tests := []struct {
+		name  string
+		word  string
+		sense inflect.PersonSense
+		want  string
+	}{
+		{name: "individuals", word: "people", sense: inflect.PersonIndividuals, want: "person"},
+		{name: "formal", word: "persons", sense: inflect.PersonFormal, want: "person"},
+		{name: "nations singular", word: "people", sense: inflect.PersonNations, want: "people"},
+		{name: "nations plural", word: "peoples", sense: inflect.PersonNations, want: "people"},
+		{name: "title case", word: "Peoples", sense: inflect.PersonNations, want: "People"},
+		{name: "other word", word: "cats", sense: inflect.PersonNations, want: "cat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.SingularPeople(tt.word, tt.sense))
+		})
+	}
+}
+
+func TestPluralPeopleIgnoresClassicalPersons(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalPersons(true)
+	assert.Equal(t, "persons", e.Plural("person"))
+	assert.Equal(t, "people", e.PluralPeople("person", inflect.PersonIndividuals))
+	assert.Equal(t, "peoples", e.PluralPeople("person", inflect.PersonNations))
+}
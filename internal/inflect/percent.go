@@ -0,0 +1,60 @@
+package inflect
+
+import "math"
+
+// PercentToWords converts a percentage to its English word representation.
+//
+// The value is rounded to two decimal places before conversion. Use
+// PercentToWordsWithPrecision for a different rounding precision. NaN and
+// infinite values return an empty string.
+//
+// Examples:
+//   - PercentToWords(12.5) returns "twelve point five percent"
+//   - PercentToWords(100) returns "one hundred percent"
+//   - PercentToWords(33.3333) returns "thirty-three point three three percent"
+//   - PercentToWords(-4) returns "negative four percent"
+func PercentToWords(percent float64) string {
+	return PercentToWordsWithPrecision(percent, 2)
+}
+
+// PercentToWordsWithPrecision converts a percentage to its English word
+// representation, rounded to the given number of decimal places.
+//
+// A negative precision disables rounding. NaN and infinite values return
+// an empty string.
+//
+// Examples:
+//   - PercentToWordsWithPrecision(33.3333, 0) returns "thirty-three percent"
+//   - PercentToWordsWithPrecision(33.3333, 1) returns "thirty-three point three percent"
+//   - PercentToWordsWithPrecision(99.96, 1) returns "one hundred percent"
+//   - PercentToWordsWithPrecision(0.125, -1) returns "zero point one two five percent"
+func PercentToWordsWithPrecision(percent float64, precision int) string {
+	if math.IsNaN(percent) || math.IsInf(percent, 0) {
+		return ""
+	}
+	if precision >= 0 {
+		percent = roundToPrecision(percent, precision)
+	}
+	return NumberToWordsFloat(percent) + " percent"
+}
+
+// RatioToWords converts a ratio to English words using "out of".
+//
+// Examples:
+//   - RatioToWords(3, 4) returns "three out of four"
+//   - RatioToWords(1, 10) returns "one out of ten"
+//   - RatioToWords(9, 10) returns "nine out of ten"
+func RatioToWords(numerator, denominator int) string {
+	return RatioToWordsWithSep(numerator, denominator, "out of")
+}
+
+// RatioToWordsWithSep converts a ratio to English words using a custom
+// word or phrase between the two numbers.
+//
+// Examples:
+//   - RatioToWordsWithSep(3, 4, "in") returns "three in four"
+//   - RatioToWordsWithSep(3, 4, "to") returns "three to four"
+//   - RatioToWordsWithSep(1, 1000, "in every") returns "one in every one thousand"
+func RatioToWordsWithSep(numerator, denominator int, sep string) string {
+	return NumberToWords(numerator) + " " + sep + " " + NumberToWords(denominator)
+}
@@ -0,0 +1,96 @@
+package inflect_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPercentToWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		{name: "decimal", input: 12.5, want: "twelve point five percent"},
+		{name: "whole", input: 100, want: "one hundred percent"},
+		{name: "zero", input: 0, want: "zero percent"},
+		{name: "rounds to two places", input: 33.3333, want: "thirty-three point three three percent"},
+		{name: "rounds up", input: 66.6666, want: "sixty-six point six seven percent"},
+		{name: "small", input: 0.05, want: "zero point zero five percent"},
+		{name: "negative", input: -4, want: "negative four percent"},
+		{name: "negative rounds to zero", input: -0.001, want: "zero percent"},
+		{name: "NaN", input: math.NaN(), want: ""},
+		{name: "infinity", input: math.Inf(1), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PercentToWords(tt.input))
+		})
+	}
+}
+
+func TestPercentToWordsWithPrecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     float64
+		precision int
+		want      string
+	}{
+		{name: "zero places", input: 33.3333, precision: 0, want: "thirty-three percent"},
+		{name: "one place", input: 33.3333, precision: 1, want: "thirty-three point three percent"},
+		{name: "rounds to whole", input: 99.96, precision: 1, want: "one hundred percent"},
+		{name: "three places", input: 12.34567, precision: 3, want: "twelve point three four six percent"},
+		{name: "no rounding", input: 0.125, precision: -1, want: "zero point one two five percent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PercentToWordsWithPrecision(tt.input, tt.precision))
+		})
+	}
+}
+
+func TestRatioToWords(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   int
+		denominator int
+		want        string
+	}{
+		{name: "three out of four", numerator: 3, denominator: 4, want: "three out of four"},
+		{name: "nine out of ten", numerator: 9, denominator: 10, want: "nine out of ten"},
+		{name: "zero", numerator: 0, denominator: 5, want: "zero out of five"},
+		{name: "large", numerator: 42, denominator: 1000, want: "forty-two out of one thousand"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.RatioToWords(tt.numerator, tt.denominator))
+		})
+	}
+}
+
+func TestRatioToWordsWithSep(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   int
+		denominator int
+		sep         string
+		want        string
+	}{
+		{name: "in", numerator: 3, denominator: 4, sep: "in", want: "three in four"},
+		{name: "to", numerator: 3, denominator: 4, sep: "to", want: "three to four"},
+		{name: "in every", numerator: 1, denominator: 1000, sep: "in every", want: "one in every one thousand"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.RatioToWordsWithSep(tt.numerator, tt.denominator, tt.sep))
+		})
+	}
+}
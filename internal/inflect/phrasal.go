@@ -0,0 +1,118 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// PluralVerbPhrase returns the plural form of a verb phrase, such as a
+// phrasal verb, by inflecting only its first word, the head verb. The
+// particles and any other words are kept as they are.
+//
+// As for PluralVerb, a count of 1 or -1 returns the singular form, and
+// with no count the default count set by Num() is used.
+//
+// Examples:
+//   - PluralVerbPhrase("logs in") returns "log in"
+//   - PluralVerbPhrase("gives up on") returns "give up on"
+//   - PluralVerbPhrase("is set up") returns "are set up"
+//   - PluralVerbPhrase("are set up", 1) returns "is set up"
+func PluralVerbPhrase(phrase string, count ...int) string {
+	return defaultEngine.PluralVerbPhrase(phrase, count...)
+}
+
+// PluralVerbPhrase returns the plural form of a verb phrase by inflecting
+// only its head verb. See the package-level PluralVerbPhrase for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.PluralVerbPhrase("checks out")    // returns "check out"
+//	e.PluralVerbPhrase("checks out", 1) // returns "checks out"
+func (e *Engine) PluralVerbPhrase(phrase string, count ...int) string {
+	return inflectHeadVerb(phrase, func(verb string) string {
+		return e.PluralVerb(verb, count...)
+	})
+}
+
+// PresentParticiplePhrase converts a verb phrase, such as a phrasal verb,
+// to its present participle (-ing) form by inflecting only its head verb.
+//
+// Examples:
+//   - PresentParticiplePhrase("set up") returns "setting up"
+//   - PresentParticiplePhrase("give up on") returns "giving up on"
+//   - PresentParticiplePhrase("make do with") returns "making do with"
+func PresentParticiplePhrase(phrase string) string {
+	return defaultEngine.PresentParticiplePhrase(phrase)
+}
+
+// PresentParticiplePhrase converts a verb phrase to its present participle
+// (-ing) form, in the dialect of this engine. See the package-level
+// PresentParticiplePhrase for details.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.PresentParticiplePhrase("travel around") // returns "travelling around"
+func (e *Engine) PresentParticiplePhrase(phrase string) string {
+	return inflectHeadVerb(phrase, e.PresentParticiple)
+}
+
+// PastTensePhrase returns the simple past tense form of a verb phrase, such
+// as a phrasal verb, by inflecting only its head verb.
+//
+// Examples:
+//   - PastTensePhrase("check out") returns "checked out"
+//   - PastTensePhrase("give up on") returns "gave up on"
+//   - PastTensePhrase("log in") returns "logged in"
+func PastTensePhrase(phrase string) string {
+	return defaultEngine.PastTensePhrase(phrase)
+}
+
+// PastTensePhrase returns the simple past tense form of a verb phrase, in
+// the dialect of this engine. See the package-level PastTensePhrase for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.PastTensePhrase("set up") // returns "set up"
+func (e *Engine) PastTensePhrase(phrase string) string {
+	return inflectHeadVerb(phrase, e.PastTense)
+}
+
+// PastParticiplePhrase converts a verb phrase, such as a phrasal verb, to
+// its past participle form by inflecting only its head verb.
+//
+// Examples:
+//   - PastParticiplePhrase("break down") returns "broken down"
+//   - PastParticiplePhrase("sign up for") returns "signed up for"
+func PastParticiplePhrase(phrase string) string {
+	return defaultEngine.PastParticiplePhrase(phrase)
+}
+
+// PastParticiplePhrase converts a verb phrase to its past participle form,
+// in the dialect of this engine. See the package-level PastParticiplePhrase
+// for details.
+//
+// Examples:
+//
+//	e := NewEngine(WithDialect(DialectUK))
+//	e.PastParticiplePhrase("cancel out") // returns "cancelled out"
+func (e *Engine) PastParticiplePhrase(phrase string) string {
+	return inflectHeadVerb(phrase, e.PastParticiple)
+}
+
+// inflectHeadVerb applies inflect to the first word of a verb phrase and
+// keeps the rest of the phrase and its surrounding whitespace.
+func inflectHeadVerb(phrase string, inflect func(string) string) string {
+	prefix, trimmed, suffix := extractWhitespace(phrase)
+	if trimmed == "" {
+		return phrase
+	}
+	head, rest := trimmed, ""
+	if i := strings.IndexFunc(trimmed, unicode.IsSpace); i >= 0 {
+		head, rest = trimmed[:i], trimmed[i:]
+	}
+	return prefix + inflect(head) + rest + suffix
+}
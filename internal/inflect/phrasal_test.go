@@ -0,0 +1,67 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralVerbPhrase(t *testing.T) {
+	tests := []struct {
+		phrase string
+		count  []int
+		want   string
+	}{
+		{phrase: "logs in", want: "log in"},
+		{phrase: "gives up on", want: "give up on"},
+		{phrase: "is set up", want: "are set up"},
+		{phrase: "Watches Out", want: "Watch Out"},
+		{phrase: "log in", want: "log in"},
+		{phrase: "are set up", count: []int{1}, want: "is set up"},
+		{phrase: "logs in", count: []int{1}, want: "logs in"},
+		{phrase: " logs  in ", want: " log  in "},
+		{phrase: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralVerbPhrase(tt.phrase, tt.count...))
+		})
+	}
+}
+
+func TestVerbPhraseTenses(t *testing.T) {
+	tests := []struct {
+		phrase     string
+		past       string
+		participle string
+		ing        string
+	}{
+		{phrase: "check out", past: "checked out", participle: "checked out", ing: "checking out"},
+		{phrase: "set up", past: "set up", participle: "set up", ing: "setting up"},
+		{phrase: "log in", past: "logged in", participle: "logged in", ing: "logging in"},
+		{phrase: "give up on", past: "gave up on", participle: "given up on", ing: "giving up on"},
+		{phrase: "break down", past: "broke down", participle: "broken down", ing: "breaking down"},
+		{phrase: "Sign Up", past: "Signed Up", participle: "Signed Up", ing: "Signing Up"},
+		{phrase: "run", past: "ran", participle: "run", ing: "running"},
+		{phrase: "  look  after ", past: "  looked  after ", participle: "  looked  after ", ing: "  looking  after "},
+		{phrase: "", past: "", participle: "", ing: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			assert.Equal(t, tt.past, inflect.PastTensePhrase(tt.phrase))
+			assert.Equal(t, tt.participle, inflect.PastParticiplePhrase(tt.phrase))
+			assert.Equal(t, tt.ing, inflect.PresentParticiplePhrase(tt.phrase))
+		})
+	}
+}
+
+func TestVerbPhraseDialect(t *testing.T) {
+	e := inflect.NewEngine(inflect.WithDialect(inflect.DialectUK))
+	assert.Equal(t, "travelling around", e.PresentParticiplePhrase("travel around"))
+	assert.Equal(t, "cancelled out", e.PastTensePhrase("cancel out"))
+	assert.Equal(t, "cancelled out", e.PastParticiplePhrase("cancel out"))
+}
@@ -0,0 +1,71 @@
+package inflect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Phrase builds a counted noun phrase in which the article or count, the
+// adjectives, and the noun agree.
+//
+// A count of 1 takes "a" or "an", chosen by the first adjective (or the
+// noun if there are none). A count of 0 uses "no", and any other count is
+// written as digits. Only the last word of the noun is made plural.
+// Blank adjectives are skipped.
+//
+// Examples:
+//   - Phrase(1, []string{"old", "oak"}, "tree") returns "an old oak tree"
+//   - Phrase(3, []string{"old", "oak"}, "tree") returns "3 old oak trees"
+//   - Phrase(0, []string{"old", "oak"}, "tree") returns "no old oak trees"
+//   - Phrase(1, nil, "hour") returns "an hour"
+//   - Phrase(2, []string{"little"}, "field mouse") returns "2 little field mice"
+func Phrase(count int, adjectives []string, noun string) string {
+	return defaultEngine.Phrase(count, adjectives, noun)
+}
+
+// Phrase builds a counted noun phrase in which the article or count, the
+// adjectives, and the noun agree.
+//
+// A count of 1 takes "a" or "an", chosen by the first adjective (or the
+// noun if there are none). A count of 0 uses "no" (with a singular noun if
+// ClassicalZero is enabled), and any other count is written as digits.
+// Only the last word of the noun is made plural. Blank adjectives are
+// skipped.
+//
+// Examples:
+//   - e.Phrase(1, []string{"old", "oak"}, "tree") returns "an old oak tree"
+//   - e.Phrase(3, []string{"old", "oak"}, "tree") returns "3 old oak trees"
+//   - e.Phrase(0, []string{"old", "oak"}, "tree") returns "no old oak trees"
+//   - e.Phrase(1, nil, "hour") returns "an hour"
+//   - e.Phrase(2, []string{"little"}, "field mouse") returns "2 little field mice"
+func (e *Engine) Phrase(count int, adjectives []string, noun string) string {
+	noun = strings.TrimSpace(noun)
+	plural := count != 1 && count != -1 && (count != 0 || !e.IsClassicalZero())
+	if plural && noun != "" {
+		head, last, _ := splitLastWord(noun)
+		noun = head + e.plural(last)
+	}
+
+	words := make([]string, 0, len(adjectives)+1)
+	for _, adj := range adjectives {
+		if adj = strings.TrimSpace(adj); adj != "" {
+			words = append(words, adj)
+		}
+	}
+	if noun != "" {
+		words = append(words, noun)
+	}
+	if len(words) == 0 {
+		return ""
+	}
+	text := strings.Join(words, " ")
+
+	switch count {
+	case 1:
+		return e.An(text)
+	case 0:
+		return "no " + text
+	default:
+		return strconv.Itoa(count) + " " + text
+	}
+}
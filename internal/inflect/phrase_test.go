@@ -0,0 +1,61 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPhrase(t *testing.T) {
+	tests := []struct {
+		name       string
+		count      int
+		adjectives []string
+		noun       string
+		want       string
+	}{
+		// Article chosen by the first adjective
+		{name: "an before vowel adjective", count: 1, adjectives: []string{"old", "oak"}, noun: "tree", want: "an old oak tree"},
+		{name: "a before consonant adjective", count: 1, adjectives: []string{"tall"}, noun: "oak", want: "a tall oak"},
+		{name: "a before consonant-sound adjective", count: 1, adjectives: []string{"unique"}, noun: "apple", want: "a unique apple"},
+		{name: "an before silent h", count: 1, adjectives: []string{"honest"}, noun: "mistake", want: "an honest mistake"},
+		{name: "no adjectives", count: 1, adjectives: nil, noun: "hour", want: "an hour"},
+
+		// Counts
+		{name: "zero", count: 0, adjectives: []string{"old", "oak"}, noun: "tree", want: "no old oak trees"},
+		{name: "plural count", count: 3, adjectives: []string{"old", "oak"}, noun: "tree", want: "3 old oak trees"},
+		{name: "negative one", count: -1, adjectives: []string{"red"}, noun: "ball", want: "-1 red ball"},
+		{name: "negative", count: -2, adjectives: []string{"red"}, noun: "ball", want: "-2 red balls"},
+
+		// Noun pluralization
+		{name: "irregular noun", count: 2, adjectives: []string{"small"}, noun: "child", want: "2 small children"},
+		{name: "multi-word noun", count: 2, adjectives: []string{"little"}, noun: "field mouse", want: "2 little field mice"},
+		{name: "adjective not pluralized", count: 4, adjectives: []string{"glass"}, noun: "box", want: "4 glass boxes"},
+
+		// Whitespace and blanks
+		{name: "blank adjectives skipped", count: 1, adjectives: []string{"", " ", "orange"}, noun: "cat", want: "an orange cat"},
+		{name: "trimmed words", count: 2, adjectives: []string{" big "}, noun: " dog ", want: "2 big dogs"},
+		{name: "empty", count: 1, adjectives: nil, noun: "", want: ""},
+		{name: "adjectives only", count: 1, adjectives: []string{"elegant"}, noun: "", want: "an elegant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Phrase(tt.count, tt.adjectives, tt.noun))
+		})
+	}
+}
+
+func TestPhraseClassicalZero(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalZero(true)
+	assert.Equal(t, "no old oak tree", e.Phrase(0, []string{"old", "oak"}, "tree"))
+}
+
+func TestPhraseIgnoresNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "3 old trees", e.Phrase(3, []string{"old"}, "tree"))
+}
@@ -0,0 +1,215 @@
+package inflect
+
+import "strings"
+
+// Stage is one step of a Pipeline: a function that takes a piece of text
+// and returns it corrected or rewritten.
+//
+// FixArticles, FixAgreement, and ExpandContractions return the built-in
+// stages. Any function with the same signature, such as Contract or
+// strings.TrimSpace, can be used as a stage too.
+type Stage func(text string) string
+
+// Pipeline is a sequence of Stages applied to text in order, for cleaning
+// up generated prose in a single call. It is created with NewPipeline and
+// is safe for concurrent use if its stages are.
+type Pipeline struct {
+	stages []Stage
+}
+
+// NewPipeline returns a Pipeline that applies the given stages in order.
+// Nil stages are skipped.
+//
+// Examples:
+//
+//	p := NewPipeline(FixArticles(), FixAgreement(), ExpandContractions())
+//	p.Apply("They doesn't want a apple.") // returns "They do not want an apple."
+func NewPipeline(stages ...Stage) *Pipeline {
+	p := &Pipeline{}
+	for _, s := range stages {
+		if s != nil {
+			p.stages = append(p.stages, s)
+		}
+	}
+	return p
+}
+
+// Then returns a new Pipeline that applies the stages of p followed by the
+// given stages. p itself is unchanged.
+//
+// Examples:
+//
+//	p := NewPipeline(FixArticles())
+//	q := p.Then(Contract)
+//	q.Apply("I am not a expert.") // returns "I'm not an expert."
+func (p *Pipeline) Then(stages ...Stage) *Pipeline {
+	q := NewPipeline(stages...)
+	q.stages = append(append([]Stage(nil), p.stages...), q.stages...)
+	return q
+}
+
+// Apply runs text through each stage of p in order and returns the result.
+// A nil Pipeline returns text unchanged.
+//
+// Examples:
+//
+//	p := NewPipeline(FixAgreement())
+//	p.Apply("The cats is asleep.") // returns "The cats are asleep."
+func (p *Pipeline) Apply(text string) string {
+	if p == nil {
+		return text
+	}
+	for _, s := range p.stages {
+		text = s(text)
+	}
+	return text
+}
+
+// FixArticles returns a Stage that corrects every indefinite article in the
+// text, as AnInSentence does.
+//
+// Examples:
+//
+//	FixArticles()("We saw a owl.") // returns "We saw an owl."
+func FixArticles() Stage {
+	return defaultEngine.FixArticles()
+}
+
+// FixArticles returns a Stage that corrects every indefinite article in the
+// text, as e.AnInSentence does. See the package-level FixArticles for
+// details.
+func (e *Engine) FixArticles() Stage {
+	return e.AnInSentence
+}
+
+// FixAgreement returns a Stage that makes present tense verbs agree with
+// their subjects.
+//
+// A verb is corrected after a subject pronoun ("he run" -> "he runs",
+// "I is" -> "I am"), and a form of "be", "have", "do", or a verb that
+// InflectDocument recognizes is corrected after a noun that follows a
+// determiner ("the cats is" -> "the cats are"). "It" and "you" count as
+// subjects only at the start of a sentence or clause, so that "give it
+// time" is unchanged. Like InflectDocument, this is a heuristic: verbs
+// separated from their subjects, as in "the cats on the mat is", are not
+// corrected.
+//
+// Examples:
+//
+//	FixAgreement()("He run and they runs.") // returns "He runs and they run."
+//	FixAgreement()("The servers was down.") // returns "The servers were down."
+//	FixAgreement()("I is ready.")           // returns "I am ready."
+func FixAgreement() Stage {
+	return defaultEngine.FixAgreement()
+}
+
+// FixAgreement returns a Stage that makes present tense verbs agree with
+// their subjects, using e's definitions. See the package-level FixAgreement
+// for details.
+func (e *Engine) FixAgreement() Stage {
+	return e.fixAgreement
+}
+
+// ExpandContractions returns a Stage that replaces contractions with the
+// words they stand for, as Expand does.
+//
+// Examples:
+//
+//	ExpandContractions()("We can't stay.") // returns "We cannot stay."
+func ExpandContractions() Stage {
+	return Expand
+}
+
+// eitherNumberNouns contains nouns that are used with either a singular or
+// a plural verb, such as "the data is" and "the team are", so that
+// FixAgreement leaves the verbs after them alone.
+var eitherNumberNouns = map[string]bool{
+	"agenda": true, "committee": true, "crew": true, "data": true,
+	"family": true, "government": true, "graffiti": true, "media": true,
+	"staff": true, "team": true, "trivia": true,
+}
+
+// fixAgreement makes the present tense verbs of text agree with their
+// subjects.
+func (e *Engine) fixAgreement(text string) string {
+	tokens := e.tokenize(text)
+
+	var b strings.Builder
+	last := 0
+	for i, tok := range tokens {
+		if tok.Prev == "" {
+			continue
+		}
+		subject := lowerWord(tokens[i-1].Text)
+		plural, pronoun, ok := subjectNumber(tokens[i-1])
+		if !ok || !e.agreeingVerb(lowerWord(tok.Text), tok, pronoun, plural) {
+			continue
+		}
+		fixed := e.inflectVerb(tok.Text, plural)
+		switch {
+		case subject == "i":
+			fixed = firstPersonVerb(fixed)
+		case strings.EqualFold(fixed, "am") && plural:
+			// "they am"
+			fixed = matchCase(fixed, "are")
+		case strings.EqualFold(fixed, "am"):
+			fixed = matchCase(fixed, "is")
+		}
+		if fixed == tok.Text {
+			continue
+		}
+		b.WriteString(text[last:tok.Offset])
+		b.WriteString(fixed)
+		last = tok.Offset + len(tok.Text)
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// subjectNumber reports whether tok is the subject of a following verb, and
+// if so whether it is plural and whether it is a pronoun.
+func subjectNumber(tok Token) (plural, pronoun, ok bool) {
+	lower := lowerWord(tok.Text)
+	switch {
+	case personalSubjects[lower]:
+		if (lower == "it" || lower == "you") && tok.Prev != "" && !functionWords[tok.Prev] && !otherWords[tok.Prev] {
+			// "give it time", "I like you"
+			return false, false, false
+		}
+		return pluralSubjects[lower] || lower == "i", true, true
+	case eitherNumberNouns[lower] || massNouns[lower]:
+		return false, false, false
+	case tok.POS == POSNoun && tok.Confidence > 0 && (questionDeterminers[tok.Prev] || documentDeterminers[tok.Prev]):
+		return tok.Plural, false, true
+	}
+	return false, false, false
+}
+
+// agreeingVerb reports whether the lowercase word of tok, after a subject,
+// is a present tense verb whose form depends on the number of the subject.
+func (e *Engine) agreeingVerb(lower string, tok Token, pronoun, plural bool) bool {
+	if _, ok := verbSingularToPlural[lower]; ok || lower == "am" {
+		return true
+	}
+	if _, ok := verbPluralToSingular[lower]; ok {
+		return true
+	}
+	if _, ok := pastTenseBase(lower); ok || verbUnchanged[lower] || isAuxiliary(lower) {
+		return false
+	}
+	if tok.POS == POSVerb {
+		return true
+	}
+	if tok.POS == POSAdjective || tok.POS == POSOther || tok.POS == POSPronoun || e.isModifier(lower) {
+		return false
+	}
+	_, third := thirdPersonBase(lower)
+	if !pronoun {
+		// "the users logs in", but not "the users settings page"
+		return plural && third && (tok.Next == "" || functionWords[tok.Next] || otherWords[tok.Next])
+	}
+	return third || isBaseVerb(lower)
+}
@@ -0,0 +1,87 @@
+package inflect_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPipeline(t *testing.T) {
+	p := inflect.NewPipeline(inflect.FixArticles(), nil, inflect.FixAgreement(), inflect.ExpandContractions())
+	assert.Equal(t, "They do not want an apple.", p.Apply("They doesn't want a apple."))
+	assert.Equal(t, "", p.Apply(""))
+
+	q := p.Then(strings.ToUpper)
+	assert.Equal(t, "HE RUNS.", q.Apply("He run."))
+	assert.Equal(t, "He runs.", p.Apply("He run."), "Then leaves p unchanged")
+
+	var nilPipeline *inflect.Pipeline
+	assert.Equal(t, "a owl", nilPipeline.Apply("a owl"))
+	assert.Equal(t, "a owl", inflect.NewPipeline().Apply("a owl"))
+}
+
+func TestPipelineOrder(t *testing.T) {
+	// Expanding first leaves "does not" for FixAgreement to correct
+	p := inflect.NewPipeline(inflect.ExpandContractions(), inflect.FixAgreement())
+	assert.Equal(t, "They do not care.", p.Apply("They doesn't care."))
+
+	p = inflect.NewPipeline(inflect.FixAgreement(), inflect.Contract)
+	assert.Equal(t, "They don't care.", p.Apply("They does not care."))
+}
+
+func TestFixAgreement(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "He run and they runs.", want: "He runs and they run."},
+		{input: "I is ready.", want: "I am ready."},
+		{input: "I are here.", want: "I am here."},
+		{input: "he am here", want: "he is here"},
+		{input: "You is late.", want: "You are late."},
+		{input: "She have a car.", want: "She has a car."},
+		{input: "We has gone.", want: "We have gone."},
+		{input: "They doesn't care.", want: "They don't care."},
+		{input: "When it rain, we sleeps.", want: "When it rains, we sleep."},
+		{input: "The cats is asleep.", want: "The cats are asleep."},
+		{input: "The servers was down.", want: "The servers were down."},
+		{input: "The users logs in.", want: "The users log in."},
+		{input: "My dogs barks", want: "My dogs bark"},
+		{input: "THE CATS IS ASLEEP", want: "THE CATS ARE ASLEEP"},
+
+		// Unchanged
+		{input: "He runs and they run.", want: "He runs and they run."},
+		{input: "Give it time.", want: "Give it time."},
+		{input: "I like you very much.", want: "I like you very much."},
+		{input: "They ran home.", want: "They ran home."},
+		{input: "He can run.", want: "He can run."},
+		{input: "The data is here.", want: "The data is here."},
+		{input: "The team are here.", want: "The team are here."},
+		{input: "The users settings page loads.", want: "The users settings page loads."},
+		{input: "The cat toys are here.", want: "The cat toys are here."},
+		{input: "It's fine.", want: "It's fine."},
+		{input: "", want: ""},
+	}
+
+	fix := inflect.FixAgreement()
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, fix(tt.input))
+		})
+	}
+}
+
+func TestFixAgreementEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("gizmo", "gizmoz")
+	fix := e.FixAgreement()
+	assert.Equal(t, "The gizmoz are here.", fix("The gizmoz is here."))
+	assert.Equal(t, "The gizmoz is here.", inflect.FixAgreement()("The gizmoz is here."))
+
+	e.DefSilentH("homage")
+	assert.Equal(t, "an homage", e.FixArticles()("a homage"))
+	assert.Equal(t, "a homage", inflect.FixArticles()("a homage"))
+}
@@ -1,13 +1,17 @@
 package inflect
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 // changeToVesWords contains words ending in -f/-fe that change to -ves.
+// Compounds ending in one of them follow it (see shouldChangeF).
 var changeToVesWords = map[string]bool{
-	"calf": true, "elf": true, "half": true, "hoof": true, "knife": true,
-	"leaf": true, "life": true, "loaf": true, "scarf": true, "self": true,
-	"sheaf": true, "shelf": true, "thief": true, "wharf": true, "wife": true,
-	"wolf": true,
+	"calf": true, "dwarf": true, "elf": true, "half": true, "hoof": true,
+	"knife": true, "leaf": true, "life": true, "loaf": true, "scarf": true,
+	"self": true, "sheaf": true, "shelf": true, "thief": true, "wharf": true,
+	"wife": true, "wolf": true,
 }
 
 // oExceptionWords contains words ending in -o that just take -s (not -es).
@@ -56,6 +60,14 @@ var unchangedPlurals = map[string]bool{
 	"manga": true, "karaoke": true,
 }
 
+// eseNouns contains nouns ending in -ese that take a regular plural.
+// Other words ending in -ese are nationalities and languages, which are
+// unchanged in the plural: "Chinese", "Portuguese".
+var eseNouns = map[string]bool{
+	"archdiocese": true, "cheese": true, "chersonese": true, "diocese": true,
+	"headcheese": true,
+}
+
 // herdAnimals contains animals that have both unchanged (classical) and
 // regular -s (modern) plural forms. When classicalHerd is enabled,
 // these remain unchanged; otherwise they take -s.
@@ -353,144 +365,401 @@ var defaultIrregularPlurals = map[string]string{
 
 // Plural returns the plural form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// singular possessive becomes a plural possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - Plural("cat") returns "cats"
 //   - Plural("box") returns "boxes"
 //   - Plural("child") returns "children"
 //   - Plural("sheep") returns "sheep"
+//   - Plural("cat's") returns "cats'"
+//   - Plural("child's") returns "children's"
+//   - Plural("cat,") returns "cats,"
+//   - Plural("child.json") returns "children.json"
+//   - Plural("iPhone") returns "iPhones"
+//
+// If a default count of 1 has been set with Num() and count propagation is
+// enabled, the word is returned unchanged.
 func Plural(word string) string {
 	return defaultEngine.Plural(word)
 }
 
 // Plural returns the plural form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// singular possessive becomes a plural possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - e.Plural("cat") returns "cats"
 //   - e.Plural("box") returns "boxes"
 //   - e.Plural("child") returns "children"
 //   - e.Plural("sheep") returns "sheep"
+//   - e.Plural("cat's") returns "cats'"
+//   - e.Plural("child's") returns "children's"
+//   - e.Plural("cat,") returns "cats,"
+//   - e.Plural("child.json") returns "children.json"
+//   - e.Plural("iPhone") returns "iPhones"
+//
+// If a default count of 1 has been set with e.Num() and count propagation
+// is enabled, the word is returned unchanged.
 func (e *Engine) Plural(word string) string {
+	return e.lookup(opPlural, word)
+}
+
+// PluralLower returns the plural form of a noun written in lowercase ASCII
+// letters, such as a table or field name, faster than Plural.
+//
+// Words of the form "[a-z]+" cannot contain punctuation, possessives, file
+// extensions, version numbers, or capitalization, so PluralLower skips
+// those steps, along with the result cache and case matching, and goes
+// straight to the noun tables and suffix rules. Any other word is passed
+// to Plural, so the result is always the same as Plural's.
+//
+// Examples:
+//   - PluralLower("user") returns "users"
+//   - PluralLower("category") returns "categories"
+//   - PluralLower("person") returns "people"
+//   - PluralLower("User") returns "Users" (through Plural)
+func PluralLower(word string) string {
+	return defaultEngine.PluralLower(word)
+}
+
+// PluralLower returns the plural form of a noun written in lowercase ASCII
+// letters faster than e.Plural. Any other word is passed to e.Plural. See
+// the package-level PluralLower for details.
+//
+// Examples:
+//   - e.PluralLower("user") returns "users"
+//   - e.PluralLower("person") returns "people"
+func (e *Engine) PluralLower(word string) string {
+	// Hooks see every lookup, so they keep the full path
+	if !isLowerASCII(word) || e.hook.Load() != nil {
+		return e.Plural(word)
+	}
+	if e.isSingularCount(nil) {
+		return word
+	}
+	return e.pluralWord(word, word, nil)
+}
+
+// pluralCounted implements Plural without the cache, recording each rule
+// it applies in x.
+func (e *Engine) pluralCounted(word string, x *explanation) string {
+	if word != "" && e.isSingularCount(nil) {
+		x.note("default count: %s is unchanged for a count of 1", word)
+		return word
+	}
+	return e.pluralExplained(word, x)
+}
+
+// plural implements Plural without consulting the default count.
+func (e *Engine) plural(word string) string {
+	return e.pluralExplained(word, nil)
+}
+
+// pluralExplained implements plural, recording each rule it applies in x.
+func (e *Engine) pluralExplained(word string, x *explanation) string {
 	if word == "" {
 		return ""
 	}
 
+	// Contractions and abbreviations are not nouns: it's, Mr., U.S.
+	if isSContraction(word) {
+		x.note("contraction: %s is unchanged", word)
+		return word
+	}
+	if isDottedAbbreviation(word) {
+		x.note("abbreviation: %s is unchanged", word)
+		return word
+	}
+
+	// Inflect the bare word inside any quotes or punctuation
+	if prefix, trimmed, suffix := extractPunctuation(word); prefix != "" || suffix != "" {
+		if trimmed == "" {
+			x.note("punctuation: %s has no word to inflect", word)
+			return word
+		}
+		x.note("punctuation: inflecting %s inside %s", trimmed, word)
+		return prefix + e.pluralExplained(trimmed, x) + suffix
+	}
+
+	// Keep zero-width joiners, soft hyphens, and other invisible characters
+	if prefix, trimmed, suffix := splitInvisible(word); prefix != "" || suffix != "" {
+		if trimmed == "" {
+			x.note("invisible characters: %q has no word to inflect", word)
+			return word
+		}
+		x.note("invisible characters: inflecting %s inside %q", trimmed, word)
+		return prefix + e.pluralExplained(trimmed, x) + suffix
+	}
+
+	// Singular possessives become plural possessives; plural ones are kept
+	if base, apos, plural := splitPossessive(word); apos != "" {
+		if plural {
+			x.note("possessive: %s is already plural", word)
+			return word
+		}
+		x.note("possessive: inflecting %s", base)
+		return pluralPossessive(e.pluralExplained(base, x), apos)
+	}
+
+	// Inflect the name of a file and keep its extension
+	if name, ext := splitExtension(word); ext != "" {
+		x.note("file extension: inflecting %s and keeping %s", name, ext)
+		return e.pluralExplained(name, x) + ext
+	}
+	if isVersionNumber(word) {
+		x.note("version number: %s is unchanged", word)
+		return word
+	}
+
+	// Keep the capitalization of brand names: iPhone -> iPhones
+	if plural, ok := e.stylizedPlural(word); ok {
+		x.note("stylized word: %s -> %s", word, plural)
+		return plural
+	}
+	if isLowerCamel(word) {
+		x.note("stylized word: inflecting %s in lowercase", word)
+		return restyle(word, e.pluralExplained(strings.ToLower(word), x))
+	}
+
 	// Handle registered acronyms: GPU -> GPUs (lowercase "s")
 	// Only applies to all-uppercase words that are registered acronyms
 	if isAllUppercase(word) && len(word) >= 2 && e.IsAcronym(word) {
+		x.note("acronym registry: %s -> %ss", word, word)
 		return word + "s"
 	}
 
-	lower := strings.ToLower(word)
+	return e.pluralWord(word, strings.ToLower(word), x)
+}
 
+// pluralWord applies the classical, irregular, and custom rules to a bare
+// word, falling back to the suffix rules.
+func (e *Engine) pluralWord(word, lower string, x *explanation) string {
 	// Check for classical proper name handling when classicalNames is enabled.
 	// Proper names (capitalized words) ending in 's' remain unchanged.
 	// Examples: Jones -> Jones, Williams -> Williams
-	if e.IsClassicalNames() && isProperNameEndingInS(word) {
+	if e.IsClassicalNames() && e.isProperNameEndingInS(word) {
+		x.note("classical names: %s is unchanged", word)
 		return word
 	}
 
+	// Check for nouns defined with DefNounMulti when classicalAncient is enabled
+	if plural, ok := e.classicalNoun(lower); ok && e.IsClassical() {
+		x.note("custom noun (DefNounMulti): %s -> %s in classical mode", word, matchCase(word, plural))
+		return matchCase(word, plural)
+	}
+
 	// Check for classical Latin/Greek plurals when classicalAncient is enabled
-	if e.IsClassical() {
-		if plural, ok := classicalLatinPlurals[lower]; ok {
+	if plural, ok := classicalLatinPlurals[lower]; ok {
+		if e.IsClassical() {
+			x.note("classical table: %s -> %s", word, matchCase(word, plural))
 			return matchCase(word, plural)
 		}
+		x.note("classical table: skipped %s -> %s, classical mode is off", lower, plural)
 	}
 
 	// Handle classicalPersons: person -> persons (instead of people)
 	if e.IsClassicalPersons() && lower == "person" {
+		x.note("classical persons: %s -> %s", word, matchCase(word, "persons"))
 		return matchCase(word, "persons")
 	}
 
+	// Handle technical plurals: index -> indexes (instead of indices)
+	if plural, ok := e.technicalPlural(lower); ok {
+		x.note("technical plurals: %s -> %s", word, matchCase(word, plural))
+		return matchCase(word, plural)
+	}
+
 	// Check for irregular plurals first
 	e.mu.RLock()
 	plural, ok := e.irregularPlurals[lower]
 	e.mu.RUnlock()
 	if ok {
+		if defaultIrregularPlurals[lower] == plural {
+			x.note("irregular table: %s -> %s", word, matchCase(word, plural))
+		} else {
+			x.note("custom noun (DefNoun): %s -> %s", word, matchCase(word, plural))
+		}
 		return matchCase(word, plural)
 	}
 
 	// Check for uncountable/unchanged words
-	if unchangedPlurals[lower] {
+	if e.isUnchanged(lower) {
+		x.note("unchanged plurals: %s is unchanged", word)
 		return word
 	}
 
+	// Correct near-misses of irregular nouns in lenient mode: childs -> children
+	if c, ok := e.lenientCorrection(word); ok {
+		x.note("lenient mode: %s", c.Note)
+		return c.Plural
+	}
+
+	// Check for custom suffix rules
+	if plural, ok := e.applyNounRules(word, lower, x); ok {
+		return plural
+	}
+
 	// Check for herd animals (affected by classicalHerd flag)
-	if herdAnimals[lower] {
+	if e.isHerd(lower) {
 		if e.IsClassicalHerd() {
+			x.note("herd animals: %s is unchanged in classical herd mode", word)
 			return word // unchanged in classical mode
 		}
 		// Modern mode: apply standard suffix rules (adds -s or -es)
-		return applySuffixRules(word, lower)
+		x.note("herd animals: classical herd mode is off")
+		return e.applySuffixRules(word, lower, e.isProperName(word), x)
 	}
 
 	// Check for words ending in -ese, -ois (nationalities that don't change)
-	if strings.HasSuffix(lower, "ese") || strings.HasSuffix(lower, "ois") {
+	if eseNouns[lower] {
+		x.note("-ese nouns: %s takes a regular plural", word)
+	} else if strings.HasSuffix(lower, "ese") || strings.HasSuffix(lower, "ois") {
+		x.note("nationality suffix: %s is unchanged", word)
 		return word
 	}
 
 	// Apply suffix rules
-	return applySuffixRules(word, lower)
+	return e.applySuffixRules(word, lower, e.isProperName(word), x)
 }
 
-// applySuffixRules applies standard English pluralization suffix rules.
-func applySuffixRules(word, lower string) string {
-	// Words ending in -man -> -men (except for words in manExceptions)
-	if strings.HasSuffix(lower, "man") && !manExceptions[lower] {
-		return word[:len(word)-3] + matchCase(word[len(word)-3:], "men")
-	}
-
-	// Words ending in -s, -ss, -sh, -ch, -x, -z -> add -es
-	if strings.HasSuffix(lower, "s") || strings.HasSuffix(lower, "ss") ||
-		strings.HasSuffix(lower, "sh") || strings.HasSuffix(lower, "ch") ||
-		strings.HasSuffix(lower, "x") || strings.HasSuffix(lower, "z") {
-		return word + matchSuffix(word, "es")
-	}
-
-	// Words ending in consonant + y -> -ies
-	// Exception: proper names (capitalized words like "Mary") just add -s
-	if strings.HasSuffix(lower, "y") && len(lower) > 1 {
-		beforeY := lower[len(lower)-2]
-		if !isVowel(rune(beforeY)) {
-			// Proper names just add -s: Mary -> Marys, not Maries
-			if isProperName(word) {
-				return word + matchSuffix(word, "s")
-			}
-			return word[:len(word)-1] + matchSuffix(word, "ies")
-		}
+// PluralSafe returns the plural form of an English noun, like Plural, or the
+// noun unchanged if it is already plural.
+//
+// Plural expects a singular noun and inflects whatever it is given, so
+// that Plural("cats") returns "catses". PluralSafe first checks whether
+// the noun is the plural of its singular form, including classical
+// plurals and nouns defined with DefNoun, which makes it safe to call on
+// words whose number is unknown and to call more than once.
+//
+// If count is provided and equals 1 or -1, the word is returned unchanged;
+// any other count makes it plural. If no count is provided, the default
+// count set by Num() is used, as in Plural.
+//
+// Examples:
+//   - PluralSafe("cat") returns "cats"
+//   - PluralSafe("cats") returns "cats"
+//   - PluralSafe("children") returns "children"
+//   - PluralSafe("formulae") returns "formulae"
+//   - PluralSafe("sheep") returns "sheep"
+//   - PluralSafe("cat", 1) returns "cat"
+func PluralSafe(word string, count ...int) string {
+	return defaultEngine.PluralSafe(word, count...)
+}
+
+// PluralSafe returns the plural form of an English noun, or the noun
+// unchanged if it is already plural. See the package-level PluralSafe for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("cow", "kine")
+//	e.PluralSafe("kine") // returns "kine"
+//	e.PluralSafe("cow")  // returns "kine"
+//
+//	e.Num(1)
+//	e.PluralSafe("cow")    // returns "cow"
+//	e.PluralSafe("cow", 2) // returns "kine"
+func (e *Engine) PluralSafe(word string, count ...int) string {
+	if e.isPluralForm(word) {
+		return word
+	}
+	if len(count) == 0 {
+		return e.Plural(word)
+	}
+	if e.isSingularCount(count) {
+		return word
 	}
+	return e.plural(e.normalize(word))
+}
 
-	// Words ending in -f or -fe -> -ves (with exceptions)
-	if strings.HasSuffix(lower, "fe") {
-		if shouldChangeF(lower) {
-			return word[:len(word)-2] + matchSuffix(word, "ves")
-		}
-	} else if strings.HasSuffix(lower, "f") && !strings.HasSuffix(lower, "ff") {
-		if shouldChangeF(lower) {
-			return word[:len(word)-1] + matchSuffix(word, "ves")
-		}
+// pluralSafe implements PluralSafe without consulting the default count,
+// for helpers such as TableName that name things rather than count them.
+func (e *Engine) pluralSafe(word string) string {
+	if e.isPluralForm(word) {
+		return word
 	}
+	return e.plural(word)
+}
 
-	// Words ending in -o -> -oes (with exceptions)
-	if strings.HasSuffix(lower, "o") && len(lower) > 1 {
-		beforeO := lower[len(lower)-2]
-		// Vowel + o -> just add s (radio, studio, zoo)
-		if isVowel(rune(beforeO)) {
-			return word + matchSuffix(word, "s")
-		}
-		// Check if it's an exception that just takes -s
-		if oExceptionTakesS(lower) {
-			return word + matchSuffix(word, "s")
-		}
-		return word + matchSuffix(word, "es")
+// isPluralForm reports whether word is a plural of its singular form in
+// any mode: "cats", "children", "formulae".
+func (e *Engine) isPluralForm(word string) bool {
+	lower := strings.ToLower(word)
+	singular := e.Singular(word)
+	return !strings.EqualFold(singular, word) && slices.Contains(e.pluralVariants(singular), lower)
+}
+
+// PluralLastWord returns a phrase with only its last word made plural.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - PluralLastWord("blue bird") returns "blue birds"
+//   - PluralLastWord("baby child") returns "baby children"
+//   - PluralLastWord("field mouse") returns "field mice"
+//   - PluralLastWord("cat") returns "cats"
+func PluralLastWord(phrase string) string {
+	return defaultEngine.PluralLastWord(phrase)
+}
+
+// PluralLastWord returns a phrase with only its last word made plural.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - e.PluralLastWord("blue bird") returns "blue birds"
+//   - e.PluralLastWord("baby child") returns "baby children"
+//   - e.PluralLastWord("field mouse") returns "field mice"
+//   - e.PluralLastWord("cat") returns "cats"
+func (e *Engine) PluralLastWord(phrase string) string {
+	head, last, tail := splitLastWord(phrase)
+	if last == "" {
+		return phrase
 	}
+	return head + e.Plural(last) + tail
+}
 
-	// Default: add -s
-	return word + matchSuffix(word, "s")
+// pluralPossessive adds the possessive marker to a plural noun using the
+// given apostrophe: "cats" -> "cats'", "children" -> "children's".
+func pluralPossessive(plural, apos string) string {
+	if strings.HasSuffix(strings.ToLower(plural), "s") {
+		return plural + apos
+	}
+	return plural + apos + matchSuffix(plural, "s")
 }
 
+// minVesCompoundPrefix is the shortest first component of a compound read
+// by its last component in shouldChangeF, so that "olives" is not read as
+// "o" + "lives".
+const minVesCompoundPrefix = 3
+
 // shouldChangeF determines if a word ending in -f/-fe should change to -ves.
+// A compound follows its last component: bookshelf -> bookshelves,
+// housewife -> housewives.
 func shouldChangeF(lower string) bool {
-	return changeToVesWords[lower]
+	if changeToVesWords[lower] {
+		return true
+	}
+	for i := minVesCompoundPrefix; i < len(lower); i++ {
+		if changeToVesWords[lower[i:]] {
+			return true
+		}
+	}
+	return false
 }
 
 // oExceptionTakesS returns true if a word ending in -o just takes -s.
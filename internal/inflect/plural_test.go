@@ -2,198 +2,303 @@ package inflect_test
 
 import (
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 
 	inflect "github.com/cv/go-inflect/v2"
 )
 
-func TestPlural(t *testing.T) {
+func TestPluralPunctuationAndPossessives(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
 		want  string
 	}{
-		// Empty string
-		{name: "empty", input: "", want: ""},
+		// Singular possessives become plural possessives
+		{name: "cat's", input: "cat's", want: "cats'"},
+		{name: "child's", input: "child's", want: "children's"},
+		{name: "mouse's", input: "mouse's", want: "mice's"},
+		{name: "boss's", input: "boss's", want: "bosses'"},
+		{name: "uppercase", input: "CAT'S", want: "CATS'"},
+		{name: "curly apostrophe", input: "cat\u2019s", want: "cats\u2019"},
+
+		// Plural possessives are already plural
+		{name: "dogs'", input: "dogs'", want: "dogs'"},
+
+		// Trailing punctuation
+		{name: "comma", input: "cat,", want: "cats,"},
+		{name: "period", input: "box.", want: "boxes."},
+		{name: "question mark", input: "child?", want: "children?"},
+		{name: "possessive with comma", input: "cat's,", want: "cats',"},
+
+		// Quotes and brackets
+		{name: "double quotes", input: `"cat"`, want: `"cats"`},
+		{name: "single quotes", input: "'cat'", want: "'cats'"},
+		{name: "curly quotes", input: "\u2018cat\u2019", want: "\u2018cats\u2019"},
+		{name: "parentheses", input: "(box)", want: "(boxes)"},
+
+		// Contractions and abbreviations are unchanged
+		{name: "it's", input: "it's", want: "it's"},
+		{name: "that's", input: "That's", want: "That's"},
+		{name: "there's with comma", input: "there\u2019s,", want: "there\u2019s,"},
+		{name: "title", input: "Mr.", want: "Mr."},
+		{name: "dotted abbreviation", input: "U.S.", want: "U.S."},
+		{name: "lowercase abbreviation", input: "(e.g.", want: "(e.g."},
+		{name: "sentence end", input: "cat.", want: "cats."},
+
+		// Only punctuation
+		{name: "ellipsis", input: "...", want: "..."},
+		{name: "apostrophe", input: "'", want: "'"},
+	}
 
-		// Regular plurals - add s
-		{name: "cat", input: "cat", want: "cats"},
-		{name: "dog", input: "dog", want: "dogs"},
-		{name: "book", input: "book", want: "books"},
-
-		// Words ending in s, ss, sh, ch, x, z - add es
-		{name: "bus", input: "bus", want: "buses"},
-		{name: "class", input: "class", want: "classes"},
-		{name: "bush", input: "bush", want: "bushes"},
-		{name: "church", input: "church", want: "churches"},
-		{name: "box", input: "box", want: "boxes"},
-		{name: "buzz", input: "buzz", want: "buzzes"},
-
-		// Consonant + y -> ies
-		{name: "city", input: "city", want: "cities"},
-		{name: "baby", input: "baby", want: "babies"},
-		{name: "fly", input: "fly", want: "flies"},
-
-		// Vowel + y -> ys
-		{name: "boy", input: "boy", want: "boys"},
-		{name: "day", input: "day", want: "days"},
-		{name: "key", input: "key", want: "keys"},
-
-		// Words ending in f/fe -> ves
-		{name: "knife", input: "knife", want: "knives"},
-		{name: "wife", input: "wife", want: "wives"},
-		{name: "leaf", input: "leaf", want: "leaves"},
-		{name: "wolf", input: "wolf", want: "wolves"},
-
-		// Words ending in f that just take s
-		{name: "roof", input: "roof", want: "roofs"},
-		{name: "chief", input: "chief", want: "chiefs"},
-
-		// Words ending in o
-		{name: "hero", input: "hero", want: "heroes"},
-		{name: "potato", input: "potato", want: "potatoes"},
-		{name: "tomato", input: "tomato", want: "tomatoes"},
-		{name: "echo", input: "echo", want: "echoes"},
-
-		// Words ending in o that take s (vowel + o, exceptions)
-		{name: "radio", input: "radio", want: "radios"},
-		{name: "studio", input: "studio", want: "studios"},
-		{name: "zoo", input: "zoo", want: "zoos"},
-		{name: "piano", input: "piano", want: "pianos"},
-		{name: "photo", input: "photo", want: "photos"},
-
-		// Irregular plurals
-		{name: "child", input: "child", want: "children"},
-		{name: "foot", input: "foot", want: "feet"},
-		{name: "tooth", input: "tooth", want: "teeth"},
-		{name: "mouse", input: "mouse", want: "mice"},
-		{name: "woman", input: "woman", want: "women"},
-		{name: "man", input: "man", want: "men"},
-		{name: "person", input: "person", want: "people"},
-		{name: "ox", input: "ox", want: "oxen"},
-
-		// Latin/Greek plurals
-		{name: "analysis", input: "analysis", want: "analyses"},
-		{name: "crisis", input: "crisis", want: "crises"},
-		{name: "thesis", input: "thesis", want: "theses"},
-		{name: "cactus", input: "cactus", want: "cacti"},
-		{name: "fungus", input: "fungus", want: "fungi"},
-		{name: "nucleus", input: "nucleus", want: "nuclei"},
-		{name: "bacterium", input: "bacterium", want: "bacteria"},
-		{name: "datum", input: "datum", want: "data"},
-		{name: "medium", input: "medium", want: "media"},
-		{name: "appendix", input: "appendix", want: "appendices"},
-		{name: "index", input: "index", want: "indices"},
-
-		// Unchanged plurals
-		{name: "sheep", input: "sheep", want: "sheep"},
-		{name: "deer", input: "deer", want: "deer"},
-		{name: "fish", input: "fish", want: "fish"},
-		{name: "species", input: "species", want: "species"},
-		{name: "series", input: "series", want: "series"},
-		{name: "aircraft", input: "aircraft", want: "aircraft"},
-
-		// Words ending in -man -> -men
-		{name: "fireman", input: "fireman", want: "firemen"},
-		{name: "policeman", input: "policeman", want: "policemen"},
-		{name: "spokesman", input: "spokesman", want: "spokesmen"},
-
-		// Words ending in -man that should NOT become -men
-		{name: "German", input: "German", want: "Germans"},
-		{name: "Roman", input: "Roman", want: "Romans"},
-		{name: "Ottoman", input: "Ottoman", want: "Ottomans"},
-		{name: "Norman", input: "Norman", want: "Normans"},
-		{name: "shaman", input: "shaman", want: "shamans"},
-		{name: "talisman", input: "talisman", want: "talismans"},
-		{name: "human", input: "human", want: "humans"},
-
-		// Additional Latin neuter (-um -> -a)
-		{name: "addendum", input: "addendum", want: "addenda"},
-		{name: "erratum", input: "erratum", want: "errata"},
-		{name: "symposium", input: "symposium", want: "symposia"},
-		{name: "atrium", input: "atrium", want: "atria"},
-
-		// Greek neuter (-on -> -a)
-		{name: "automaton", input: "automaton", want: "automata"},
-		{name: "polyhedron", input: "polyhedron", want: "polyhedra"},
-
-		// Hebrew plurals
-		{name: "seraph", input: "seraph", want: "seraphim"},
-		{name: "cherub", input: "cherub", want: "cherubim"},
-		{name: "kibbutz", input: "kibbutz", want: "kibbutzim"},
-
-		// Italian plurals
-		{name: "graffito", input: "graffito", want: "graffiti"},
-		{name: "virtuoso", input: "virtuoso", want: "virtuosi"},
-		{name: "libretto", input: "libretto", want: "libretti"},
-
-		// Words ending in -f that now use -ves
-		{name: "hoof", input: "hoof", want: "hooves"},
-		{name: "scarf", input: "scarf", want: "scarves"},
-		{name: "wharf", input: "wharf", want: "wharves"},
-
-		// Additional -is -> -es words
-		{name: "axis", input: "axis", want: "axes"},
-		{name: "ellipsis", input: "ellipsis", want: "ellipses"},
-		{name: "nemesis", input: "nemesis", want: "nemeses"},
-		{name: "synthesis", input: "synthesis", want: "syntheses"},
-
-		// Additional -us -> -i words
-		{name: "calculus", input: "calculus", want: "calculi"},
-		{name: "locus", input: "locus", want: "loci"},
-		{name: "bacillus", input: "bacillus", want: "bacilli"},
-
-		// Additional -ex/-ix -> -ices words
-		{name: "cortex", input: "cortex", want: "cortices"},
-		{name: "vortex", input: "vortex", want: "vortices"},
-		{name: "helix", input: "helix", want: "helices"},
-
-		// French -eau -> -eaux
-		{name: "bureau", input: "bureau", want: "bureaux"},
-		{name: "plateau", input: "plateau", want: "plateaux"},
-		{name: "chateau", input: "chateau", want: "chateaux"},
-
-		// Unchanged plurals (French loanwords, etc.)
-		{name: "corps", input: "corps", want: "corps"},
-		{name: "chassis", input: "chassis", want: "chassis"},
-		{name: "means", input: "means", want: "means"},
-		{name: "gallows", input: "gallows", want: "gallows"},
-		{name: "barracks", input: "barracks", want: "barracks"},
-		{name: "headquarters", input: "headquarters", want: "headquarters"},
-
-		// Compound -foot -> -feet
-		{name: "bigfoot", input: "bigfoot", want: "bigfeet"},
-		{name: "clubfoot", input: "clubfoot", want: "clubfeet"},
-
-		// Compound -tooth -> -teeth
-		{name: "eyetooth", input: "eyetooth", want: "eyeteeth"},
-		{name: "sabertooth", input: "sabertooth", want: "saberteeth"},
-
-		// Brand names and other -man exceptions
-		{name: "Walkman", input: "Walkman", want: "Walkmans"},
-		{name: "leman", input: "leman", want: "lemans"},
-
-		// Nationalities ending in -ese (unchanged)
-		{name: "Chinese", input: "Chinese", want: "Chinese"},
-		{name: "Japanese", input: "Japanese", want: "Japanese"},
-		{name: "Portuguese", input: "Portuguese", want: "Portuguese"},
-
-		// Case preservation
-		{name: "CAT uppercase", input: "CAT", want: "CATS"},
-		{name: "Cat titlecase", input: "Cat", want: "Cats"},
-		{name: "Child titlecase", input: "Child", want: "Children"},
-		{name: "CHILD uppercase", input: "CHILD", want: "CHILDREN"},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Plural(tt.input))
+		})
+	}
+}
+
+func TestPluralFileNamesAndVersions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// Only the name of a file is inflected
+		{name: "json", input: "child.json", want: "children.json"},
+		{name: "txt", input: "box.txt", want: "boxes.txt"},
+		{name: "html", input: "index.html", want: "indices.html"},
+		{name: "double extension", input: "file.tar.gz", want: "files.tar.gz"},
+		{name: "one letter extension", input: "main.c", want: "mains.c"},
+		{name: "uppercase extension", input: "report.PDF", want: "reports.PDF"},
+		{name: "digit extension", input: "photo.mp4", want: "photos.mp4"},
+		{name: "library name", input: "node.js", want: "nodes.js"},
+		{name: "capitalized", input: "Child.json", want: "Children.json"},
+		{name: "possessive", input: "child.json's", want: "children.json's"},
+		{name: "sentence end", input: "child.json.", want: "children.json."},
+		{name: "in phrase", input: "config.yaml file", want: "config.yaml files"},
+
+		// Version numbers are left unchanged
+		{name: "version", input: "v1.2", want: "v1.2"},
+		{name: "uppercase v", input: "V2.0.1", want: "V2.0.1"},
+		{name: "decimal", input: "2.0", want: "2.0"},
+		{name: "version before noun", input: "v1.2 release", want: "v1.2 releases"},
+
+		// Abbreviations are not file names
+		{name: "Ph.D.", input: "Ph.D.", want: "Ph.D."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Plural(tt.input))
+		})
+	}
+}
+
+func TestPluralUnicode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// Accented Latin words follow the usual rules
+		{name: "café", input: "café", want: "cafés"},
+		{name: "résumé", input: "résumé", want: "résumés"},
+		{name: "fiancée", input: "fiancée", want: "fiancées"},
+		{name: "señor", input: "señor", want: "señors"},
+		{name: "Müller", input: "Müller", want: "Müllers"},
+		{name: "uppercase accented", input: "ÉCOLE", want: "ÉCOLES"},
+		{name: "accented vowel before y", input: "Andréy", want: "Andréys"},
+
+		// Other scripts are left unchanged
+		{name: "Cyrillic", input: "кошка", want: "кошка"},
+		{name: "Japanese", input: "日本語", want: "日本語"},
+		{name: "Greek", input: "λόγος", want: "λόγος"},
+
+		// Symbols and digits
+		{name: "emoji", input: "😀", want: "😀"},
+		{name: "trailing emoji", input: "party 🎉", want: "party 🎉"},
+		{name: "decade", input: "1990", want: "1990s"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := inflect.Plural(tt.input)
 			assert.Equal(t, tt.want, got)
+			assert.True(t, utf8.ValidString(got))
 		})
 	}
 }
 
+func TestPluralEseNouns(t *testing.T) {
+	tests := []struct {
+		name     string
+		singular string
+		plural   string
+	}{
+		{name: "cheese", singular: "cheese", plural: "cheeses"},
+		{name: "diocese", singular: "diocese", plural: "dioceses"},
+		{name: "archdiocese", singular: "archdiocese", plural: "archdioceses"},
+		{name: "case preserved", singular: "Cheese", plural: "Cheeses"},
+		{name: "nationality", singular: "Chinese", plural: "Chinese"},
+		{name: "language", singular: "Portuguese", plural: "Portuguese"},
+		{name: "jargon", singular: "legalese", plural: "legalese"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.plural, inflect.Plural(tt.singular))
+			assert.Equal(t, tt.singular, inflect.Singular(tt.plural))
+			assert.Equal(t, tt.plural, inflect.PluralSafe(tt.plural))
+		})
+	}
+}
+
+func TestPluralVesCompounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		singular string
+		plural   string
+	}{
+		{name: "dwarf", singular: "dwarf", plural: "dwarves"},
+		{name: "shelf", singular: "bookshelf", plural: "bookshelves"},
+		{name: "wolf", singular: "werewolf", plural: "werewolves"},
+		{name: "wife", singular: "housewife", plural: "housewives"},
+		{name: "knife", singular: "pocketknife", plural: "pocketknives"},
+		{name: "case preserved", singular: "Bookshelf", plural: "Bookshelves"},
+		{name: "not a compound", singular: "chief", plural: "chiefs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.plural, inflect.Plural(tt.singular))
+			assert.Equal(t, tt.singular, inflect.Singular(tt.plural))
+		})
+	}
+}
+
+func TestPluralSafe(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "singular", input: "cat", want: "cats"},
+		{name: "regular plural", input: "cats", want: "cats"},
+		{name: "irregular singular", input: "child", want: "children"},
+		{name: "irregular plural", input: "children", want: "children"},
+		{name: "irregular vowel change", input: "mice", want: "mice"},
+		{name: "person", input: "people", want: "people"},
+		{name: "sibilant plural", input: "glasses", want: "glasses"},
+		{name: "classical plural", input: "formulae", want: "formulae"},
+		{name: "modern plural", input: "formulas", want: "formulas"},
+		{name: "unchanged plural", input: "sheep", want: "sheep"},
+		{name: "title case", input: "Cats", want: "Cats"},
+		{name: "upper case", input: "CATS", want: "CATS"},
+		{name: "trailing punctuation", input: "cats,", want: "cats,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.PluralSafe(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, inflect.PluralSafe(got), "idempotent")
+		})
+	}
+}
+
+func TestPluralSafeEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("cow", "kine")
+	assert.Equal(t, "kine", e.PluralSafe("cow"))
+	assert.Equal(t, "kine", e.PluralSafe("kine"))
+	assert.Equal(t, "cows", inflect.PluralSafe("cow"))
+}
+
+func TestPluralSafeNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "cat", e.PluralSafe("cat"))
+	assert.Equal(t, "cats", e.PluralSafe("cats"))
+
+	assert.Equal(t, "cats", e.PluralSafe("cat", 2))
+	assert.Equal(t, "cat", e.PluralSafe("cat", -1))
+
+	e.NumPropagation(false)
+	assert.Equal(t, "cats", e.PluralSafe("cat"))
+}
+
+func TestPluralLastWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "whitespace only", input: "  ", want: "  "},
+		{name: "single word", input: "cat", want: "cats"},
+		{name: "adjective", input: "blue bird", want: "blue birds"},
+		{name: "irregular", input: "baby child", want: "baby children"},
+		{name: "irregular compound", input: "field mouse", want: "field mice"},
+		{name: "several words", input: "big red fire truck", want: "big red fire trucks"},
+		{name: "earlier words unchanged", input: "mouse trap", want: "mouse traps"},
+		{name: "case preserved", input: "Baby Child", want: "Baby Children"},
+		{name: "trailing whitespace", input: "blue bird ", want: "blue birds "},
+		{name: "tab separator", input: "blue\tbird", want: "blue\tbirds"},
+		{name: "unicode separator", input: "blue\u00a0bird", want: "blue\u00a0birds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralLastWord(tt.input))
+		})
+	}
+}
+
+func TestPluralLower(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "regular", input: "user", want: "users"},
+		{name: "consonant y", input: "category", want: "categories"},
+		{name: "sibilant", input: "address", want: "addresses"},
+		{name: "irregular", input: "person", want: "people"},
+		{name: "unchanged", input: "sheep", want: "sheep"},
+		{name: "man suffix", input: "salesman", want: "salesmen"},
+
+		// Other input goes through Plural
+		{name: "empty", input: "", want: ""},
+		{name: "capitalized", input: "User", want: "Users"},
+		{name: "uppercase", input: "USER", want: "USERS"},
+		{name: "underscore", input: "line_item", want: "line_items"},
+		{name: "possessive", input: "child's", want: "children's"},
+		{name: "non-ASCII", input: "café", want: "cafés"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.PluralLower(tt.input))
+			assert.Equal(t, inflect.Plural(tt.input), inflect.PluralLower(tt.input))
+		})
+	}
+}
+
+func TestEnginePluralLower(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	assert.Equal(t, "regexen", e.PluralLower("regex"))
+
+	e.Classical(true)
+	assert.Equal(t, "formulae", e.PluralLower("formula"))
+
+	e.Num(1)
+	assert.Equal(t, "formula", e.PluralLower("formula"))
+}
+
 func BenchmarkPlural(b *testing.B) {
 	// Test with representative inputs covering different pluralization rules
 	benchmarks := []struct {
@@ -236,6 +341,7 @@ func BenchmarkPluralNoun(b *testing.B) {
 
 	for _, bm := range benchmarks {
 		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
 			for range b.N {
 				inflect.PluralNoun(bm.word, bm.count...)
 			}
@@ -13,7 +13,8 @@ import "strings"
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -38,7 +39,8 @@ func PluralNoun(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -60,7 +62,7 @@ func (e *Engine) PluralNoun(word string, count ...int) string {
 	}
 
 	// Handle count parameter
-	if len(count) > 0 && (count[0] == 1 || count[0] == -1) {
+	if e.isSingularCount(count) {
 		return word // Return singular form as-is
 	}
 
@@ -72,7 +74,7 @@ func (e *Engine) PluralNoun(word string, count ...int) string {
 	}
 
 	// Fall back to regular Plural() for nouns
-	return prefix + e.Plural(trimmed) + suffix
+	return prefix + e.plural(trimmed) + suffix
 }
 
 // PluralVerb returns the plural form of an English verb.
@@ -85,7 +87,8 @@ func (e *Engine) PluralNoun(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -110,7 +113,8 @@ func PluralVerb(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -133,7 +137,7 @@ func (e *Engine) PluralVerb(word string, count ...int) string {
 	}
 
 	// Handle count parameter - if singular count, return singular form
-	if len(count) > 0 && (count[0] == 1 || count[0] == -1) {
+	if e.isSingularCount(count) {
 		// Return appropriate singular form
 		lower := strings.ToLower(trimmed)
 		if singular, ok := verbPluralToSingular[lower]; ok {
@@ -144,8 +148,8 @@ func (e *Engine) PluralVerb(word string, count ...int) string {
 
 	lower := strings.ToLower(trimmed)
 
-	// Check for unchanged modal verbs
-	if verbUnchanged[lower] {
+	// Check for unchanged modal verbs and base forms ending in -s
+	if verbUnchanged[lower] || sBaseVerbs[lower] {
 		return word
 	}
 
@@ -173,6 +177,13 @@ func (e *Engine) PluralVerb(word string, count ...int) string {
 	// Handle -es after sibilants: -sses, -shes, -ches, -xes, -zes
 	if strings.HasSuffix(lower, "es") && len(lower) > 2 {
 		base := lower[:len(lower)-2]
+		// -ses of a verb ending in -s: focuses -> focus, gasses -> gas
+		if sBaseVerbs[base] {
+			return prefix + trimmed[:len(trimmed)-2] + suffix
+		}
+		if sBaseVerbs[strings.TrimSuffix(base, "s")] {
+			return prefix + trimmed[:len(trimmed)-3] + suffix
+		}
 		if strings.HasSuffix(base, "ss") ||
 			strings.HasSuffix(base, "sh") ||
 			strings.HasSuffix(base, "ch") ||
@@ -210,7 +221,8 @@ func (e *Engine) PluralVerb(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -234,7 +246,8 @@ func PluralAdj(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the plural form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the plural form.
 //
 // Examples:
 //
@@ -257,7 +270,7 @@ func (e *Engine) PluralAdj(word string, count ...int) string {
 	}
 
 	// Handle count parameter - if singular count, return singular form
-	if len(count) > 0 && (count[0] == 1 || count[0] == -1) {
+	if e.isSingularCount(count) {
 		// Return appropriate singular form
 		lower := strings.ToLower(trimmed)
 		if singular, ok := adjPluralToSingular[lower]; ok {
@@ -310,7 +323,8 @@ func (e *Engine) PluralAdj(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the singular form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the singular form.
 //
 // Examples:
 //
@@ -342,7 +356,8 @@ func SingularNoun(word string, count ...int) string {
 //
 // If count is provided and equals 1 or -1, returns the singular form.
 // If count is not 1, returns the plural form.
-// If no count is provided, returns the singular form.
+// If no count is provided, the default count set by Num() is used; if there
+// is none, returns the singular form.
 //
 // Examples:
 //
@@ -365,7 +380,7 @@ func (e *Engine) SingularNoun(word string, count ...int) string {
 	}
 
 	// Handle count parameter - if plural count, return plural form
-	if len(count) > 0 && count[0] != 1 && count[0] != -1 {
+	if n, ok := e.countOrNum(count); ok && n != 1 && n != -1 {
 		return word // Return plural form as-is
 	}
 
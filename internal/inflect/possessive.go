@@ -1,6 +1,7 @@
 package inflect
 
 import (
+	"slices"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -211,7 +212,7 @@ func (e *Engine) Possessive(word string) string {
 	}
 
 	// Proper names ending in s are typically singular
-	if isProperName(word) {
+	if e.isProperName(word) {
 		// Check if this might be a plural of a common noun (like "Cats")
 		singular := Singular(word)
 		singularLower := strings.ToLower(singular)
@@ -238,6 +239,88 @@ func (e *Engine) Possessive(word string) string {
 	return word + matchSuffix(word, "'s")
 }
 
+// JoinPossessive lists owners who each have their own noun, such as
+// "Alice's and Bob's reports".
+//
+// Every name becomes possessive, and the noun is made plural when there is
+// more than one owner. Use JoinPossessiveShared for something the owners
+// have together. The noun may be empty, leaving just the possessives.
+//
+// Examples:
+//   - JoinPossessive([]string{"Alice", "Bob"}, "report") returns "Alice's and Bob's reports"
+//   - JoinPossessive([]string{"Alice", "Bob", "James"}, "car") returns "Alice's, Bob's, and James's cars"
+//   - JoinPossessive([]string{"Alice"}, "report") returns "Alice's report"
+//   - JoinPossessive([]string{"Alice", "me"}, "report") returns "Alice's and my reports"
+//   - JoinPossessive([]string{"Alice", "Bob"}, "") returns "Alice's and Bob's"
+func JoinPossessive(names []string, noun string) string {
+	return defaultEngine.JoinPossessive(names, noun)
+}
+
+// JoinPossessive lists owners who each have their own noun, such as
+// "Alice's and Bob's reports".
+//
+// Every name becomes possessive, and the noun is made plural when there is
+// more than one owner. Use e.JoinPossessiveShared for something the owners
+// have together. The noun may be empty, leaving just the possessives.
+//
+// Examples:
+//   - e.JoinPossessive([]string{"Alice", "Bob"}, "report") returns "Alice's and Bob's reports"
+//   - e.JoinPossessive([]string{"Alice"}, "report") returns "Alice's report"
+func (e *Engine) JoinPossessive(names []string, noun string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	owners := make([]string, len(names))
+	for i, name := range names {
+		owners[i] = e.Possessive(name)
+	}
+	if noun != "" && len(names) > 1 {
+		noun = e.plural(noun)
+	}
+	return joinOwned(Join(owners), noun)
+}
+
+// JoinPossessiveShared lists owners who have a noun together, such as
+// "Alice and Bob's report".
+//
+// Only the last name becomes possessive, and the noun is left as given.
+// Use JoinPossessive when each owner has their own.
+//
+// Examples:
+//   - JoinPossessiveShared([]string{"Alice", "Bob"}, "report") returns "Alice and Bob's report"
+//   - JoinPossessiveShared([]string{"Alice", "Bob", "James"}, "house") returns "Alice, Bob, and James's house"
+//   - JoinPossessiveShared([]string{"Alice"}, "report") returns "Alice's report"
+//   - JoinPossessiveShared([]string{"Alice", "Bob"}, "") returns "Alice and Bob's"
+func JoinPossessiveShared(names []string, noun string) string {
+	return defaultEngine.JoinPossessiveShared(names, noun)
+}
+
+// JoinPossessiveShared lists owners who have a noun together, such as
+// "Alice and Bob's report".
+//
+// Only the last name becomes possessive, and the noun is left as given.
+// Use e.JoinPossessive when each owner has their own.
+//
+// Examples:
+//   - e.JoinPossessiveShared([]string{"Alice", "Bob"}, "report") returns "Alice and Bob's report"
+//   - e.JoinPossessiveShared([]string{"Alice"}, "report") returns "Alice's report"
+func (e *Engine) JoinPossessiveShared(names []string, noun string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	owners := slices.Clone(names)
+	owners[len(owners)-1] = e.Possessive(owners[len(owners)-1])
+	return joinOwned(Join(owners), noun)
+}
+
+// joinOwned appends the owned noun, if any, to a list of owners.
+func joinOwned(owners, noun string) string {
+	if noun == "" {
+		return owners
+	}
+	return owners + " " + noun
+}
+
 // endsWithS checks if a word ends with 's' or 'S' without allocation.
 func endsWithS(word string) bool {
 	if word == "" {
@@ -294,7 +377,7 @@ func isEsPlural(lower string) bool {
 
 // isValidPluralOfSingular checks if lower is a valid plural of singular.
 func isValidPluralOfSingular(lower, singular, singularLower string) bool {
-	pluralOfSingular := strings.ToLower(Plural(singular))
+	pluralOfSingular := strings.ToLower(defaultEngine.plural(singular))
 	if pluralOfSingular != lower || singularLower == lower {
 		return false
 	}
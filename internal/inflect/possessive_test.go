@@ -201,3 +201,61 @@ func BenchmarkPossessive(b *testing.B) {
 		})
 	}
 }
+
+func TestJoinPossessive(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		noun  string
+		want  string
+	}{
+		{name: "two owners", names: []string{"Alice", "Bob"}, noun: "report", want: "Alice's and Bob's reports"},
+		{name: "three owners", names: []string{"Alice", "Bob", "James"}, noun: "car", want: "Alice's, Bob's, and James's cars"},
+		{name: "one owner", names: []string{"Alice"}, noun: "report", want: "Alice's report"},
+		{name: "irregular noun", names: []string{"Alice", "Bob"}, noun: "child", want: "Alice's and Bob's children"},
+		{name: "pronoun", names: []string{"Alice", "me"}, noun: "report", want: "Alice's and my reports"},
+		{name: "plural owner", names: []string{"the teachers", "the students"}, noun: "room", want: "the teachers' and the students' rooms"},
+		{name: "no noun", names: []string{"Alice", "Bob"}, want: "Alice's and Bob's"},
+		{name: "no names", names: nil, noun: "report", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.JoinPossessive(tt.names, tt.noun))
+		})
+	}
+}
+
+func TestJoinPossessiveShared(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		noun  string
+		want  string
+	}{
+		{name: "two owners", names: []string{"Alice", "Bob"}, noun: "report", want: "Alice and Bob's report"},
+		{name: "three owners", names: []string{"Alice", "Bob", "James"}, noun: "house", want: "Alice, Bob, and James's house"},
+		{name: "one owner", names: []string{"Alice"}, noun: "report", want: "Alice's report"},
+		{name: "plural noun kept", names: []string{"Alice", "Bob"}, noun: "children", want: "Alice and Bob's children"},
+		{name: "no noun", names: []string{"Alice", "Bob"}, want: "Alice and Bob's"},
+		{name: "no names", names: nil, noun: "report", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.JoinPossessiveShared(tt.names, tt.noun))
+		})
+	}
+
+	names := []string{"Alice", "Bob"}
+	inflect.JoinPossessiveShared(names, "report")
+	assert.Equal(t, []string{"Alice", "Bob"}, names, "input is not modified")
+}
+
+func TestJoinPossessiveEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.SetPossessiveStyle(inflect.PossessiveTraditional)
+	e.Num(1)
+	assert.Equal(t, "Alice's and James' reports", e.JoinPossessive([]string{"Alice", "James"}, "report"))
+	assert.Equal(t, "Alice and James' report", e.JoinPossessiveShared([]string{"Alice", "James"}, "report"))
+}
@@ -0,0 +1,127 @@
+package inflect
+
+import "strings"
+
+// StandardMethod is the verb of a gRPC service method, as named by
+// ServiceMethodName. Custom methods can use their own verb:
+// StandardMethod("Archive").
+type StandardMethod string
+
+const (
+	// MethodGet reads one resource.
+	// Example: "UserProfile" -> "GetUserProfile"
+	MethodGet StandardMethod = "Get"
+
+	// MethodList reads a collection of resources.
+	// Example: "UserProfile" -> "ListUserProfiles"
+	MethodList StandardMethod = "List"
+
+	// MethodCreate creates one resource.
+	// Example: "UserProfile" -> "CreateUserProfile"
+	MethodCreate StandardMethod = "Create"
+
+	// MethodUpdate updates one resource.
+	// Example: "UserProfile" -> "UpdateUserProfile"
+	MethodUpdate StandardMethod = "Update"
+
+	// MethodDelete deletes one resource.
+	// Example: "UserProfile" -> "DeleteUserProfile"
+	MethodDelete StandardMethod = "Delete"
+
+	// MethodBatchGet reads several resources by name.
+	// Example: "UserProfile" -> "BatchGetUserProfiles"
+	MethodBatchGet StandardMethod = "BatchGet"
+)
+
+// MessageNamePlural returns the plural of a Protobuf message name, keeping
+// its PascalCase: "UserProfile" -> "UserProfiles".
+//
+// Only the last word is made plural, and a name that is already plural is
+// kept.
+//
+// Examples:
+//   - MessageNamePlural("UserProfile") returns "UserProfiles"
+//   - MessageNamePlural("Person") returns "People"
+//   - MessageNamePlural("HTTPRoute") returns "HTTPRoutes"
+func MessageNamePlural(name string) string {
+	return defaultEngine.MessageNamePlural(name)
+}
+
+// MessageNamePlural returns the plural of a Protobuf message name, using
+// e's plurals. See the package-level MessageNamePlural for details.
+func (e *Engine) MessageNamePlural(name string) string {
+	return inflectIdentifier(name, e.pluralSafe)
+}
+
+// MessageNameSingular returns the singular of a Protobuf message name,
+// keeping its PascalCase: "UserProfiles" -> "UserProfile".
+//
+// Examples:
+//   - MessageNameSingular("UserProfiles") returns "UserProfile"
+//   - MessageNameSingular("People") returns "Person"
+//   - MessageNameSingular("Status") returns "Status"
+func MessageNameSingular(name string) string {
+	return defaultEngine.MessageNameSingular(name)
+}
+
+// MessageNameSingular returns the singular of a Protobuf message name,
+// using e's singulars. See the package-level MessageNameSingular for
+// details.
+func (e *Engine) MessageNameSingular(name string) string {
+	return inflectIdentifier(name, e.SingularSafe)
+}
+
+// EnumValueName returns the name of a Protobuf enum value in the style the
+// Protobuf style guide asks for: UPPER_SNAKE_CASE, prefixed with the enum
+// name.
+//
+// Enum names are singular, so a plural enum name is made singular for the
+// prefix: "Colors", "red" -> "COLOR_RED". A value that already has the
+// prefix is not prefixed again.
+//
+// Examples:
+//   - EnumValueName("OrderStatus", "shipped") returns "ORDER_STATUS_SHIPPED"
+//   - EnumValueName("OrderStatus", "InTransit") returns "ORDER_STATUS_IN_TRANSIT"
+//   - EnumValueName("Colors", "red") returns "COLOR_RED"
+//   - EnumValueName("OrderStatus", "ORDER_STATUS_SHIPPED") returns "ORDER_STATUS_SHIPPED"
+//   - EnumValueName("OrderStatus", "unspecified") returns "ORDER_STATUS_UNSPECIFIED"
+func EnumValueName(enumName, value string) string {
+	return defaultEngine.EnumValueName(enumName, value)
+}
+
+// EnumValueName returns the name of a Protobuf enum value, using e's
+// singulars. See the package-level EnumValueName for details.
+func (e *Engine) EnumValueName(enumName, value string) string {
+	prefix := strings.ToUpper(inflectIdentifier(SnakeCase(enumName), e.SingularSafe))
+	name := strings.ToUpper(SnakeCase(value))
+	if prefix == "" || strings.HasPrefix(name, prefix+"_") {
+		return name
+	}
+	return prefix + "_" + name
+}
+
+// ServiceMethodName returns the name of a gRPC service method acting on a
+// message type, following the resource-oriented design of the Google API
+// Improvement Proposals.
+//
+// List and batch methods act on many resources and take the plural of the
+// message name; other methods take the singular.
+//
+// Examples:
+//   - ServiceMethodName(MethodList, "UserProfile") returns "ListUserProfiles"
+//   - ServiceMethodName(MethodGet, "UserProfiles") returns "GetUserProfile"
+//   - ServiceMethodName(MethodBatchGet, "Person") returns "BatchGetPeople"
+//   - ServiceMethodName("Archive", "Order") returns "ArchiveOrder"
+func ServiceMethodName(method StandardMethod, message string) string {
+	return defaultEngine.ServiceMethodName(method, message)
+}
+
+// ServiceMethodName returns the name of a gRPC service method acting on a
+// message type, using e's plurals and singulars. See the package-level
+// ServiceMethodName for details.
+func (e *Engine) ServiceMethodName(method StandardMethod, message string) string {
+	if method == MethodList || strings.HasPrefix(string(method), "Batch") {
+		return string(method) + e.MessageNamePlural(message)
+	}
+	return string(method) + e.MessageNameSingular(message)
+}
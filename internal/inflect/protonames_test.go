@@ -0,0 +1,112 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestMessageNamePlural(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "compound", input: "UserProfile", want: "UserProfiles"},
+		{name: "irregular", input: "Person", want: "People"},
+		{name: "consonant y", input: "OrderCategory", want: "OrderCategories"},
+		{name: "acronym", input: "HTTPRoute", want: "HTTPRoutes"},
+		{name: "already plural", input: "UserProfiles", want: "UserProfiles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.MessageNamePlural(tt.input))
+		})
+	}
+}
+
+func TestMessageNameSingular(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "compound", input: "UserProfiles", want: "UserProfile"},
+		{name: "irregular", input: "People", want: "Person"},
+		{name: "consonant y", input: "OrderCategories", want: "OrderCategory"},
+		{name: "known singular", input: "OrderStatus", want: "OrderStatus"},
+		{name: "already singular", input: "UserProfile", want: "UserProfile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.MessageNameSingular(tt.input))
+		})
+	}
+}
+
+func TestEnumValueName(t *testing.T) {
+	tests := []struct {
+		name  string
+		enum  string
+		value string
+		want  string
+	}{
+		{name: "lowercase value", enum: "OrderStatus", value: "shipped", want: "ORDER_STATUS_SHIPPED"},
+		{name: "pascal case value", enum: "OrderStatus", value: "InTransit", want: "ORDER_STATUS_IN_TRANSIT"},
+		{name: "upper snake value", enum: "OrderStatus", value: "IN_TRANSIT", want: "ORDER_STATUS_IN_TRANSIT"},
+		{name: "already prefixed", enum: "OrderStatus", value: "ORDER_STATUS_SHIPPED", want: "ORDER_STATUS_SHIPPED"},
+		{name: "plural enum", enum: "Colors", value: "red", want: "COLOR_RED"},
+		{name: "irregular plural enum", enum: "Mice", value: "white", want: "MOUSE_WHITE"},
+		{name: "unspecified", enum: "Color", value: "unspecified", want: "COLOR_UNSPECIFIED"},
+		{name: "no enum name", enum: "", value: "red", want: "RED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.EnumValueName(tt.enum, tt.value))
+		})
+	}
+}
+
+func TestServiceMethodName(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  inflect.StandardMethod
+		message string
+		want    string
+	}{
+		{name: "list", method: inflect.MethodList, message: "UserProfile", want: "ListUserProfiles"},
+		{name: "get", method: inflect.MethodGet, message: "UserProfile", want: "GetUserProfile"},
+		{name: "get from plural", method: inflect.MethodGet, message: "UserProfiles", want: "GetUserProfile"},
+		{name: "create", method: inflect.MethodCreate, message: "Person", want: "CreatePerson"},
+		{name: "update", method: inflect.MethodUpdate, message: "Person", want: "UpdatePerson"},
+		{name: "delete", method: inflect.MethodDelete, message: "Person", want: "DeletePerson"},
+		{name: "batch get", method: inflect.MethodBatchGet, message: "Person", want: "BatchGetPeople"},
+		{name: "custom batch", method: "BatchDelete", message: "Order", want: "BatchDeleteOrders"},
+		{name: "custom", method: "Archive", message: "Orders", want: "ArchiveOrder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ServiceMethodName(tt.method, tt.message))
+		})
+	}
+}
+
+func TestServiceMethodNameEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("octopus", "octopodes")
+	assert.Equal(t, "ListSeaOctopodes", e.ServiceMethodName(inflect.MethodList, "SeaOctopus"))
+}
+
+func TestProtoNamesIgnoreNum(t *testing.T) {
+	e := inflect.NewEngine()
+	e.Num(1)
+	assert.Equal(t, "UserProfiles", e.MessageNamePlural("UserProfile"))
+	assert.Equal(t, "ListUserProfiles", e.ServiceMethodName(inflect.MethodList, "UserProfile"))
+}
@@ -0,0 +1,142 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+)
+
+// QuantityBucket pairs a minimum count with a quantifier phrase, for use
+// with QuantifyCountWithBuckets.
+//
+// A bucket applies to counts from Min up to the Min of the next bucket.
+type QuantityBucket struct {
+	// Min is the smallest count the bucket applies to.
+	Min int
+
+	// Quantifier is the phrase placed before the plural noun,
+	// e.g. "several" or "dozens of".
+	Quantifier string
+}
+
+// quantityBuckets lists the default buckets used by QuantifyCount, from
+// smallest to largest.
+var quantityBuckets = []QuantityBucket{
+	{2, "a couple of"},
+	{3, "a few"},
+	{5, "several"},
+	{12, "dozens of"},
+	{100, "hundreds of"},
+	{1000, "thousands of"},
+	{1000000, "millions of"},
+	{1000000000, "billions of"},
+}
+
+// DefaultQuantityBuckets returns a copy of the buckets used by QuantifyCount.
+//
+// The result can be modified and passed to QuantifyCountWithBuckets to
+// adjust the thresholds or wording.
+func DefaultQuantityBuckets() []QuantityBucket {
+	return append([]QuantityBucket(nil), quantityBuckets...)
+}
+
+// QuantifyCount describes a count of nouns with an approximate quantifier
+// instead of the exact number.
+//
+// Zero uses "no" and one uses an indefinite article, following No() and
+// An(). Larger counts use the default magnitude buckets:
+//   - 2 returns "a couple of"
+//   - 3-4 returns "a few"
+//   - 5-11 returns "several"
+//   - 12-99 returns "dozens of"
+//   - 100-999 returns "hundreds of"
+//   - 1,000 and up returns "thousands of", "millions of", or "billions of"
+//
+// A negative count, such as a change of -3 files, is treated as its
+// absolute value, so it is described by the size of the change.
+//
+// Examples:
+//   - QuantifyCount(0, "file") returns "no files"
+//   - QuantifyCount(1, "file") returns "a file"
+//   - QuantifyCount(2, "file") returns "a couple of files"
+//   - QuantifyCount(7, "file") returns "several files"
+//   - QuantifyCount(40, "file") returns "dozens of files"
+//   - QuantifyCount(250, "child") returns "hundreds of children"
+//   - QuantifyCount(-3, "file") returns "a few files"
+func QuantifyCount(n int, noun string) string {
+	return defaultEngine.QuantifyCount(n, noun)
+}
+
+// QuantifyCount describes a count of nouns with an approximate quantifier
+// instead of the exact number.
+//
+// Zero uses "no" and one uses an indefinite article, following e.No() and
+// e.An(). Larger counts use the default magnitude buckets:
+//   - 2 returns "a couple of"
+//   - 3-4 returns "a few"
+//   - 5-11 returns "several"
+//   - 12-99 returns "dozens of"
+//   - 100-999 returns "hundreds of"
+//   - 1,000 and up returns "thousands of", "millions of", or "billions of"
+//
+// A negative count, such as a change of -3 files, is treated as its
+// absolute value, so it is described by the size of the change.
+//
+// Examples:
+//   - e.QuantifyCount(0, "file") returns "no files"
+//   - e.QuantifyCount(1, "file") returns "a file"
+//   - e.QuantifyCount(2, "file") returns "a couple of files"
+//   - e.QuantifyCount(7, "file") returns "several files"
+//   - e.QuantifyCount(40, "file") returns "dozens of files"
+//   - e.QuantifyCount(250, "child") returns "hundreds of children"
+//   - e.QuantifyCount(-3, "file") returns "a few files"
+func (e *Engine) QuantifyCount(n int, noun string) string {
+	return e.QuantifyCountWithBuckets(n, noun, quantityBuckets)
+}
+
+// QuantifyCountWithBuckets describes a count of nouns with the quantifier of
+// the bucket the count falls in.
+//
+// The buckets must be sorted by Min. Zero uses "no" and one uses an
+// indefinite article; counts of two or more below the first bucket are
+// written as digits. A negative count is treated as its absolute value.
+//
+// Examples:
+//   - QuantifyCountWithBuckets(30, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "lots of files"
+//   - QuantifyCountWithBuckets(4, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "some files"
+//   - QuantifyCountWithBuckets(4, "file", []QuantityBucket{{10, "many"}}) returns "4 files"
+func QuantifyCountWithBuckets(n int, noun string, buckets []QuantityBucket) string {
+	return defaultEngine.QuantifyCountWithBuckets(n, noun, buckets)
+}
+
+// QuantifyCountWithBuckets describes a count of nouns with the quantifier of
+// the bucket the count falls in.
+//
+// The buckets must be sorted by Min. Zero uses "no" and one uses an
+// indefinite article; counts of two or more below the first bucket are
+// written as digits. A negative count is treated as its absolute value.
+//
+// Examples:
+//   - e.QuantifyCountWithBuckets(30, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "lots of files"
+//   - e.QuantifyCountWithBuckets(4, "file", []QuantityBucket{{2, "some"}, {20, "lots of"}}) returns "some files"
+//   - e.QuantifyCountWithBuckets(4, "file", []QuantityBucket{{10, "many"}}) returns "4 files"
+func (e *Engine) QuantifyCountWithBuckets(n int, noun string, buckets []QuantityBucket) string {
+	if n < 0 {
+		// -math.MinInt overflows, so the largest int stands in for it
+		n = -max(n, -math.MaxInt)
+	}
+	switch n {
+	case 0:
+		return e.noWithFormat(noun, 0, strconv.Itoa)
+	case 1:
+		return e.An(noun)
+	}
+
+	quantifier := strconv.Itoa(n)
+	for _, b := range buckets {
+		if n < b.Min {
+			break
+		}
+		quantifier = b.Quantifier
+	}
+	return quantifier + " " + e.plural(noun)
+}
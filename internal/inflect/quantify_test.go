@@ -0,0 +1,73 @@
+package inflect_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestQuantifyCount(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		noun string
+		want string
+	}{
+		{name: "zero", n: 0, noun: "file", want: "no files"},
+		{name: "one", n: 1, noun: "file", want: "a file"},
+		{name: "one with an", n: 1, noun: "error", want: "an error"},
+		{name: "two", n: 2, noun: "file", want: "a couple of files"},
+		{name: "three", n: 3, noun: "file", want: "a few files"},
+		{name: "four", n: 4, noun: "file", want: "a few files"},
+		{name: "five", n: 5, noun: "file", want: "several files"},
+		{name: "eleven", n: 11, noun: "file", want: "several files"},
+		{name: "twelve", n: 12, noun: "file", want: "dozens of files"},
+		{name: "ninety-nine", n: 99, noun: "file", want: "dozens of files"},
+		{name: "hundred", n: 100, noun: "file", want: "hundreds of files"},
+		{name: "thousands", n: 4500, noun: "file", want: "thousands of files"},
+		{name: "millions", n: 2000000, noun: "file", want: "millions of files"},
+		{name: "billions", n: 3000000000, noun: "file", want: "billions of files"},
+		{name: "irregular plural", n: 250, noun: "child", want: "hundreds of children"},
+		{name: "negative", n: -7, noun: "file", want: "several files"},
+		{name: "negative few", n: -3, noun: "file", want: "a few files"},
+		{name: "negative one", n: -1, noun: "file", want: "a file"},
+		{name: "smallest int", n: math.MinInt, noun: "file", want: "billions of files"},
+		{name: "case preserved", n: 40, noun: "File", want: "dozens of Files"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.QuantifyCount(tt.n, tt.noun))
+		})
+	}
+}
+
+func TestQuantifyCountWithBuckets(t *testing.T) {
+	buckets := []inflect.QuantityBucket{{Min: 2, Quantifier: "some"}, {Min: 20, Quantifier: "lots of"}}
+	assert.Equal(t, "some files", inflect.QuantifyCountWithBuckets(4, "file", buckets))
+	assert.Equal(t, "lots of files", inflect.QuantifyCountWithBuckets(30, "file", buckets))
+	assert.Equal(t, "4 files", inflect.QuantifyCountWithBuckets(4, "file", []inflect.QuantityBucket{{Min: 10, Quantifier: "many"}}))
+	assert.Equal(t, "3 files", inflect.QuantifyCountWithBuckets(3, "file", nil))
+	assert.Equal(t, "no files", inflect.QuantifyCountWithBuckets(0, "file", buckets))
+	assert.Equal(t, "lots of files", inflect.QuantifyCountWithBuckets(-30, "file", buckets))
+}
+
+func TestDefaultQuantityBuckets(t *testing.T) {
+	buckets := inflect.DefaultQuantityBuckets()
+	assert.NotEmpty(t, buckets)
+
+	// Modifying the copy does not affect QuantifyCount
+	buckets[0].Quantifier = "a pair of"
+	assert.Equal(t, "a pair of files", inflect.QuantifyCountWithBuckets(2, "file", buckets))
+	assert.Equal(t, "a couple of files", inflect.QuantifyCount(2, "file"))
+}
+
+func TestQuantifyCountEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalZero(true)
+	assert.Equal(t, "no file", e.QuantifyCount(0, "file"))
+	assert.Equal(t, "no files", inflect.QuantifyCount(0, "file"))
+}
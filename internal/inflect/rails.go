@@ -122,7 +122,19 @@ func ForeignKeyCondensed(word string) string {
 //	Tableize("RawScaledScorer") // "raw_scaled_scorers"
 //	Tableize("MouseTrap")      // "mouse_traps"
 func Tableize(word string) string {
-	return Plural(SnakeCase(word))
+	return defaultEngine.Tableize(word)
+}
+
+// Tableize creates a table name from a type name, using e's plurals. See
+// the package-level Tableize for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("octopus", "octopodes")
+//	e.Tableize("Octopus") // "octopodes"
+func (e *Engine) Tableize(word string) string {
+	return e.plural(SnakeCase(word))
 }
 
 // notURLSafe matches characters that are not safe for URLs.
@@ -176,7 +188,19 @@ func ParameterizeJoin(word, sep string) string {
 //	Typeify("raw_scaled_scorers") // "RawScaledScorer"
 //	Typeify("people")          // "Person"
 func Typeify(word string) string {
-	return PascalCase(Singular(word))
+	return defaultEngine.Typeify(word)
+}
+
+// Typeify converts a table name or plural word to a type name, using e's
+// singulars. See the package-level Typeify for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("octopus", "octopodes")
+//	e.Typeify("octopodes") // "Octopus"
+func (e *Engine) Typeify(word string) string {
+	return PascalCase(e.Singular(word))
 }
 
 // Asciify removes or transliterates non-ASCII characters from a string.
@@ -0,0 +1,108 @@
+package inflect
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownRulePack is returned by LoadRulePack for a name that is not
+// one of RulePacks.
+var ErrUnknownRulePack = errors.New("unknown rule pack")
+
+// rulePacks maps the name of each rule pack to the plurals it defines.
+// Only nouns whose plural in the domain differs from the default are
+// listed; "viscus" -> "viscera", for example, is already built in.
+var rulePacks = map[string]map[string]string{
+	// Anatomical Latin and Greek
+	"medical": {
+		"alveolus": "alveoli", "bursa": "bursae", "embolus": "emboli",
+		"fistula": "fistulae", "foramen": "foramina", "lumen": "lumina",
+		"meatus": "meatus", "metastasis": "metastases", "pelvis": "pelves",
+		"septum": "septa", "sulcus": "sulci", "thrombus": "thrombi",
+		"vertebra": "vertebrae", "villus": "villi",
+	},
+	// Titles with a postpositive adjective, and Latin document names
+	"legal": {
+		"attorney general": "attorneys general", "court martial": "courts martial",
+		"heir apparent": "heirs apparent", "notary public": "notaries public",
+		"solicitor general": "solicitors general", "corrigendum": "corrigenda",
+	},
+	// Taxonomy, cell biology, and zoology
+	"biology": {
+		"alga": "algae", "amoeba": "amoebae", "antenna": "antennae",
+		"chrysalis": "chrysalides", "hypha": "hyphae", "larva": "larvae",
+		"mitochondrion": "mitochondria", "nucleolus": "nucleoli",
+		"protozoon": "protozoa", "pupa": "pupae",
+		"spermatozoon": "spermatozoa", "taxon": "taxa",
+	},
+	// Database and type theory usage
+	"computing": {
+		"index": "indexes", "lemma": "lemmata", "schema": "schemata",
+	},
+}
+
+// RulePacks returns the names of the rule packs that LoadRulePack accepts,
+// in alphabetical order.
+//
+// Examples:
+//   - RulePacks() returns ["biology" "computing" "legal" "medical"]
+func RulePacks() []string {
+	names := make([]string, 0, len(rulePacks))
+	for name := range rulePacks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// LoadRulePack defines the plurals used in a technical domain, as if each
+// were passed to DefNoun.
+//
+// The packs are:
+//   - "medical": anatomical Latin, such as "vertebra" -> "vertebrae"
+//   - "legal": titles such as "attorney general" -> "attorneys general"
+//   - "biology": taxonomy and zoology, such as "larva" -> "larvae"
+//   - "computing": "index" -> "indexes", "schema" -> "schemata", and
+//     "lemma" -> "lemmata"
+//
+// Packs are never loaded by default, and loading one affects only the
+// engine it is loaded into. Later calls to DefNoun override a pack's
+// plurals, and Reset removes them. The name is not case sensitive; an
+// unknown name returns an error wrapping ErrUnknownRulePack and defines
+// nothing.
+//
+// Examples:
+//
+//	LoadRulePack("computing")
+//	Plural("index")     // returns "indexes"
+//	Singular("indexes") // returns "index"
+//	LoadRulePack("chemistry") // returns an error
+func LoadRulePack(name string) error {
+	return defaultEngine.LoadRulePack(name)
+}
+
+// LoadRulePack defines the plurals used in a technical domain, as if each
+// were passed to e.DefNoun. See the package-level LoadRulePack for the
+// available packs.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.LoadRulePack("medical")
+//	e.Plural("vertebra") // returns "vertebrae"
+func (e *Engine) LoadRulePack(name string) error {
+	pack, ok := rulePacks[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownRulePack, name)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	for singular, plural := range pack {
+		e.irregularPlurals[singular] = plural
+		e.singularIrregulars[plural] = singular
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestRulePacks(t *testing.T) {
+	assert.Equal(t, []string{"biology", "computing", "legal", "medical"}, inflect.RulePacks())
+}
+
+func TestLoadRulePack(t *testing.T) {
+	tests := []struct {
+		pack     string
+		singular string
+		plural   string
+		before   string
+	}{
+		{pack: "medical", singular: "vertebra", plural: "vertebrae", before: "vertebras"},
+		{pack: "medical", singular: "metastasis", plural: "metastases", before: "metastasises"},
+		{pack: "legal", singular: "attorney general", plural: "attorneys general", before: "attorney generals"},
+		{pack: "legal", singular: "court martial", plural: "courts martial", before: "court martials"},
+		{pack: "biology", singular: "larva", plural: "larvae", before: "larvas"},
+		{pack: "biology", singular: "taxon", plural: "taxa", before: "taxons"},
+		{pack: "computing", singular: "index", plural: "indexes", before: "indices"},
+		{pack: "computing", singular: "schema", plural: "schemata", before: "schemas"},
+		{pack: "computing", singular: "lemma", plural: "lemmata", before: "lemmas"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pack+"/"+tt.singular, func(t *testing.T) {
+			e := inflect.NewEngine()
+			assert.Equal(t, tt.before, e.Plural(tt.singular))
+
+			require.NoError(t, e.LoadRulePack(tt.pack))
+			assert.Equal(t, tt.plural, e.Plural(tt.singular))
+			assert.Equal(t, tt.singular, e.Singular(tt.plural))
+		})
+	}
+}
+
+func TestLoadRulePackScope(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.Equal(t, "indices", e.Plural("index"))
+
+	require.NoError(t, e.LoadRulePack("Computing"))
+	assert.Equal(t, "indexes", e.Plural("index"), "cached result is cleared")
+	assert.Equal(t, "Indexes", e.Plural("Index"))
+	assert.Equal(t, "larvas", e.Plural("larva"), "other packs are not loaded")
+	assert.Equal(t, "indices", inflect.Plural("index"), "default engine is unaffected")
+	assert.Equal(t, "index", e.Singular("indices"))
+
+	e.DefNoun("index", "indices")
+	assert.Equal(t, "indices", e.Plural("index"), "DefNoun overrides a pack")
+
+	require.NoError(t, e.LoadRulePack("computing"))
+	e.Reset()
+	assert.Equal(t, "indices", e.Plural("index"), "Reset removes a pack")
+}
+
+func TestLoadRulePackUnknown(t *testing.T) {
+	e := inflect.NewEngine()
+	err := e.LoadRulePack("chemistry")
+	require.ErrorIs(t, err, inflect.ErrUnknownRulePack)
+	assert.Contains(t, err.Error(), `"chemistry"`)
+	assert.Equal(t, "indices", e.Plural("index"))
+}
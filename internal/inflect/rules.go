@@ -0,0 +1,186 @@
+package inflect
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SuffixRule is one of the ordered rules that Plural falls back to for a
+// noun that is not in any table and matches no rule defined with
+// DefNounRule. The first rule that applies to a noun gives its plural.
+type SuffixRule struct {
+	// Name describes the rule in ExplainPlural, as in "-man -> -men".
+	Name string
+
+	// Match is the ending of the lowercase noun that the rule applies to, or
+	// "" for a rule that applies to any noun.
+	Match string
+
+	// Replace is the plural ending that replaces Match. Letters at the start
+	// of Replace that are the same as the start of Match keep their case in
+	// the noun, and the rest are uppercase if the noun is: "box" -> "boxes"
+	// and "BOX" -> "BOXES" for Match "x" and Replace "xes".
+	Replace string
+
+	// Condition, if not nil, must also return true for the rule to apply.
+	// It is called with the lowercase noun and whether the noun was written
+	// as a proper name, such as "Mary".
+	Condition func(lower string, properName bool) bool
+}
+
+// defaultSuffixRules contains the suffix rules of a new Engine, in the order
+// they are checked. An Engine's rules are never modified in place, so
+// engines share this slice until SetRules is called.
+var defaultSuffixRules = []SuffixRule{
+	// Leave words in other scripts, and words ending in a symbol, unchanged
+	{Name: "unchanged for other scripts and symbols", Condition: func(lower string, _ bool) bool {
+		last := lastBaseRune(lower)
+		return isNonLatinWord(lower) || !unicode.IsLetter(last) && !unicode.IsDigit(last)
+	}},
+
+	// Words ending in -man -> -men (except for words in manExceptions)
+	{Name: "-man -> -men", Match: "man", Replace: "men", Condition: func(lower string, _ bool) bool {
+		return !manExceptions[lower]
+	}},
+
+	// Words ending in -s, -ss, -sh, -ch, -x, -z -> add -es
+	{Name: "sibilant + -es", Match: "s", Replace: "ses"},
+	{Name: "sibilant + -es", Match: "sh", Replace: "shes"},
+	{Name: "sibilant + -es", Match: "ch", Replace: "ches"},
+	{Name: "sibilant + -es", Match: "x", Replace: "xes"},
+	{Name: "sibilant + -es", Match: "z", Replace: "zes"},
+
+	// Words ending in consonant + y -> -ies, except proper names: Mary -> Marys
+	{Name: "proper name + -s", Match: "y", Replace: "ys", Condition: func(lower string, name bool) bool {
+		return name && len(lower) > 1 && !isVowel(runeBefore(lower, 1))
+	}},
+	{Name: "consonant + -y -> -ies", Match: "y", Replace: "ies", Condition: func(lower string, _ bool) bool {
+		return len(lower) > 1 && !isVowel(runeBefore(lower, 1))
+	}},
+
+	// Words ending in -f or -fe -> -ves (with exceptions)
+	{Name: "-fe -> -ves", Match: "fe", Replace: "ves", Condition: func(lower string, _ bool) bool {
+		return shouldChangeF(lower)
+	}},
+	{Name: "-f -> -ves", Match: "f", Replace: "ves", Condition: func(lower string, _ bool) bool {
+		return !strings.HasSuffix(lower, "ff") && shouldChangeF(lower)
+	}},
+
+	// Words ending in -o -> -oes, except after a vowel (radio, studio, zoo)
+	// and for exceptions (piano)
+	{Name: "vowel + -o + -s", Match: "o", Replace: "os", Condition: func(lower string, _ bool) bool {
+		return len(lower) > 1 && isVowel(runeBefore(lower, 1))
+	}},
+	{Name: "-o exception + -s", Match: "o", Replace: "os", Condition: func(lower string, _ bool) bool {
+		return len(lower) > 1 && oExceptionTakesS(lower)
+	}},
+	{Name: "-o + -es", Match: "o", Replace: "oes", Condition: func(lower string, _ bool) bool {
+		return len(lower) > 1
+	}},
+
+	// Default: add -s
+	{Name: "default + -s", Replace: "s"},
+}
+
+// Rules returns a copy of the suffix rules that Plural falls back to, in the
+// order they are checked.
+//
+// The rules can be changed and passed to SetRules to reorder, insert, or
+// delete rules.
+//
+// Examples:
+//
+//	rules := Rules()
+//	rules[0].Name            // returns "unchanged for other scripts and symbols"
+//	rules[len(rules)-1].Name // returns "default + -s"
+func Rules() []SuffixRule {
+	return defaultEngine.Rules()
+}
+
+// Rules returns a copy of the suffix rules that e.Plural falls back to, in
+// the order they are checked. See the package-level Rules for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	rules := e.Rules()
+//	rules[len(rules)-1].Name // returns "default + -s"
+func (e *Engine) Rules() []SuffixRule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return slices.Clone(e.suffixRules)
+}
+
+// SetRules replaces the suffix rules that Plural falls back to with rules,
+// which are checked in order.
+//
+// A noun that no rule applies to is unchanged in the plural, so the rules
+// usually end with a rule that applies to any noun. The rules do not affect
+// Singular. Reset restores the default rules.
+//
+// Examples:
+//
+//	rules := Rules()
+//	rule := SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+//	SetRules(slices.Insert(rules, 1, rule))
+//	Plural("hippopotamus") // returns "hippopotami"
+func SetRules(rules []SuffixRule) {
+	defaultEngine.SetRules(rules)
+}
+
+// SetRules replaces the suffix rules that e.Plural falls back to with rules,
+// which are checked in order. See the package-level SetRules for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	rules := e.Rules()
+//	rule := SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+//	e.SetRules(slices.Insert(rules, 1, rule))
+//	e.Plural("hippopotamus") // returns "hippopotami"
+func (e *Engine) SetRules(rules []SuffixRule) {
+	rules = slices.Clone(rules)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	e.suffixRules = rules
+}
+
+// applySuffixRules applies the engine's suffix rules, recording the rule
+// used in x. Proper names are not respelled.
+func (e *Engine) applySuffixRules(word, lower string, name bool, x *explanation) string {
+	e.mu.RLock()
+	rules := e.suffixRules
+	e.mu.RUnlock()
+
+	for i := range rules {
+		r := &rules[i]
+		if !strings.HasSuffix(lower, r.Match) || r.Condition != nil && !r.Condition(lower, name) {
+			continue
+		}
+		plural := r.apply(word)
+		if x != nil {
+			// Checked here so that the arguments are not boxed in the hot path
+			x.note("suffix rule (%s): %s -> %s", r.Name, word, plural)
+		}
+		return plural
+	}
+	x.note("suffix rules: no rule applies to %s", word)
+	return word
+}
+
+// apply returns word with the ending matched by r replaced.
+func (r *SuffixRule) apply(word string) string {
+	// Keep the letters that Match and Replace share as written
+	keep := 0
+	for keep < len(r.Match) && keep < len(r.Replace) && r.Match[keep] == r.Replace[keep] {
+		keep++
+	}
+	for keep > 0 && keep < len(r.Match) && !utf8.RuneStart(r.Match[keep]) {
+		keep--
+	}
+	trim := utf8.RuneCountInString(r.Match[keep:])
+	return trimRunes(word, trim) + matchSuffix(word, r.Replace[keep:])
+}
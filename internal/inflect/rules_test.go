@@ -0,0 +1,138 @@
+package inflect_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestRulesDefaultOrder(t *testing.T) {
+	want := []struct {
+		name    string
+		match   string
+		replace string
+	}{
+		{name: "unchanged for other scripts and symbols"},
+		{name: "-man -> -men", match: "man", replace: "men"},
+		{name: "sibilant + -es", match: "s", replace: "ses"},
+		{name: "sibilant + -es", match: "sh", replace: "shes"},
+		{name: "sibilant + -es", match: "ch", replace: "ches"},
+		{name: "sibilant + -es", match: "x", replace: "xes"},
+		{name: "sibilant + -es", match: "z", replace: "zes"},
+		{name: "proper name + -s", match: "y", replace: "ys"},
+		{name: "consonant + -y -> -ies", match: "y", replace: "ies"},
+		{name: "-fe -> -ves", match: "fe", replace: "ves"},
+		{name: "-f -> -ves", match: "f", replace: "ves"},
+		{name: "vowel + -o + -s", match: "o", replace: "os"},
+		{name: "-o exception + -s", match: "o", replace: "os"},
+		{name: "-o + -es", match: "o", replace: "oes"},
+		{name: "default + -s", replace: "s"},
+	}
+
+	rules := inflect.NewEngine().Rules()
+	require.Len(t, rules, len(want))
+	for i, w := range want {
+		assert.Equal(t, w.name, rules[i].Name, "rule %d", i)
+		assert.Equal(t, w.match, rules[i].Match, "rule %d", i)
+		assert.Equal(t, w.replace, rules[i].Replace, "rule %d", i)
+	}
+}
+
+func TestSetRules(t *testing.T) {
+	usToI := inflect.SuffixRule{Name: "-us -> -i", Match: "us", Replace: "i"}
+
+	tests := []struct {
+		name   string
+		change func(rules []inflect.SuffixRule) []inflect.SuffixRule
+		word   string
+		want   string
+	}{
+		{
+			name: "insert",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				return slices.Insert(rules, 1, usToI)
+			},
+			word: "Hippopotamus",
+			want: "Hippopotami",
+		},
+		{
+			name: "insert after the rule that matches first",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				return append(rules, usToI)
+			},
+			word: "hippopotamus",
+			want: "hippopotamuses",
+		},
+		{
+			name: "delete",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				return slices.DeleteFunc(rules, func(r inflect.SuffixRule) bool {
+					return r.Name == "-man -> -men"
+				})
+			},
+			word: "batman",
+			want: "batmans",
+		},
+		{
+			name: "reorder",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				last := rules[len(rules)-1]
+				return append([]inflect.SuffixRule{last}, rules[:len(rules)-1]...)
+			},
+			word: "box",
+			want: "boxs",
+		},
+		{
+			name: "condition",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				rule := usToI
+				rule.Condition = func(lower string, _ bool) bool { return lower != "platypus" }
+				return slices.Insert(rules, 0, rule)
+			},
+			word: "PLATYPUS",
+			want: "PLATYPUSES",
+		},
+		{
+			name: "no rule applies",
+			change: func(rules []inflect.SuffixRule) []inflect.SuffixRule {
+				return rules[:len(rules)-1]
+			},
+			word: "cat",
+			want: "cat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			e.SetRules(tt.change(e.Rules()))
+			assert.Equal(t, tt.want, e.Plural(tt.word))
+		})
+	}
+}
+
+func TestSetRulesScope(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.Equal(t, "boxes", e.Plural("box"))
+
+	rules := e.Rules()
+	rules[0] = inflect.SuffixRule{Name: "unchanged", Replace: ""}
+	assert.Equal(t, "boxes", e.Plural("box"), "Rules returns a copy")
+
+	e.SetRules(rules)
+	rules[0] = inflect.SuffixRule{Name: "default + -s", Replace: "s"}
+	assert.Equal(t, "box", e.Plural("box"), "cached result is cleared")
+	assert.Equal(t, "box", e.Plural("box"), "SetRules copies its argument")
+	assert.Equal(t, "box", e.Clone().Plural("box"))
+	assert.Equal(t, "boxes", inflect.Plural("box"), "default engine is unaffected")
+	assert.Equal(t, "children", e.Plural("child"), "tables are checked first")
+
+	e.Reset()
+	assert.Equal(t, "boxes", e.Plural("box"))
+	assert.Len(t, e.Rules(), len(inflect.NewEngine().Rules()))
+}
@@ -1,57 +1,305 @@
 package inflect
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
-// feWordBases contains base words whose singular ends in -fe (plural is -ves).
-var feWordBases = map[string]bool{
-	"kni": true, "wi": true, "li": true,
+// knownSingulars contains singular nouns that end in s and would lose it to
+// the suffix rules: "bus" is not the plural of "bu". Nouns with a plural in
+// the irregular table, such as "analysis" and "cactus", are recognized
+// without being listed here, and nouns with an unchanged plural, such as
+// "series" and "corps", are in the unchanged table.
+var knownSingulars = map[string]bool{
+	// -as, -is, -os
+	"alias": true, "atlas": true, "bias": true, "canvas": true, "gas": true,
+	"pancreas": true, "axis": true, "iris": true, "pelvis": true,
+	"tennis": true, "trellis": true, "metropolis": true, "epidermis": true,
+	"asbestos": true, "chaos": true, "cosmos": true, "ethos": true,
+	"pathos": true, "rhinoceros": true,
+	// -us
+	"bus": true, "plus": true, "minus": true, "bonus": true, "campus": true,
+	"caucus": true, "census": true, "chorus": true, "circus": true,
+	"citrus": true, "abacus": true, "apparatus": true, "callus": true,
+	"fetus": true, "genius": true, "hiatus": true, "hippopotamus": true,
+	"impetus": true, "mucus": true, "nexus": true, "octopus": true,
+	"onus": true, "platypus": true, "prospectus": true, "sinus": true,
+	"status": true, "surplus": true, "thesaurus": true, "virus": true,
+	"walrus": true,
+	// Others
+	"lens": true,
 }
 
+// usIsRule is the suffix rule that removes the -s of a word ending in -us
+// or -is. These words are more often singular ("bus", "axis") than plural
+// ("menus", "taxis"), so SingularSafe leaves them unchanged.
+const usIsRule = "-s removed after u or i"
+
 // Singular returns the singular form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// plural possessive becomes a singular possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - Singular("cats") returns "cat"
 //   - Singular("boxes") returns "box"
 //   - Singular("children") returns "child"
 //   - Singular("sheep") returns "sheep"
+//   - Singular("dogs'") returns "dog's"
+//   - Singular("children's") returns "child's"
+//   - Singular("cats.") returns "cat."
+//   - Singular("children.json") returns "child.json"
+//   - Singular("iPhones") returns "iPhone"
 func Singular(word string) string {
 	return defaultEngine.Singular(word)
 }
 
 // Singular returns the singular form of an English noun.
 //
+// Surrounding quotes and trailing punctuation are kept in place, and a
+// plural possessive becomes a singular possessive. In a file name, only
+// the name is inflected and the extension is kept. Version numbers such
+// as "v1.2", contractions such as "it's", and abbreviations such as "Mr."
+// and "U.S." are left unchanged. Brand names such as "iPhone" keep their
+// capitalization; see DefStylized.
+//
 // Examples:
 //   - e.Singular("cats") returns "cat"
 //   - e.Singular("boxes") returns "box"
 //   - e.Singular("children") returns "child"
 //   - e.Singular("sheep") returns "sheep"
+//   - e.Singular("dogs'") returns "dog's"
+//   - e.Singular("children's") returns "child's"
+//   - e.Singular("cats.") returns "cat."
+//   - e.Singular("children.json") returns "child.json"
+//   - e.Singular("iPhones") returns "iPhone"
 func (e *Engine) Singular(word string) string {
+	return e.lookup(opSingular, word)
+}
+
+// singular implements Singular without the cache.
+func (e *Engine) singular(word string) string {
+	return e.singularExplained(word, nil)
+}
+
+// singularExplained implements Singular without the cache, recording each
+// rule it applies in x.
+func (e *Engine) singularExplained(word string, x *explanation) string {
 	if word == "" {
 		return ""
 	}
 
+	// Contractions and abbreviations are not nouns: it's, Mr., U.S.
+	if isSContraction(word) {
+		x.note("contraction: %s is unchanged", word)
+		return word
+	}
+	if isDottedAbbreviation(word) {
+		x.note("abbreviation: %s is unchanged", word)
+		return word
+	}
+
+	// Inflect the bare word inside any quotes or punctuation
+	if prefix, trimmed, suffix := extractPunctuation(word); prefix != "" || suffix != "" {
+		if trimmed == "" {
+			x.note("punctuation: %s has no word to inflect", word)
+			return word
+		}
+		x.note("punctuation: inflecting %s inside %s", trimmed, word)
+		return prefix + e.singularExplained(trimmed, x) + suffix
+	}
+
+	// Keep zero-width joiners, soft hyphens, and other invisible characters
+	if prefix, trimmed, suffix := splitInvisible(word); prefix != "" || suffix != "" {
+		if trimmed == "" {
+			x.note("invisible characters: %q has no word to inflect", word)
+			return word
+		}
+		x.note("invisible characters: inflecting %s inside %q", trimmed, word)
+		return prefix + e.singularExplained(trimmed, x) + suffix
+	}
+
+	// Possessives keep their marker in the singular form. A proper name
+	// ending in s with a bare apostrophe is already singular ("James'").
+	if base, apos, plural := splitPossessive(word); apos != "" {
+		if plural && e.isProperNameEndingInS(base) {
+			x.note("possessive: %s is a singular name", word)
+			return word
+		}
+		x.note("possessive: inflecting %s", base)
+		singular := e.singularExplained(base, x)
+		return singular + apos + matchSuffix(singular, "s")
+	}
+
+	// Inflect the name of a file and keep its extension
+	if name, ext := splitExtension(word); ext != "" {
+		x.note("file extension: inflecting %s and keeping %s", name, ext)
+		return e.singularExplained(name, x) + ext
+	}
+	if isVersionNumber(word) {
+		x.note("version number: %s is unchanged", word)
+		return word
+	}
+
+	// Keep the capitalization of brand names: iPhones -> iPhone
+	if singular, ok := e.stylizedSingular(word); ok {
+		x.note("stylized word: %s -> %s", word, singular)
+		return singular
+	}
+	if isLowerCamel(word) {
+		x.note("stylized word: inflecting %s in lowercase", word)
+		return restyle(word, e.singularExplained(strings.ToLower(word), x))
+	}
+
 	lower := strings.ToLower(word)
 
+	// Prefer the reading of the domains weighted with SetDomainBias
+	if singular, ok := e.domainSingular(lower); ok {
+		x.note("domain bias: %s -> %s", word, matchCase(word, singular))
+		return matchCase(word, singular)
+	}
+
 	// Check for irregular plurals first
 	e.mu.RLock()
 	singular, ok := e.singularIrregulars[lower]
 	e.mu.RUnlock()
 	if ok {
+		x.note("irregular table: %s -> %s", word, matchCase(word, singular))
 		return matchCase(word, singular)
 	}
 
 	// Check for uncountable/unchanged words
-	if unchangedPlurals[lower] {
+	if e.isUnchanged(lower) {
+		x.note("unchanged plurals: %s is unchanged", word)
+		return word
+	}
+
+	// Keep singular nouns ending in s: bus, lens, analysis
+	if e.isKnownSingular(lower) {
+		x.note("known singular: %s is already singular", word)
 		return word
 	}
 
+	// Correct near-misses of irregular nouns in lenient mode: childs -> child
+	if c, ok := e.lenientCorrection(word); ok {
+		x.note("lenient mode: %s", c.Note)
+		return c.Singular
+	}
+
 	// Check for words ending in -ese, -ois (nationalities that don't change)
 	if strings.HasSuffix(lower, "ese") || strings.HasSuffix(lower, "ois") {
+		x.note("nationality suffix: %s is unchanged", word)
 		return word
 	}
+	if base := strings.TrimSuffix(lower, "s"); eseNouns[base] {
+		x.note("-ese nouns: %s -> %s", word, trimRunes(word, 1))
+		return trimRunes(word, 1)
+	}
 
 	// Apply suffix rules to singularize
-	return applySingularSuffixRules(word, lower)
+	singular, rule := applySingularSuffixRules(word, lower)
+	x.note("suffix rule (%s): %s -> %s", rule, word, singular)
+	return singular
+}
+
+// isKnownSingular reports whether lower is a singular noun ending in s,
+// either listed in knownSingulars or given an irregular plural.
+func (e *Engine) isKnownSingular(lower string) bool {
+	if !strings.HasSuffix(lower, "s") {
+		return false
+	}
+	if knownSingulars[lower] {
+		return true
+	}
+	if _, ok := technicalPlurals[lower]; ok {
+		return true
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.irregularPlurals[lower]
+	return ok
+}
+
+// SingularSafe returns the singular form of an English noun, like
+// Singular, but never removes an s that may belong to a singular noun.
+//
+// Singular removes the final -s of a word it does not know, so an unlisted
+// singular noun such as "octothorpus" loses it. SingularSafe leaves words
+// ending in -us or -is unchanged unless a table gives their singular,
+// preferring to keep a rare plural such as "menus" over mangling a
+// singular. It is safe to call on words whose number is unknown and to
+// call more than once. Use ExplainSingularSafe to see why a word was kept.
+//
+// Examples:
+//   - SingularSafe("cats") returns "cat"
+//   - SingularSafe("bus") returns "bus"
+//   - SingularSafe("buses") returns "bus"
+//   - SingularSafe("lens") returns "lens"
+//   - SingularSafe("cacti") returns "cactus"
+//   - SingularSafe("octothorpus") returns "octothorpus"
+func SingularSafe(word string) string {
+	return defaultEngine.SingularSafe(word)
+}
+
+// SingularSafe returns the singular form of an English noun, but never
+// removes an s that may belong to a singular noun. See the package-level
+// SingularSafe for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("octothorpus", "octothorpi")
+//	e.SingularSafe("octothorpi")  // returns "octothorpus"
+//	e.SingularSafe("octothorpus") // returns "octothorpus"
+func (e *Engine) SingularSafe(word string) string {
+	return e.singularSafe(e.normalize(word), &explanation{})
+}
+
+// singularSafe implements SingularSafe, recording each rule it applies in
+// x, which must not be nil.
+func (e *Engine) singularSafe(word string, x *explanation) string {
+	singular := e.singularExplained(word, x)
+	if x.rule() == "suffix rule ("+usIsRule+")" {
+		x.note("safe mode: %s may be singular and is kept", word)
+		return word
+	}
+	return singular
+}
+
+// SingularLastWord returns a phrase with only its last word made singular.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - SingularLastWord("blue birds") returns "blue bird"
+//   - SingularLastWord("baby children") returns "baby child"
+//   - SingularLastWord("field mice") returns "field mouse"
+//   - SingularLastWord("cats") returns "cat"
+func SingularLastWord(phrase string) string {
+	return defaultEngine.SingularLastWord(phrase)
+}
+
+// SingularLastWord returns a phrase with only its last word made singular.
+//
+// Earlier words, such as adjectives or noun modifiers, are left unchanged,
+// so irregular plurals are found even inside a longer phrase.
+//
+// Examples:
+//   - e.SingularLastWord("blue birds") returns "blue bird"
+//   - e.SingularLastWord("baby children") returns "baby child"
+//   - e.SingularLastWord("field mice") returns "field mouse"
+//   - e.SingularLastWord("cats") returns "cat"
+func (e *Engine) SingularLastWord(phrase string) string {
+	head, last, tail := splitLastWord(phrase)
+	if last == "" {
+		return phrase
+	}
+	return head + e.Singular(last) + tail
 }
 
 // classicalPluralSingulars maps classical Latin/Greek plural endings to singular.
@@ -63,43 +311,53 @@ var classicalPluralSingulars = map[string]string{
 	"aureolae": "aureola", "coronae": "corona",
 }
 
-// applySingularSuffixRules applies standard English singularization suffix rules.
-func applySingularSuffixRules(word, lower string) string {
-	n := len(lower)
+// applySingularSuffixRules applies standard English singularization suffix
+// rules, returning the singular along with a short description of the rule
+// that produced it.
+func applySingularSuffixRules(word, lower string) (singular, rule string) {
+	// Leave words in other scripts unchanged
+	if isNonLatinWord(word) {
+		return word, "unchanged for other scripts"
+	}
+
+	n := utf8.RuneCountInString(lower)
 
 	// Check for classical Latin/Greek plurals
 	if singular, ok := classicalPluralSingulars[lower]; ok {
-		return matchCase(word, singular)
+		return matchCase(word, singular), "classical -ae -> -a"
 	}
 
 	// Words ending in -men -> -man (but not "women" which is irregular)
 	if strings.HasSuffix(lower, "men") && n > 3 {
-		return word[:len(word)-3] + matchCase(word[len(word)-3:], "man")
+		return trimRunes(word, 3) + matchCase(lastRunes(word, 3), "man"), "-men -> -man"
 	}
 
-	// Words ending in -ves -> -f or -fe
+	// Words ending in -ves -> -f or -fe, for the nouns and compounds whose
+	// plural takes -ves; other -ves words are singular -ve nouns
+	// (waves -> wave)
 	if strings.HasSuffix(lower, "ves") && n > 3 {
-		base := lower[:n-3]
-		// Check if original was -fe (knives -> knife, wives -> wife)
-		if singularEndsInFe(base) {
-			return word[:len(word)-3] + matchSuffix(word, "fe")
+		base := trimRunes(lower, 3)
+		// knives -> knife, housewives -> housewife
+		if shouldChangeF(base + "fe") {
+			return trimRunes(word, 3) + matchSuffix(word, "fe"), "-ves -> -fe"
+		}
+		// wolves -> wolf, bookshelves -> bookshelf
+		if shouldChangeF(base + "f") {
+			return trimRunes(word, 3) + matchSuffix(word, "f"), "-ves -> -f"
 		}
-		// Otherwise was -f (wolves -> wolf, leaves -> leaf)
-		return word[:len(word)-3] + matchSuffix(word, "f")
 	}
 
 	// Words ending in -ies (consonant + ies) -> -y
 	if strings.HasSuffix(lower, "ies") && n > 3 {
-		beforeIes := lower[n-4]
-		if !isVowel(rune(beforeIes)) {
-			return word[:len(word)-3] + matchSuffix(word, "y")
+		if !isVowel(runeBefore(lower, 3)) {
+			return trimRunes(word, 3) + matchSuffix(word, "y"), "consonant + -ies -> -y"
 		}
 	}
 
 	// Words ending in -es after sibilants (s, ss, sh, ch, x, z)
 	if strings.HasSuffix(lower, "es") && n > 2 {
-		if result, ok := singularizeEsSuffix(word, lower[:n-2]); ok {
-			return result
+		if result, ok := singularizeEsSuffix(word, trimRunes(lower, 2)); ok {
+			return result, "-es removed"
 		}
 	}
 
@@ -107,18 +365,16 @@ func applySingularSuffixRules(word, lower string) string {
 	if strings.HasSuffix(lower, "s") && n > 1 {
 		// Don't remove -s from words ending in -ss
 		if strings.HasSuffix(lower, "ss") {
-			return word
+			return word, "-ss unchanged"
+		}
+		if strings.HasSuffix(lower, "us") || strings.HasSuffix(lower, "is") {
+			return trimRunes(word, 1), usIsRule
 		}
-		return word[:len(word)-1]
+		return trimRunes(word, 1), "-s removed"
 	}
 
 	// Word doesn't appear to be plural
-	return word
-}
-
-// singularEndsInFe checks if a base word's singular form ends in -fe.
-func singularEndsInFe(base string) bool {
-	return feWordBases[base]
+	return word, "not plural"
 }
 
 // singularizeEsSuffix handles -es suffix singularization.
@@ -126,36 +382,40 @@ func singularEndsInFe(base string) bool {
 func singularizeEsSuffix(word, base string) (string, bool) {
 	// -sses -> -ss (classes -> class)
 	if strings.HasSuffix(base, "ss") {
-		return word[:len(word)-2], true
+		return trimRunes(word, 2), true
 	}
 	// -shes -> -sh (bushes -> bush)
 	if strings.HasSuffix(base, "sh") {
-		return word[:len(word)-2], true
+		return trimRunes(word, 2), true
 	}
 	// -ches -> -ch (churches -> church)
 	if strings.HasSuffix(base, "ch") {
-		return word[:len(word)-2], true
+		return trimRunes(word, 2), true
 	}
 	// -xes -> -x (boxes -> box)
 	if strings.HasSuffix(base, "x") {
-		return word[:len(word)-2], true
+		return trimRunes(word, 2), true
 	}
 	// -zes -> -z (buzzes -> buzz)
 	if strings.HasSuffix(base, "zz") {
-		return word[:len(word)-2], true
+		return trimRunes(word, 2), true
 	}
 	// -oes -> -o (heroes -> hero, potatoes -> potato)
 	// But not words like "shoes" -> "shoe"
 	if strings.HasSuffix(base, "o") && !oExceptionTakesS(base) && len(base) >= 2 {
 		// Check if this looks like a word that would have taken -oes
-		beforeO := base[len(base)-2]
-		if !isVowel(rune(beforeO)) {
-			return word[:len(word)-2], true
+		if !isVowel(runeBefore(base, 1)) {
+			return trimRunes(word, 2), true
 		}
 	}
-	// Single -s ending with -es: buses -> bus
-	if strings.HasSuffix(base, "s") && !strings.HasSuffix(base, "ss") {
-		return word[:len(word)-2], true
+	// -ses -> -s for the known singular -s nouns, including those with an
+	// irregular plural (buses -> bus, cactuses -> cactus), and after e, as
+	// -ese words other than eseNouns are unchanged in the plural; other
+	// -ses words are -se nouns (houses -> house)
+	if strings.HasSuffix(base, "s") {
+		if _, irregular := defaultIrregularPlurals[base]; irregular || knownSingulars[base] || strings.HasSuffix(base, "es") {
+			return trimRunes(word, 2), true
+		}
 	}
 	return "", false
 }
@@ -2,141 +2,239 @@ package inflect_test
 
 import (
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 
 	inflect "github.com/cv/go-inflect/v2"
 )
 
-func TestSingular(t *testing.T) {
+func TestSingularLastWord(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
 		want  string
 	}{
-		// Empty string
 		{name: "empty", input: "", want: ""},
+		{name: "single word", input: "cats", want: "cat"},
+		{name: "adjective", input: "blue birds", want: "blue bird"},
+		{name: "irregular", input: "baby children", want: "baby child"},
+		{name: "irregular compound", input: "field mice", want: "field mouse"},
+		{name: "earlier words unchanged", input: "glass boxes", want: "glass box"},
+		{name: "trailing whitespace", input: "blue birds\n", want: "blue bird\n"},
+	}
 
-		// Regular plurals - remove s
-		{name: "cats", input: "cats", want: "cat"},
-		{name: "dogs", input: "dogs", want: "dog"},
-		{name: "books", input: "books", want: "book"},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.SingularLastWord(tt.input))
+		})
+	}
+}
 
-		// Words ending in -es after sibilants
+func TestSingularKnownSingulars(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "bus", input: "bus", want: "bus"},
 		{name: "buses", input: "buses", want: "bus"},
-		{name: "classes", input: "classes", want: "class"},
-		{name: "bushes", input: "bushes", want: "bush"},
-		{name: "churches", input: "churches", want: "church"},
-		{name: "boxes", input: "boxes", want: "box"},
-		{name: "buzzes", input: "buzzes", want: "buzz"},
-
-		// -ies -> -y (consonant + ies)
-		{name: "cities", input: "cities", want: "city"},
-		{name: "babies", input: "babies", want: "baby"},
-		{name: "flies", input: "flies", want: "fly"},
-
-		// -ys (vowel + y) - just remove s
-		{name: "boys", input: "boys", want: "boy"},
-		{name: "days", input: "days", want: "day"},
-		{name: "keys", input: "keys", want: "key"},
-
-		// Words ending in -ves -> -f or -fe
-		{name: "knives", input: "knives", want: "knife"},
-		{name: "wives", input: "wives", want: "wife"},
-		{name: "lives", input: "lives", want: "life"},
-		{name: "leaves", input: "leaves", want: "leaf"},
-		{name: "wolves", input: "wolves", want: "wolf"},
-		{name: "calves", input: "calves", want: "calf"},
-		{name: "halves", input: "halves", want: "half"},
-
-		// Words ending in -oes -> -o
-		{name: "heroes", input: "heroes", want: "hero"},
-		{name: "potatoes", input: "potatoes", want: "potato"},
-		{name: "tomatoes", input: "tomatoes", want: "tomato"},
-		{name: "echoes", input: "echoes", want: "echo"},
-
-		// Words ending in -os (just remove s)
-		{name: "radios", input: "radios", want: "radio"},
-		{name: "studios", input: "studios", want: "studio"},
-		{name: "zoos", input: "zoos", want: "zoo"},
-		{name: "pianos", input: "pianos", want: "piano"},
-		{name: "photos", input: "photos", want: "photo"},
-
-		// Irregular plurals
-		{name: "children", input: "children", want: "child"},
-		{name: "feet", input: "feet", want: "foot"},
-		{name: "teeth", input: "teeth", want: "tooth"},
-		{name: "mice", input: "mice", want: "mouse"},
-		{name: "women", input: "women", want: "woman"},
-		{name: "men", input: "men", want: "man"},
-		{name: "people", input: "people", want: "person"},
-		{name: "oxen", input: "oxen", want: "ox"},
-		{name: "geese", input: "geese", want: "goose"},
-		{name: "lice", input: "lice", want: "louse"},
-		{name: "dice", input: "dice", want: "die"},
-
-		// Latin/Greek plurals
-		{name: "analyses", input: "analyses", want: "analysis"},
-		{name: "crises", input: "crises", want: "crisis"},
-		{name: "theses", input: "theses", want: "thesis"},
-		{name: "cacti", input: "cacti", want: "cactus"},
-		{name: "fungi", input: "fungi", want: "fungus"},
-		{name: "nuclei", input: "nuclei", want: "nucleus"},
-		{name: "bacteria", input: "bacteria", want: "bacterium"},
-		{name: "data", input: "data", want: "datum"},
-		{name: "media", input: "media", want: "medium"},
-		{name: "appendices", input: "appendices", want: "appendix"},
-		{name: "indices", input: "indices", want: "index"},
-		{name: "criteria", input: "criteria", want: "criterion"},
-		{name: "phenomena", input: "phenomena", want: "phenomenon"},
-
-		// Latin feminine -ae -> -a (classical forms)
-		{name: "larvae", input: "larvae", want: "larva"},
-		{name: "pupae", input: "pupae", want: "pupa"},
-		{name: "antennae", input: "antennae", want: "antenna"},
-		{name: "alumnae", input: "alumnae", want: "alumna"},
-		{name: "formulae", input: "formulae", want: "formula"},
-		{name: "nebulae", input: "nebulae", want: "nebula"},
-		{name: "vertebrae", input: "vertebrae", want: "vertebra"},
-		{name: "algae", input: "algae", want: "alga"},
-
-		// Unchanged plurals
-		{name: "sheep", input: "sheep", want: "sheep"},
-		{name: "deer", input: "deer", want: "deer"},
-		{name: "fish", input: "fish", want: "fish"},
-		{name: "species", input: "species", want: "species"},
-		{name: "series", input: "series", want: "series"},
-		{name: "aircraft", input: "aircraft", want: "aircraft"},
-		{name: "moose", input: "moose", want: "moose"},
-
-		// Words ending in -men -> -man
-		{name: "firemen", input: "firemen", want: "fireman"},
-		{name: "policemen", input: "policemen", want: "policeman"},
-		{name: "spokesmen", input: "spokesmen", want: "spokesman"},
-
-		// Nationalities ending in -ese (unchanged)
-		{name: "Chinese", input: "Chinese", want: "Chinese"},
-		{name: "Japanese", input: "Japanese", want: "Japanese"},
-		{name: "Portuguese", input: "Portuguese", want: "Portuguese"},
-
-		// Case preservation
-		{name: "CATS uppercase", input: "CATS", want: "CAT"},
-		{name: "Cats titlecase", input: "Cats", want: "Cat"},
-		{name: "Children titlecase", input: "Children", want: "Child"},
-		{name: "CHILDREN uppercase", input: "CHILDREN", want: "CHILD"},
-		{name: "BOXES uppercase", input: "BOXES", want: "BOX"},
-		{name: "Cities titlecase", input: "Cities", want: "City"},
-		{name: "MICE uppercase", input: "MICE", want: "MOUSE"},
-
-		// Already singular (should return unchanged)
-		{name: "already singular cat", input: "cat", want: "cat"},
-		{name: "already singular class", input: "class", want: "class"},
+		{name: "lens", input: "lens", want: "lens"},
+		{name: "lenses", input: "lenses", want: "lens"},
+		{name: "status", input: "status", want: "status"},
+		{name: "statuses", input: "statuses", want: "status"},
+		{name: "gas", input: "gas", want: "gas"},
+		{name: "atlas", input: "atlas", want: "atlas"},
+		{name: "chaos", input: "chaos", want: "chaos"},
+		{name: "irregular -is", input: "analysis", want: "analysis"},
+		{name: "irregular -us", input: "cactus", want: "cactus"},
+		{name: "unchanged corps", input: "corps", want: "corps"},
+		{name: "unchanged series", input: "series", want: "series"},
+		{name: "case preserved", input: "Bus", want: "Bus"},
+		{name: "possessive", input: "bus's", want: "bus's"},
+		{name: "plural -us", input: "menus", want: "menu"},
+		{name: "plural -is", input: "taxis", want: "taxi"},
+		{name: "irregular -uses", input: "cactuses", want: "cactus"},
+		{name: "-se noun", input: "houses", want: "house"},
+		{name: "-use noun", input: "causes", want: "cause"},
+		{name: "-oise noun", input: "noises", want: "noise"},
+		{name: "-ise noun", input: "promises", want: "promise"},
+		{name: "-ase noun", input: "databases", want: "database"},
+		{name: "-nse noun", input: "responses", want: "response"},
+		{name: "uses", input: "uses", want: "use"},
+		{name: "-ese noun", input: "cheeses", want: "cheese"},
+		{name: "-eses of an unlisted word", input: "eses", want: "es"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.input))
+		})
+	}
+}
+
+func TestSingularVes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "-ves to -f", input: "wolves", want: "wolf"},
+		{name: "-ves to -fe", input: "knives", want: "knife"},
+		{name: "-ves to -ve", input: "waves", want: "wave"},
+		{name: "short -ve noun", input: "aves", want: "ave"},
+		{name: "-ove noun", input: "gloves", want: "glove"},
+		{name: "-ive noun", input: "olives", want: "olive"},
+		{name: "case preserved", input: "Leaves", want: "Leaf"},
+		{name: "dwarves", input: "dwarves", want: "dwarf"},
+		{name: "compound -f", input: "bookshelves", want: "bookshelf"},
+		{name: "compound wolf", input: "werewolves", want: "werewolf"},
+		{name: "compound -fe", input: "housewives", want: "housewife"},
+		{name: "compound knife", input: "pocketknives", want: "pocketknife"},
+		{name: "compound self", input: "themselves", want: "themself"},
+		{name: "-ve noun ending in lives", input: "olives", want: "olive"},
+		{name: "-ve noun ending in elves", input: "twelves", want: "twelve"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.input))
+		})
+	}
+}
+
+func TestSingularKnownSingularsIsPlural(t *testing.T) {
+	for _, word := range []string{"bus", "lens", "status", "analysis", "virus"} {
+		assert.False(t, inflect.IsPlural(word), word)
+		assert.Equal(t, inflect.Plural(word), inflect.PluralSafe(word), word)
+	}
+}
+
+func TestSingularSafe(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "regular", input: "cats", want: "cat"},
+		{name: "sibilant", input: "buses", want: "bus"},
+		{name: "known singular", input: "bus", want: "bus"},
+		{name: "irregular", input: "cacti", want: "cactus"},
+		{name: "irregular singular", input: "cactus", want: "cactus"},
+		{name: "unknown -us", input: "octothorpus", want: "octothorpus"},
+		{name: "unknown -is", input: "zorbis", want: "zorbis"},
+		{name: "ambiguous -us kept", input: "menus", want: "menus"},
+		{name: "singular", input: "cat", want: "cat"},
+		{name: "punctuation", input: "octothorpus,", want: "octothorpus,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inflect.SingularSafe(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, inflect.SingularSafe(got), "idempotent")
+		})
+	}
+}
+
+func TestSingularSafeEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("octothorpus", "octothorpi")
+	assert.Equal(t, "octothorpus", e.SingularSafe("octothorpi"))
+	assert.Equal(t, "octothorpus", e.SingularSafe("octothorpus"))
+	assert.Equal(t, "octothorpu", inflect.Singular("octothorpus"))
+}
+
+func TestSingularUnicode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// Accented Latin words follow the usual rules
+		{name: "cafés", input: "cafés", want: "café"},
+		{name: "résumés", input: "résumés", want: "résumé"},
+		{name: "fiancées", input: "fiancées", want: "fiancée"},
+		{name: "jalapeños", input: "jalapeños", want: "jalapeño"},
+		{name: "uppercase accented", input: "ÉCOLES", want: "ÉCOLE"},
+		{name: "pâtés", input: "pâtés", want: "pâté"},
+
+		// Other scripts are left unchanged
+		{name: "Cyrillic", input: "кошки", want: "кошки"},
+		{name: "Japanese", input: "日本語", want: "日本語"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := inflect.Singular(tt.input)
 			assert.Equal(t, tt.want, got)
+			assert.True(t, utf8.ValidString(got))
+		})
+	}
+}
+
+func TestSingularFileNamesAndVersions(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "json", input: "children.json", want: "child.json"},
+		{name: "txt", input: "boxes.txt", want: "box.txt"},
+		{name: "double extension", input: "files.tar.gz", want: "file.tar.gz"},
+		{name: "library name", input: "nodes.js", want: "node.js"},
+		{name: "singular file name", input: "node.js", want: "node.js"},
+		{name: "version", input: "v1.2", want: "v1.2"},
+		{name: "version after noun", input: "releases v1.2", want: "releases v1.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.input))
+		})
+	}
+}
+
+func TestSingularPunctuationAndPossessives(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		// Plural possessives become singular possessives
+		{name: "dogs'", input: "dogs'", want: "dog's"},
+		{name: "children's", input: "children's", want: "child's"},
+		{name: "boxes'", input: "boxes'", want: "box's"},
+		{name: "uppercase", input: "DOGS'", want: "DOG'S"},
+		{name: "curly apostrophe", input: "dogs\u2019", want: "dog\u2019s"},
+
+		// Singular possessives are already singular
+		{name: "cat's", input: "cat's", want: "cat's"},
+		{name: "proper name", input: "James'", want: "James'"},
+
+		// Trailing punctuation
+		{name: "comma", input: "cats,", want: "cat,"},
+		{name: "period", input: "boxes.", want: "box."},
+		{name: "possessive with period", input: "dogs'.", want: "dog's."},
+
+		// Quotes and brackets
+		{name: "double quotes", input: `"cats"`, want: `"cat"`},
+		{name: "single quotes", input: "'cats'", want: "'cat'"},
+		{name: "parentheses", input: "(children)", want: "(child)"},
+
+		// Contractions and abbreviations are unchanged
+		{name: "it's", input: "it's", want: "it's"},
+		{name: "title", input: "Mrs.", want: "Mrs."},
+		{name: "dotted abbreviation", input: "U.S.", want: "U.S."},
+
+		// Only punctuation
+		{name: "ellipsis", input: "...", want: "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.input))
 		})
 	}
 }
@@ -0,0 +1,210 @@
+package inflect
+
+// EngineSnapshot is a saved copy of an Engine's settings and custom
+// definitions, taken with Snapshot and put back with Restore.
+//
+// A snapshot is not affected by later changes to the engine it was taken
+// from, and it can be restored any number of times, to any engine.
+type EngineSnapshot struct {
+	engine *Engine
+}
+
+// Snapshot returns a copy of the current settings and custom definitions,
+// such as those made with DefNoun, DefA, and Classical, for a later call to
+// Restore.
+//
+// Together with Restore, it lets a tool try out definitions and roll back
+// only those, where DefNounReset or Reset would also remove definitions made
+// earlier. Checkpoint and Undo keep a stack of snapshots for the same
+// purpose.
+//
+// Examples:
+//
+//	DefNoun("gadget", "gadgetz")
+//	s := Snapshot()
+//	DefNoun("widget", "widgetz")
+//	DefA("apple")
+//	Restore(s)
+//	Plural("widget") // returns "widgets"
+//	Plural("gadget") // returns "gadgetz"
+func Snapshot() *EngineSnapshot {
+	return defaultEngine.Snapshot()
+}
+
+// Snapshot returns a copy of e's current settings and custom definitions
+// for a later call to e.Restore. See the package-level Snapshot for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	s := e.Snapshot()
+//	e.DefNoun("widget", "widgetz")
+//	e.Restore(s)
+//	e.Plural("widget") // returns "widgets"
+func (e *Engine) Snapshot() *EngineSnapshot {
+	return &EngineSnapshot{engine: e.Clone()}
+}
+
+// Restore replaces the current settings and custom definitions with those
+// saved by Snapshot.
+//
+// The result cache, if enabled, is emptied, and the hook set with SetHook
+// is kept. Restoring a nil snapshot does nothing.
+//
+// Examples:
+//
+//	s := Snapshot()
+//	Classical(true)
+//	Restore(s)
+//	IsClassical() // returns false
+func Restore(s *EngineSnapshot) {
+	defaultEngine.Restore(s)
+}
+
+// Restore replaces e's settings and custom definitions with those saved by
+// e.Snapshot, or by Snapshot on another engine. See the package-level
+// Restore for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	s := e.Snapshot()
+//	e.Classical(true)
+//	e.Restore(s)
+//	e.IsClassical() // returns false
+func (e *Engine) Restore(s *EngineSnapshot) {
+	if s == nil {
+		return
+	}
+	// Copy the snapshot so that it can be restored again
+	c := s.engine.Clone()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+
+	e.classicalMode = c.classicalMode
+	e.classicalAll = c.classicalAll
+	e.classicalZero = c.classicalZero
+	e.classicalHerd = c.classicalHerd
+	e.classicalNames = c.classicalNames
+	e.classicalAncient = c.classicalAncient
+	e.classicalPersons = c.classicalPersons
+	e.irregularPlurals = c.irregularPlurals
+	e.singularIrregulars = c.singularIrregulars
+	e.classicalNouns = c.classicalNouns
+	e.customUnchanged = c.customUnchanged
+	e.customHerd = c.customHerd
+	e.nounRules = c.nounRules
+	e.suffixRules = c.suffixRules
+	e.customVerbs = c.customVerbs
+	e.customVerbsReverse = c.customVerbsReverse
+	e.customAdjs = c.customAdjs
+	e.customAdjsReverse = c.customAdjsReverse
+	e.customAWords = c.customAWords
+	e.customAnWords = c.customAnWords
+	e.customAPatterns = c.customAPatterns
+	e.customAnPatterns = c.customAnPatterns
+	e.customSounds = c.customSounds
+	e.gender = c.gender
+	e.dialect = c.dialect
+	e.possessiveStyle = c.possessiveStyle
+	e.properNameDetection = c.properNameDetection
+	e.customProperNames = c.customProperNames
+	e.defaultNum = c.defaultNum
+	e.numPropagation = c.numPropagation
+	e.normalizeInput = c.normalizeInput
+	e.technicalPlurals = c.technicalPlurals
+	e.domainBias = c.domainBias
+	e.lenient = c.lenient
+	e.acronyms = c.acronyms
+	e.acronymPronunciations = c.acronymPronunciations
+	e.customCollectives = c.customCollectives
+	e.customDiminutives = c.customDiminutives
+	e.customStylized = c.customStylized
+	e.customStylizedPlurals = c.customStylizedPlurals
+}
+
+// Checkpoint saves the current settings and custom definitions on an undo
+// stack, to be restored by Undo.
+//
+// Each Undo restores the most recent checkpoint that has not been undone,
+// so nested experiments can be rolled back one at a time. Reset does not
+// clear the stack, so a Reset after a Checkpoint can be undone.
+//
+// Examples:
+//
+//	Checkpoint()
+//	DefNoun("widget", "widgetz")
+//	Checkpoint()
+//	DefNoun("gadget", "gadgetz")
+//	Undo()           // returns true
+//	Plural("gadget") // returns "gadgets"
+//	Plural("widget") // returns "widgetz"
+//	Undo()           // returns true
+//	Plural("widget") // returns "widgets"
+//	Undo()           // returns false
+func Checkpoint() {
+	defaultEngine.Checkpoint()
+}
+
+// Checkpoint saves e's current settings and custom definitions on an undo
+// stack, to be restored by e.Undo. See the package-level Checkpoint for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.Checkpoint()
+//	e.DefNoun("widget", "widgetz")
+//	e.Undo()
+//	e.Plural("widget") // returns "widgets"
+func (e *Engine) Checkpoint() {
+	s := e.Snapshot()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.undoStack = append(e.undoStack, s)
+}
+
+// Undo restores the settings and custom definitions saved by the most recent
+// Checkpoint and removes it from the undo stack. It returns false, and
+// changes nothing, if the stack is empty.
+//
+// Examples:
+//
+//	Undo() // returns false
+//	Checkpoint()
+//	Classical(true)
+//	Undo() // returns true
+//	IsClassical() // returns false
+func Undo() bool {
+	return defaultEngine.Undo()
+}
+
+// Undo restores e's settings and custom definitions saved by the most
+// recent e.Checkpoint and removes it from the undo stack. It returns false
+// if the stack is empty. See the package-level Undo for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.Undo() // returns false
+//	e.Checkpoint()
+//	e.Classical(true)
+//	e.Undo() // returns true
+func (e *Engine) Undo() bool {
+	e.mu.Lock()
+	n := len(e.undoStack)
+	if n == 0 {
+		e.mu.Unlock()
+		return false
+	}
+	s := e.undoStack[n-1]
+	e.undoStack[n-1] = nil
+	e.undoStack = e.undoStack[:n-1]
+	e.mu.Unlock()
+
+	e.Restore(s)
+	return true
+}
@@ -0,0 +1,135 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("gadget", "gadgetz")
+	s := e.Snapshot()
+
+	e.DefNoun("widget", "widgetz")
+	e.DefNoun("gadget", "gadgetes")
+	e.DefA("apple")
+	e.DefVerb("blorp", "blorps")
+	e.Classical(true)
+	e.SetGender("f")
+	require.Equal(t, "a apple", e.A("apple"))
+
+	e.Restore(s)
+	assert.Equal(t, "gadgetz", e.Plural("gadget"), "earlier definitions are kept")
+	assert.Equal(t, "widgets", e.Plural("widget"))
+	assert.Equal(t, "an apple", e.A("apple"))
+	assert.False(t, e.IsClassical())
+	assert.Equal(t, "t", e.GetGender())
+
+	// A snapshot can be restored again after further changes
+	e.DefNoun("gadget", "gadgetes")
+	e.Restore(s)
+	assert.Equal(t, "gadgetz", e.Plural("gadget"))
+	e.Restore(nil)
+	assert.Equal(t, "gadgetz", e.Plural("gadget"))
+}
+
+func TestSnapshotRestoresEverySetting(t *testing.T) {
+	e := inflect.NewEngine()
+	e.ClassicalAll(true)
+	e.DefNoun("gadget", "gadgetz")
+	require.NoError(t, e.DefNounRule("us$", "i", 0))
+	e.SetRules(e.Rules()[1:])
+	e.DefVerb("blorp", "blorps")
+	e.DefAdj("blurg", "blurgs")
+	e.DefAn("yak")
+	require.NoError(t, e.DefAPattern("euro.*"))
+	e.SetGender("m")
+	e.SetDialect(inflect.DialectUK)
+	e.SetPossessiveStyle(inflect.PossessiveTraditional)
+	e.SetProperNameDetection(inflect.ProperNameOff)
+	e.Num(1)
+	e.NumPropagation(false)
+	e.NormalizeInput(true)
+	e.SetTechnicalPlurals(true)
+	e.AddAcronym("GPU")
+	e.DefCollective("gadget", "bundle")
+	e.DefDiminutive("gadget", "gadgette")
+	e.DefStylized("GmbH", "GmbHs")
+	want := e.Clone()
+
+	s := e.Snapshot()
+	e.Reset()
+	e.Restore(s)
+
+	words := []string{"gadget", "cactus", "box", "index", "Jones", "formula"}
+	for _, w := range words {
+		assert.Equal(t, want.Plural(w), e.Plural(w), w)
+	}
+	assert.Equal(t, want.PluralVerb("blorp"), e.PluralVerb("blorp"))
+	assert.Equal(t, want.PluralAdj("blurg"), e.PluralAdj("blurg"))
+	assert.Equal(t, want.An("yak"), e.An("yak"))
+	assert.Equal(t, want.An("european"), e.An("european"))
+	assert.Equal(t, want.GetGender(), e.GetGender())
+	assert.Equal(t, want.GetDialect(), e.GetDialect())
+	assert.Equal(t, want.GetPossessiveStyle(), e.GetPossessiveStyle())
+	assert.Equal(t, want.GetProperNameDetection(), e.GetProperNameDetection())
+	assert.Equal(t, want.GetNum(), e.GetNum())
+	assert.Equal(t, want.IsNumPropagation(), e.IsNumPropagation())
+	assert.Equal(t, want.IsNormalizeInput(), e.IsNormalizeInput())
+	assert.Equal(t, want.IsTechnicalPlurals(), e.IsTechnicalPlurals())
+	assert.Equal(t, want.GetAcronyms(), e.GetAcronyms())
+	assert.Equal(t, want.CollectiveNoun("gadget"), e.CollectiveNoun("gadget"))
+	assert.Equal(t, want.Diminutive("gadget"), e.Diminutive("gadget"))
+	assert.Equal(t, want.Plural("GmbH"), e.Plural("GmbH"))
+	assert.Len(t, e.Rules(), len(want.Rules()))
+}
+
+func TestSnapshotIsolation(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	s := e.Snapshot()
+	assert.Equal(t, "widgets", e.Plural("widget"))
+
+	other := inflect.NewEngine()
+	other.DefNoun("widget", "widgetz")
+	e.Restore(other.Snapshot())
+	assert.Equal(t, "widgetz", e.Plural("widget"), "cached result is cleared")
+
+	e.DefNoun("gadget", "gadgetz")
+	assert.Equal(t, "gadgets", other.Plural("gadget"), "restored definitions are copies")
+	e.Restore(s)
+	assert.Equal(t, "widgets", e.Plural("widget"))
+	assert.Equal(t, "widgets", inflect.Plural("widget"), "default engine is unaffected")
+}
+
+func TestCheckpointUndo(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.False(t, e.Undo(), "empty stack")
+
+	e.Checkpoint()
+	e.DefNoun("widget", "widgetz")
+	e.Checkpoint()
+	e.DefNoun("gadget", "gadgetz")
+	e.Checkpoint()
+	e.Reset()
+	assert.Equal(t, "gadgets", e.Plural("gadget"))
+
+	require.True(t, e.Undo(), "Reset is undone")
+	assert.Equal(t, "gadgetz", e.Plural("gadget"))
+	assert.Equal(t, "widgetz", e.Plural("widget"))
+
+	require.True(t, e.Undo())
+	assert.Equal(t, "gadgets", e.Plural("gadget"))
+	assert.Equal(t, "widgetz", e.Plural("widget"))
+
+	c := e.Clone()
+	assert.False(t, c.Undo(), "Clone does not copy the stack")
+
+	require.True(t, e.Undo())
+	assert.Equal(t, "widgets", e.Plural("widget"))
+	assert.False(t, e.Undo())
+}
@@ -0,0 +1,163 @@
+package inflect
+
+import "strings"
+
+// DefSilentH registers the start of words whose "h" is silent, so that An
+// uses "an" for every word beginning with it, like the built-in "hour" and
+// "honest".
+//
+// Unlike DefAn, which matches a whole first word, the prefix also matches
+// longer words: DefSilentH("homage") covers "homages". When prefixes
+// registered with DefSilentH and DefSoundedVowel both match, the longer one
+// wins. DefA and DefAn still take precedence.
+//
+// Examples:
+//
+//	An("homage")  // returns "a homage"
+//	DefSilentH("homage")
+//	An("homage")  // returns "an homage"
+//	An("Homages") // returns "an Homages"
+func DefSilentH(prefix string) {
+	defaultEngine.DefSilentH(prefix)
+}
+
+// DefSilentH registers the start of words whose "h" is silent, so that e.An
+// uses "an" for every word beginning with it. See the package-level
+// DefSilentH for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefSilentH("homage")
+//	e.An("homage") // returns "an homage"
+func (e *Engine) DefSilentH(prefix string) {
+	e.defSound(prefix, true)
+}
+
+// UndefSilentH removes a prefix registered with DefSilentH.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	DefSilentH("homage")
+//	UndefSilentH("homage") // returns true
+//	An("homage")           // returns "a homage"
+func UndefSilentH(prefix string) bool {
+	return defaultEngine.UndefSilentH(prefix)
+}
+
+// UndefSilentH removes a prefix registered with e.DefSilentH.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefSilentH("homage")
+//	e.UndefSilentH("homage") // returns true
+func (e *Engine) UndefSilentH(prefix string) bool {
+	return e.undefSound(prefix, true)
+}
+
+// DefSoundedVowel registers the start of words whose first vowel is
+// pronounced as a consonant, like the "y" sound of "euro" and "unicorn" or
+// the "w" sound of "one" and "Oaxaca", so that An uses "a" for every word
+// beginning with it.
+//
+// Unlike DefA, which matches a whole first word, the prefix also matches
+// longer words: DefSoundedVowel("oaxac") covers "Oaxaca" and "Oaxacan".
+// When prefixes registered with DefSilentH and DefSoundedVowel both match,
+// the longer one wins. DefA and DefAn still take precedence.
+//
+// Examples:
+//
+//	An("Oaxaca")  // returns "an Oaxaca"
+//	DefSoundedVowel("oaxac")
+//	An("Oaxaca")  // returns "a Oaxaca"
+//	An("Oaxacan") // returns "a Oaxacan"
+func DefSoundedVowel(prefix string) {
+	defaultEngine.DefSoundedVowel(prefix)
+}
+
+// DefSoundedVowel registers the start of words whose first vowel is
+// pronounced as a consonant, so that e.An uses "a" for every word beginning
+// with it. See the package-level DefSoundedVowel for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefSoundedVowel("oaxac")
+//	e.An("Oaxaca") // returns "a Oaxaca"
+func (e *Engine) DefSoundedVowel(prefix string) {
+	e.defSound(prefix, false)
+}
+
+// UndefSoundedVowel removes a prefix registered with DefSoundedVowel.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	DefSoundedVowel("oaxac")
+//	UndefSoundedVowel("oaxac") // returns true
+//	An("Oaxaca")               // returns "an Oaxaca"
+func UndefSoundedVowel(prefix string) bool {
+	return defaultEngine.UndefSoundedVowel(prefix)
+}
+
+// UndefSoundedVowel removes a prefix registered with e.DefSoundedVowel.
+//
+// Returns true if the prefix was removed, false if it was not registered.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefSoundedVowel("oaxac")
+//	e.UndefSoundedVowel("oaxac") // returns true
+func (e *Engine) UndefSoundedVowel(prefix string) bool {
+	return e.undefSound(prefix, false)
+}
+
+// defSound registers a word prefix that takes "an" or "a". A prefix can be
+// registered only once, so registering it again with the other article
+// replaces it.
+func (e *Engine) defSound(prefix string, an bool) {
+	lower := strings.ToLower(strings.TrimSpace(prefix))
+	if lower == "" {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if e.customSounds == nil {
+		e.customSounds = make(map[string]bool)
+	}
+	e.customSounds[lower] = an
+}
+
+// undefSound removes a word prefix registered by defSound with the same
+// article.
+func (e *Engine) undefSound(prefix string, an bool) bool {
+	lower := strings.ToLower(strings.TrimSpace(prefix))
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if registered, ok := e.customSounds[lower]; !ok || registered != an {
+		return false
+	}
+	e.cache.clear()
+	delete(e.customSounds, lower)
+	return true
+}
+
+// customSound returns whether a lowercase word takes "an" according to the
+// longest prefix registered with DefSilentH or DefSoundedVowel, and the
+// prefix, or "" if none matches. The caller must hold e.mu.
+func (e *Engine) customSound(lower string) (an bool, prefix string) {
+	for p, a := range e.customSounds {
+		if len(p) > len(prefix) && strings.HasPrefix(lower, p) {
+			an, prefix = a, p
+		}
+	}
+	return an, prefix
+}
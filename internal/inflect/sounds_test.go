@@ -0,0 +1,101 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestDefSilentH(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.Equal(t, "a homage", e.An("homage"))
+
+	e.DefSilentH("Homage")
+	assert.Equal(t, "an homage", e.An("homage"), "cached result is cleared")
+	assert.Equal(t, "an Homages", e.An("Homages"))
+	assert.Equal(t, "an \"homage\" to jazz", e.An("\"homage\" to jazz"))
+	assert.Equal(t, "a home", e.An("home"))
+	assert.Equal(t, "a homage", inflect.An("homage"), "default engine is unaffected")
+
+	assert.False(t, e.UndefSoundedVowel("homage"), "registered as silent h")
+	assert.True(t, e.UndefSilentH("homage"))
+	assert.Equal(t, "a homage", e.An("homage"))
+	assert.False(t, e.UndefSilentH("homage"))
+}
+
+func TestDefSoundedVowel(t *testing.T) {
+	e := inflect.NewEngine()
+	assert.Equal(t, "an Oaxaca", e.An("Oaxaca"))
+
+	e.DefSoundedVowel("oaxac")
+	assert.Equal(t, "a Oaxaca", e.An("Oaxaca"))
+	assert.Equal(t, "a Oaxacan dish", e.An("Oaxacan dish"))
+	assert.Equal(t, "an oak", e.An("oak"))
+	assert.Equal(t, "a Oaxaca", e.Clone().An("Oaxaca"))
+
+	assert.True(t, e.UndefSoundedVowel("OAXAC"))
+	assert.Equal(t, "an Oaxaca", e.An("Oaxaca"))
+}
+
+func TestDefSoundsPrecedence(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(e *inflect.Engine)
+		word  string
+		want  string
+	}{
+		{
+			name:  "longer prefix wins",
+			setup: func(e *inflect.Engine) { e.DefSoundedVowel("o"); e.DefSilentH("oaxac") },
+			word:  "Oaxaca",
+			want:  "an Oaxaca",
+		},
+		{
+			name:  "shorter prefix still applies",
+			setup: func(e *inflect.Engine) { e.DefSoundedVowel("o"); e.DefSilentH("oaxac") },
+			word:  "oak",
+			want:  "a oak",
+		},
+		{
+			name:  "redefinition replaces",
+			setup: func(e *inflect.Engine) { e.DefSilentH("homage"); e.DefSoundedVowel("homage") },
+			word:  "homage",
+			want:  "a homage",
+		},
+		{
+			name:  "DefA takes precedence",
+			setup: func(e *inflect.Engine) { e.DefSilentH("homage"); e.DefA("homages") },
+			word:  "homages",
+			want:  "a homages",
+		},
+		{
+			name:  "DefAReset clears prefixes",
+			setup: func(e *inflect.Engine) { e.DefSilentH("homage"); e.DefAReset() },
+			word:  "homage",
+			want:  "a homage",
+		},
+		{
+			name:  "Reset clears prefixes",
+			setup: func(e *inflect.Engine) { e.DefSilentH("homage"); e.Reset() },
+			word:  "homage",
+			want:  "a homage",
+		},
+		{
+			name:  "empty prefix is ignored",
+			setup: func(e *inflect.Engine) { e.DefSilentH(" ") },
+			word:  "cat",
+			want:  "a cat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := inflect.NewEngine()
+			tt.setup(e)
+			assert.Equal(t, tt.want, e.An(tt.word))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package inflect
+
+import (
+	"slices"
+	"strings"
+)
+
+// TableName returns the SQL table name for a Go type name: the snake_case
+// plural most Go ORMs expect.
+//
+// Unlike Tableize, only the last word is made plural, and a name that is
+// already plural is kept, so TableName can be given either a type name or
+// an existing table name.
+//
+// Examples:
+//   - TableName("Person") returns "people"
+//   - TableName("UserProfile") returns "user_profiles"
+//   - TableName("HTTPServer") returns "http_servers"
+//   - TableName("people") returns "people"
+func TableName(typeName string) string {
+	return defaultEngine.TableName(typeName)
+}
+
+// TableName returns the SQL table name for a Go type name, using e's
+// plurals. See the package-level TableName for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNoun("octopus", "octopodes")
+//	e.TableName("SeaOctopus") // returns "sea_octopodes"
+func (e *Engine) TableName(typeName string) string {
+	return inflectIdentifier(SnakeCase(typeName), e.pluralSafe)
+}
+
+// ColumnName returns the SQL column name for a Go field name: its
+// snake_case form.
+//
+// Examples:
+//   - ColumnName("CreatedAt") returns "created_at"
+//   - ColumnName("UserID") returns "user_id"
+//   - ColumnName("HTMLBody") returns "html_body"
+func ColumnName(fieldName string) string {
+	return SnakeCase(fieldName)
+}
+
+// JoinTableName returns the name of the table joining two types in a
+// many-to-many relation: their table names in alphabetical order, joined
+// by an underscore, as Rails names join tables.
+//
+// Sorting makes the name the same whichever side of the relation declares
+// it.
+//
+// Examples:
+//   - JoinTableName("User", "Role") returns "roles_users"
+//   - JoinTableName("Role", "User") returns "roles_users"
+//   - JoinTableName("Person", "Team") returns "people_teams"
+func JoinTableName(a, b string) string {
+	return defaultEngine.JoinTableName(a, b)
+}
+
+// JoinTableName returns the name of the table joining two types in a
+// many-to-many relation, using e's plurals. See the package-level
+// JoinTableName for details.
+func (e *Engine) JoinTableName(a, b string) string {
+	tables := []string{e.TableName(a), e.TableName(b)}
+	slices.Sort(tables)
+	return strings.Join(tables, "_")
+}
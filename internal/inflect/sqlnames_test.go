@@ -0,0 +1,87 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestTableName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "regular", input: "User", want: "users"},
+		{name: "irregular", input: "Person", want: "people"},
+		{name: "compound", input: "UserProfile", want: "user_profiles"},
+		{name: "acronym", input: "HTTPServer", want: "http_servers"},
+		{name: "consonant y", input: "Category", want: "categories"},
+		{name: "known singular", input: "Status", want: "statuses"},
+		{name: "already plural", input: "people", want: "people"},
+		{name: "already a table", input: "user_profiles", want: "user_profiles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.TableName(tt.input))
+		})
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "compound", input: "CreatedAt", want: "created_at"},
+		{name: "acronym", input: "UserID", want: "user_id"},
+		{name: "leading acronym", input: "HTMLBody", want: "html_body"},
+		{name: "single word", input: "Name", want: "name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ColumnName(tt.input))
+		})
+	}
+}
+
+func TestJoinTableName(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{name: "sorted", a: "User", b: "Role", want: "roles_users"},
+		{name: "either order", a: "Role", b: "User", want: "roles_users"},
+		{name: "irregular", a: "Team", b: "Person", want: "people_teams"},
+		{name: "compound", a: "UserGroup", b: "Permission", want: "permissions_user_groups"},
+		{name: "self", a: "User", b: "User", want: "users_users"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.JoinTableName(tt.a, tt.b))
+		})
+	}
+}
+
+func TestTableNameEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("octopus", "octopodes")
+	assert.Equal(t, "sea_octopodes", e.TableName("SeaOctopus"))
+	assert.Equal(t, "octopodes_tanks", e.JoinTableName("Tank", "Octopus"))
+}
+
+func TestTableNameIgnoresNum(t *testing.T) {
+	defer inflect.Num()
+
+	inflect.Num(1)
+	assert.Equal(t, "people", inflect.TableName("Person"))
+	assert.Equal(t, "roles_users", inflect.JoinTableName("User", "Role"))
+}
@@ -0,0 +1,113 @@
+package inflect
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Errors returned by the strict variants of the inflection functions.
+// They are wrapped with the offending input, so compare them with errors.Is.
+var (
+	// ErrUnknownWord is returned when the input is not a word that can be
+	// inflected or parsed, such as an empty string, a symbol, a word in
+	// another script, or an unrecognized or misplaced number word.
+	ErrUnknownWord = errors.New("unknown word")
+
+	// ErrNotPlural is returned by SingularE when the word is not a plural.
+	ErrNotPlural = errors.New("word is not plural")
+
+	// ErrOverflow is returned by WordsToNumberE when the number does not
+	// fit in an int.
+	ErrOverflow = errors.New("number overflows int")
+
+	// ErrNotDigits is returned by NumberStringToWordsGrouped when the input
+	// is empty or contains anything other than the digits 0-9.
+	ErrNotDigits = errors.New("not a string of digits")
+
+	// ErrInvalidGroupSize is returned by NumberStringToWordsGrouped when
+	// the group size is less than 1.
+	ErrInvalidGroupSize = errors.New("group size must be at least 1")
+)
+
+// PluralE returns the plural form of an English noun, like Plural, but
+// returns ErrUnknownWord instead of echoing input that has no letters to
+// inflect or is written in another script.
+//
+// Examples:
+//   - PluralE("cat") returns ("cats", nil)
+//   - PluralE("child") returns ("children", nil)
+//   - PluralE("") returns ("", ErrUnknownWord)
+//   - PluralE("42") returns ("", ErrUnknownWord)
+//   - PluralE("猫") returns ("", ErrUnknownWord)
+func PluralE(word string) (string, error) {
+	return defaultEngine.PluralE(word)
+}
+
+// PluralE returns the plural form of an English noun, like e.Plural, but
+// returns ErrUnknownWord instead of echoing input that has no letters to
+// inflect or is written in another script.
+//
+// Examples:
+//   - e.PluralE("cat") returns ("cats", nil)
+//   - e.PluralE("") returns ("", ErrUnknownWord)
+//   - e.PluralE("42") returns ("", ErrUnknownWord)
+func (e *Engine) PluralE(word string) (string, error) {
+	if err := checkWord(word); err != nil {
+		return "", err
+	}
+	return e.Plural(word), nil
+}
+
+// SingularE returns the singular form of an English noun, like Singular,
+// but returns ErrNotPlural if the word is not a plural and ErrUnknownWord
+// if it is not a word that can be inflected.
+//
+// Words whose plural is the same as their singular, such as "sheep", are
+// accepted and returned unchanged.
+//
+// Examples:
+//   - SingularE("cats") returns ("cat", nil)
+//   - SingularE("children") returns ("child", nil)
+//   - SingularE("sheep") returns ("sheep", nil)
+//   - SingularE("cat") returns ("", ErrNotPlural)
+//   - SingularE("") returns ("", ErrUnknownWord)
+func SingularE(word string) (string, error) {
+	return defaultEngine.SingularE(word)
+}
+
+// SingularE returns the singular form of an English noun, like e.Singular,
+// but returns ErrNotPlural if the word is not a plural and ErrUnknownWord
+// if it is not a word that can be inflected.
+//
+// Words whose plural is the same as their singular, such as "sheep", are
+// accepted and returned unchanged.
+//
+// Examples:
+//   - e.SingularE("cats") returns ("cat", nil)
+//   - e.SingularE("sheep") returns ("sheep", nil)
+//   - e.SingularE("cat") returns ("", ErrNotPlural)
+func (e *Engine) SingularE(word string) (string, error) {
+	if err := checkWord(word); err != nil {
+		return "", err
+	}
+	singular := e.Singular(word)
+	if strings.EqualFold(singular, word) && !strings.EqualFold(e.plural(word), word) {
+		return "", fmt.Errorf("%w: %q", ErrNotPlural, word)
+	}
+	return singular, nil
+}
+
+// checkWord returns ErrUnknownWord unless word, without surrounding quotes
+// and punctuation, contains a Latin letter and ends in a letter or digit.
+func checkWord(word string) error {
+	_, trimmed, _ := extractPunctuation(strings.TrimSpace(word))
+	last, _ := utf8.DecodeLastRuneInString(trimmed)
+	if !strings.ContainsFunc(trimmed, unicode.IsLetter) || isNonLatinWord(trimmed) ||
+		!unicode.IsLetter(last) && !unicode.IsDigit(last) {
+		return fmt.Errorf("%w: %q", ErrUnknownWord, word)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralE(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "regular", input: "cat", want: "cats"},
+		{name: "irregular", input: "child", want: "children"},
+		{name: "unchanged", input: "sheep", want: "sheep"},
+		{name: "punctuation", input: "cat,", want: "cats,"},
+		{name: "possessive", input: "cat's", want: "cats'"},
+		{name: "digits and letters", input: "mp3", want: "mp3s"},
+		{name: "empty", input: "", wantErr: inflect.ErrUnknownWord},
+		{name: "whitespace", input: "  ", wantErr: inflect.ErrUnknownWord},
+		{name: "digits", input: "42", wantErr: inflect.ErrUnknownWord},
+		{name: "punctuation only", input: "?!", wantErr: inflect.ErrUnknownWord},
+		{name: "trailing symbol", input: "C++", wantErr: inflect.ErrUnknownWord},
+		{name: "other script", input: "猫", wantErr: inflect.ErrUnknownWord},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.PluralE(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSingularE(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "regular", input: "cats", want: "cat"},
+		{name: "irregular", input: "children", want: "child"},
+		{name: "unchanged", input: "sheep", want: "sheep"},
+		{name: "capitalized", input: "Boxes", want: "Box"},
+		{name: "singular", input: "cat", wantErr: inflect.ErrNotPlural},
+		{name: "irregular singular", input: "child", wantErr: inflect.ErrNotPlural},
+		{name: "empty", input: "", wantErr: inflect.ErrUnknownWord},
+		{name: "digits", input: "42", wantErr: inflect.ErrUnknownWord},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.SingularE(tt.input)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStrictErrorsNameInput(t *testing.T) {
+	_, err := inflect.SingularE("cat")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"cat"`)
+
+	_, err = inflect.WordsToNumberE("forty-lots")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"lots"`)
+}
+
+func TestStrictEngine(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+
+	got, err := e.PluralE("regex")
+	require.NoError(t, err)
+	assert.Equal(t, "regexen", got)
+
+	got, err = e.SingularE("regexen")
+	require.NoError(t, err)
+	assert.Equal(t, "regex", got)
+
+	_, err = e.SingularE("regex")
+	require.ErrorIs(t, err, inflect.ErrNotPlural)
+}
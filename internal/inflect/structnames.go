@@ -0,0 +1,135 @@
+package inflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrNotStruct is returned by InflectStructNames when it is not given a
+// struct.
+var ErrNotStruct = errors.New("not a struct type")
+
+// NameMode is the form InflectStructNames gives each name.
+type NameMode int
+
+const (
+	// NamePlural makes each name plural.
+	// Example: "UserProfile" -> "UserProfiles"
+	NamePlural NameMode = iota
+
+	// NameSingular makes each name singular.
+	// Example: "UserProfiles" -> "UserProfile"
+	NameSingular
+)
+
+// InflectedName is an identifier and its inflected form.
+type InflectedName struct {
+	// Name is the identifier as declared.
+	Name string
+
+	// Inflected is the plural or singular of the identifier, or the value
+	// of its inflect tag.
+	Inflected string
+}
+
+// StructNames holds the inflected names of a struct type and its fields,
+// as returned by InflectStructNames.
+type StructNames struct {
+	// Type is the name of the struct type.
+	Type InflectedName
+
+	// Fields are the exported fields, in declaration order.
+	Fields []InflectedName
+}
+
+// InflectStructNames returns the plural or singular names of a struct type
+// and its exported fields, for code generators such as ORMs and OpenAPI
+// generators that name tables, collections, or properties after Go types.
+//
+// v may be a struct, a pointer to one, or its reflect.Type. Only the last
+// word of each identifier is inflected, keeping its case:
+// "UserProfile" -> "UserProfiles". A field tagged `inflect:"name"` is given
+// that name instead, and a field tagged `inflect:"-"` is left out. The
+// fields of embedded structs without a tag are included as if they were
+// declared in the outer struct, as encoding/json does. Names are made
+// plural even when a default count of 1 has been set with Num.
+//
+// It returns an error wrapping ErrNotStruct if v is not a struct.
+//
+// Examples:
+//
+//	type User struct {
+//		Address string
+//		Person  string `inflect:"staff"`
+//		Secret  string `inflect:"-"`
+//	}
+//	InflectStructNames(User{}, NamePlural)
+//	// returns {Type: {User Users}, Fields: [{Address Addresses} {Person staff}]}
+func InflectStructNames(v any, mode NameMode) (StructNames, error) {
+	return defaultEngine.InflectStructNames(v, mode)
+}
+
+// InflectStructNames returns the plural or singular names of a struct type
+// and its exported fields, using e's plurals and singulars. See the
+// package-level InflectStructNames for details.
+func (e *Engine) InflectStructNames(v any, mode NameMode) (StructNames, error) {
+	t, ok := v.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(v)
+	}
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return StructNames{}, fmt.Errorf("%w: %v", ErrNotStruct, t)
+	}
+
+	inflect := e.pluralSafe
+	if mode == NameSingular {
+		inflect = e.SingularSafe
+	}
+	names := StructNames{
+		Type: InflectedName{Name: t.Name(), Inflected: inflectIdentifier(t.Name(), inflect)},
+	}
+	names.Fields = appendFieldNames(names.Fields, t, inflect, map[reflect.Type]bool{t: true})
+	return names, nil
+}
+
+// appendFieldNames appends the inflected names of the exported fields of
+// struct type t to names, including those of untagged embedded structs not
+// already in seen.
+func appendFieldNames(names []InflectedName, t reflect.Type, inflect func(string) string, seen map[reflect.Type]bool) []InflectedName {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("inflect"), ",")
+		if tag == "-" {
+			continue
+		}
+
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if !seen[ft] {
+					seen[ft] = true
+					names = appendFieldNames(names, ft, inflect, seen)
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		inflected := tag
+		if inflected == "" {
+			inflected = inflectIdentifier(f.Name, inflect)
+		}
+		names = append(names, InflectedName{Name: f.Name, Inflected: inflected})
+	}
+	return names
+}
@@ -0,0 +1,119 @@
+package inflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+type timestamps struct {
+	CreatedAt string
+	UpdatedAt string
+}
+
+type Audit struct {
+	Reviewer string
+}
+
+type UserProfile struct {
+	timestamps
+	*Audit   `inflect:"audits"`
+	Address  string
+	Person   string `inflect:"staff"`
+	Category string `inflect:"categories,omitempty"`
+	Secret   string `inflect:"-"`
+	userID   string
+}
+
+func TestInflectStructNames(t *testing.T) {
+	want := inflect.StructNames{
+		Type: inflect.InflectedName{Name: "UserProfile", Inflected: "UserProfiles"},
+		Fields: []inflect.InflectedName{
+			{Name: "CreatedAt", Inflected: "CreatedAts"},
+			{Name: "UpdatedAt", Inflected: "UpdatedAts"},
+			{Name: "Audit", Inflected: "audits"},
+			{Name: "Address", Inflected: "Addresses"},
+			{Name: "Person", Inflected: "staff"},
+			{Name: "Category", Inflected: "categories"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input any
+	}{
+		{name: "value", input: UserProfile{}},
+		{name: "pointer", input: &UserProfile{}},
+		{name: "nil pointer", input: (*UserProfile)(nil)},
+		{name: "reflect type", input: reflect.TypeFor[UserProfile]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.InflectStructNames(tt.input, inflect.NamePlural)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestInflectStructNamesSingular(t *testing.T) {
+	type Users struct {
+		Addresses []string
+		People    []string
+		Status    string
+	}
+
+	got, err := inflect.InflectStructNames(Users{}, inflect.NameSingular)
+	require.NoError(t, err)
+	assert.Equal(t, inflect.StructNames{
+		Type: inflect.InflectedName{Name: "Users", Inflected: "User"},
+		Fields: []inflect.InflectedName{
+			{Name: "Addresses", Inflected: "Address"},
+			{Name: "People", Inflected: "Person"},
+			{Name: "Status", Inflected: "Status"},
+		},
+	}, got)
+}
+
+func TestInflectStructNamesEmbeddedCycle(t *testing.T) {
+	type Node struct {
+		*Node
+		Child string
+	}
+
+	got, err := inflect.InflectStructNames(Node{}, inflect.NamePlural)
+	require.NoError(t, err)
+	assert.Equal(t, []inflect.InflectedName{{Name: "Child", Inflected: "Children"}}, got.Fields)
+}
+
+func TestInflectStructNamesNotStruct(t *testing.T) {
+	for _, v := range []any{nil, 42, "User", []UserProfile{}} {
+		_, err := inflect.InflectStructNames(v, inflect.NamePlural)
+		assert.ErrorIs(t, err, inflect.ErrNotStruct, "%#v", v)
+	}
+}
+
+func TestInflectStructNamesEngine(t *testing.T) {
+	type Regex struct{}
+
+	e := inflect.NewEngine()
+	e.DefNoun("regex", "regexen")
+	got, err := e.InflectStructNames(Regex{}, inflect.NamePlural)
+	require.NoError(t, err)
+	assert.Equal(t, "Regexen", got.Type.Inflected)
+}
+
+func TestInflectStructNamesIgnoresNum(t *testing.T) {
+	type Person struct{}
+
+	e := inflect.NewEngine()
+	e.Num(1)
+	got, err := e.InflectStructNames(Person{}, inflect.NamePlural)
+	require.NoError(t, err)
+	assert.Equal(t, "People", got.Type.Inflected)
+}
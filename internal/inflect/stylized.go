@@ -0,0 +1,143 @@
+package inflect
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// stylizedWords maps brand names and other words with unusual
+// capitalization to their plurals. Words such as "macOS" and "LaTeX" that
+// the suffix rules would mangle are unchanged in the plural.
+var stylizedWords = map[string]string{
+	// Operating systems
+	"iOS": "iOS", "iPadOS": "iPadOS", "macOS": "macOS", "tvOS": "tvOS",
+	"visionOS": "visionOS", "watchOS": "watchOS",
+	// Typesetting
+	"BibTeX": "BibTeX", "LaTeX": "LaTeX", "TeX": "TeX", "XeTeX": "XeTeX",
+	// Devices
+	"iMac": "iMacs", "iPad": "iPads", "iPhone": "iPhones", "iPod": "iPods",
+}
+
+// stylizedPlurals maps the plurals in stylizedWords back to their
+// singulars.
+var stylizedPlurals = func() map[string]string {
+	singulars := make(map[string]string, len(stylizedWords))
+	for word, plural := range stylizedWords {
+		singulars[plural] = word
+	}
+	return singulars
+}()
+
+// DefStylized registers a brand name or other word with unusual
+// capitalization, such as "iPhone" or "macOS", along with its plural.
+//
+// Registered words are matched by their exact spelling, or in all
+// uppercase, so a stylized name never changes the inflection of an
+// ordinary word ("LaTeX" is registered, "latex" is not). Plural and
+// Singular return the registered forms without changing their case. An
+// empty plural leaves the word unchanged in the plural.
+//
+// Words that start with a lowercase letter and contain an uppercase one,
+// such as "iPhone" or "eBay", keep their capitalization without being
+// registered. DefStylized is for words that the regular rules get wrong.
+//
+// Examples:
+//
+//	DefStylized("fooOS", "")
+//	Plural("fooOS")        // returns "fooOS"
+//	DefStylized("GmbH", "GmbHs")
+//	Plural("GmbH")         // returns "GmbHs"
+//	Singular("GmbHs")      // returns "GmbH"
+func DefStylized(word, plural string) {
+	defaultEngine.DefStylized(word, plural)
+}
+
+// DefStylized registers a brand name or other word with unusual
+// capitalization, such as "iPhone" or "macOS", along with its plural.
+//
+// Registered words are matched by their exact spelling, or in all
+// uppercase. e.Plural and e.Singular return the registered forms without
+// changing their case. An empty plural leaves the word unchanged in the
+// plural.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefStylized("GmbH", "GmbHs")
+//	e.Plural("GmbH")       // returns "GmbHs"
+//	e.Singular("GmbHs")    // returns "GmbH"
+func (e *Engine) DefStylized(word, plural string) {
+	if plural == "" {
+		plural = word
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache.clear()
+	if e.customStylized == nil {
+		e.customStylized = make(map[string]string)
+		e.customStylizedPlurals = make(map[string]string)
+	}
+	e.customStylized[word] = plural
+	e.customStylizedPlurals[plural] = word
+}
+
+// stylizedPlural returns the plural of a word registered with DefStylized
+// or in stylizedWords, in uppercase if the word is.
+func (e *Engine) stylizedPlural(word string) (string, bool) {
+	return e.stylizedLookup(word, e.customStylized, stylizedWords)
+}
+
+// stylizedSingular returns the singular of a plural registered with
+// DefStylized or in stylizedWords, in uppercase if the plural is.
+func (e *Engine) stylizedSingular(word string) (string, bool) {
+	return e.stylizedLookup(word, e.customStylizedPlurals, stylizedPlurals)
+}
+
+// stylizedLookup looks word up in a custom table, then in a built-in one,
+// by its exact spelling or in uppercase.
+func (e *Engine) stylizedLookup(word string, custom, builtin map[string]string) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for _, table := range []map[string]string{custom, builtin} {
+		if result, ok := table[word]; ok {
+			return result, true
+		}
+	}
+	if !isAllUppercase(word) {
+		return "", false
+	}
+	for _, table := range []map[string]string{custom, builtin} {
+		for from, to := range table {
+			if strings.ToUpper(from) == word {
+				return strings.ToUpper(to), true
+			}
+		}
+	}
+	return "", false
+}
+
+// isLowerCamel reports whether word starts with a lowercase letter and
+// contains an uppercase one, like "iPhone" or "eBay". Uppercase letters
+// without a lowercase form, such as "ϔ", do not count, so that inflecting
+// word in lowercase always changes it.
+func isLowerCamel(word string) bool {
+	runes := []rune(word)
+	return len(runes) > 1 && unicode.IsLower(runes[0]) && slices.ContainsFunc(runes[1:], func(r rune) bool {
+		return unicode.IsUpper(r) && unicode.ToLower(r) != r
+	})
+}
+
+// restyle applies the capitalization of original to the start of result,
+// an inflection of original in lowercase, as far as the two agree:
+// "iCity", "icities" -> "iCities".
+func restyle(original, result string) string {
+	orig, res := []rune(original), []rune(result)
+	for i := 0; i < len(orig) && i < len(res); i++ {
+		if unicode.ToLower(orig[i]) != res[i] {
+			break
+		}
+		res[i] = orig[i]
+	}
+	return string(res)
+}
@@ -0,0 +1,94 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestPluralStylized(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		// Built-in registry
+		{word: "iPhone", want: "iPhones"},
+		{word: "macOS", want: "macOS"},
+		{word: "iOS", want: "iOS"},
+		{word: "LaTeX", want: "LaTeX"},
+		{word: "MACOS", want: "MACOS"},
+
+		// Lowercase start with an inner capital
+		{word: "eBay", want: "eBays"},
+		{word: "iCity", want: "iCities"},
+		{word: "iPhone's", want: "iPhones'"},
+		{word: "eBay,", want: "eBays,"},
+
+		// Other capitalizations are unaffected
+		{word: "GitHub", want: "GitHubs"},
+		{word: "PlayStation", want: "PlayStations"},
+		{word: "latex", want: "latices"},
+		{word: "IPHONE", want: "IPHONES"},
+		{word: "fisϔh", want: "fisϔhs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Plural(tt.word))
+		})
+	}
+}
+
+func TestSingularStylized(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{word: "iPhones", want: "iPhone"},
+		{word: "macOS", want: "macOS"},
+		{word: "LaTeX", want: "LaTeX"},
+		{word: "eBays", want: "eBay"},
+		{word: "iCities", want: "iCity"},
+		{word: "IPHONES", want: "IPHONE"},
+		{word: "fisϔhs", want: "fisϔh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.Singular(tt.word))
+		})
+	}
+}
+
+func TestAnStylized(t *testing.T) {
+	assert.Equal(t, "an iPhone", inflect.An("iPhone"))
+	assert.Equal(t, "an iOS device", inflect.An("iOS device"))
+	assert.Equal(t, "a macOS update", inflect.An("macOS update"))
+	assert.Equal(t, "an eBay listing", inflect.An("eBay listing"))
+}
+
+func TestDefStylized(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.Equal(t, "FooOSes", e.Plural("FooOS"))
+
+	e.DefStylized("FooOS", "")
+	e.DefStylized("GmbH", "GmbHs")
+	assert.Equal(t, "FooOS", e.Plural("FooOS"))
+	assert.Equal(t, "GmbHs", e.Plural("GmbH"))
+	assert.Equal(t, "GmbH", e.Singular("GmbHs"))
+	assert.Equal(t, "GMBHS", e.Plural("GMBH"))
+
+	// Only the registered spelling matches
+	assert.Equal(t, "Gmbhs", e.Plural("Gmbh"))
+
+	// Other engines are unaffected
+	assert.Equal(t, "FooOSes", inflect.Plural("FooOS"))
+
+	clone := e.Clone()
+	e.Reset()
+	assert.Equal(t, "FooOSes", e.Plural("FooOS"))
+	assert.Equal(t, "FooOS", clone.Plural("FooOS"))
+}
@@ -0,0 +1,91 @@
+package inflect
+
+// technicalPlurals maps the Latin -ex and -ix nouns whose -es plural is
+// usual in technical writing to that plural. Nouns such as "matrix" and
+// "vertex", whose -ices plural is also the technical one, are not listed.
+var technicalPlurals = map[string]string{
+	"apex": "apexes", "appendix": "appendixes", "cortex": "cortexes",
+	"index": "indexes", "vortex": "vortexes",
+}
+
+// SetTechnicalPlurals enables or disables technical plurals of Latin nouns
+// ending in -ex and -ix.
+//
+// When enabled, Plural() uses the -es plural common in technical writing,
+// such as database indexes and document appendixes:
+//   - index -> indexes (instead of indices)
+//   - appendix -> appendixes (instead of appendices)
+//   - apex -> apexes, cortex -> cortexes, vortex -> vortexes
+//
+// When disabled (false, the default), the -ices plurals are used. Singular()
+// accepts both forms either way.
+//
+// Classical Latin plurals take precedence: with ClassicalAncient(true), the
+// -ices plurals are used whether technical plurals are enabled or not. Nouns
+// given another plural with DefNoun or LoadRulePack keep that plural.
+//
+// Examples:
+//
+//	Plural("index")   // returns "indices"
+//	SetTechnicalPlurals(true)
+//	Plural("index")   // returns "indexes"
+//	Plural("matrix")  // returns "matrices"
+func SetTechnicalPlurals(enabled bool) {
+	defaultEngine.SetTechnicalPlurals(enabled)
+}
+
+// SetTechnicalPlurals enables or disables technical plurals of Latin nouns
+// ending in -ex and -ix, such as "indexes" for "index". See the
+// package-level SetTechnicalPlurals for details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.SetTechnicalPlurals(true)
+//	e.Plural("appendix") // returns "appendixes"
+func (e *Engine) SetTechnicalPlurals(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.technicalPlurals = enabled
+}
+
+// IsTechnicalPlurals returns whether technical plurals of Latin nouns
+// ending in -ex and -ix are enabled.
+//
+// Examples:
+//
+//	IsTechnicalPlurals() // returns false (default)
+//	SetTechnicalPlurals(true)
+//	IsTechnicalPlurals() // returns true
+func IsTechnicalPlurals() bool {
+	return defaultEngine.IsTechnicalPlurals()
+}
+
+// IsTechnicalPlurals returns whether technical plurals of Latin nouns
+// ending in -ex and -ix are enabled.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.IsTechnicalPlurals() // returns false (default)
+func (e *Engine) IsTechnicalPlurals() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.technicalPlurals
+}
+
+// technicalPlural returns the technical plural of lower, if technical
+// plurals are enabled, classical plurals are not, and the noun has not been
+// redefined.
+func (e *Engine) technicalPlural(lower string) (string, bool) {
+	plural, ok := technicalPlurals[lower]
+	if !ok {
+		return "", false
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if !e.technicalPlurals || e.classicalAncient || e.classicalMode {
+		return "", false
+	}
+	return plural, e.irregularPlurals[lower] == defaultIrregularPlurals[lower]
+}
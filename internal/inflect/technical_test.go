@@ -0,0 +1,68 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestSetTechnicalPlurals(t *testing.T) {
+	tests := []struct {
+		word      string
+		technical string
+		standard  string
+	}{
+		{word: "index", technical: "indexes", standard: "indices"},
+		{word: "appendix", technical: "appendixes", standard: "appendices"},
+		{word: "apex", technical: "apexes", standard: "apices"},
+		{word: "cortex", technical: "cortexes", standard: "cortices"},
+		{word: "vortex", technical: "vortexes", standard: "vortices"},
+		{word: "Index", technical: "Indexes", standard: "Indices"},
+		{word: "INDEX", technical: "INDEXES", standard: "INDICES"},
+		{word: "matrix", technical: "matrices", standard: "matrices"},
+		{word: "vertex", technical: "vertices", standard: "vertices"},
+		{word: "box", technical: "boxes", standard: "boxes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			e := inflect.NewEngine()
+			assert.Equal(t, tt.standard, e.Plural(tt.word))
+
+			e.SetTechnicalPlurals(true)
+			assert.Equal(t, tt.technical, e.Plural(tt.word))
+			assert.Equal(t, tt.word, e.Singular(tt.technical))
+			assert.Equal(t, tt.word, e.Singular(tt.standard))
+		})
+	}
+}
+
+func TestSetTechnicalPluralsInteractions(t *testing.T) {
+	e := inflect.NewEngine()
+	e.EnableCache(10)
+	assert.False(t, e.IsTechnicalPlurals())
+	assert.Equal(t, "indices", e.Plural("index"))
+
+	e.SetTechnicalPlurals(true)
+	assert.True(t, e.IsTechnicalPlurals())
+	assert.Equal(t, "indexes", e.Plural("index"), "cached result is not reused")
+	assert.Equal(t, "indices", inflect.Plural("index"), "default engine is unaffected")
+	assert.Equal(t, "indexes", e.Clone().Plural("index"))
+
+	e.ClassicalAncient(true)
+	assert.Equal(t, "indices", e.Plural("index"), "classical plurals take precedence")
+	e.ClassicalAncient(false)
+	e.Classical(true)
+	assert.Equal(t, "indices", e.Plural("index"))
+	e.Classical(false)
+	assert.Equal(t, "indexes", e.Plural("index"))
+
+	e.DefNoun("vortex", "vortexen")
+	assert.Equal(t, "vortexen", e.Plural("vortex"), "DefNoun takes precedence")
+
+	e.Reset()
+	assert.False(t, e.IsTechnicalPlurals())
+	assert.Equal(t, "indices", e.Plural("index"))
+}
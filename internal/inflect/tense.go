@@ -0,0 +1,310 @@
+package inflect
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrNoVerb is returned by ChangeTense when it cannot find a verb to change.
+var ErrNoVerb = errors.New("no verb found")
+
+// sentenceWordPattern matches a word, including contractions such as
+// "isn't" written with a straight or curly apostrophe.
+var sentenceWordPattern = regexp.MustCompile(`[A-Za-z]+(?:['’][A-Za-z]+)*`)
+
+// functionWords contains prepositions, conjunctions, and adverbs that can
+// follow a plural subject without being its verb ("the users of the site").
+var functionWords = map[string]bool{
+	"about": true, "after": true, "all": true, "also": true, "always": true,
+	"and": true, "as": true, "at": true, "before": true, "both": true,
+	"but": true, "by": true, "during": true, "for": true, "from": true,
+	"here": true, "in": true, "into": true, "never": true, "not": true,
+	"now": true, "of": true, "often": true, "on": true, "only": true,
+	"or": true, "over": true, "still": true, "that": true, "then": true,
+	"there": true, "to": true, "under": true, "which": true, "who": true,
+	"with": true, "without": true,
+}
+
+// commonModifiers contains frequent adjectives and quantifiers that come
+// before a noun, so that "users" in "the new users log in" is not taken
+// for a verb.
+var commonModifiers = map[string]bool{
+	"active": true, "all": true, "current": true, "different": true,
+	"existing": true, "few": true, "many": true, "more": true, "most": true,
+	"new": true, "old": true, "other": true, "pending": true, "recent": true,
+	"remaining": true, "several": true, "some": true,
+}
+
+// verbPhrase is the main verb phrase of a clause, as found by
+// findVerbPhrase.
+type verbPhrase struct {
+	first, last int    // indexes of its first and last words
+	base        string // base form of the main verb, "be" for forms of "be"
+	number      int    // 1 or 2 if the verb shows its number, or 0
+	negative    bool
+	contracted  bool // negated with "n't" rather than "not"
+	modal       bool // a modal other than "will", which has no tenses
+}
+
+// ChangeTense rewrites the main verb of a simple sentence in the given
+// tense.
+//
+// The main verb is the first word that the verb tables, or its position
+// after the subject, mark as a verb. Auxiliaries are rewritten together
+// with the verb they go with, and negation is kept. The new verb agrees
+// with the subject. Clauses built on a modal other than "will", such as
+// "can", have no tenses and are returned unchanged. This is a best-effort
+// heuristic for short generated sentences, not a parser: it does not
+// handle questions, subordinate clauses, or more than one verb. If no verb
+// is found, the sentence is returned unchanged with an error wrapping
+// ErrNoVerb.
+//
+// Examples:
+//   - ChangeTense("The job runs nightly", TensePast) returns "The job ran nightly"
+//   - ChangeTense("The build is green", TensePast) returns "The build was green"
+//   - ChangeTense("The users log in", TensePerfect) returns "The users have logged in"
+//   - ChangeTense("She doesn't know", TensePast) returns "She didn't know"
+//   - ChangeTense("The job ran", TenseFuture) returns "The job will run"
+//   - ChangeTense("Nightly", TensePast) returns ("Nightly", ErrNoVerb)
+func ChangeTense(sentence string, tense Tense) (string, error) {
+	return defaultEngine.ChangeTense(sentence, tense)
+}
+
+// ChangeTense rewrites the main verb of a simple sentence in the given
+// tense. See the package-level ChangeTense for details.
+//
+// Examples:
+//   - e.ChangeTense("The job runs nightly", TensePast) returns "The job ran nightly"
+//   - e.ChangeTense("The users log in", TensePerfect) returns "The users have logged in"
+func (e *Engine) ChangeTense(sentence string, tense Tense) (string, error) {
+	spans := sentenceWordPattern.FindAllStringIndex(sentence, -1)
+	text := make([]string, len(spans))
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		text[i] = sentence[span[0]:span[1]]
+		words[i] = strings.ToLower(text[i])
+	}
+	vp, ok := e.findVerbPhrase(text, words)
+	if !ok {
+		return sentence, fmt.Errorf("%w: %q", ErrNoVerb, sentence)
+	}
+	if vp.modal {
+		return sentence, nil
+	}
+
+	start, end := spans[vp.first][0], spans[vp.last][1]
+	firstPerson := vp.first > 0 && words[vp.first-1] == "i"
+	number := vp.number
+	if number == 0 {
+		number = 1
+		if e.isPluralSubject(strings.TrimSpace(sentence[:start])) {
+			number = 2
+		}
+	}
+
+	phrase := conjugate(vp.base, tense, number, firstPerson)
+	if vp.negative {
+		phrase = NegateWithOptions(phrase, vp.contracted)
+	}
+	return sentence[:start] + matchCase(text[vp.first], phrase) + sentence[end:], nil
+}
+
+// findVerbPhrase returns the main verb phrase of a sentence, given its
+// words as written and in lowercase.
+func (e *Engine) findVerbPhrase(text, words []string) (verbPhrase, bool) {
+	for i, w := range words {
+		if vp, ok := auxiliaryPhrase(words, i); ok {
+			return vp, true
+		}
+		// A lexical verb follows its subject, which is at least a noun
+		if i == 0 || isSubjectWord(w) || functionWords[w] || questionDeterminers[words[i-1]] {
+			continue
+		}
+		vp := verbPhrase{first: i, last: i}
+		prev := text[i-1]
+		if i == 1 && !properNames[words[0]] {
+			// A capital at the start of the sentence does not mark a name
+			prev = words[0]
+		}
+		if base, ok := pastTenseBase(w); ok {
+			vp.base = base
+			return vp, true
+		}
+		if base, ok := thirdPersonBase(w); ok && !e.isPluralSubject(prev) {
+			// "users" in "the new users log in" is the subject
+			if i+1 == len(words) || !isBaseVerb(words[i+1]) || !e.isModifier(words[i-1]) {
+				vp.base, vp.number = base, 1
+				return vp, true
+			}
+		}
+		if isBaseVerb(w) && (words[i-1] == "i" || e.isPluralSubject(prev)) {
+			vp.base, vp.number = w, 2
+			return vp, true
+		}
+	}
+	return verbPhrase{}, false
+}
+
+// auxiliaryPhrase returns the verb phrase starting at words[i] if that is a
+// form of "be", "have", or "do", or a modal verb, with any "not" and the
+// verb that follows it.
+func auxiliaryPhrase(words []string, i int) (verbPhrase, bool) {
+	w := words[i]
+	lower, negative := w, false
+	if positive, ok := verbPositives[w]; ok {
+		lower, negative = positive, true
+	}
+	switch lower {
+	case "am", "is", "are", "was", "were", "will", "has", "have", "had", "do", "does", "did":
+	default:
+		if verbUnchanged[lower] || lower == "may" {
+			return verbPhrase{first: i, last: i, modal: true}, true
+		}
+		return verbPhrase{}, false
+	}
+
+	next := i + 1
+	if !negative && next < len(words) && words[next] == "not" {
+		negative = true
+		next++
+	}
+	vp := verbPhrase{first: i, last: next - 1, negative: negative, contracted: w != lower}
+	hasNext := next < len(words)
+
+	switch lower {
+	case "am", "is", "are", "was", "were":
+		vp.base = "be"
+		switch lower {
+		case "is":
+			vp.number = 1
+		case "are", "were":
+			vp.number = 2
+		}
+	case "will":
+		if !hasNext {
+			return verbPhrase{}, false
+		}
+		vp.base, vp.last = words[next], next
+	case "has", "have", "had":
+		vp.base = "have"
+		if hasNext && isPastParticiple(words[next]) {
+			vp.base, vp.last = verbBase(words[next]), next
+		}
+		vp.number = map[string]int{"has": 1, "have": 2}[lower]
+	case "do", "does", "did":
+		vp.base = "do"
+		if hasNext && isBaseVerb(words[next]) {
+			vp.base, vp.last = words[next], next
+		}
+		vp.number = map[string]int{"does": 1, "do": 2}[lower]
+	}
+	return vp, true
+}
+
+// conjugate returns the verb phrase for a base verb in the given tense,
+// agreeing with a subject of the given number.
+func conjugate(base string, tense Tense, number int, firstPerson bool) string {
+	plural := number == 2 || firstPerson
+	switch tense {
+	case TensePast:
+		if base == "be" {
+			if number == 2 && !firstPerson {
+				return "were"
+			}
+			return "was"
+		}
+		return PastTense(base)
+	case TensePerfect:
+		participle := PastParticiple(base)
+		if plural {
+			return "have " + participle
+		}
+		return "has " + participle
+	case TenseFuture:
+		return "will " + base
+	}
+	switch {
+	case base == "be" && firstPerson:
+		return "am"
+	case base == "be" && plural:
+		return "are"
+	case base == "be":
+		return "is"
+	case plural:
+		return base
+	}
+	if singular, ok := verbPluralToSingular[base]; ok {
+		return singular
+	}
+	return thirdPersonSingular(base)
+}
+
+// pastTenseBase returns the base form of a lowercase simple past verb:
+// "ran" -> "run", "walked" -> "walk".
+func pastTenseBase(w string) (string, bool) {
+	base := verbLemma(w)
+	if base == "" || base == w || !slices.ContainsFunc(dialects, func(d Dialect) bool { return PastTenseIn(base, d) == w }) {
+		return "", false
+	}
+	return base, true
+}
+
+// thirdPersonBase returns the base form of a lowercase third-person
+// singular verb: "runs" -> "run", "has" -> "have".
+func thirdPersonBase(w string) (string, bool) {
+	if !strings.HasSuffix(w, "s") {
+		return "", false
+	}
+	base := verbLemma(w)
+	if base == "" || base == w || verbPluralToSingular[base] != w && thirdPersonSingular(base) != w {
+		return "", false
+	}
+	return base, true
+}
+
+// isPastParticiple reports whether a lowercase word is a past participle,
+// such as "been", "written", or "logged".
+func isPastParticiple(w string) bool {
+	if isSubjectWord(w) || functionWords[w] {
+		return false
+	}
+	base := verbBase(w)
+	return slices.ContainsFunc(dialects, func(d Dialect) bool { return PastParticipleIn(base, d) == w })
+}
+
+// isBaseVerb reports whether a lowercase word could be the base form of a
+// verb, rather than a determiner, pronoun, preposition, or adverb.
+func isBaseVerb(w string) bool {
+	if isSubjectWord(w) || functionWords[w] || strings.HasSuffix(w, "ly") {
+		return false
+	}
+	base := verbLemma(w)
+	return base == "" || base == w
+}
+
+// isModifier reports whether a lowercase word is likely an adjective or
+// quantifier before a noun.
+func (e *Engine) isModifier(w string) bool {
+	if definiteModifiers[w] || commonModifiers[w] {
+		return true
+	}
+	pos, _ := e.GuessPOS(w)
+	return pos == POSAdjective
+}
+
+// isSubjectWord reports whether a lowercase word is a determiner or
+// pronoun that can start a subject.
+func isSubjectWord(w string) bool {
+	return questionDeterminers[w] || singularSubjects[w] || pluralSubjects[w] || w == "i"
+}
+
+// verbBase returns the base form of a lowercase verb.
+func verbBase(w string) string {
+	if base := verbLemma(w); base != "" {
+		return base
+	}
+	return w
+}
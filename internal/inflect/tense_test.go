@@ -0,0 +1,83 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestChangeTense(t *testing.T) {
+	tests := []struct {
+		name     string
+		sentence string
+		tense    inflect.Tense
+		want     string
+	}{
+		// Lexical verbs
+		{name: "present to past", sentence: "The job runs nightly", tense: inflect.TensePast, want: "The job ran nightly"},
+		{name: "present to perfect", sentence: "The job runs nightly", tense: inflect.TensePerfect, want: "The job has run nightly"},
+		{name: "present to future", sentence: "The job runs nightly", tense: inflect.TenseFuture, want: "The job will run nightly"},
+		{name: "past to present", sentence: "The job ran nightly", tense: inflect.TensePresent, want: "The job runs nightly"},
+		{name: "past to present plural", sentence: "The jobs ran nightly", tense: inflect.TensePresent, want: "The jobs run nightly"},
+		{name: "regular past", sentence: "The user opened the file.", tense: inflect.TensePresent, want: "The user opens the file."},
+		{name: "plural base", sentence: "The users log in", tense: inflect.TensePerfect, want: "The users have logged in"},
+		{name: "adjective before subject", sentence: "The new users log in daily", tense: inflect.TensePast, want: "The new users logged in daily"},
+		{name: "pronoun", sentence: "She watches the logs", tense: inflect.TensePast, want: "She watched the logs"},
+		{name: "I", sentence: "I walk home", tense: inflect.TensePresent, want: "I walk home"},
+		{name: "name", sentence: "Alice goes home", tense: inflect.TensePast, want: "Alice went home"},
+		{name: "name ending in s", sentence: "James runs fast", tense: inflect.TensePast, want: "James ran fast"},
+		{name: "plural subject first", sentence: "Users report bugs", tense: inflect.TensePast, want: "Users reported bugs"},
+		{name: "have as main verb", sentence: "The team has a plan", tense: inflect.TensePast, want: "The team had a plan"},
+		{name: "have to present", sentence: "The team had a plan", tense: inflect.TensePresent, want: "The team has a plan"},
+
+		// Forms of be
+		{name: "is to past", sentence: "The build is green", tense: inflect.TensePast, want: "The build was green"},
+		{name: "are to past", sentence: "The tests are green", tense: inflect.TensePast, want: "The tests were green"},
+		{name: "was to present", sentence: "The build was green", tense: inflect.TensePresent, want: "The build is green"},
+		{name: "were to perfect", sentence: "The tests were green", tense: inflect.TensePerfect, want: "The tests have been green"},
+		{name: "am to past", sentence: "I am ready", tense: inflect.TensePast, want: "I was ready"},
+		{name: "was to present I", sentence: "I was ready", tense: inflect.TensePresent, want: "I am ready"},
+		{name: "you", sentence: "You were late", tense: inflect.TensePresent, want: "You are late"},
+		{name: "future be", sentence: "The build will be green", tense: inflect.TensePast, want: "The build was green"},
+		{name: "passive", sentence: "The report was written", tense: inflect.TensePresent, want: "The report is written"},
+
+		// Auxiliaries
+		{name: "perfect to past", sentence: "The file has been deleted", tense: inflect.TensePast, want: "The file was deleted"},
+		{name: "perfect participle", sentence: "She has written a book", tense: inflect.TensePast, want: "She wrote a book"},
+		{name: "future to past", sentence: "The job will run", tense: inflect.TensePast, want: "The job ran"},
+		{name: "did to present", sentence: "He did finish", tense: inflect.TensePresent, want: "He finishes"},
+
+		// Negation
+		{name: "doesn't", sentence: "She doesn't know", tense: inflect.TensePast, want: "She didn't know"},
+		{name: "does not", sentence: "She does not know", tense: inflect.TensePast, want: "She did not know"},
+		{name: "isn't", sentence: "The build isn't green", tense: inflect.TensePast, want: "The build wasn't green"},
+		{name: "won't", sentence: "The job won't run", tense: inflect.TensePresent, want: "The job doesn't run"},
+		{name: "haven't", sentence: "They haven't left", tense: inflect.TenseFuture, want: "They won't leave"},
+
+		// Case and modals
+		{name: "capitalized verb", sentence: "Alice Runs fast", tense: inflect.TensePast, want: "Alice Ran fast"},
+		{name: "uppercase", sentence: "THE BUILD IS GREEN", tense: inflect.TensePast, want: "THE BUILD WAS GREEN"},
+		{name: "modal unchanged", sentence: "The user can log in", tense: inflect.TensePast, want: "The user can log in"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inflect.ChangeTense(tt.sentence, tt.tense)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChangeTenseNoVerb(t *testing.T) {
+	for _, sentence := range []string{"", "Nightly", "The big red dog", "!!!"} {
+		t.Run(sentence, func(t *testing.T) {
+			got, err := inflect.ChangeTense(sentence, inflect.TensePast)
+			require.ErrorIs(t, err, inflect.ErrNoVerb)
+			assert.Equal(t, sentence, got)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// ClockStyle represents the style used by ClockToWordsWithStyle.
+type ClockStyle int
+
+const (
+	// ClockCasual reads the time the way it is usually spoken on a 12-hour clock.
+	// Example: "twenty-five to three", "half past two", "noon"
+	ClockCasual ClockStyle = iota
+
+	// ClockFormal reads the hour and minute as numbers on a 12-hour clock.
+	// Example: "two thirty-five", "two oh five", "three o'clock"
+	ClockFormal
+
+	// ClockTwentyFourHour reads the hour and minute as numbers on a 24-hour clock.
+	// Example: "fourteen thirty-five", "oh nine hundred"
+	ClockTwentyFourHour
+)
+
+// DateStyle represents the style used by DateToWordsWithStyle.
+type DateStyle int
+
+const (
+	// DateSpelledOut spells out the day, month, and year.
+	// Example: "the fourth of July, nineteen seventy-six"
+	DateSpelledOut DateStyle = iota
+
+	// DateNumeric uses the month name with a numeric ordinal day and year.
+	// Example: "July 4th, 1976"
+	DateNumeric
+)
+
+// OrdinalDayStyle represents the style used by OrdinalDay.
+type OrdinalDayStyle int
+
+const (
+	// OrdinalDayMonthFirst writes the month followed by the numeric ordinal
+	// day, as is usual in American English.
+	// Example: "July 4th"
+	OrdinalDayMonthFirst OrdinalDayStyle = iota
+
+	// OrdinalDayDayFirst writes the numeric ordinal day followed by the
+	// month, as is usual in British English.
+	// Example: "4th July"
+	OrdinalDayDayFirst
+
+	// OrdinalDaySpelledOut spells out the day.
+	// Example: "the fourth of July"
+	OrdinalDaySpelledOut
+)
+
+// durationUnits lists the units used by DurationToWords, from largest to smallest.
+var durationUnits = []struct {
+	size time.Duration
+	name string
+}{
+	{24 * time.Hour, "day"},
+	{time.Hour, "hour"},
+	{time.Minute, "minute"},
+	{time.Second, "second"},
+}
+
+// DurationToWords converts a time.Duration to its English word representation.
+//
+// The duration is broken into days, hours, minutes, and seconds; zero
+// components are omitted and the rest are joined into an English list.
+// Fractions of a second are dropped, except for durations shorter than one
+// second, which are read in milliseconds.
+//
+// Examples:
+//   - DurationToWords(90*time.Minute) returns "one hour and thirty minutes"
+//   - DurationToWords(time.Hour) returns "one hour"
+//   - DurationToWords(26*time.Hour + 3*time.Second) returns "one day, two hours, and three seconds"
+//   - DurationToWords(1500*time.Millisecond) returns "one second"
+//   - DurationToWords(250*time.Millisecond) returns "two hundred fifty milliseconds"
+//   - DurationToWords(0) returns "zero seconds"
+//   - DurationToWords(-5*time.Minute) returns "negative five minutes"
+func DurationToWords(d time.Duration) string {
+	if d < 0 {
+		// The minimum duration has no positive counterpart; drop a nanosecond
+		if d == math.MinInt64 {
+			d++
+		}
+		return "negative " + DurationToWords(-d)
+	}
+
+	if d < time.Second {
+		ms := int(d / time.Millisecond)
+		if ms == 0 {
+			return countUnit(0, "second")
+		}
+		return countUnit(ms, "millisecond")
+	}
+
+	parts := make([]string, 0, len(durationUnits))
+	for _, unit := range durationUnits {
+		n := int(d / unit.size)
+		if n == 0 {
+			continue
+		}
+		parts = append(parts, countUnit(n, unit.name))
+		d -= time.Duration(n) * unit.size
+	}
+
+	return Join(parts)
+}
+
+// countUnit returns n in words followed by unit, pluralized unless n is 1.
+func countUnit(n int, unit string) string {
+	if n == 1 {
+		return NumberToWords(n) + " " + unit
+	}
+	return NumberToWords(n) + " " + unit + "s"
+}
+
+// ClockToWords converts a time of day to words in the casual spoken style.
+//
+// The hour is given on a 24-hour clock (0-23) and the minute must be 0-59;
+// out-of-range values return an empty string.
+//
+// Examples:
+//   - ClockToWords(14, 35) returns "twenty-five to three"
+//   - ClockToWords(14, 30) returns "half past two"
+//   - ClockToWords(9, 15) returns "quarter past nine"
+//   - ClockToWords(9, 7) returns "seven minutes past nine"
+//   - ClockToWords(15, 0) returns "three o'clock"
+//   - ClockToWords(12, 0) returns "noon"
+//   - ClockToWords(0, 0) returns "midnight"
+func ClockToWords(hour, minute int) string {
+	return ClockToWordsWithStyle(hour, minute, ClockCasual)
+}
+
+// ClockToWordsWithStyle converts a time of day to words in the given style.
+//
+// The hour is given on a 24-hour clock (0-23) and the minute must be 0-59;
+// out-of-range values return an empty string.
+//
+// Examples:
+//   - ClockToWordsWithStyle(14, 35, ClockCasual) returns "twenty-five to three"
+//   - ClockToWordsWithStyle(14, 35, ClockFormal) returns "two thirty-five"
+//   - ClockToWordsWithStyle(14, 5, ClockFormal) returns "two oh five"
+//   - ClockToWordsWithStyle(14, 0, ClockFormal) returns "two o'clock"
+//   - ClockToWordsWithStyle(14, 35, ClockTwentyFourHour) returns "fourteen thirty-five"
+//   - ClockToWordsWithStyle(9, 0, ClockTwentyFourHour) returns "oh nine hundred"
+func ClockToWordsWithStyle(hour, minute int, style ClockStyle) string {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return ""
+	}
+
+	switch style {
+	case ClockFormal:
+		if minute == 0 {
+			return clockHour(hour) + " o'clock"
+		}
+		return clockHour(hour) + " " + clockMinute(minute)
+	case ClockTwentyFourHour:
+		h := cardinalWord(hour)
+		if hour > 0 && hour < 10 {
+			h = "oh " + h
+		}
+		if minute == 0 {
+			return h + " hundred"
+		}
+		return h + " " + clockMinute(minute)
+	case ClockCasual:
+		return casualClock(hour, minute)
+	}
+	return casualClock(hour, minute)
+}
+
+// casualClock implements the ClockCasual style.
+func casualClock(hour, minute int) string {
+	switch {
+	case minute == 0 && hour == 0:
+		return "midnight"
+	case minute == 0 && hour == 12:
+		return "noon"
+	case minute == 0:
+		return clockHour(hour) + " o'clock"
+	case minute == 15:
+		return "quarter past " + clockHour(hour)
+	case minute == 30:
+		return "half past " + clockHour(hour)
+	case minute == 45:
+		return "quarter to " + clockHour(hour+1)
+	case minute < 30:
+		return casualMinutes(minute) + " past " + clockHour(hour)
+	default:
+		return casualMinutes(60-minute) + " to " + clockHour(hour+1)
+	}
+}
+
+// casualMinutes reads a minute count for the casual style. Multiples of
+// five are read bare ("twenty past"); other values include the unit
+// ("seven minutes past").
+func casualMinutes(m int) string {
+	if m%5 == 0 {
+		return cardinalWord(m)
+	}
+	return countUnit(m, "minute")
+}
+
+// clockHour returns the 12-hour clock word for a 24-hour clock hour.
+func clockHour(hour int) string {
+	h := hour % 12
+	if h == 0 {
+		h = 12
+	}
+	return cardinalWord(h)
+}
+
+// clockMinute reads a non-zero minute as it follows an hour ("oh five", "thirty-five").
+func clockMinute(minute int) string {
+	if minute < 10 {
+		return "oh " + cardinalWord(minute)
+	}
+	return cardinalWord(minute)
+}
+
+// YearToWords converts a year to words the way years are usually spoken.
+//
+// Four-digit years are read as two pairs of digits, with "hundred" for
+// round centuries and "oh" for single-digit years within a century.
+// Years that are multiples of 1000, and the first nine years of a
+// millennium, are read as ordinary numbers. Years outside 1000-9999 are
+// read with NumberToWords.
+//
+// Examples:
+//   - YearToWords(1976) returns "nineteen seventy-six"
+//   - YearToWords(1900) returns "nineteen hundred"
+//   - YearToWords(1905) returns "nineteen oh five"
+//   - YearToWords(2000) returns "two thousand"
+//   - YearToWords(2005) returns "two thousand five"
+//   - YearToWords(2024) returns "twenty twenty-four"
+//   - YearToWords(1066) returns "ten sixty-six"
+//   - YearToWords(800) returns "eight hundred"
+func YearToWords(year int) string {
+	if year < 1000 || year > 9999 {
+		return NumberToWords(year)
+	}
+
+	century, rest := year/100, year%100
+	switch {
+	case year%1000 == 0, century%10 == 0 && rest < 10:
+		return cardinalWord(year)
+	case rest == 0:
+		return cardinalWord(century) + " hundred"
+	case rest < 10:
+		return cardinalWord(century) + " oh " + cardinalWord(rest)
+	default:
+		return cardinalWord(century) + " " + cardinalWord(rest)
+	}
+}
+
+// OrdinalDay writes a day of the month with its ordinal, in the given
+// style, for use in running text such as "on July 4th".
+//
+// The day must exist in the month, counting February 29th; invalid months
+// and days return an empty string.
+//
+// Examples:
+//   - OrdinalDay(time.July, 4, OrdinalDayMonthFirst) returns "July 4th"
+//   - OrdinalDay(time.July, 4, OrdinalDayDayFirst) returns "4th July"
+//   - OrdinalDay(time.July, 4, OrdinalDaySpelledOut) returns "the fourth of July"
+//   - OrdinalDay(time.March, 22, OrdinalDayDayFirst) returns "22nd March"
+//   - OrdinalDay(time.April, 31, OrdinalDayMonthFirst) returns ""
+func OrdinalDay(month time.Month, day int, style OrdinalDayStyle) string {
+	// Day 0 of the next month is the last day of this one, in a leap year
+	if month < time.January || month > time.December || day < 1 ||
+		day > time.Date(2000, month+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return ""
+	}
+
+	switch style {
+	case OrdinalDayDayFirst:
+		return Ordinal(day) + " " + month.String()
+	case OrdinalDaySpelledOut:
+		return "the " + OrdinalWord(day) + " of " + month.String()
+	case OrdinalDayMonthFirst:
+		return month.String() + " " + Ordinal(day)
+	}
+	return month.String() + " " + Ordinal(day)
+}
+
+// DateToWords converts a date to words, spelling out the day, month, and year.
+//
+// Only the date portion of t is used; the time of day and location are ignored.
+//
+// Examples:
+//   - DateToWords(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC)) returns "the fourth of July, nineteen seventy-six"
+//   - DateToWords(time.Date(2001, time.September, 11, 0, 0, 0, 0, time.UTC)) returns "the eleventh of September, two thousand one"
+func DateToWords(t time.Time) string {
+	return DateToWordsWithStyle(t, DateSpelledOut)
+}
+
+// DateToWordsWithStyle converts a date to words in the given style.
+//
+// Only the date portion of t is used; the time of day and location are ignored.
+//
+// Examples:
+//   - DateToWordsWithStyle(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC), DateSpelledOut) returns "the fourth of July, nineteen seventy-six"
+//   - DateToWordsWithStyle(time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC), DateNumeric) returns "July 4th, 1976"
+func DateToWordsWithStyle(t time.Time, style DateStyle) string {
+	year, month, day := t.Date()
+
+	switch style {
+	case DateNumeric:
+		return month.String() + " " + Ordinal(day) + ", " + strconv.Itoa(year)
+	case DateSpelledOut:
+		return "the " + OrdinalWord(day) + " of " + month.String() + ", " + YearToWords(year)
+	}
+	return "the " + OrdinalWord(day) + " of " + month.String() + ", " + YearToWords(year)
+}
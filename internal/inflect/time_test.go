@@ -0,0 +1,238 @@
+package inflect_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestDurationToWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input time.Duration
+		want  string
+	}{
+		{name: "zero", input: 0, want: "zero seconds"},
+		{name: "one second", input: time.Second, want: "one second"},
+		{name: "seconds", input: 45 * time.Second, want: "forty-five seconds"},
+		{name: "one minute", input: time.Minute, want: "one minute"},
+		{name: "hour", input: time.Hour, want: "one hour"},
+		{name: "hour and minutes", input: 90 * time.Minute, want: "one hour and thirty minutes"},
+		{name: "hours and seconds", input: 2*time.Hour + 5*time.Second, want: "two hours and five seconds"},
+		{
+			name:  "three components",
+			input: 26*time.Hour + 3*time.Second,
+			want:  "one day, two hours, and three seconds",
+		},
+		{
+			name:  "all components",
+			input: 2*24*time.Hour + time.Hour + 2*time.Minute + 3*time.Second,
+			want:  "two days, one hour, two minutes, and three seconds",
+		},
+		{name: "drops fractional seconds", input: 1500 * time.Millisecond, want: "one second"},
+		{name: "milliseconds", input: 250 * time.Millisecond, want: "two hundred fifty milliseconds"},
+		{name: "one millisecond", input: time.Millisecond, want: "one millisecond"},
+		{name: "sub-millisecond", input: 500 * time.Microsecond, want: "zero seconds"},
+		{name: "negative", input: -5 * time.Minute, want: "negative five minutes"},
+		{name: "minimum duration", input: math.MinInt64, want: "negative one hundred six thousand seven hundred fifty-one days, twenty-three hours, forty-seven minutes, and sixteen seconds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DurationToWords(tt.input))
+		})
+	}
+}
+
+func TestClockToWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		hour   int
+		minute int
+		want   string
+	}{
+		{name: "midnight", hour: 0, minute: 0, want: "midnight"},
+		{name: "noon", hour: 12, minute: 0, want: "noon"},
+		{name: "o'clock", hour: 15, minute: 0, want: "three o'clock"},
+		{name: "five past", hour: 9, minute: 5, want: "five past nine"},
+		{name: "minutes past", hour: 9, minute: 7, want: "seven minutes past nine"},
+		{name: "one minute past", hour: 9, minute: 1, want: "one minute past nine"},
+		{name: "quarter past", hour: 9, minute: 15, want: "quarter past nine"},
+		{name: "half past", hour: 14, minute: 30, want: "half past two"},
+		{name: "twenty-five to", hour: 14, minute: 35, want: "twenty-five to three"},
+		{name: "quarter to", hour: 14, minute: 45, want: "quarter to three"},
+		{name: "minutes to", hour: 14, minute: 58, want: "two minutes to three"},
+		{name: "to midnight", hour: 23, minute: 50, want: "ten to twelve"},
+		{name: "after midnight", hour: 0, minute: 20, want: "twenty past twelve"},
+		{name: "invalid hour", hour: 24, minute: 0, want: ""},
+		{name: "negative hour", hour: -1, minute: 0, want: ""},
+		{name: "invalid minute", hour: 10, minute: 60, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ClockToWords(tt.hour, tt.minute))
+		})
+	}
+}
+
+func TestClockToWordsWithStyle(t *testing.T) {
+	tests := []struct {
+		name   string
+		hour   int
+		minute int
+		style  inflect.ClockStyle
+		want   string
+	}{
+		{name: "casual", hour: 14, minute: 35, style: inflect.ClockCasual, want: "twenty-five to three"},
+		{name: "formal", hour: 14, minute: 35, style: inflect.ClockFormal, want: "two thirty-five"},
+		{name: "formal oh", hour: 14, minute: 5, style: inflect.ClockFormal, want: "two oh five"},
+		{name: "formal o'clock", hour: 14, minute: 0, style: inflect.ClockFormal, want: "two o'clock"},
+		{name: "formal midnight", hour: 0, minute: 10, style: inflect.ClockFormal, want: "twelve ten"},
+		{name: "formal noon", hour: 12, minute: 0, style: inflect.ClockFormal, want: "twelve o'clock"},
+		{name: "24-hour", hour: 14, minute: 35, style: inflect.ClockTwentyFourHour, want: "fourteen thirty-five"},
+		{name: "24-hour hundred", hour: 14, minute: 0, style: inflect.ClockTwentyFourHour, want: "fourteen hundred"},
+		{name: "24-hour morning", hour: 9, minute: 0, style: inflect.ClockTwentyFourHour, want: "oh nine hundred"},
+		{name: "24-hour oh minute", hour: 9, minute: 5, style: inflect.ClockTwentyFourHour, want: "oh nine oh five"},
+		{name: "24-hour midnight", hour: 0, minute: 0, style: inflect.ClockTwentyFourHour, want: "zero hundred"},
+		{name: "24-hour late", hour: 23, minute: 59, style: inflect.ClockTwentyFourHour, want: "twenty-three fifty-nine"},
+		{name: "invalid", hour: 25, minute: 0, style: inflect.ClockFormal, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.ClockToWordsWithStyle(tt.hour, tt.minute, tt.style))
+		})
+	}
+}
+
+func TestYearToWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int
+		want  string
+	}{
+		{name: "twentieth century", input: 1976, want: "nineteen seventy-six"},
+		{name: "round century", input: 1900, want: "nineteen hundred"},
+		{name: "oh year", input: 1905, want: "nineteen oh five"},
+		{name: "millennium", input: 2000, want: "two thousand"},
+		{name: "early millennium", input: 2005, want: "two thousand five"},
+		{name: "twenty ten", input: 2010, want: "twenty ten"},
+		{name: "recent", input: 2024, want: "twenty twenty-four"},
+		{name: "medieval", input: 1066, want: "ten sixty-six"},
+		{name: "first millennium year", input: 1000, want: "one thousand"},
+		{name: "one thousand one", input: 1001, want: "one thousand one"},
+		{name: "eleven hundred", input: 1100, want: "eleven hundred"},
+		{name: "three digits", input: 800, want: "eight hundred"},
+		{name: "ancient", input: 44, want: "forty-four"},
+		{name: "zero", input: 0, want: "zero"},
+		{name: "five digits", input: 10000, want: "ten thousand"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.YearToWords(tt.input))
+		})
+	}
+}
+
+func TestDateToWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input time.Time
+		want  string
+	}{
+		{
+			name:  "independence day",
+			input: time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC),
+			want:  "the fourth of July, nineteen seventy-six",
+		},
+		{
+			name:  "compound ordinal day",
+			input: time.Date(2024, time.March, 21, 0, 0, 0, 0, time.UTC),
+			want:  "the twenty-first of March, twenty twenty-four",
+		},
+		{
+			name:  "early millennium",
+			input: time.Date(2001, time.September, 11, 0, 0, 0, 0, time.UTC),
+			want:  "the eleventh of September, two thousand one",
+		},
+		{
+			name:  "ignores time of day",
+			input: time.Date(1999, time.December, 31, 23, 59, 59, 0, time.UTC),
+			want:  "the thirty-first of December, nineteen ninety-nine",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DateToWords(tt.input))
+		})
+	}
+}
+
+func TestDateToWordsWithStyle(t *testing.T) {
+	date := time.Date(1976, time.July, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input time.Time
+		style inflect.DateStyle
+		want  string
+	}{
+		{name: "spelled out", input: date, style: inflect.DateSpelledOut, want: "the fourth of July, nineteen seventy-six"},
+		{name: "numeric", input: date, style: inflect.DateNumeric, want: "July 4th, 1976"},
+		{
+			name:  "numeric teen day",
+			input: time.Date(2023, time.November, 12, 0, 0, 0, 0, time.UTC),
+			style: inflect.DateNumeric,
+			want:  "November 12th, 2023",
+		},
+		{
+			name:  "numeric first",
+			input: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			style: inflect.DateNumeric,
+			want:  "January 1st, 2000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.DateToWordsWithStyle(tt.input, tt.style))
+		})
+	}
+}
+
+func TestOrdinalDay(t *testing.T) {
+	tests := []struct {
+		name  string
+		month time.Month
+		day   int
+		style inflect.OrdinalDayStyle
+		want  string
+	}{
+		{name: "month first", month: time.July, day: 4, style: inflect.OrdinalDayMonthFirst, want: "July 4th"},
+		{name: "day first", month: time.July, day: 4, style: inflect.OrdinalDayDayFirst, want: "4th July"},
+		{name: "spelled out", month: time.July, day: 4, style: inflect.OrdinalDaySpelledOut, want: "the fourth of July"},
+		{name: "first", month: time.January, day: 1, style: inflect.OrdinalDayDayFirst, want: "1st January"},
+		{name: "teen", month: time.November, day: 12, style: inflect.OrdinalDayMonthFirst, want: "November 12th"},
+		{name: "compound", month: time.March, day: 22, style: inflect.OrdinalDaySpelledOut, want: "the twenty-second of March"},
+		{name: "last of month", month: time.December, day: 31, style: inflect.OrdinalDayMonthFirst, want: "December 31st"},
+		{name: "leap day", month: time.February, day: 29, style: inflect.OrdinalDayDayFirst, want: "29th February"},
+		{name: "unknown style", month: time.July, day: 4, style: inflect.OrdinalDayStyle(99), want: "July 4th"},
+		{name: "day past end of month", month: time.April, day: 31, style: inflect.OrdinalDayMonthFirst, want: ""},
+		{name: "february 30th", month: time.February, day: 30, style: inflect.OrdinalDayMonthFirst, want: ""},
+		{name: "day zero", month: time.July, day: 0, style: inflect.OrdinalDayMonthFirst, want: ""},
+		{name: "invalid month", month: 13, day: 4, style: inflect.OrdinalDayMonthFirst, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.OrdinalDay(tt.month, tt.day, tt.style))
+		})
+	}
+}
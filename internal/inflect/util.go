@@ -3,12 +3,57 @@ package inflect
 import (
 	"maps"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
+// bufferPool holds byte buffers reused to build strings, so that functions
+// called in hot loops, such as Join and Inflect, allocate only their
+// results.
+var bufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 256)
+		return &b
+	},
+}
+
+// maxPooledBuffer is the capacity above which a buffer is not returned to
+// bufferPool, so that one long text does not keep its memory alive.
+const maxPooledBuffer = 64 << 10
+
+// buildString returns the string appended to an empty buffer by fill,
+// using a buffer from bufferPool.
+func buildString(fill func(dst []byte) []byte) string {
+	bp := bufferPool.Get().(*[]byte)
+	b := fill((*bp)[:0])
+	s := string(b)
+	if cap(b) <= maxPooledBuffer {
+		*bp = b
+		bufferPool.Put(bp)
+	}
+	return s
+}
+
 // isAllUpper checks if all letters in a word are uppercase.
 func isAllUpper(word string) bool {
+	// ASCII fast path, without decoding runes
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		if c >= utf8.RuneSelf {
+			return isAllUpperUnicode(word[i:])
+		}
+		if 'a' <= c && c <= 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAllUpperUnicode is isAllUpper for any text.
+func isAllUpperUnicode(word string) bool {
 	for _, r := range word {
 		if unicode.IsLetter(r) && !unicode.IsUpper(r) {
 			return false
@@ -39,22 +84,56 @@ func isProperName(word string) bool {
 	return true
 }
 
-// isProperNameEndingInS checks if a word is a proper name ending in 's'.
-// A proper name is detected by having a capitalized first letter and not being
-// all uppercase. Examples: "Jones", "Williams", "Hastings".
-func isProperNameEndingInS(word string) bool {
-	if !isProperName(word) {
-		return false
+// isVowel checks if a rune is a vowel. Accented vowels ("é", "ü") count
+// as vowels.
+func isVowel(r rune) bool {
+	if r >= utf8.RuneSelf {
+		r = baseLetter(r)
 	}
+	return strings.ContainsRune("aeiouAEIOU", r)
+}
 
-	// Check if the word ends in 's' or 'S'
-	lastRune := rune(word[len(word)-1])
-	return unicode.ToLower(lastRune) == 's'
+// baseLetter returns r with any diacritics removed: 'é' -> 'e'.
+func baseLetter(r rune) rune {
+	base, _ := utf8.DecodeRuneInString(norm.NFD.String(string(r)))
+	return base
 }
 
-// isVowel checks if a rune is a vowel.
-func isVowel(r rune) bool {
-	return strings.ContainsRune("aeiouAEIOU", r)
+// trimRunes returns s without its last n runes.
+func trimRunes(s string, n int) string {
+	for ; n > 0 && s != ""; n-- {
+		_, size := utf8.DecodeLastRuneInString(s)
+		s = s[:len(s)-size]
+	}
+	return s
+}
+
+// lastRunes returns the last n runes of s.
+func lastRunes(s string, n int) string {
+	return s[len(trimRunes(s, n)):]
+}
+
+// runeBefore returns the rune that precedes the last n runes of s, or
+// utf8.RuneError if there is none.
+func runeBefore(s string, n int) rune {
+	r, _ := utf8.DecodeLastRuneInString(trimRunes(s, n))
+	return r
+}
+
+// isNonLatinWord reports whether word contains letters but none from the
+// Latin script, such as Cyrillic or CJK words. English suffix rules do not
+// apply to these.
+func isNonLatinWord(word string) bool {
+	hasLetter := false
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			if unicode.Is(unicode.Latin, r) {
+				return false
+			}
+			hasLetter = true
+		}
+	}
+	return hasLetter
 }
 
 // matchCase adjusts the replacement to match the case pattern of the original.
@@ -62,7 +141,48 @@ func matchCase(original, replacement string) string {
 	if original == "" || replacement == "" {
 		return replacement
 	}
+	if isASCII(original) && isASCII(replacement) {
+		return matchCaseASCII(original, replacement)
+	}
+	return matchCaseUnicode(original, replacement)
+}
 
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// matchCaseASCII is matchCase for ASCII original and replacement strings,
+// which it handles byte by byte.
+func matchCaseASCII(original, replacement string) string {
+	letters, upper := 0, 0
+	for i := 0; i < len(original); i++ {
+		switch c := original[i]; {
+		case 'A' <= c && c <= 'Z':
+			letters++
+			upper++
+		case 'a' <= c && c <= 'z':
+			letters++
+		}
+	}
+
+	firstUpper := 'A' <= original[0] && original[0] <= 'Z'
+	switch {
+	case letters != 1 && upper == letters:
+		return strings.ToUpper(replacement)
+	case firstUpper && 'a' <= replacement[0] && replacement[0] <= 'z':
+		return string(replacement[0]-'a'+'A') + replacement[1:]
+	}
+	return replacement
+}
+
+// matchCaseUnicode is matchCase for any text.
+func matchCaseUnicode(original, replacement string) string {
 	// Count letters to determine if it's a single-letter word
 	letterCount := 0
 	for _, r := range original {
@@ -100,8 +220,9 @@ func matchCase(original, replacement string) string {
 }
 
 // matchSuffix returns the suffix in uppercase if the word is all uppercase.
+// Words without letters, such as "1990", take a lowercase suffix.
 func matchSuffix(word, suffix string) string {
-	if isAllUpper(word) {
+	if isAllUpper(word) && strings.IndexFunc(word, unicode.IsLetter) >= 0 {
 		return strings.ToUpper(suffix)
 	}
 	return suffix
@@ -134,6 +255,170 @@ func extractWhitespace(word string) (prefix, trimmed, suffix string) {
 	return
 }
 
+// splitLastWord splits phrase into the text before its last word, the last
+// word itself, and any trailing whitespace.
+func splitLastWord(phrase string) (head, last, tail string) {
+	trimmed := strings.TrimRightFunc(phrase, unicode.IsSpace)
+	tail = phrase[len(trimmed):]
+	i := strings.LastIndexFunc(trimmed, unicode.IsSpace)
+	if i < 0 {
+		return "", trimmed, tail
+	}
+	_, size := utf8.DecodeRuneInString(trimmed[i:])
+	return trimmed[:i+size], trimmed[i+size:], tail
+}
+
+// trailingPunctuation contains characters that can follow a word in running
+// text without being part of it. Apostrophes are excluded because they may
+// mark a possessive ("dogs'").
+const trailingPunctuation = ".,;:!?\")]}`*_\u201d\u00bb"
+
+// extractPunctuation returns the leading quotes and brackets, the bare word,
+// and the trailing punctuation of word. A closing single quote is only
+// treated as punctuation when the word also opens with one, so that
+// possessives like "dogs'" are left intact.
+func extractPunctuation(word string) (prefix, trimmed, suffix string) {
+	trimmed = strings.TrimLeft(word, leadingWrappers)
+	prefix = word[:len(word)-len(trimmed)]
+	trimmed = strings.TrimRight(trimmed, trailingPunctuation)
+	suffix = word[len(prefix)+len(trimmed):]
+
+	for _, quote := range [][2]string{{"'", "'"}, {"\u2018", "\u2019"}} {
+		if strings.Contains(prefix, quote[0]) && strings.HasSuffix(trimmed, quote[1]) {
+			trimmed = strings.TrimSuffix(trimmed, quote[1])
+			suffix = quote[1] + suffix
+		}
+	}
+	return prefix, trimmed, suffix
+}
+
+// sContractions contains contractions ending in 's, which look like
+// singular possessives but are not nouns: "it's" is "it is", not a thing
+// belonging to "it".
+var sContractions = map[string]bool{
+	"it's": true, "that's": true, "there's": true, "here's": true,
+	"what's": true, "who's": true, "where's": true, "when's": true,
+	"why's": true, "how's": true, "he's": true, "she's": true, "let's": true,
+}
+
+// titleAbbreviations contains abbreviations written with a single final
+// period, such as "Mr.", which must not be inflected as words.
+var titleAbbreviations = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "dr": true, "st": true, "jr": true,
+	"sr": true, "prof": true, "messrs": true, "etc": true, "vs": true,
+	"inc": true, "ltd": true, "co": true,
+}
+
+// isSContraction reports whether word, inside any quotes or punctuation, is
+// a contraction ending in 's, such as "it's" or "there's".
+func isSContraction(word string) bool {
+	_, trimmed, _ := extractPunctuation(word)
+	return sContractions[strings.ReplaceAll(strings.ToLower(trimmed), "\u2019", "'")]
+}
+
+// isDottedAbbreviation reports whether word, inside any quotes or
+// punctuation, is an abbreviation written with periods: "U.S.", "e.g.",
+// "Ph.D.", or a title such as "Mr.".
+func isDottedAbbreviation(word string) bool {
+	_, trimmed, suffix := extractPunctuation(word)
+	if !strings.Contains(trimmed, ".") {
+		return strings.HasPrefix(suffix, ".") && titleAbbreviations[strings.ToLower(trimmed)]
+	}
+	for seg := range strings.SplitSeq(trimmed, ".") {
+		if len(seg) == 0 || len(seg) > 2 || !isASCIILetter(seg[0]) || !isASCIILetter(seg[len(seg)-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPossessive splits a trailing possessive marker from word, returning
+// the noun, the apostrophe used (straight or curly), and whether the marker
+// is the plural form. Singular possessives ("cat's") return the noun without
+// "'s"; plural possessives ("dogs'") keep the final "s". If word is not
+// possessive, apos is empty.
+func splitPossessive(word string) (base, apos string, plural bool) {
+	lower := strings.ToLower(word)
+	for _, a := range []string{"'", "\u2019"} {
+		if strings.HasSuffix(lower, a+"s") && len(word) > len(a)+1 {
+			return word[:len(word)-len(a)-1], a, false
+		}
+		if strings.HasSuffix(lower, "s"+a) && len(word) > len(a)+1 {
+			return word[:len(word)-len(a)], a, true
+		}
+	}
+	return word, "", false
+}
+
+// splitExtension splits a file name such as "child.json" or "file.tar.gz"
+// into the name and its extensions, so that only the name is inflected.
+// The name must end in at least two letters and each extension must be at
+// most five lowercase letters or digits, or two to five uppercase ones, so
+// abbreviations like "e.g" and "Ph.D" are not split. If word is not a file
+// name, ext is empty.
+func splitExtension(word string) (name, ext string) {
+	for i := 0; i < len(word); i++ {
+		if word[i] != '.' || i < 2 || !isASCIILetter(word[i-1]) || !isASCIILetter(word[i-2]) {
+			continue
+		}
+		valid := true
+		for seg := range strings.SplitSeq(word[i+1:], ".") {
+			if !isExtension(seg) {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return word[:i], word[i:]
+		}
+	}
+	return word, ""
+}
+
+// isExtension reports whether seg looks like a file extension, such as
+// "json", "gz", "c", or "PDF".
+func isExtension(seg string) bool {
+	if seg == "" || len(seg) > 5 {
+		return false
+	}
+	lower := strings.IndexFunc(seg, func(r rune) bool { return !('a' <= r && r <= 'z' || '0' <= r && r <= '9') }) < 0
+	upper := strings.IndexFunc(seg, func(r rune) bool { return !('A' <= r && r <= 'Z' || '0' <= r && r <= '9') }) < 0
+	return lower || upper && len(seg) >= 2
+}
+
+// isASCIILetter reports whether c is an ASCII letter.
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// isLowerASCII reports whether word is made only of the letters a to z.
+func isLowerASCII(word string) bool {
+	if word == "" {
+		return false
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isVersionNumber reports whether word is a dotted version number such as
+// "2.0" or "v1.2.3", which is left unchanged by Plural and Singular.
+func isVersionNumber(word string) bool {
+	word = strings.TrimPrefix(strings.TrimPrefix(word, "v"), "V")
+	if !strings.Contains(word, ".") {
+		return false
+	}
+	for seg := range strings.SplitSeq(word, ".") {
+		if seg == "" || strings.IndexFunc(seg, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // IsPlural checks if a word appears to be in plural form.
 //
 // This function checks if the word is different from its singular form,
@@ -208,6 +493,89 @@ func Capitalize(s string) string {
 	return string(runes)
 }
 
+// sentenceAbbreviations contains abbreviations, without their final period
+// and in lowercase, that usually do not end a sentence.
+var sentenceAbbreviations = map[string]bool{
+	"e.g": true, "i.e": true, "vs": true, "cf": true, "approx": true,
+	"mr": true, "mrs": true, "ms": true, "dr": true, "prof": true, "st": true,
+}
+
+// CapitalizeSentence capitalizes the first letter of each sentence in s.
+//
+// A sentence starts at the beginning of s and after a period, question
+// mark, or exclamation mark followed by a space, skipping any quotes or
+// brackets. Common abbreviations such as "e.g." and "Dr.", single
+// initials, and a quoted question or exclamation do not end a sentence. Nothing is lowercased, and words like
+// "iPhone" and "eBay", which start with a lowercase letter but contain an
+// uppercase one, are left unchanged.
+//
+// Examples:
+//   - CapitalizeSentence("no errors were found. an apple a day.") returns "No errors were found. An apple a day."
+//   - CapitalizeSentence("3 files changed! see the log") returns "3 files changed! See the log"
+//   - CapitalizeSentence("see e.g. the FAQ") returns "See e.g. the FAQ"
+//   - CapitalizeSentence("iPhone sales grew") returns "iPhone sales grew"
+//   - CapitalizeSentence(`"why?" she asked`) returns `"Why?" she asked`
+func CapitalizeSentence(s string) string {
+	runes := []rune(s)
+	start := true
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if start {
+			switch {
+			case unicode.IsSpace(r) || strings.ContainsRune(leadingWrappers, r):
+				continue
+			case unicode.IsLower(r) && !hasInnerUpper(runes[i:]):
+				runes[i] = unicode.ToUpper(r)
+			}
+			start = false
+			continue
+		}
+		if r != '.' && r != '?' && r != '!' {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && strings.ContainsRune(trailingWrappers, runes[j]) {
+			j++
+		}
+		if j < len(runes) && !unicode.IsSpace(runes[j]) {
+			continue
+		}
+		// A question or exclamation in quotes is usually followed by a
+		// dialogue tag, as in "why?" she asked
+		if r != '.' && j > i+1 || r == '.' && isAbbreviationBefore(runes[:i]) {
+			continue
+		}
+		start = true
+	}
+	return string(runes)
+}
+
+// hasInnerUpper reports whether the word at the start of runes contains an
+// uppercase letter after its first letter, as in "iPhone".
+func hasInnerUpper(runes []rune) bool {
+	for i, r := range runes {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+		if i > 0 && unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAbbreviationBefore reports whether text ends with an abbreviation or a
+// single initial, so that a following period does not end a sentence.
+func isAbbreviationBefore(text []rune) bool {
+	i := len(text)
+	for i > 0 && (unicode.IsLetter(text[i-1]) || text[i-1] == '.') {
+		i--
+	}
+	word := string(text[i:])
+	return utf8.RuneCountInString(word) == 1 && unicode.IsUpper(text[i]) ||
+		sentenceAbbreviations[strings.ToLower(word)]
+}
+
 // Titleize converts a string to title case.
 //
 // Each word's first letter is capitalized, rest are lowercased.
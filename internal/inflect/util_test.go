@@ -139,6 +139,36 @@ func TestCapitalize(t *testing.T) {
 	}
 }
 
+func TestCapitalizeSentence(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty string", input: "", want: ""},
+		{name: "single sentence", input: "no errors were found", want: "No errors were found"},
+		{name: "several sentences", input: "done. an apple a day! why? because.", want: "Done. An apple a day! Why? Because."},
+		{name: "leading space", input: "  hello", want: "  Hello"},
+		{name: "number first", input: "3 files changed. see the log", want: "3 files changed. See the log"},
+		{name: "nothing lowercased", input: "the URL and NASA. ok", want: "The URL and NASA. Ok"},
+		{name: "brand name", input: "iPhone sales grew. eBay fell.", want: "iPhone sales grew. eBay fell."},
+		{name: "quoted sentence", input: `"why?" she asked. "because."`, want: `"Why?" she asked. "Because."`},
+		{name: "bracketed", input: "see above. (it was late.)", want: "See above. (It was late.)"},
+		{name: "abbreviation", input: "use e.g. the FAQ, i.e. the docs", want: "Use e.g. the FAQ, i.e. the docs"},
+		{name: "title", input: "ask dr. smith", want: "Ask dr. smith"},
+		{name: "initial", input: "by J. doe", want: "By J. doe"},
+		{name: "no space after period", input: "see v1.2.release", want: "See v1.2.release"},
+		{name: "newline", input: "first.\nsecond", want: "First.\nSecond"},
+		{name: "unicode", input: "ça va. über", want: "Ça va. Über"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.CapitalizeSentence(tt.input))
+		})
+	}
+}
+
 func TestTitleize(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,112 @@
+package inflect
+
+import "strings"
+
+// PluralLabel describes the kind of a plural form returned by AllPlurals.
+type PluralLabel string
+
+const (
+	// PluralModern is the English plural, used with the default settings.
+	// Example: "octopus" -> "octopuses"
+	PluralModern PluralLabel = "modern"
+
+	// PluralAssimilated is a plural formed by a classical pattern that does
+	// not match the word's origin, but has entered common use.
+	// Example: "octopus" -> "octopi"
+	PluralAssimilated PluralLabel = "assimilated"
+
+	// PluralClassical is the plural used in classical mode.
+	// Example: "octopus" -> "octopodes"
+	PluralClassical PluralLabel = "classical"
+)
+
+// PluralForm is a plural of a noun and its kind.
+type PluralForm struct {
+	// Plural is the plural, in the case of the singular.
+	Plural string
+
+	// Label is the kind of plural.
+	Label PluralLabel
+}
+
+// assimilatedPlurals maps lowercase nouns to plurals by a classical pattern
+// that does not match their origin, such as the Latin -i of "octopi" for
+// a Greek noun.
+var assimilatedPlurals = map[string]string{
+	"octopus":  "octopi",
+	"platypus": "platypi",
+}
+
+// AllPlurals returns every accepted plural of a noun with its kind: the
+// modern plural first, then any assimilated and classical plurals.
+//
+// Unlike Plural, the result does not depend on classical or technical mode,
+// so it can be used to offer a choice of plurals. Nouns with a single
+// plural return one form, labeled PluralModern. Plurals defined with DefNoun
+// and DefNounMulti are included, and the forms keep the case of word.
+//
+// Examples:
+//   - AllPlurals("octopus") returns [{octopuses modern} {octopi assimilated} {octopodes classical}]
+//   - AllPlurals("person") returns [{people modern} {persons classical}]
+//   - AllPlurals("index") returns [{indexes modern} {indices classical}]
+//   - AllPlurals("cat") returns [{cats modern}]
+func AllPlurals(word string) []PluralForm {
+	return defaultEngine.AllPlurals(word)
+}
+
+// AllPlurals returns every accepted plural of a noun with its kind, using
+// the nouns defined on this engine. See the package-level AllPlurals for
+// details.
+//
+// Examples:
+//
+//	e := NewEngine()
+//	e.DefNounMulti("virus", "viruses", "viri")
+//	e.AllPlurals("virus") // returns [{viruses modern} {viri classical}]
+func (e *Engine) AllPlurals(word string) []PluralForm {
+	if word == "" {
+		return nil
+	}
+	lower := strings.ToLower(word)
+
+	e.mu.RLock()
+	irregular, isIrregular := e.irregularPlurals[lower]
+	classicalNoun, isClassicalNoun := e.classicalNouns[lower]
+	herd := e.isHerdLocked(lower)
+	e.mu.RUnlock()
+
+	var modern, classical string
+	switch technical, isTechnical := technicalPlurals[lower]; {
+	case isClassicalNoun:
+		modern, classical = irregular, classicalNoun
+	case isTechnical && isIrregular:
+		modern, classical = technical, irregular
+	case lower == "person" && isIrregular:
+		modern, classical = irregular, "persons"
+	case classicalLatinPlurals[lower] != "":
+		modern, classical = irregular, classicalLatinPlurals[lower]
+		if !isIrregular {
+			modern = e.applySuffixRules(word, lower, false, nil)
+		}
+	case herd && !isIrregular:
+		modern, classical = e.applySuffixRules(word, lower, false, nil), word
+	default:
+		modern = e.plural(word)
+	}
+
+	forms := []PluralForm{{Plural: matchCase(word, modern), Label: PluralModern}}
+	add := func(plural string, label PluralLabel) {
+		if plural == "" {
+			return
+		}
+		for _, f := range forms {
+			if strings.EqualFold(f.Plural, plural) {
+				return
+			}
+		}
+		forms = append(forms, PluralForm{Plural: matchCase(word, plural), Label: label})
+	}
+	add(assimilatedPlurals[lower], PluralAssimilated)
+	add(classical, PluralClassical)
+	return forms
+}
@@ -0,0 +1,82 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	inflect "github.com/cv/go-inflect/v2"
+)
+
+func TestAllPlurals(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want []inflect.PluralForm
+	}{
+		{name: "modern assimilated classical", word: "octopus", want: []inflect.PluralForm{
+			{Plural: "octopuses", Label: inflect.PluralModern},
+			{Plural: "octopi", Label: inflect.PluralAssimilated},
+			{Plural: "octopodes", Label: inflect.PluralClassical},
+		}},
+		{name: "keeps case", word: "Platypus", want: []inflect.PluralForm{
+			{Plural: "Platypuses", Label: inflect.PluralModern},
+			{Plural: "Platypi", Label: inflect.PluralAssimilated},
+			{Plural: "Platypodes", Label: inflect.PluralClassical},
+		}},
+		{name: "classical latin", word: "formula", want: []inflect.PluralForm{
+			{Plural: "formulas", Label: inflect.PluralModern},
+			{Plural: "formulae", Label: inflect.PluralClassical},
+		}},
+		{name: "persons", word: "person", want: []inflect.PluralForm{
+			{Plural: "people", Label: inflect.PluralModern},
+			{Plural: "persons", Label: inflect.PluralClassical},
+		}},
+		{name: "technical", word: "index", want: []inflect.PluralForm{
+			{Plural: "indexes", Label: inflect.PluralModern},
+			{Plural: "indices", Label: inflect.PluralClassical},
+		}},
+		{name: "herd", word: "bison", want: []inflect.PluralForm{
+			{Plural: "bisons", Label: inflect.PluralModern},
+			{Plural: "bison", Label: inflect.PluralClassical},
+		}},
+		{name: "irregular", word: "child", want: []inflect.PluralForm{
+			{Plural: "children", Label: inflect.PluralModern},
+		}},
+		{name: "regular", word: "cat", want: []inflect.PluralForm{
+			{Plural: "cats", Label: inflect.PluralModern},
+		}},
+		{name: "empty", word: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inflect.AllPlurals(tt.word))
+		})
+	}
+}
+
+func TestAllPluralsIgnoresModes(t *testing.T) {
+	e := inflect.NewEngine(inflect.WithClassical(inflect.ClassicalModeAll))
+	e.SetTechnicalPlurals(true)
+
+	assert.Equal(t, inflect.AllPlurals("octopus"), e.AllPlurals("octopus"))
+	assert.Equal(t, inflect.AllPlurals("person"), e.AllPlurals("person"))
+	assert.Equal(t, inflect.AllPlurals("index"), e.AllPlurals("index"))
+	assert.Equal(t, inflect.AllPlurals("bison"), e.AllPlurals("bison"))
+}
+
+func TestAllPluralsCustomNouns(t *testing.T) {
+	e := inflect.NewEngine()
+	e.DefNounMulti("virus", "viruses", "viri")
+	assert.Equal(t, []inflect.PluralForm{
+		{Plural: "viruses", Label: inflect.PluralModern},
+		{Plural: "viri", Label: inflect.PluralClassical},
+	}, e.AllPlurals("virus"))
+
+	e.DefNoun("octopus", "octopi")
+	assert.Equal(t, []inflect.PluralForm{
+		{Plural: "octopi", Label: inflect.PluralModern},
+		{Plural: "octopodes", Label: inflect.PluralClassical},
+	}, e.AllPlurals("octopus"))
+}
@@ -1,46 +1,107 @@
 package inflect
 
-// verbSingularToPlural maps singular verb forms to plural forms.
-var verbSingularToPlural = map[string]string{
-	"is":      "are",
-	"was":     "were",
-	"has":     "have",
-	"does":    "do",
-	"goes":    "go",
-	"isn't":   "aren't",
-	"wasn't":  "weren't",
-	"hasn't":  "haven't",
-	"doesn't": "don't",
+// verbSingularToPlural maps singular verb forms to plural forms. The
+// contracted negatives ("isn't" -> "aren't") are added from verbNegatives.
+var verbSingularToPlural = withNegatives(map[string]string{
+	"is":   "are",
+	"was":  "were",
+	"has":  "have",
+	"does": "do",
+	"goes": "go",
+})
+
+// verbPluralToSingular maps plural verb forms to singular forms. The
+// contracted negatives ("aren't" -> "isn't") are added from verbNegatives.
+var verbPluralToSingular = withNegatives(map[string]string{
+	"are":  "is",
+	"were": "was",
+	"have": "has",
+	"do":   "does",
+	"go":   "goes",
+})
+
+// verbUnchanged contains verbs that don't change between singular and
+// plural. The contracted negatives ("can't", "won't") are added from
+// verbNegatives.
+var verbUnchanged = withNegativeModals(map[string]bool{
+	"can":    true,
+	"could":  true,
+	"may":    true,
+	"might":  true,
+	"must":   true,
+	"shall":  true,
+	"should": true,
+	"will":   true,
+	"would":  true,
+})
+
+// sBaseVerbs contains verbs whose base form ends in a single s, which
+// PluralVerb must not take for the third-person -s: "they focus", not
+// "they focu".
+var sBaseVerbs = map[string]bool{
+	"bias": true, "bus": true, "canvas": true, "caucus": true,
+	"chorus": true, "defocus": true, "focus": true, "gas": true,
+	"nonplus": true, "refocus": true,
 }
 
-// verbPluralToSingular maps plural verb forms to singular forms.
-var verbPluralToSingular = map[string]string{
-	"are":     "is",
-	"were":    "was",
-	"have":    "has",
-	"do":      "does",
-	"go":      "goes",
-	"aren't":  "isn't",
-	"weren't": "wasn't",
-	"haven't": "hasn't",
-	"don't":   "doesn't",
+// verbNegatives maps auxiliary and modal verbs to their contracted
+// negative forms.
+var verbNegatives = map[string]string{
+	"is":     "isn't",
+	"are":    "aren't",
+	"was":    "wasn't",
+	"were":   "weren't",
+	"has":    "hasn't",
+	"have":   "haven't",
+	"had":    "hadn't",
+	"does":   "doesn't",
+	"do":     "don't",
+	"did":    "didn't",
+	"can":    "can't",
+	"could":  "couldn't",
+	"might":  "mightn't",
+	"must":   "mustn't",
+	"shall":  "shan't",
+	"should": "shouldn't",
+	"will":   "won't",
+	"would":  "wouldn't",
+}
+
+// verbPositives maps contracted negatives back to their positive forms.
+var verbPositives = func() map[string]string {
+	positives := make(map[string]string, len(verbNegatives))
+	for verb, neg := range verbNegatives {
+		positives[neg] = verb
+	}
+	return positives
+}()
+
+// withNegatives returns table with an entry added for the contracted
+// negative of each pair of verbs that have one.
+func withNegatives(table map[string]string) map[string]string {
+	result := make(map[string]string, 2*len(table))
+	for from, to := range table {
+		result[from] = to
+		fromNeg, ok1 := verbNegatives[from]
+		toNeg, ok2 := verbNegatives[to]
+		if ok1 && ok2 {
+			result[fromNeg] = toNeg
+		}
+	}
+	return result
 }
 
-// verbUnchanged contains verbs that don't change between singular and plural.
-var verbUnchanged = map[string]bool{
-	"can":     true,
-	"could":   true,
-	"may":     true,
-	"might":   true,
-	"must":    true,
-	"shall":   true,
-	"should":  true,
-	"will":    true,
-	"would":   true,
-	"can't":   true,
-	"won't":   true,
-	"shan't":  true,
-	"mustn't": true,
+// withNegativeModals returns set with the contracted negative of each verb
+// that has one added.
+func withNegativeModals(set map[string]bool) map[string]bool {
+	result := make(map[string]bool, 2*len(set))
+	for verb := range set {
+		result[verb] = true
+		if neg, ok := verbNegatives[verb]; ok {
+			result[neg] = true
+		}
+	}
+	return result
 }
 
 // adjSingularToPlural maps singular adjectives to plural forms.
@@ -0,0 +1,127 @@
+//go:build ignore
+
+// bench-gate compares two sets of benchmark results and fails if any
+// benchmark in both got slower or allocates more.
+//
+// Results are read in the format written by go test -bench -benchmem, so
+// the same files can be passed to benchstat. A benchmark regresses if its
+// median ns/op grew by more than the threshold, or if its median allocs/op
+// grew at all, since allocation counts do not depend on machine load.
+//
+// Usage:
+//
+//	go run ./tools/bench-gate.go [-threshold 10] old.txt new.txt
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// samples holds the measurements of one benchmark, by unit.
+type samples map[string][]float64
+
+func main() {
+	threshold := flag.Float64("threshold", 10, "allowed ns/op increase, in percent")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		log.Fatal("usage: go run ./tools/bench-gate.go [-threshold 10] old.txt new.txt")
+	}
+
+	old, err := readResults(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	cur, err := readResults(flag.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(cur))
+	for name := range cur {
+		if _, ok := old[name]; ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	if len(names) == 0 {
+		log.Fatal("no benchmarks in common")
+	}
+
+	failed := 0
+	for _, name := range names {
+		for _, unit := range []string{"ns/op", "allocs/op"} {
+			before, after := median(old[name][unit]), median(cur[name][unit])
+			limit := before
+			if unit == "ns/op" {
+				limit *= 1 + *threshold/100
+			}
+			if after > limit {
+				fmt.Printf("REGRESSION %s: %s %.0f -> %.0f\n", name, unit, before, after)
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d regressions in %d benchmarks\n", failed, len(names))
+		os.Exit(1)
+	}
+	fmt.Printf("no regressions in %d benchmarks\n", len(names))
+}
+
+// readResults reads benchmark result lines from a file, keyed by benchmark
+// name without the GOMAXPROCS suffix.
+func readResults(path string) (map[string]samples, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	results := make(map[string]samples)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		name := fields[0]
+		if i := strings.LastIndexByte(name, '-'); i > 0 {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				name = name[:i]
+			}
+		}
+		if results[name] == nil {
+			results[name] = make(samples)
+		}
+		// Fields after the iteration count are value-unit pairs
+		for i := 2; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			results[name][fields[i+1]] = append(results[name][fields[i+1]], v)
+		}
+	}
+	return results, scanner.Err()
+}
+
+// median returns the median of values, or 0 if there are none.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Sorted(slices.Values(values))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,116 @@
+//go:build ignore
+
+// gen-an-exceptions generates the pronunciation-based a/an exceptions
+// dictionary used by An() and ArticleFor().
+//
+// The input is a pronunciation lexicon in CMU Pronouncing Dictionary format:
+// one word per line followed by its ARPAbet phonemes, with ";;;" comments.
+// Alternate pronunciations ("word(2)") are ignored. A word takes "an" when
+// its first phoneme is a vowel.
+//
+// The checked-in lexicon is an excerpt of words whose spelling misleads the
+// a/an heuristics. To regenerate from another lexicon, such as the full CMU
+// dictionary, pass its path with -lexicon.
+//
+// Usage:
+//
+//	go run ./tools/gen-an-exceptions.go [-lexicon tools/data/an-lexicon.dict]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const outputFile = "internal/inflect/article_exceptions_gen.go"
+
+// vowelPhonemes are the ARPAbet vowel phonemes, without stress markers.
+var vowelPhonemes = map[string]bool{
+	"AA": true, "AE": true, "AH": true, "AO": true, "AW": true, "AY": true,
+	"EH": true, "ER": true, "EY": true, "IH": true, "IY": true, "OW": true,
+	"OY": true, "UH": true, "UW": true,
+}
+
+func main() {
+	lexicon := flag.String("lexicon", "tools/data/an-lexicon.dict", "path to a CMU-format pronunciation lexicon")
+	flag.Parse()
+
+	entries, err := readLexicon(*lexicon)
+	if err != nil {
+		log.Fatalf("reading %s: %v", *lexicon, err)
+	}
+
+	writeOutput(generate(entries, *lexicon))
+}
+
+// readLexicon returns a map of lowercase word to whether it takes "an".
+func readLexicon(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";;;") {
+			continue
+		}
+		// Drop trailing comments ("# ...") used by newer CMU dictionary releases
+		line, _, _ = strings.Cut(line, "#")
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		word := strings.ToLower(fields[0])
+		if strings.Contains(word, "(") {
+			continue // alternate pronunciation
+		}
+		first := strings.TrimRight(fields[1], "012")
+		entries[word] = vowelPhonemes[first]
+	}
+	return entries, scanner.Err()
+}
+
+func generate(entries map[string]bool, source string) []byte {
+	words := make([]string, 0, len(entries))
+	for w := range entries {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen-an-exceptions. DO NOT EDIT.\n\n")
+	buf.WriteString("package inflect\n\n")
+	fmt.Fprintf(&buf, "// pronunciationExceptions maps lowercase words to whether they take \"an\",\n")
+	fmt.Fprintf(&buf, "// based on their pronunciation in %s.\n", source)
+	buf.WriteString("var pronunciationExceptions = map[string]bool{\n")
+	for _, w := range words {
+		fmt.Fprintf(&buf, "\t%q: %t,\n", w, entries[w])
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func writeOutput(content []byte) {
+	formatted, err := format.Source(content)
+	if err != nil {
+		log.Fatalf("formatting output: %v", err)
+	}
+
+	if err := os.WriteFile(outputFile, formatted, 0o600); err != nil {
+		log.Fatalf("writing %s: %v", outputFile, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Generated %s\n", outputFile)
+}
@@ -0,0 +1,142 @@
+//go:build ignore
+
+// gen-context generates context-aware variants of the engine-scoped package
+// functions, such as PluralCtx and AnCtx.
+//
+// A variant is generated for every Engine method that has a package-level
+// function of the same name and returns a single string. Each variant takes
+// a context.Context as its first argument and calls the method on the Engine
+// returned by EngineFromContext.
+//
+// Usage:
+//
+//	go run ./tools/gen-context.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	internalPkg = "./internal/inflect"
+	outputFile  = "internal/inflect/context_gen.go"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, internalPkg, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != "context_gen.go"
+	}, 0)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", internalPkg, err)
+	}
+	pkg, ok := pkgs["inflect"]
+	if !ok {
+		log.Fatalf("package 'inflect' not found in %s", internalPkg)
+	}
+
+	funcs := make(map[string]bool)
+	methods := make(map[string]*ast.FuncDecl)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+			if fn.Recv == nil {
+				funcs[fn.Name.Name] = true
+			} else if isEngineReceiver(fn.Recv) && returnsString(fn.Type) {
+				methods[fn.Name.Name] = fn
+			}
+		}
+	}
+
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		if funcs[name] && !strings.HasPrefix(name, "Get") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen-context. DO NOT EDIT.\n\n")
+	buf.WriteString("package inflect\n\n")
+	buf.WriteString("import \"context\"\n")
+	for _, name := range names {
+		writeWrapper(&buf, fset, methods[name])
+	}
+
+	writeOutput(buf.Bytes())
+}
+
+// isEngineReceiver reports whether recv is a *Engine receiver.
+func isEngineReceiver(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Engine"
+}
+
+// returnsString reports whether a function returns a single string.
+func returnsString(ft *ast.FuncType) bool {
+	if ft.Results == nil || len(ft.Results.List) != 1 || len(ft.Results.List[0].Names) > 1 {
+		return false
+	}
+	ident, ok := ft.Results.List[0].Type.(*ast.Ident)
+	return ok && ident.Name == "string"
+}
+
+func writeWrapper(buf *bytes.Buffer, fset *token.FileSet, fn *ast.FuncDecl) {
+	name := fn.Name.Name
+	var params, args []string
+	for _, field := range fn.Type.Params.List {
+		var typ bytes.Buffer
+		if err := format.Node(&typ, fset, field.Type); err != nil {
+			log.Fatalf("formatting parameters of %s: %v", name, err)
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		for _, n := range field.Names {
+			params = append(params, n.Name+" "+typ.String())
+			if variadic {
+				args = append(args, n.Name+"...")
+			} else {
+				args = append(args, n.Name)
+			}
+		}
+	}
+
+	fmt.Fprintf(buf, "\n// %sCtx is like %s but uses the Engine carried by ctx.\n", name, name)
+	buf.WriteString("// See WithEngine and EngineFromContext.\n")
+	fmt.Fprintf(buf, "func %sCtx(%s) string {\n", name, strings.Join(append([]string{"ctx context.Context"}, params...), ", "))
+	fmt.Fprintf(buf, "\treturn EngineFromContext(ctx).%s(%s)\n", name, strings.Join(args, ", "))
+	buf.WriteString("}\n")
+}
+
+func writeOutput(content []byte) {
+	formatted, err := format.Source(content)
+	if err != nil {
+		log.Fatalf("formatting output: %v", err)
+	}
+
+	if err := os.WriteFile(outputFile, formatted, 0o600); err != nil {
+		log.Fatalf("writing %s: %v", outputFile, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Generated %s\n", outputFile)
+}
@@ -30,7 +30,10 @@ const (
 // stdLibImports maps types from standard library packages that need to be imported.
 // Key is the type name as it appears in the code, value is the import path.
 var stdLibImports = map[string]string{
+	"context.Context":  "context",
 	"template.FuncMap": "text/template",
+	"time.Duration":    "time",
+	"time.Time":        "time",
 }
 
 // neededImports tracks which standard library imports are needed
@@ -284,7 +287,7 @@ func exprToString(fset *token.FileSet, expr ast.Expr) string {
 // prefixInternalTypes adds impl. prefix to types defined in our package.
 func prefixInternalTypes(typeStr string) string {
 	// Known types from our package that need prefixing
-	knownTypes := []string{"Engine", "ClassicalMode", "Plurality", "PossessiveStyleType"}
+	knownTypes := []string{"Engine", "ClassicalMode", "Plurality", "PossessiveStyleType", "QuantityBucket"}
 
 	for _, t := range knownTypes {
 		// Handle pointer types
@@ -31,30 +31,82 @@ type funcInfo struct {
 var fileToGroup = map[string]string{
 	"plural.go":        "nouns",
 	"singular.go":      "nouns",
+	"collective.go":    "nouns",
+	"diminutive.go":    "nouns",
+	"names.go":         "nouns",
+	"stylized.go":      "nouns",
+	"countability.go":  "nouns",
 	"article.go":       "articles",
+	"sounds.go":        "articles",
+	"an_sentence.go":   "articles",
+	"definite.go":      "articles",
 	"adjective.go":     "adjectives",
 	"adverb.go":        "adverbs",
 	"verbs.go":         "verbs",
 	"participle.go":    "verbs",
 	"past_tense.go":    "verbs",
+	"phrasal.go":       "verbs",
+	"agree.go":         "verbs",
+	"negate.go":        "verbs",
+	"interrogate.go":   "verbs",
+	"passive.go":       "verbs",
+	"tense.go":         "verbs",
 	"number.go":        "numbers",
 	"ordinal.go":       "numbers",
 	"fraction.go":      "numbers",
 	"currency.go":      "numbers",
 	"counting.go":      "numbers",
+	"quantify.go":      "numbers",
+	"compact.go":       "numbers",
+	"digits.go":        "numbers",
+	"percent.go":       "numbers",
+	"phrase.go":        "formatting",
+	"inflectf.go":      "formatting",
+	"time.go":          "numbers",
 	"join.go":          "formatting",
 	"case.go":          "formatting",
 	"possessive.go":    "formatting",
+	"contraction.go":   "formatting",
 	"compare.go":       "comparison",
 	"classical.go":     "classical",
+	"technical.go":     "classical",
 	"custom.go":        "customization",
+	"rulepack.go":      "customization",
+	"rules.go":         "customization",
+	"invariant.go":     "customization",
 	"gender.go":        "gender",
 	"rails.go":         "rails",
 	"util.go":          "utility",
+	"lemma.go":         "utility",
+	"guess.go":         "utility",
+	"explain.go":       "utility",
+	"strict.go":        "utility",
+	"normalize.go":     "utility",
 	"inflect_funcs.go": "inflection",
 	"inflect.go":       "inflection",
+	"document.go":      "inflection",
+	"inflect_html.go":  "inflection",
+	"markdown.go":      "inflection",
 	"pronouns.go":      "pronouns",
 	"engine.go":        "engine",
+	"context.go":       "engine",
+	"context_gen.go":   "engine",
+	"cache.go":         "engine",
+	"hook.go":          "engine",
+	"snapshot.go":      "engine",
+	"variants.go":      "nouns",
+	"people.go":        "nouns",
+	"keys.go":          "naming",
+	"structnames.go":   "naming",
+	"sqlnames.go":      "naming",
+	"protonames.go":    "naming",
+	"graphqlnames.go":  "naming",
+	"options.go":       "engine",
+	"dialect.go":       "articles",
+	"pipeline.go":      "inflection",
+	"domain.go":        "nouns",
+	"lenient.go":       "nouns",
+	"nounphrase.go":    "nouns",
 }
 
 func main() {